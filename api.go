@@ -0,0 +1,93 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/vertikon/mcp-ultra/internal/config"
+	"github.com/vertikon/mcp-ultra/internal/features"
+	grpcserver "github.com/vertikon/mcp-ultra/internal/grpc/server"
+	httphandlers "github.com/vertikon/mcp-ultra/internal/handlers/http"
+	"github.com/vertikon/mcp-ultra/internal/ratelimit"
+	"github.com/vertikon/mcp-ultra/internal/security"
+	"github.com/vertikon/mcp-ultra/pkg/metrics"
+)
+
+// newAuthService verifies the bearer tokens of the HTTP and gRPC APIs,
+// authorizing them with OPA when security.opa.url is set. It returns nil in
+// auth mode "none", which leaves the APIs open for local development and is
+// refused in production.
+func newAuthService(cfg *config.Config, logger *zap.Logger) (*security.AuthService, error) {
+	switch cfg.Security.Auth.Mode {
+	case "", "jwt":
+	case "none":
+		if cfg.Environment == "production" {
+			return nil, errors.New(`auth mode "none" is not allowed in production`)
+		}
+		logger.Warn("Authentication disabled, every request acts for the default tenant")
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unsupported auth mode %q", cfg.Security.Auth.Mode)
+	}
+
+	var opa security.OPAAuthorizer
+	if cfg.Security.OPA.URL != "" {
+		opa = security.NewOPAService(cfg.Security.OPA, logger)
+	}
+	return security.NewAuthService(cfg.Security.Auth, logger, opa), nil
+}
+
+// newRouter serves the health, metrics, task, flag and AI APIs over HTTP.
+// With auth, the task, flag and AI routes need a bearer token and act for
+// its tenant; limiter then caps the requests of each tenant.
+func newRouter(
+	taskService httphandlers.TaskService,
+	taskFeed httphandlers.TaskFeed,
+	flagManager *features.FlagManager,
+	healthService httphandlers.HealthServiceInterface,
+	ai http.Handler,
+	auth *security.AuthService,
+	limiter *ratelimit.TenantLimiter,
+	logger *zap.Logger,
+) http.Handler {
+	var guards []func(http.Handler) http.Handler
+	if auth != nil {
+		guards = append(guards, auth.JWTMiddleware)
+	}
+	if limiter != nil {
+		guards = append(guards, limiter.Middleware)
+	}
+	var guard func(http.Handler) http.Handler
+	if len(guards) > 0 {
+		guard = func(next http.Handler) http.Handler {
+			for i := len(guards) - 1; i >= 0; i-- {
+				next = guards[i](next)
+			}
+			return next
+		}
+	}
+
+	router := httphandlers.NewRouter(taskService, taskFeed, flagManager, healthService, guard, logger)
+	router.Method("GET", "/metrics", metrics.Handler())
+	if guard != nil {
+		ai = guard(ai)
+	}
+	router.Mount("/ai", ai)
+	return router
+}
+
+// newGRPCServer creates the gRPC server. With auth, every call but health
+// checks and reflection needs a bearer token and acts for its tenant. The
+// admin plane needs the admin role, so it is closed without auth.
+func newGRPCServer(cfg config.GRPCConfig, auth *security.AuthService, logger *zap.Logger) *grpcserver.Server {
+	var opts []grpc.ServerOption
+	if auth != nil {
+		opts = grpcserver.AuthInterceptors(auth)
+	}
+	opts = append(opts, grpcserver.AdminInterceptors()...)
+	return grpcserver.NewServer(cfg, logger, opts...)
+}
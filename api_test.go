@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	taskv1 "github.com/vertikon/mcp-ultra/api/grpc/gen/task/v1"
+	"github.com/vertikon/mcp-ultra/internal/config"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/events"
+	grpcserver "github.com/vertikon/mcp-ultra/internal/grpc/server"
+	"github.com/vertikon/mcp-ultra/internal/repository/memory"
+	"github.com/vertikon/mcp-ultra/internal/security"
+	"github.com/vertikon/mcp-ultra/internal/services"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// TestAPI_TenantIsolation checks that a token of one tenant cannot read
+// another tenant's task over HTTP or gRPC
+func TestAPI_TenantIsolation(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	cfg := &config.Config{Security: config.SecurityConfig{Auth: security.AuthConfig{
+		Mode:     "jwt",
+		Issuer:   "https://auth.example.com",
+		Audience: "mcp-ultra",
+	}}}
+	auth, err := newAuthService(cfg, logger)
+	require.NoError(t, err)
+	auth.AddPublicKey("test", &key.PublicKey)
+
+	users := memory.NewUserRepository()
+	owner := &domain.User{ID: types.New(), Email: "owner@acme.example", Name: "Owner", Role: domain.RoleUser, Active: true}
+	require.NoError(t, users.Create(context.Background(), owner))
+
+	feed := events.NewTaskFeed(16, logger)
+	t.Cleanup(feed.Close)
+	taskService := services.NewTaskService(
+		memory.NewTaskRepository(),
+		users,
+		memory.NewEventRepository(),
+		memory.NewCacheRepository(),
+		logger,
+		memory.NewEventBus(),
+	)
+	task, err := taskService.CreateTask(tenant.WithID(context.Background(), "acme"), services.CreateTaskRequest{
+		Title:     "Quarterly report",
+		Priority:  domain.PriorityMedium,
+		CreatedBy: owner.ID,
+	})
+	require.NoError(t, err)
+
+	sign := func(tenantID string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, security.Claims{
+			UserID:   owner.ID.String(),
+			Role:     "user",
+			TenantID: tenantID,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    cfg.Security.Auth.Issuer,
+				Audience:  jwt.ClaimStrings{cfg.Security.Auth.Audience},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		})
+		token.Header["kid"] = "test"
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		return signed
+	}
+	acme, globex := sign("acme"), sign("globex")
+
+	t.Run("http", func(t *testing.T) {
+		router := newRouter(taskService, feed, nil, nil, http.NotFoundHandler(), auth, nil, logger)
+		get := func(path, token string) int {
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+			return rec.Code
+		}
+
+		path := "/api/v1/tasks/" + task.ID.String()
+		assert.Equal(t, http.StatusOK, get(path, acme))
+		assert.Equal(t, http.StatusNotFound, get(path, globex))
+		assert.Equal(t, http.StatusUnauthorized, get(path, ""))
+		assert.Equal(t, http.StatusUnauthorized, get("/ai/v1/router/status", ""))
+	})
+
+	t.Run("grpc", func(t *testing.T) {
+		srv := newGRPCServer(config.GRPCConfig{ShutdownTimeout: time.Second}, auth, logger)
+		taskv1.RegisterTaskServiceServer(srv, grpcserver.NewTaskServer(taskService, feed, logger))
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		go func() { _ = srv.Serve(lis) }()
+		t.Cleanup(func() { _ = srv.Shutdown(context.Background()) })
+
+		conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = conn.Close() })
+		client := taskv1.NewTaskServiceClient(conn)
+
+		get := func(token string) codes.Code {
+			ctx := context.Background()
+			if token != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+			}
+			_, err := client.GetTask(ctx, &taskv1.GetTaskRequest{Id: task.ID.String()})
+			return status.Code(err)
+		}
+
+		assert.Equal(t, codes.OK, get(acme))
+		assert.Equal(t, codes.NotFound, get(globex))
+		assert.Equal(t, codes.Unauthenticated, get(""))
+	})
+}
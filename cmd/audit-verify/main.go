@@ -0,0 +1,96 @@
+// Command audit-verify checks that the compliance audit log was not edited.
+//
+// It walks the hash chain of the configured audit store and prints the
+// result as JSON. Record the printed head and pass it back with -anchor on
+// the next run to also detect records removed from the end of the log.
+//
+//	audit-verify                          # store from CONFIG_FILE
+//	audit-verify -store file -file data/audit/compliance-audit.log
+//	audit-verify -anchor <head from the previous run>
+//
+// Exit status is 0 when the chain is intact, 1 when it is broken and 2 when
+// it could not be read.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+	"github.com/vertikon/mcp-ultra/internal/config"
+	"github.com/vertikon/mcp-ultra/internal/repository/postgres"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	storeKind := flag.String("store", "", "audit store to verify: file or postgres (default compliance.audit_logging.store)")
+	filePath := flag.String("file", "", "audit file of the file store (default compliance.audit_logging.file_path)")
+	anchor := flag.String("anchor", "", "head hash printed by a previous verification")
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "loading configuration: %v\n", err)
+		return 2
+	}
+	if *storeKind == "" {
+		*storeKind = cfg.Compliance.AuditLogging.Store
+	}
+	if *filePath == "" {
+		*filePath = cfg.Compliance.AuditLogging.FilePath
+	}
+
+	store, err := openStore(*storeKind, *filePath, cfg.Database.PostgreSQL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "opening audit store: %v\n", err)
+		return 2
+	}
+	defer func() { _ = store.Close() }()
+
+	result, err := compliance.VerifyAuditChain(context.Background(), store, *anchor)
+	if err != nil && !errors.Is(err, compliance.ErrAuditChainBroken) {
+		fmt.Fprintf(os.Stderr, "reading audit store: %v\n", err)
+		return 2
+	}
+
+	out := json.NewEncoder(os.Stdout)
+	out.SetIndent("", "  ")
+	_ = out.Encode(result)
+
+	if !result.Valid() {
+		return 1
+	}
+	return 0
+}
+
+func openStore(kind, filePath string, db config.PostgreSQLConfig) (compliance.AuditStore, error) {
+	switch kind {
+	case compliance.AuditStoreFile:
+		return compliance.NewFileAuditStore(filePath)
+	case compliance.AuditStorePostgres:
+		conn, err := postgres.Connect(db)
+		if err != nil {
+			return nil, err
+		}
+		return &closingStore{AuditStore: compliance.NewPostgresAuditStore(conn), close: conn.Close}, nil
+	default:
+		return nil, fmt.Errorf("no audit store to verify (store %q)", kind)
+	}
+}
+
+// closingStore closes the database connection it owns
+type closingStore struct {
+	compliance.AuditStore
+	close func() error
+}
+
+func (s *closingStore) Close() error {
+	return s.close()
+}
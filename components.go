@@ -0,0 +1,374 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	aicache "github.com/vertikon/mcp-ultra/internal/ai/cache"
+	"github.com/vertikon/mcp-ultra/internal/ai/wiring"
+	"github.com/vertikon/mcp-ultra/internal/cache"
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+	"github.com/vertikon/mcp-ultra/internal/compliance/datasources"
+	"github.com/vertikon/mcp-ultra/internal/config"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/events"
+	"github.com/vertikon/mcp-ultra/internal/features"
+	httphandlers "github.com/vertikon/mcp-ultra/internal/handlers/http"
+	"github.com/vertikon/mcp-ultra/internal/lifecycle"
+	natsx "github.com/vertikon/mcp-ultra/internal/nats"
+	"github.com/vertikon/mcp-ultra/internal/ratelimit"
+	"github.com/vertikon/mcp-ultra/internal/repository/memory"
+	"github.com/vertikon/mcp-ultra/internal/repository/postgres"
+	"github.com/vertikon/mcp-ultra/internal/repository/redis"
+	applog "github.com/vertikon/mcp-ultra/pkg/logger"
+	"github.com/vertikon/mcp-ultra/pkg/redisx"
+)
+
+// Component priorities: connections start first, then the messaging built
+// on them, then the workers using both. They stop in reverse.
+const (
+	priorityStorage   = 10
+	priorityMessaging = 20
+	priorityWorkers   = 30
+)
+
+// aiEventStream is the JetStream stream newAIPublisher creates when none
+// captures the AI event subjects
+const aiEventStream = "ULTRA_AI"
+
+// component adapts a dependency's start, stop and check functions to
+// lifecycle.Component. Any of them may be nil.
+type component struct {
+	name     string
+	priority int
+	start    func(ctx context.Context) error
+	stop     func(ctx context.Context) error
+	check    func(ctx context.Context) error
+
+	ready   atomic.Bool
+	healthy atomic.Bool
+}
+
+var _ lifecycle.Component = (*component)(nil)
+
+func (c *component) Name() string  { return c.name }
+func (c *component) Priority() int { return c.priority }
+
+func (c *component) Start(ctx context.Context) error {
+	if c.start != nil {
+		if err := c.start(ctx); err != nil {
+			return err
+		}
+	}
+	c.ready.Store(true)
+	c.healthy.Store(true)
+	return nil
+}
+
+func (c *component) Stop(ctx context.Context) error {
+	c.ready.Store(false)
+	if c.stop == nil {
+		return nil
+	}
+	return c.stop(ctx)
+}
+
+func (c *component) HealthCheck(ctx context.Context) error {
+	var err error
+	if c.check != nil {
+		err = c.check(ctx)
+	}
+	c.healthy.Store(err == nil)
+	return err
+}
+
+func (c *component) IsReady() bool   { return c.ready.Load() }
+func (c *component) IsHealthy() bool { return c.healthy.Load() }
+
+// repositories holds the service's storage: PostgreSQL and Redis when
+// enabled in the config, in-process otherwise
+type repositories struct {
+	tasks  domain.TaskRepository
+	users  domain.UserRepository
+	events datasources.EventStore
+	cache  domain.CacheRepository
+	flags  domain.FeatureFlagRepository
+
+	// tx and outbox are set with PostgreSQL, where task events are stored
+	// in the task's transaction and relayed once committed
+	tx     domain.Transactor
+	outbox events.OutboxStore
+}
+
+// newRepositories builds the repositories from cfg. Connections are
+// registered with manager, which checks them on start and closes them on
+// stop, and with health. PostgreSQL fails to start when its schema lacks
+// migrations of this build. Redis commands go through redisBreaker.
+func newRepositories(cfg *config.Config, manager *lifecycle.Manager, health *httphandlers.HealthService, redisBreaker *cache.CircuitBreaker, logger *zap.Logger) (repositories, error) {
+	repos := repositories{
+		tasks:  memory.NewTaskRepository(),
+		users:  memory.NewUserRepository(),
+		events: memory.NewEventRepository(),
+		cache:  memory.NewCacheRepository(),
+		flags:  memory.NewFeatureFlagRepository(),
+	}
+
+	if cfg.Database.PostgreSQL.Enabled {
+		db, err := postgres.Open(cfg.Database.PostgreSQL)
+		if err != nil {
+			return repositories{}, err
+		}
+		eventRepo := postgres.NewEventRepository(db)
+		repos.tasks = postgres.NewTaskRepository(db)
+		repos.users = postgres.NewUserRepository(db)
+		repos.events = eventRepo
+		repos.flags = postgres.NewFeatureFlagRepository(db)
+		repos.tx = postgres.NewTxManager(db)
+		repos.outbox = eventRepo
+
+		migrator, err := postgres.NewMigrator(db)
+		if err != nil {
+			return repositories{}, err
+		}
+		// Refuse to run against a schema missing this build's migrations;
+		// "mcp-ultra migrate up" applies them
+		start := func(ctx context.Context) error {
+			if err := db.PingContext(ctx); err != nil {
+				return err
+			}
+			return migrator.Check(ctx)
+		}
+
+		manager.RegisterComponent(&component{
+			name:     "postgresql",
+			priority: priorityStorage,
+			start:    start,
+			stop:     closer(db.Close),
+			check:    db.PingContext,
+		})
+		health.RegisterChecker("postgresql", httphandlers.NewDatabaseHealthChecker("postgresql", db.PingContext))
+	} else {
+		logger.Info("PostgreSQL disabled, repositories are in-process")
+	}
+
+	if cfg.Database.Redis.Enabled {
+		client := redis.NewClient(cfg.Database.Redis)
+		client.AddHook(redisBreaker.RedisHook())
+		ping := func(ctx context.Context) error { return client.Ping(ctx).Err() }
+		repos.cache = redis.NewCacheRepository(client)
+
+		manager.RegisterComponent(&component{
+			name:     "redis",
+			priority: priorityStorage,
+			start:    ping,
+			stop:     closer(client.Close),
+			check:    ping,
+		})
+		health.RegisterChecker("redis", httphandlers.NewRedisHealthChecker(ping))
+	} else {
+		logger.Info("Redis disabled, the cache is in-process")
+	}
+
+	return repos, nil
+}
+
+// newOutboxRelay registers a relay publishing the events repos.outbox
+// holds, when there is one. Events go to JetStream when NATS is up and to
+// the in-process bus otherwise.
+func newOutboxRelay(cfg *config.Config, repos repositories, natsBus *events.NATSEventBus, memBus *memory.EventBus, manager *lifecycle.Manager, logger *zap.Logger) error {
+	if repos.outbox == nil {
+		return nil
+	}
+
+	var publisher events.Publisher = memBus
+	var ensureStream func(ctx context.Context) error
+	if natsBus != nil {
+		jetStream, err := events.NewJetStreamPublisher(natsBus.Conn(), logger)
+		if err != nil {
+			return err
+		}
+		publisher = jetStream
+		ensureStream = func(ctx context.Context) error {
+			return jetStream.EnsureStream(ctx, events.DefaultEventStream)
+		}
+	}
+
+	relay := events.NewOutboxRelay(repos.outbox, publisher, events.OutboxRelayConfig{Retention: cfg.Outbox.Retention}, logger)
+	manager.RegisterComponent(&component{
+		name:     "outbox_relay",
+		priority: priorityWorkers,
+		start: func(ctx context.Context) error {
+			if ensureStream != nil {
+				if err := ensureStream(ctx); err != nil {
+					return err
+				}
+			}
+			// The relay outlives the startup context
+			relay.Start(context.Background())
+			return nil
+		},
+		stop: func(context.Context) error {
+			relay.Stop()
+			return nil
+		},
+	})
+	return nil
+}
+
+// newFlagManager builds the feature flag manager over repos and registers
+// it with manager, which loads the flags on start
+func newFlagManager(repos repositories, manager *lifecycle.Manager, logger *zap.Logger) *features.FlagManager {
+	flagManager := features.NewFlagManager(repos.flags, repos.cache, logger)
+	manager.RegisterComponent(&component{
+		name:     "feature_flags",
+		priority: priorityWorkers,
+		start:    flagManager.RefreshFlags,
+		stop: func(context.Context) error {
+			flagManager.Stop()
+			return nil
+		},
+	})
+	return flagManager
+}
+
+// watchConfig applies the runtime-changeable fields of the active config
+// now and after every update or reload of store
+func watchConfig(store *config.Store, level zap.AtomicLevel, flags *features.FlagManager, limiter *ratelimit.TenantLimiter, logger *zap.Logger) {
+	apply := func(previous, current *config.Config) {
+		if previous == nil || current.Telemetry.LogLevel != previous.Telemetry.LogLevel {
+			if l, err := zapcore.ParseLevel(current.Telemetry.LogLevel); err == nil {
+				level.SetLevel(l)
+			}
+		}
+		if previous == nil || current.Features.RefreshInterval != previous.Features.RefreshInterval {
+			flags.SetRefreshInterval(current.Features.RefreshInterval)
+		}
+		if previous == nil || current.Server.RateLimit != previous.Server.RateLimit {
+			limiter.SetLimit(current.Server.RateLimit.RequestsPerSecond, current.Server.RateLimit.Burst)
+		}
+		if previous != nil {
+			logger.Info("Applied configuration change",
+				zap.String("log_level", level.Level().String()),
+				zap.Duration("flags_refresh_interval", current.Features.RefreshInterval),
+				zap.Float64("rate_limit_rps", current.Server.RateLimit.RequestsPerSecond))
+		}
+	}
+
+	apply(nil, store.Get())
+	store.OnChange(apply, "telemetry.log_level", "features.flags_refresh_interval", "server.rate_limit")
+}
+
+// newAIConfig builds the AI wiring config: the budget ledger and cache live
+// in Redis, whose client connects on first use, guardrails mask with the
+// compliance PII detectors when framework is set, and AI events are
+// published to NATS when it is enabled and reachable. Redis commands go
+// through the "redis" breaker of breakers, and a distributed cache registers
+// its own. The returned function closes the connections.
+func newAIConfig(cfg *config.Config, framework *compliance.Framework, breakers *cache.CircuitBreakerRegistry, logger *zap.Logger) (wiring.Config, func()) {
+	redisClient := redisx.NewClient(&redisx.Options{
+		Addr:     cfg.Database.Redis.Addr,
+		Password: cfg.Database.Redis.Password,
+		DB:       cfg.Database.Redis.DB,
+		PoolSize: cfg.Database.Redis.PoolSize,
+	})
+	if breaker, ok := breakers.Get("redis"); ok {
+		redisClient.AddHook(breaker.RedisHook())
+	}
+	store, distributed := newAICacheStore(cfg, redisClient, logger)
+	if distributed != nil {
+		if err := breakers.Register("distributed_cache", "cache", distributed.Breaker()); err != nil {
+			logger.Warn("Failed to register circuit breaker", zap.Error(err))
+		}
+	}
+
+	aiConfig := wiring.Config{Logger: logger, Redis: redisClient, Cache: store, ReloadInterval: 30 * time.Second}
+	if framework != nil {
+		aiConfig.PIIManager = framework.PIIManager()
+	}
+	var natsConn *nats.Conn
+	if cfg.NATS.Enabled {
+		publisher, conn, err := newAIPublisher(cfg.NATS.URL)
+		if err != nil {
+			logger.Warn("NATS unavailable, AI events are not published", zap.Error(err))
+		} else {
+			aiConfig.Publisher, natsConn = publisher, conn
+		}
+	}
+	return aiConfig, func() {
+		if natsConn != nil {
+			natsConn.Close()
+		}
+		if distributed != nil {
+			_ = distributed.Close()
+		}
+		_ = redisClient.Close()
+	}
+}
+
+// newAIPublisher connects to NATS at url and returns a publisher of AI
+// events to JetStream, creating the aiEventStream stream on "ultra.ai.>"
+// unless one exists, and the connection for the caller to close
+func newAIPublisher(url string) (*natsx.Publisher, *nats.Conn, error) {
+	conn, err := nats.Connect(url, nats.Name("mcp-ultra-ai"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("connecting to NATS: %w", err)
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("opening JetStream: %w", err)
+	}
+	if _, err := js.StreamInfo(aiEventStream); errors.Is(err, nats.ErrStreamNotFound) {
+		_, err = js.AddStream(&nats.StreamConfig{Name: aiEventStream, Subjects: []string{"ultra.ai.>"}})
+		if err != nil {
+			conn.Close()
+			return nil, nil, fmt.Errorf("creating stream %s: %w", aiEventStream, err)
+		}
+	} else if err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("looking up stream %s: %w", aiEventStream, err)
+	}
+	return natsx.NewPublisher(js, ""), conn, nil
+}
+
+// newAICacheStore returns where cached AI answers live: the Redis cluster
+// at redis.cluster_addrs through cache.DistributedCache when set and
+// reachable, the Redis server of client otherwise. The distributed cache is
+// also returned, nil when not used, for the caller to close.
+func newAICacheStore(cfg *config.Config, client *redisx.Client, logger *zap.Logger) (aicache.Store, *cache.DistributedCache) {
+	if len(cfg.Database.Redis.ClusterAddrs) == 0 {
+		return aicache.NewRedisStore(client), nil
+	}
+
+	cacheConfig := cache.DefaultConfig()
+	cacheConfig.Addrs = cfg.Database.Redis.ClusterAddrs
+	cacheConfig.Password = cfg.Database.Redis.Password
+	distributed, err := cache.NewDistributedCache(cacheConfig, applog.FromZap(logger), nil)
+	if err != nil {
+		logger.Warn("Redis cluster unavailable, cached AI answers go to the Redis server", zap.Error(err))
+		return aicache.NewRedisStore(client), nil
+	}
+	return distributed, distributed
+}
+
+// registerBreaker adds a circuit breaker named name, guarding service, to
+// breakers. It trips with the thresholds of cache.DefaultConfig.
+func registerBreaker(breakers *cache.CircuitBreakerRegistry, name, service string) *cache.CircuitBreaker {
+	defaults := cache.DefaultConfig()
+	breaker := cache.NewCircuitBreaker(defaults.FailureThreshold, defaults.RecoveryTimeout, defaults.HalfOpenMaxRequests)
+	// Names are unique within the composition root
+	_ = breakers.Register(name, service, breaker)
+	return breaker
+}
+
+// closer adapts a Close method to a component stop function
+func closer(closeFn func() error) func(context.Context) error {
+	return func(context.Context) error { return closeFn() }
+}
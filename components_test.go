@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/inference"
+	"github.com/vertikon/mcp-ultra/internal/ai/router"
+	"github.com/vertikon/mcp-ultra/internal/ai/wiring"
+	"github.com/vertikon/mcp-ultra/internal/cache"
+	"github.com/vertikon/mcp-ultra/internal/config"
+)
+
+// writeAIDir writes feature flags with AI and its cache on, and the cache
+// config, to a temporary templates/ai directory
+func writeAIDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "config"), 0o755))
+	flags := `{"ai":{"enabled":true,"budgets":{"enforce":false},"cache":{"enabled":true}}}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "feature_flags.json"), []byte(flags), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config", "ai-cache.json"), []byte(`{"ttl_seconds": 60}`), 0o644))
+	return dir
+}
+
+func TestNewAIConfig_CachesInRedis(t *testing.T) {
+	server := miniredis.RunT(t)
+	cfg := &config.Config{}
+	cfg.Database.Redis.Addr = server.Addr()
+
+	breakers := cache.NewCircuitBreakerRegistry()
+	redisBreaker := registerBreaker(breakers, "redis", "cache")
+	aiConfig, closeAI := newAIConfig(cfg, nil, breakers, zaptest.NewLogger(t))
+	defer closeAI()
+	aiConfig.BasePathAI = writeAIDir(t)
+	aiConfig.Registry = prometheus.NewRegistry()
+	aiConfig.ReloadInterval = 0
+
+	svc, err := wiring.Init(context.Background(), aiConfig)
+	require.NoError(t, err, "the cache flag must not stop the binary")
+	defer svc.Close()
+	require.NotNil(t, svc.Cache)
+
+	ctx := context.Background()
+	req := inference.Request{Caller: inference.Caller{TenantID: "acme"}, Prompt: "Qual o status?", UseCase: inference.UseCaseGeneration}
+	rule := router.Rule{Provider: "local", Model: "echo"}
+	svc.Cache.Store(ctx, req, rule, &inference.Response{Content: "enviado"})
+
+	resp, ok := svc.Cache.Lookup(ctx, req, rule)
+	require.True(t, ok)
+	assert.Equal(t, "enviado", resp.Content)
+	assert.Len(t, server.Keys(), 1, "answers are cached in Redis")
+
+	// Redis commands go through the registered breaker
+	server.Close()
+	for i := 0; i < cache.DefaultConfig().FailureThreshold; i++ {
+		svc.Cache.Lookup(ctx, req, rule)
+	}
+	assert.Equal(t, cache.CircuitBreakerOpen, redisBreaker.State())
+}
+
+func TestNewAIConfig_PublishesToNATS(t *testing.T) {
+	natsServer, err := server.NewServer(&server.Options{Host: "127.0.0.1", Port: -1, JetStream: true, StoreDir: t.TempDir(), NoLog: true, NoSigs: true})
+	require.NoError(t, err)
+	go natsServer.Start()
+	require.True(t, natsServer.ReadyForConnections(5*time.Second), "nats-server not ready")
+	t.Cleanup(natsServer.Shutdown)
+
+	cfg := &config.Config{}
+	cfg.Database.Redis.Addr = miniredis.RunT(t).Addr()
+	cfg.NATS.Enabled = true
+	cfg.NATS.URL = natsServer.ClientURL()
+
+	aiConfig, closeAI := newAIConfig(cfg, nil, cache.NewCircuitBreakerRegistry(), zaptest.NewLogger(t))
+	defer closeAI()
+	require.NotNil(t, aiConfig.Publisher, "AI events go to NATS when it is enabled")
+	// The templates/ai of the repository, as the binary loads it
+	aiConfig.Registry = prometheus.NewRegistry()
+	aiConfig.ReloadInterval = 0
+
+	svc, err := wiring.Init(context.Background(), aiConfig)
+	require.NoError(t, err)
+	defer svc.Close()
+
+	conn, err := nats.Connect(natsServer.ClientURL())
+	require.NoError(t, err)
+	defer conn.Close()
+	sub, err := conn.SubscribeSync("ultra.ai.>")
+	require.NoError(t, err)
+	require.NoError(t, conn.Flush(), "the subscription is in place before the reload")
+
+	require.NoError(t, svc.Watcher.Reload(context.Background(), "signal"))
+
+	msg, err := sub.NextMsg(5 * time.Second)
+	require.NoError(t, err, "the reload is published")
+	assert.Equal(t, "ultra.ai.config.reload.v1", msg.Subject)
+
+	js, err := conn.JetStream()
+	require.NoError(t, err)
+	info, err := js.StreamInfo(aiEventStream)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, info.State.Msgs, "the stream keeps the event")
+}
@@ -0,0 +1,325 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/inference"
+	"github.com/vertikon/mcp-ultra/internal/ai/provider"
+	"github.com/vertikon/mcp-ultra/internal/ai/router"
+	"github.com/vertikon/mcp-ultra/pkg/redisx"
+)
+
+const shippedBudgets = "../../../templates/ai/config/ai-budgets.json"
+
+func newTestLedger(t *testing.T, opts ...LedgerOption) (*Ledger, *miniredis.Miniredis) {
+	t.Helper()
+	server := miniredis.RunT(t)
+	client := redisx.NewClient(&redisx.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	return NewLedger(client, opts...), server
+}
+
+func approx(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+
+func TestLoadBudgets_Shipped(t *testing.T) {
+	budgets, err := LoadBudgets(shippedBudgets)
+	if err != nil {
+		t.Fatalf("LoadBudgets failed: %v", err)
+	}
+	location, err := budgets.Location()
+	if err != nil || location.String() != "America/Sao_Paulo" {
+		t.Errorf("location = %v, %v", location, err)
+	}
+
+	entries := budgets.Entries("acme", "mcp-wa-autenticacao")
+	if len(entries) != 3 {
+		t.Fatalf("entries = %+v", entries)
+	}
+	if entries[1].Scope != ScopeTenant || entries[1].ID != "acme" || entries[1].Cap.DailyBRLCap != 60 {
+		t.Errorf("tenants without an entry must get the default cap in their own bucket, got %+v", entries[1])
+	}
+	if entries[2].Scope != ScopeMCP || entries[2].Cap.OnBreach != ActionBlock {
+		t.Errorf("unexpected MCP entry %+v", entries[2])
+	}
+
+	entries = budgets.Entries("", "mcp-vendas")
+	if len(entries) != 2 || entries[1].ID != DefaultID {
+		t.Errorf("anonymous callers share the default bucket and MCPs without a cap have none, got %+v", entries)
+	}
+
+	gpt4o := router.Rule{Provider: "openai", Model: "gpt-4o"}
+	if cost := budgets.Cost(gpt4o, provider.Usage{TokensIn: 1000, TokensOut: 1000}); !approx(cost, 0.0688) {
+		t.Errorf("gpt-4o cost = %v", cost)
+	}
+	if cost := budgets.Cost(router.Rule{Provider: "qwen", Model: "qwen-max"}, provider.Usage{TokensIn: 1000}); !approx(cost, 0.0022) {
+		t.Errorf("provider-wide prices must cover other models, got %v", cost)
+	}
+	if cost := budgets.Estimate(gpt4o, inference.UseCaseGeneration, []string{"um dois"}, 0); !approx(cost, 2*0.0138/1000+defaultOutputTokens*0.055/1000) {
+		t.Errorf("estimate = %v", cost)
+	}
+}
+
+func TestLoadBudgets_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"unknown action":   `{"global": {"daily_brl_cap": 10, "on_breach": "warn"}}`,
+		"zero cap":         `{"per_mcp": [{"mcp_id": "a", "daily_brl_cap": 0, "on_breach": "block"}]}`,
+		"duplicate tenant": `{"per_tenant": [{"tenant_id": "a", "daily_brl_cap": 1, "on_breach": "block"}, {"tenant_id": "a", "daily_brl_cap": 2, "on_breach": "block"}]}`,
+		"bad timezone":     `{"timezone": "Mars/Olympus"}`,
+		"unknown field":    `{"monthly": {}}`,
+	}
+	for name, raw := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "ai-budgets.json")
+			if err := os.WriteFile(path, []byte(raw), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadBudgets(path); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestLedger_Reserve(t *testing.T) {
+	ledger, _ := newTestLedger(t)
+	ctx := context.Background()
+	tenant := Entry{Scope: ScopeTenant, ID: "acme", Cap: Cap{DailyBRLCap: 1, OnBreach: ActionDegrade}}
+	mcp := Entry{Scope: ScopeMCP, ID: "mcp-vendas", Cap: Cap{DailyBRLCap: 5, OnBreach: ActionBlock}}
+	entries := []Entry{tenant, mcp}
+
+	first, err := ledger.Reserve(ctx, entries, 0.6)
+	if err != nil {
+		t.Fatalf("Reserve failed: %v", err)
+	}
+
+	_, err = ledger.Reserve(ctx, entries, 0.6)
+	var exceeded *ExceededError
+	if !errors.As(err, &exceeded) || len(exceeded.Breaches) != 1 || exceeded.Breaches[0].ID != "acme" || !approx(exceeded.Breaches[0].SpentBRL, 0.6) {
+		t.Fatalf("expected the tenant cap to be breached, got %v", err)
+	}
+	if spent, _ := ledger.Spent(ctx, mcp); !approx(spent, 0.6) {
+		t.Errorf("a refused reservation must not write to any key, MCP spent %v", spent)
+	}
+
+	// Settling below the estimate frees room for the next request
+	if err := first.Commit(ctx, 0.2); err != nil {
+		t.Fatal(err)
+	}
+	second, err := ledger.Reserve(ctx, entries, 0.6)
+	if err != nil {
+		t.Fatalf("Reserve after commit failed: %v", err)
+	}
+	if err := second.Release(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if spent, _ := ledger.Spent(ctx, tenant); !approx(spent, 0.2) {
+		t.Errorf("tenant spent = %v, want 0.2", spent)
+	}
+
+	// Free calls always fit, even over the cap
+	if err := first.Commit(ctx, 3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ledger.Reserve(ctx, entries, 0); err != nil {
+		t.Errorf("zero reservations must fit: %v", err)
+	}
+}
+
+func TestLedger_DailyRollover(t *testing.T) {
+	location, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	now := time.Date(2026, 10, 18, 23, 59, 0, 0, location)
+	ledger, server := newTestLedger(t, WithLocation(location), WithClock(func() time.Time { return now }))
+	ctx := context.Background()
+	entry := Entry{Scope: ScopeGlobal, ID: ScopeGlobal, Cap: Cap{DailyBRLCap: 1, OnBreach: ActionBlock}}
+
+	reservation, err := ledger.Reserve(ctx, []Entry{entry}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := "ai:budget:2026-10-18:global:global"
+	if ttl := server.TTL(key); ttl != 24*time.Hour+time.Minute {
+		t.Errorf("TTL = %v, want the rest of the day plus one", ttl)
+	}
+	_, err = ledger.Reserve(ctx, []Entry{entry}, 0.5)
+	var exceeded *ExceededError
+	if !errors.As(err, &exceeded) || !exceeded.ResetAt.Equal(time.Date(2026, 10, 19, 0, 0, 0, 0, location)) {
+		t.Fatalf("expected a breach resetting at local midnight, got %v", err)
+	}
+
+	// Midnight in São Paulo is 03:00 UTC
+	now = now.Add(2 * time.Minute)
+	if spent, _ := ledger.Spent(ctx, entry); spent != 0 {
+		t.Errorf("a new day must start empty, spent %v", spent)
+	}
+	if _, err := ledger.Reserve(ctx, []Entry{entry}, 0.5); err != nil {
+		t.Errorf("Reserve on the new day failed: %v", err)
+	}
+
+	// Settling after midnight lands on the day of the reservation
+	if err := reservation.Commit(ctx, 0.25); err != nil {
+		t.Fatal(err)
+	}
+	if value, _ := server.Get(key); value != "250000" {
+		t.Errorf("previous day = %s micro-BRL, want 250000", value)
+	}
+}
+
+func TestGuard(t *testing.T) {
+	budgets := &Budgets{
+		Global:  &Cap{DailyBRLCap: 0.001, OnBreach: ActionDegrade},
+		PerMCP:  []MCPCap{{MCPID: "mcp-wa-autenticacao", Cap: Cap{DailyBRLCap: 0.001, OnBreach: ActionBlock}}},
+		Pricing: []Price{{Provider: "openai", InputPer1K: 1, OutputPer1K: 1}},
+	}
+	openai := router.Rule{Provider: "openai", Model: "gpt-4o"}
+	local := router.Rule{Provider: provider.LocalName}
+	request := func(mcpID string) inference.Request {
+		return inference.Request{Caller: inference.Caller{TenantID: "acme", MCPID: mcpID}, Prompt: "resuma", UseCase: inference.UseCaseClassification}
+	}
+
+	tests := []struct {
+		name     string
+		hardStop bool
+		mcpID    string
+		rule     router.Rule
+		degrade  bool
+		blocked  bool
+	}{
+		{"degrade", true, "mcp-vendas", openai, true, true},
+		{"block wins over degrade", true, "mcp-wa-autenticacao", openai, false, true},
+		{"free rule fits", true, "mcp-wa-autenticacao", local, false, false},
+		{"soft stop only records", false, "mcp-wa-autenticacao", openai, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ledger, _ := newTestLedger(t)
+			guard := NewGuard(budgets, ledger, zaptest.NewLogger(t), WithHardStop(tt.hardStop))
+
+			reservation, err := guard.Reserve(context.Background(), request(tt.mcpID), tt.rule)
+			var exceeded *inference.BudgetExceededError
+			if blocked := errors.As(err, &exceeded); blocked != tt.blocked {
+				t.Fatalf("blocked = %v, err %v", blocked, err)
+			}
+			if tt.blocked {
+				if exceeded.Degrade != tt.degrade {
+					t.Errorf("degrade = %v, want %v", exceeded.Degrade, tt.degrade)
+				}
+				return
+			}
+			if cost := reservation.Commit(context.Background(), provider.Usage{TokensIn: 1000}); cost != budgets.Cost(tt.rule, provider.Usage{TokensIn: 1000}) {
+				t.Errorf("cost = %v", cost)
+			}
+		})
+	}
+}
+
+func TestGuard_HardStopReservesWorstCase(t *testing.T) {
+	budgets := &Budgets{
+		Global:  &Cap{DailyBRLCap: 1, OnBreach: ActionBlock},
+		Pricing: []Price{{Provider: "openai", OutputPer1K: 1}},
+	}
+	openai := router.Rule{Provider: "openai", Model: "gpt-4o"}
+	generation := func(maxTokens int) inference.Request {
+		return inference.Request{Caller: inference.Caller{TenantID: "acme"}, Prompt: "resuma", UseCase: inference.UseCaseGeneration, MaxTokens: maxTokens}
+	}
+	ctx := context.Background()
+
+	ledger, _ := newTestLedger(t)
+	guard := NewGuard(budgets, ledger, zaptest.NewLogger(t), WithHardStop(true))
+
+	var exceeded *inference.BudgetExceededError
+	if _, err := guard.Reserve(ctx, generation(0), openai); !errors.As(err, &exceeded) {
+		t.Fatalf("without max_tokens the worst case is MaxTokensLimit and breaches the cap, got %v", err)
+	}
+
+	reservation, err := guard.Reserve(ctx, generation(500), openai)
+	if err != nil {
+		t.Fatalf("Reserve failed: %v", err)
+	}
+	if cost := reservation.Commit(ctx, provider.Usage{TokensOut: 2000}); !approx(cost, 2) {
+		t.Errorf("cost = %v, want the priced usage", cost)
+	}
+	global := budgets.Entries("acme", "")[0]
+	if spent, _ := ledger.Spent(ctx, global); !approx(spent, 0.5) {
+		t.Errorf("spent = %v, want the reservation, not the usage over it", spent)
+	}
+
+	if _, err := guard.Reserve(ctx, generation(500), openai); err != nil {
+		t.Fatalf("Reserve up to the cap failed: %v", err)
+	}
+	if _, err := guard.Reserve(ctx, generation(1), openai); !errors.As(err, &exceeded) {
+		t.Fatalf("expected the cap to be reached, got %v", err)
+	}
+
+	// Without hard stop the estimate assumes the default output
+	soft := NewGuard(budgets, ledger, zaptest.NewLogger(t), WithHardStop(false))
+	if _, err := soft.Reserve(ctx, generation(0), openai); err != nil {
+		t.Errorf("soft stop must not block: %v", err)
+	}
+}
+
+func TestGuard_SoftModeChargesPastTheCap(t *testing.T) {
+	budgets := &Budgets{
+		Global:  &Cap{DailyBRLCap: 1, OnBreach: ActionBlock},
+		Pricing: []Price{{Provider: "openai", OutputPer1K: 1}},
+	}
+	openai := router.Rule{Provider: "openai", Model: "gpt-4o"}
+	req := inference.Request{Caller: inference.Caller{TenantID: "acme"}, Prompt: "resuma", UseCase: inference.UseCaseGeneration, MaxTokens: 800}
+	global := budgets.Entries("acme", "")[0]
+	ctx := context.Background()
+
+	ledger, _ := newTestLedger(t)
+	guard := NewGuard(budgets, ledger, zaptest.NewLogger(t), WithHardStop(false))
+
+	want := 0.0
+	for i := 0; i < 3; i++ {
+		reservation, err := guard.Reserve(ctx, req, openai)
+		if err != nil {
+			t.Fatalf("soft stop must not block: %v", err)
+		}
+		want += reservation.Commit(ctx, provider.Usage{TokensOut: 800})
+		if spent, _ := ledger.Spent(ctx, global); !approx(spent, want) {
+			t.Fatalf("call %d: spent = %v, want %v", i+1, spent, want)
+		}
+	}
+	if want <= global.Cap.DailyBRLCap {
+		t.Fatalf("spend %v did not pass the cap", want)
+	}
+
+	// A breached call that is released is not charged
+	reservation, err := guard.Reserve(ctx, req, openai)
+	if err != nil {
+		t.Fatalf("soft stop must not block: %v", err)
+	}
+	reservation.Release(ctx)
+	if spent, _ := ledger.Spent(ctx, global); !approx(spent, want) {
+		t.Errorf("spent = %v after release, want %v", spent, want)
+	}
+}
+
+func TestGuard_LedgerDown(t *testing.T) {
+	budgets := &Budgets{Global: &Cap{DailyBRLCap: 1, OnBreach: ActionBlock}}
+	req := inference.Request{Prompt: "x", UseCase: inference.UseCaseGeneration}
+
+	for _, hardStop := range []bool{true, false} {
+		ledger, server := newTestLedger(t)
+		server.Close()
+		guard := NewGuard(budgets, ledger, zaptest.NewLogger(t), WithHardStop(hardStop))
+
+		_, err := guard.Reserve(context.Background(), req, router.Rule{Provider: "openai"})
+		if failed := err != nil; failed != hardStop {
+			t.Errorf("hard stop %v: failed = %v (%v)", hardStop, failed, err)
+		}
+	}
+}
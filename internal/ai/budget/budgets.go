@@ -0,0 +1,250 @@
+// Package budget holds AI spend to the daily caps of
+// templates/ai/config/ai-budgets.json. Spend is kept per day in a Redis
+// ledger, shared by every instance, and reserved atomically before each
+// provider call.
+package budget
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/inference"
+	"github.com/vertikon/mcp-ultra/internal/ai/provider"
+	"github.com/vertikon/mcp-ultra/internal/ai/router"
+)
+
+// Scopes a cap applies to
+const (
+	ScopeGlobal = "global"
+	ScopeTenant = "tenant"
+	ScopeMCP    = "mcp"
+)
+
+// Actions taken when a request would go over a cap
+const (
+	// ActionDegrade moves the request down the fallback chain to a cheaper
+	// rule
+	ActionDegrade = "degrade"
+	// ActionBlock refuses the request
+	ActionBlock = "block"
+)
+
+// DefaultID is the per_tenant and per_mcp entry that applies to every
+// tenant or MCP without an entry of its own. Each of them is still
+// tracked separately.
+const DefaultID = "default"
+
+// defaultOutputTokens estimates the output of generations without
+// max_tokens
+const defaultOutputTokens = 512
+
+// Budgets is ai-budgets.json
+type Budgets struct {
+	Version string `json:"version"`
+	// Timezone is where the daily caps roll over, e.g. "America/Sao_Paulo";
+	// UTC when empty
+	Timezone  string      `json:"timezone"`
+	Global    *Cap        `json:"global"`
+	PerTenant []TenantCap `json:"per_tenant"`
+	PerMCP    []MCPCap    `json:"per_mcp"`
+	Pricing   []Price     `json:"pricing"`
+}
+
+// Cap is a daily spending limit
+type Cap struct {
+	DailyBRLCap float64 `json:"daily_brl_cap"`
+	OnBreach    string  `json:"on_breach"`
+}
+
+// TenantCap caps the spend of one tenant
+type TenantCap struct {
+	TenantID string `json:"tenant_id"`
+	Cap
+}
+
+// MCPCap caps the spend of one MCP
+type MCPCap struct {
+	MCPID string `json:"mcp_id"`
+	Cap
+}
+
+// Price is what a provider charges in BRL per thousand tokens. An entry
+// without a model prices the provider's other models.
+type Price struct {
+	Provider    string  `json:"provider"`
+	Model       string  `json:"model,omitempty"`
+	InputPer1K  float64 `json:"brl_per_1k_input"`
+	OutputPer1K float64 `json:"brl_per_1k_output"`
+}
+
+// LoadBudgets reads and validates ai-budgets.json
+func LoadBudgets(path string) (*Budgets, error) {
+	raw, err := os.ReadFile(path) // #nosec G304 -- path comes from configuration
+	if err != nil {
+		return nil, fmt.Errorf("reading AI budgets: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	var budgets Budgets
+	if err := decoder.Decode(&budgets); err != nil {
+		return nil, fmt.Errorf("decoding AI budgets: %w", err)
+	}
+	if err := budgets.Validate(); err != nil {
+		return nil, err
+	}
+	return &budgets, nil
+}
+
+// Validate checks the caps, prices and timezone
+func (b *Budgets) Validate() error {
+	if _, err := b.Location(); err != nil {
+		return err
+	}
+	if b.Global != nil {
+		if err := b.Global.validate(); err != nil {
+			return fmt.Errorf("global: %w", err)
+		}
+	}
+	tenants := make(map[string]bool)
+	for _, entry := range b.PerTenant {
+		if entry.TenantID == "" || tenants[entry.TenantID] {
+			return fmt.Errorf("per_tenant: missing or duplicate tenant_id %q", entry.TenantID)
+		}
+		tenants[entry.TenantID] = true
+		if err := entry.validate(); err != nil {
+			return fmt.Errorf("per_tenant %s: %w", entry.TenantID, err)
+		}
+	}
+	mcps := make(map[string]bool)
+	for _, entry := range b.PerMCP {
+		if entry.MCPID == "" || mcps[entry.MCPID] {
+			return fmt.Errorf("per_mcp: missing or duplicate mcp_id %q", entry.MCPID)
+		}
+		mcps[entry.MCPID] = true
+		if err := entry.validate(); err != nil {
+			return fmt.Errorf("per_mcp %s: %w", entry.MCPID, err)
+		}
+	}
+	for _, price := range b.Pricing {
+		if price.Provider == "" || price.InputPer1K < 0 || price.OutputPer1K < 0 {
+			return fmt.Errorf("pricing: invalid entry %+v", price)
+		}
+	}
+	return nil
+}
+
+func (c Cap) validate() error {
+	if c.DailyBRLCap <= 0 {
+		return fmt.Errorf("daily_brl_cap must be positive")
+	}
+	if c.OnBreach != ActionDegrade && c.OnBreach != ActionBlock {
+		return fmt.Errorf("on_breach must be %q or %q, got %q", ActionDegrade, ActionBlock, c.OnBreach)
+	}
+	return nil
+}
+
+// Location returns the timezone the caps roll over in
+func (b *Budgets) Location() (*time.Location, error) {
+	if b.Timezone == "" {
+		return time.UTC, nil
+	}
+	location, err := time.LoadLocation(b.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", b.Timezone, err)
+	}
+	return location, nil
+}
+
+// Entries returns the caps a request from tenantID and mcpID is held to.
+// Callers without a tenant or MCP share the default entry's bucket.
+func (b *Budgets) Entries(tenantID, mcpID string) []Entry {
+	var entries []Entry
+	if b.Global != nil {
+		entries = append(entries, Entry{Scope: ScopeGlobal, ID: ScopeGlobal, Cap: *b.Global})
+	}
+
+	tenantID = idOrDefault(tenantID)
+	var tenantCap *Cap
+	for i := range b.PerTenant {
+		if b.PerTenant[i].TenantID == tenantID {
+			tenantCap = &b.PerTenant[i].Cap
+			break
+		}
+		if b.PerTenant[i].TenantID == DefaultID {
+			tenantCap = &b.PerTenant[i].Cap
+		}
+	}
+	if tenantCap != nil {
+		entries = append(entries, Entry{Scope: ScopeTenant, ID: tenantID, Cap: *tenantCap})
+	}
+
+	mcpID = idOrDefault(mcpID)
+	var mcpCap *Cap
+	for i := range b.PerMCP {
+		if b.PerMCP[i].MCPID == mcpID {
+			mcpCap = &b.PerMCP[i].Cap
+			break
+		}
+		if b.PerMCP[i].MCPID == DefaultID {
+			mcpCap = &b.PerMCP[i].Cap
+		}
+	}
+	if mcpCap != nil {
+		entries = append(entries, Entry{Scope: ScopeMCP, ID: mcpID, Cap: *mcpCap})
+	}
+	return entries
+}
+
+func idOrDefault(id string) string {
+	if id == "" {
+		return DefaultID
+	}
+	return id
+}
+
+// price returns the price of rule. Providers without a price, like the
+// local one, cost nothing.
+func (b *Budgets) price(rule router.Rule) Price {
+	var fallback Price
+	for _, price := range b.Pricing {
+		if price.Provider != rule.Provider {
+			continue
+		}
+		if price.Model == rule.Model && rule.Model != "" {
+			return price
+		}
+		if price.Model == "" {
+			fallback = price
+		}
+	}
+	return fallback
+}
+
+// Cost is what usage of rule costs in BRL
+func (b *Budgets) Cost(rule router.Rule, usage provider.Usage) float64 {
+	price := b.price(rule)
+	return float64(usage.TokensIn)/1000*price.InputPer1K + float64(usage.TokensOut)/1000*price.OutputPer1K
+}
+
+// Estimate is the pre-flight cost of sending inputs to rule for useCase.
+// maxTokens caps the output of generations.
+func (b *Budgets) Estimate(rule router.Rule, useCase string, inputs []string, maxTokens int) float64 {
+	usage := provider.Usage{}
+	for _, input := range inputs {
+		usage.TokensIn += provider.CountTokens(input)
+	}
+	switch useCase {
+	case inference.UseCaseGeneration:
+		usage.TokensOut = maxTokens
+		if usage.TokensOut <= 0 {
+			usage.TokensOut = defaultOutputTokens
+		}
+	case inference.UseCaseClassification:
+		usage.TokensOut = 1
+	}
+	return b.Cost(rule, usage)
+}
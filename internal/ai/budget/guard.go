@@ -0,0 +1,173 @@
+package budget
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/inference"
+	"github.com/vertikon/mcp-ultra/internal/ai/provider"
+	"github.com/vertikon/mcp-ultra/internal/ai/router"
+	"github.com/vertikon/mcp-ultra/internal/ai/telemetry"
+)
+
+// GuardOption configures a Guard
+type GuardOption func(*Guard)
+
+// WithHardStop makes breaches and ledger failures stop requests, which is
+// the default. Requests then reserve their worst case, generations their
+// full max_tokens, and never settle above it, so spend stays within the
+// caps. Without it breaches are only recorded and requests go through
+// unchanged; what they spend is still charged to the ledger, past the caps.
+func WithHardStop(hardStop bool) GuardOption {
+	return func(g *Guard) {
+		g.hardStop = hardStop
+	}
+}
+
+// Guard holds inference requests to the caps of a Budgets in a Ledger. It
+// implements inference.Budget.
+type Guard struct {
+	budgets  *Budgets
+	ledger   *Ledger
+	hardStop bool
+	logger   *zap.Logger
+}
+
+var _ inference.Budget = (*Guard)(nil)
+
+// NewGuard creates a guard
+func NewGuard(budgets *Budgets, ledger *Ledger, logger *zap.Logger, opts ...GuardOption) *Guard {
+	g := &Guard{budgets: budgets, ledger: ledger, hardStop: true, logger: logger}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// Reserve holds the estimated cost of sending req to rule, or with hard
+// stop its worst case. When a cap would be breached it returns an
+// *inference.BudgetExceededError for the breach with the strictest action.
+func (g *Guard) Reserve(ctx context.Context, req inference.Request, rule router.Rule) (inference.Reservation, error) {
+	inputs := []string{req.Prompt}
+	if req.UseCase == inference.UseCaseRerank {
+		inputs = append(inputs, metadataStrings(req.Metadata["documents"])...)
+	}
+	maxTokens := req.MaxTokens
+	if g.hardStop && maxTokens <= 0 {
+		maxTokens = inference.MaxTokensLimit
+	}
+	estimate := g.budgets.Estimate(rule, req.UseCase, inputs, maxTokens)
+	entries := g.budgets.Entries(req.Caller.TenantID, req.Caller.MCPID)
+
+	reservation, err := g.ledger.Reserve(ctx, entries, estimate)
+	var exceeded *ExceededError
+	switch {
+	case errors.As(err, &exceeded):
+		return g.breach(exceeded, rule, entries, estimate)
+	case err != nil && g.hardStop:
+		return nil, err
+	case err != nil:
+		g.logger.Warn("AI budget ledger unavailable, not enforcing caps", zap.Error(err))
+		return &guardReservation{guard: g, rule: rule, entries: entries}, nil
+	}
+	return &guardReservation{guard: g, rule: rule, reservation: reservation, reserved: estimate}, nil
+}
+
+// breach records every breached cap and returns the error for the
+// strictest one, a block before a degrade
+func (g *Guard) breach(exceeded *ExceededError, rule router.Rule, entries []Entry, estimate float64) (inference.Reservation, error) {
+	worst := exceeded.Breaches[0]
+	for _, breach := range exceeded.Breaches {
+		telemetry.IncBudgetBreach(breach.Scope)
+		if breach.Cap.OnBreach == ActionBlock && worst.Cap.OnBreach != ActionBlock {
+			worst = breach
+		}
+	}
+	g.logger.Warn("AI budget exceeded",
+		zap.String("scope", worst.Scope),
+		zap.String("id", worst.ID),
+		zap.String("action", worst.Cap.OnBreach),
+		zap.String("provider", rule.Provider),
+		zap.String("model", rule.Model),
+		zap.Float64("estimate_brl", estimate),
+		zap.Float64("spent_brl", worst.SpentBRL),
+		zap.Float64("cap_brl", worst.Cap.DailyBRLCap))
+
+	if !g.hardStop {
+		return &guardReservation{guard: g, rule: rule, entries: entries}, nil
+	}
+	return nil, &inference.BudgetExceededError{
+		Scope:    worst.Scope,
+		SpentBRL: worst.SpentBRL,
+		CapBRL:   worst.Cap.DailyBRLCap,
+		ResetAt:  exceeded.ResetAt,
+		Degrade:  worst.Cap.OnBreach == ActionDegrade,
+	}
+}
+
+// guardReservation settles a ledger reservation at the priced usage. A nil
+// reservation means the ledger is not enforcing this call; the usage is
+// then charged to entries without checking their caps.
+type guardReservation struct {
+	guard       *Guard
+	rule        router.Rule
+	reservation *Reservation
+	reserved    float64
+	entries     []Entry
+}
+
+// Commit settles the reservation and returns the priced usage. With hard
+// stop the ledger is charged at most the reserved worst case, which fit
+// the caps; usage above it only shows the estimate was off, e.g. a token
+// count differing from the provider's.
+func (r *guardReservation) Commit(ctx context.Context, usage provider.Usage) float64 {
+	cost := r.guard.budgets.Cost(r.rule, usage)
+	if r.reservation == nil {
+		if err := r.guard.ledger.Charge(ctx, r.entries, cost); err != nil {
+			r.guard.logger.Error("Failed to charge AI budget", zap.Float64("cost_brl", cost), zap.Error(err))
+		}
+		return cost
+	}
+	settled := cost
+	if r.guard.hardStop && cost > r.reserved {
+		r.guard.logger.Warn("AI usage exceeded its budget reservation",
+			zap.String("provider", r.rule.Provider),
+			zap.String("model", r.rule.Model),
+			zap.Float64("cost_brl", cost),
+			zap.Float64("reserved_brl", r.reserved))
+		settled = r.reserved
+	}
+	if err := r.reservation.Commit(ctx, settled); err != nil {
+		r.guard.logger.Error("Failed to settle AI budget", zap.Float64("cost_brl", settled), zap.Error(err))
+	}
+	return cost
+}
+
+func (r *guardReservation) Release(ctx context.Context) {
+	if r.reservation == nil {
+		return
+	}
+	if err := r.reservation.Release(ctx); err != nil {
+		r.guard.logger.Error("Failed to release AI budget", zap.Error(err))
+	}
+}
+
+// metadataStrings reads a metadata list of strings, skipping other values
+func metadataStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case []string:
+		return v
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	default:
+		return nil
+	}
+}
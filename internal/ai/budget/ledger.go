@@ -0,0 +1,257 @@
+package budget
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/vertikon/mcp-ultra/pkg/redisx"
+)
+
+// Amounts are kept in Redis as integer micro-BRL, so INCRBY stays exact
+const microsPerBRL = 1_000_000
+
+// DefaultKeyPrefix starts every ledger key
+const DefaultKeyPrefix = "ai:budget:"
+
+// reserveScript adds the amount to every key unless one of them would go
+// over its cap, in which case nothing is written and the breached key
+// positions are returned with their spend.
+//
+// KEYS: ledger keys. ARGV[1]: amount, ARGV[2]: TTL in seconds, ARGV[3..]:
+// the cap of each key. Amounts and caps are in micro-BRL.
+const reserveScript = `
+local amount = tonumber(ARGV[1])
+local breached = {}
+for i, key in ipairs(KEYS) do
+	local spent = tonumber(redis.call('GET', key) or '0')
+	if amount > 0 and spent + amount > tonumber(ARGV[i + 2]) then
+		table.insert(breached, i)
+		table.insert(breached, spent)
+	end
+end
+if #breached > 0 then
+	return breached
+end
+for _, key in ipairs(KEYS) do
+	redis.call('INCRBY', key, amount)
+	redis.call('EXPIRE', key, ARGV[2])
+end
+return breached
+`
+
+// adjustScript adds a delta, which may be negative, to every key without
+// letting the spend drop below zero.
+//
+// KEYS: ledger keys. ARGV[1]: delta in micro-BRL, ARGV[2]: TTL in seconds.
+const adjustScript = `
+for _, key in ipairs(KEYS) do
+	if redis.call('INCRBY', key, ARGV[1]) < 0 then
+		redis.call('SET', key, 0)
+	end
+	redis.call('EXPIRE', key, ARGV[2])
+end
+return #KEYS
+`
+
+// Entry is one cap a request is held to
+type Entry struct {
+	Scope string
+	ID    string
+	Cap   Cap
+}
+
+// Breach is an entry a reservation would have taken over its cap
+type Breach struct {
+	Entry
+	SpentBRL float64
+}
+
+// ExceededError is returned by Reserve when an amount does not fit
+type ExceededError struct {
+	Breaches []Breach
+	// ResetAt is when the caps roll over
+	ResetAt time.Time
+}
+
+func (e *ExceededError) Error() string {
+	breach := e.Breaches[0]
+	return fmt.Sprintf("%s %s budget exceeded: R$ %.2f of R$ %.2f spent", breach.Scope, breach.ID, breach.SpentBRL, breach.Cap.DailyBRLCap)
+}
+
+// LedgerOption configures a Ledger
+type LedgerOption func(*Ledger)
+
+// WithKeyPrefix replaces DefaultKeyPrefix
+func WithKeyPrefix(prefix string) LedgerOption {
+	return func(l *Ledger) {
+		l.prefix = prefix
+	}
+}
+
+// WithLocation sets the timezone days roll over in; UTC by default
+func WithLocation(location *time.Location) LedgerOption {
+	return func(l *Ledger) {
+		l.location = location
+	}
+}
+
+// WithClock replaces time.Now, for tests
+func WithClock(now func() time.Time) LedgerOption {
+	return func(l *Ledger) {
+		l.now = now
+	}
+}
+
+// Ledger keeps the daily spend of every entry in Redis. Each day has its
+// own keys, kept for a day after it ends for inspection.
+type Ledger struct {
+	client   *redisx.Client
+	prefix   string
+	location *time.Location
+	now      func() time.Time
+	reserve  *redisx.Script
+	adjust   *redisx.Script
+}
+
+// NewLedger creates a ledger over client
+func NewLedger(client *redisx.Client, opts ...LedgerOption) *Ledger {
+	l := &Ledger{
+		client:   client,
+		prefix:   DefaultKeyPrefix,
+		location: time.UTC,
+		now:      time.Now,
+		reserve:  redisx.NewScript(reserveScript),
+		adjust:   redisx.NewScript(adjustScript),
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Reservation is spend held in the ledger. It remembers its keys, so it
+// settles on the day it was made even after the rollover.
+type Reservation struct {
+	ledger *Ledger
+	keys   []string
+	micros int64
+}
+
+// Reserve adds amountBRL to every entry at once, or to none of them and
+// returns an *ExceededError when one would go over its cap. A zero amount
+// always fits.
+func (l *Ledger) Reserve(ctx context.Context, entries []Entry, amountBRL float64) (*Reservation, error) {
+	now := l.now().In(l.location)
+	micros := toMicros(amountBRL)
+	keys := make([]string, len(entries))
+	args := []interface{}{micros, l.ttl(now)}
+	for i, entry := range entries {
+		keys[i] = l.key(now, entry)
+		args = append(args, toMicros(entry.Cap.DailyBRLCap))
+	}
+	if len(keys) == 0 {
+		return &Reservation{ledger: l}, nil
+	}
+
+	reply, err := l.reserve.Run(ctx, l.client, keys, args...)
+	if err != nil {
+		return nil, fmt.Errorf("reserving AI budget: %w", err)
+	}
+	breached, ok := reply.([]interface{})
+	if !ok || len(breached)%2 != 0 {
+		return nil, fmt.Errorf("reserving AI budget: unexpected reply %v", reply)
+	}
+	if len(breached) > 0 {
+		exceeded := &ExceededError{ResetAt: l.resetAt(now)}
+		for i := 0; i < len(breached); i += 2 {
+			position, _ := breached[i].(int64)
+			spent, _ := breached[i+1].(int64)
+			if position < 1 || int(position) > len(entries) {
+				return nil, fmt.Errorf("reserving AI budget: unexpected reply %v", reply)
+			}
+			exceeded.Breaches = append(exceeded.Breaches, Breach{Entry: entries[position-1], SpentBRL: fromMicros(spent)})
+		}
+		return nil, exceeded
+	}
+	return &Reservation{ledger: l, keys: keys, micros: micros}, nil
+}
+
+// Charge adds amountBRL to every entry regardless of their caps, for spend
+// that was not reserved
+func (l *Ledger) Charge(ctx context.Context, entries []Entry, amountBRL float64) error {
+	now := l.now().In(l.location)
+	micros := toMicros(amountBRL)
+	if micros == 0 || len(entries) == 0 {
+		return nil
+	}
+	keys := make([]string, len(entries))
+	for i, entry := range entries {
+		keys[i] = l.key(now, entry)
+	}
+	if _, err := l.adjust.Run(ctx, l.client, keys, micros, l.ttl(now)); err != nil {
+		return fmt.Errorf("charging AI budget: %w", err)
+	}
+	return nil
+}
+
+// Commit settles the reservation at costBRL
+func (r *Reservation) Commit(ctx context.Context, costBRL float64) error {
+	delta := toMicros(costBRL) - r.micros
+	if delta == 0 || len(r.keys) == 0 {
+		return nil
+	}
+	_, err := r.ledger.adjust.Run(ctx, r.ledger.client, r.keys, delta, r.ledger.ttl(r.ledger.now().In(r.ledger.location)))
+	if err != nil {
+		return fmt.Errorf("settling AI budget: %w", err)
+	}
+	r.micros += delta
+	return nil
+}
+
+// Release gives the reserved amount back
+func (r *Reservation) Release(ctx context.Context) error {
+	return r.Commit(ctx, 0)
+}
+
+// Spent returns what entry has spent today
+func (l *Ledger) Spent(ctx context.Context, entry Entry) (float64, error) {
+	value, err := l.client.Get(ctx, l.key(l.now().In(l.location), entry))
+	if err == redisx.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("reading AI budget: %w", err)
+	}
+	var micros int64
+	if _, err := fmt.Sscan(value, &micros); err != nil {
+		return 0, fmt.Errorf("reading AI budget: %w", err)
+	}
+	return fromMicros(micros), nil
+}
+
+// key names the ledger entry of one day, e.g.
+// "ai:budget:2026-10-18:tenant:acme"
+func (l *Ledger) key(day time.Time, entry Entry) string {
+	return fmt.Sprintf("%s%s:%s:%s", l.prefix, day.Format("2006-01-02"), entry.Scope, entry.ID)
+}
+
+// resetAt is the next midnight in the ledger's timezone
+func (l *Ledger) resetAt(now time.Time) time.Time {
+	year, month, day := now.Date()
+	return time.Date(year, month, day+1, 0, 0, 0, 0, l.location)
+}
+
+// ttl keeps a day's keys until a day after it ends
+func (l *Ledger) ttl(now time.Time) int64 {
+	return int64(math.Ceil(l.resetAt(now).Add(24 * time.Hour).Sub(now).Seconds()))
+}
+
+func toMicros(brl float64) int64 {
+	return int64(math.Round(brl * microsPerBRL))
+}
+
+func fromMicros(micros int64) float64 {
+	return float64(micros) / microsPerBRL
+}
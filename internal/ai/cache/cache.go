@@ -0,0 +1,315 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/inference"
+	"github.com/vertikon/mcp-ultra/internal/ai/provider"
+	"github.com/vertikon/mcp-ultra/internal/ai/router"
+	"github.com/vertikon/mcp-ultra/internal/ai/telemetry"
+	distributed "github.com/vertikon/mcp-ultra/internal/cache"
+)
+
+// DefaultKeyPrefix starts every cache key
+const DefaultKeyPrefix = "ai:cache:"
+
+// anonymousTenant scopes the entries of requests without a tenant
+const anonymousTenant = "default"
+
+// Lookup results reported to telemetry
+const (
+	ResultHit         = "hit"
+	ResultSemanticHit = "semantic_hit"
+	ResultMiss        = "miss"
+)
+
+// Store keeps values as JSON. Values read back may be decoded into
+// generic maps and slices.
+type Store interface {
+	Get(ctx context.Context, key string) (interface{}, bool, error)
+	Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error
+}
+
+var _ Store = (*distributed.DistributedCache)(nil)
+
+// Option configures a Cache
+type Option func(*Cache)
+
+// WithKeyPrefix replaces DefaultKeyPrefix
+func WithKeyPrefix(prefix string) Option {
+	return func(c *Cache) {
+		c.prefix = prefix
+	}
+}
+
+// WithEmbedder sets the provider semantic mode embeds prompts with
+func WithEmbedder(embedder provider.Provider) Option {
+	return func(c *Cache) {
+		c.embedder = embedder
+	}
+}
+
+// WithLogger sets the logger store failures are reported to
+func WithLogger(logger *zap.Logger) Option {
+	return func(c *Cache) {
+		c.logger = logger
+	}
+}
+
+// Cache answers inference requests from a Store. It implements
+// inference.Cache. Store failures are logged and read as misses, so the
+// cache never fails a request.
+type Cache struct {
+	config   *Config
+	store    Store
+	embedder provider.Provider
+	prefix   string
+	logger   *zap.Logger
+}
+
+var _ inference.Cache = (*Cache)(nil)
+
+// New creates a cache over store. Semantic mode needs WithEmbedder.
+func New(config *Config, store Store, opts ...Option) (*Cache, error) {
+	c := &Cache{config: config, store: store, prefix: DefaultKeyPrefix, logger: zap.NewNop()}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if config.Semantic.Enabled && c.embedder == nil {
+		return nil, fmt.Errorf("AI cache: semantic mode needs the %s provider for embeddings", config.Semantic.Provider)
+	}
+	return c, nil
+}
+
+// entry is a cached response
+type entry struct {
+	Response  inference.Response `json:"response"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+// indexEntry is a cached prompt semantic mode compares against
+type indexEntry struct {
+	Key    string    `json:"key"`
+	Vector []float64 `json:"vector"`
+}
+
+// Lookup returns the cached answer to req routed to rule. Hits are marked
+// Cached and cost nothing: no tokens were used to serve them.
+func (c *Cache) Lookup(ctx context.Context, req inference.Request, rule router.Rule) (*inference.Response, bool) {
+	if !c.config.caches(req.UseCase) {
+		return nil, false
+	}
+	keys := c.keys(req, rule)
+
+	result := ResultMiss
+	var cached entry
+	switch {
+	case c.read(ctx, keys.exact, &cached):
+		result = ResultHit
+	case c.config.Semantic.Enabled && c.nearest(ctx, req, keys, &cached):
+		result = ResultSemanticHit
+	}
+	labels := telemetry.Labels{TenantID: req.Caller.TenantID, MCPID: req.Caller.MCPID, SDKName: req.Caller.SDK, UseCase: req.UseCase}
+	telemetry.IncCacheLookup(labels, result)
+	if result == ResultMiss {
+		return nil, false
+	}
+
+	resp := cached.Response
+	resp.Cached = true
+	resp.TokensIn, resp.TokensOut, resp.CostBRL = 0, 0, 0
+	return &resp, true
+}
+
+// Store caches resp as the answer to req routed to rule
+func (c *Cache) Store(ctx context.Context, req inference.Request, rule router.Rule, resp *inference.Response) {
+	if !c.config.caches(req.UseCase) {
+		return
+	}
+	keys := c.keys(req, rule)
+
+	stored := *resp
+	stored.Annotations, stored.LatencyMs, stored.Cached = nil, 0, false
+	if err := c.store.Set(ctx, keys.exact, entry{Response: stored, CreatedAt: time.Now().UTC()}, c.config.TTL()); err != nil {
+		c.logger.Warn("Failed to cache AI response", zap.Error(err))
+		return
+	}
+	if c.config.Semantic.Enabled {
+		c.index(ctx, req, keys)
+	}
+}
+
+// nearest reads the cached answer to the prompt closest to req's
+func (c *Cache) nearest(ctx context.Context, req inference.Request, keys cacheKeys, cached *entry) bool {
+	vector, ok := c.embed(ctx, req)
+	if !ok {
+		return false
+	}
+	var index []indexEntry
+	if !c.read(ctx, keys.index, &index) {
+		return false
+	}
+
+	best, bestScore := "", c.config.Semantic.Threshold
+	for _, candidate := range index {
+		if score := cosine(vector, candidate.Vector); score >= bestScore {
+			best, bestScore = candidate.Key, score
+		}
+	}
+	return best != "" && c.read(ctx, best, cached)
+}
+
+// index adds req's prompt to the semantic index of its tenant and
+// parameters. Concurrent writers may drop each other's additions, which
+// only costs a cache miss.
+func (c *Cache) index(ctx context.Context, req inference.Request, keys cacheKeys) {
+	vector, ok := c.embed(ctx, req)
+	if !ok {
+		return
+	}
+	var index []indexEntry
+	c.read(ctx, keys.index, &index)
+
+	updated := make([]indexEntry, 0, len(index)+1)
+	for _, existing := range index {
+		if existing.Key != keys.exact {
+			updated = append(updated, existing)
+		}
+	}
+	updated = append(updated, indexEntry{Key: keys.exact, Vector: vector})
+	if excess := len(updated) - c.config.Semantic.MaxEntries; excess > 0 {
+		updated = updated[excess:]
+	}
+	if err := c.store.Set(ctx, keys.index, updated, c.config.TTL()); err != nil {
+		c.logger.Warn("Failed to update the AI cache index", zap.Error(err))
+	}
+}
+
+func (c *Cache) embed(ctx context.Context, req inference.Request) ([]float64, bool) {
+	result, err := c.embedder.Embed(ctx, provider.EmbedRequest{
+		Model:  c.config.Semantic.Model,
+		Inputs: []string{normalize(req.Prompt)},
+	})
+	if err != nil || len(result.Vectors) != 1 {
+		c.logger.Warn("Failed to embed prompt for the AI cache", zap.Error(err))
+		return nil, false
+	}
+	return result.Vectors[0], true
+}
+
+// read loads key into value, reporting whether it was found. Values come
+// back from the store as generic JSON and are decoded again into value.
+func (c *Cache) read(ctx context.Context, key string, value interface{}) bool {
+	raw, found, err := c.store.Get(ctx, key)
+	if err != nil {
+		c.logger.Warn("Failed to read the AI cache", zap.Error(err))
+		return false
+	}
+	if !found {
+		return false
+	}
+	encoded, err := json.Marshal(raw)
+	if err == nil {
+		err = json.Unmarshal(encoded, value)
+	}
+	if err != nil {
+		c.logger.Warn("Discarding unreadable AI cache entry", zap.String("key", key), zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// cacheKeys are the keys of one request: its exact entry and the semantic
+// index of its parameters
+type cacheKeys struct {
+	exact string
+	index string
+}
+
+// params is everything besides the prompt that shapes a provider's answer
+type params struct {
+	UseCase     string   `json:"use_case"`
+	Provider    string   `json:"provider"`
+	Model       string   `json:"model"`
+	Temperature float64  `json:"temperature"`
+	MaxTokens   int      `json:"max_tokens"`
+	Labels      []string `json:"labels,omitempty"`
+	Documents   []string `json:"documents,omitempty"`
+}
+
+// keys names the entries of req, e.g. "ai:cache:acme:<sha256>" and
+// "ai:cache:acme:index:<sha256>". Metadata other than the labels and
+// documents providers see, like session IDs, does not split entries.
+func (c *Cache) keys(req inference.Request, rule router.Rule) cacheKeys {
+	tenant := req.Caller.TenantID
+	if tenant == "" {
+		tenant = anonymousTenant
+	}
+	encoded, _ := json.Marshal(params{
+		UseCase:     req.UseCase,
+		Provider:    rule.Provider,
+		Model:       rule.Model,
+		Temperature: req.Temperature,
+		MaxTokens:   req.MaxTokens,
+		Labels:      metadataStrings(req.Metadata["labels"]),
+		Documents:   metadataStrings(req.Metadata["documents"]),
+	})
+	paramsSum := sha256.Sum256(encoded)
+	exactSum := sha256.Sum256(append(append(encoded, 0), normalize(req.Prompt)...))
+
+	scope := c.prefix + tenant + ":"
+	return cacheKeys{
+		exact: scope + hex.EncodeToString(exactSum[:]),
+		index: scope + "index:" + hex.EncodeToString(paramsSum[:]),
+	}
+}
+
+// normalize folds whitespace, so prompts differing only in spacing share
+// an entry. Case is kept: it can change what a prompt asks for, such as
+// code or identifiers.
+func normalize(prompt string) string {
+	return strings.Join(strings.Fields(prompt), " ")
+}
+
+func cosine(a, b []float64) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// metadataStrings reads a metadata list of strings, skipping other values
+func metadataStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case []string:
+		return v
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	default:
+		return nil
+	}
+}
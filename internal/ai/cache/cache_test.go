@@ -0,0 +1,223 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/inference"
+	"github.com/vertikon/mcp-ultra/internal/ai/provider"
+	"github.com/vertikon/mcp-ultra/internal/ai/router"
+)
+
+// memoryStore round-trips values through JSON like DistributedCache does
+type memoryStore struct {
+	values map[string][]byte
+	ttls   map[string]time.Duration
+	err    error
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{values: make(map[string][]byte), ttls: make(map[string]time.Duration)}
+}
+
+func (s *memoryStore) Get(_ context.Context, key string) (interface{}, bool, error) {
+	if s.err != nil {
+		return nil, false, s.err
+	}
+	raw, ok := s.values[key]
+	if !ok {
+		return nil, false, nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (s *memoryStore) Set(_ context.Context, key string, value interface{}, ttl time.Duration) error {
+	if s.err != nil {
+		return s.err
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	s.values[key], s.ttls[key] = raw, ttl
+	return nil
+}
+
+// wordEmbedder embeds a prompt as the counts of a fixed vocabulary, so
+// prompts sharing most words are close
+type wordEmbedder struct {
+	provider.Provider
+}
+
+var vocabulary = []string{"qual", "o", "status", "do", "pedido", "meu", "cancelar"}
+
+func (wordEmbedder) Embed(_ context.Context, req provider.EmbedRequest) (*provider.Embedding, error) {
+	vectors := make([][]float64, len(req.Inputs))
+	for i, input := range req.Inputs {
+		vectors[i] = make([]float64, len(vocabulary))
+		for _, word := range strings.Fields(input) {
+			for j, known := range vocabulary {
+				if word == known {
+					vectors[i][j]++
+				}
+			}
+		}
+	}
+	return &provider.Embedding{Vectors: vectors}, nil
+}
+
+var rule = router.Rule{Provider: "openai", Model: "gpt-4o"}
+
+func request(tenant, prompt string) inference.Request {
+	return inference.Request{Caller: inference.Caller{TenantID: tenant}, Prompt: prompt, UseCase: inference.UseCaseGeneration}
+}
+
+func answer() *inference.Response {
+	return &inference.Response{
+		Content: "enviado", Provider: "openai", Model: "gpt-4o",
+		TokensIn: 10, TokensOut: 4, LatencyMs: 900, CostBRL: 0.05,
+		Annotations: map[string]string{"risk_tier": "low"},
+	}
+}
+
+func TestCache_Exact(t *testing.T) {
+	store := newMemoryStore()
+	c, err := New(&Config{TTLSeconds: 60}, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	if _, ok := c.Lookup(ctx, request("acme", "Qual o status?"), rule); ok {
+		t.Fatal("empty cache must miss")
+	}
+	c.Store(ctx, request("acme", "Qual o status?"), rule, answer())
+
+	resp, ok := c.Lookup(ctx, request("acme", "  Qual   o\tstatus? "), rule)
+	if !ok {
+		t.Fatal("prompts differing in whitespace must hit")
+	}
+	if !resp.Cached || resp.CostBRL != 0 || resp.TokensIn != 0 || resp.TokensOut != 0 {
+		t.Errorf("hits must be cached and free, got %+v", resp)
+	}
+	if resp.Content != "enviado" || resp.Annotations != nil {
+		t.Errorf("hits must carry the raw answer, got %+v", resp)
+	}
+	for key, ttl := range store.ttls {
+		if !strings.HasPrefix(key, DefaultKeyPrefix+"acme:") || ttl != time.Minute {
+			t.Errorf("entry %s stored with ttl %v", key, ttl)
+		}
+	}
+
+	misses := map[string]struct {
+		req  inference.Request
+		rule router.Rule
+	}{
+		"other tenant":      {request("globex", "Qual o status?"), rule},
+		"other case":        {request("acme", "qual o STATUS?"), rule},
+		"other model":       {request("acme", "Qual o status?"), router.Rule{Provider: "openai", Model: "gpt-4o-mini"}},
+		"other temperature": {func() inference.Request { r := request("acme", "Qual o status?"); r.Temperature = 1; return r }(), rule},
+		"other prompt":      {request("acme", "Qual o pedido?"), rule},
+	}
+	for name, tt := range misses {
+		if _, ok := c.Lookup(ctx, tt.req, tt.rule); ok {
+			t.Errorf("%s must miss", name)
+		}
+	}
+}
+
+func TestCache_UseCases(t *testing.T) {
+	c, err := New(&Config{TTLSeconds: 60, UseCases: []string{inference.UseCaseClassification}}, newMemoryStore())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	c.Store(ctx, request("acme", "Qual o status?"), rule, answer())
+	if _, ok := c.Lookup(ctx, request("acme", "Qual o status?"), rule); ok {
+		t.Error("use cases outside the config must not be cached")
+	}
+}
+
+func TestCache_Semantic(t *testing.T) {
+	config := &Config{TTLSeconds: 60, Semantic: Semantic{Enabled: true, Provider: "local", Threshold: 0.85, MaxEntries: 2}}
+	if _, err := New(config, newMemoryStore()); err == nil {
+		t.Fatal("semantic mode without an embedder must fail")
+	}
+	c, err := New(config, newMemoryStore(), WithEmbedder(wordEmbedder{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	c.Store(ctx, request("acme", "qual o status do pedido"), rule, answer())
+
+	if resp, ok := c.Lookup(ctx, request("acme", "qual o status do meu pedido"), rule); !ok || resp.Content != "enviado" {
+		t.Errorf("a near duplicate must hit, got %+v", resp)
+	}
+	if _, ok := c.Lookup(ctx, request("acme", "cancelar"), rule); ok {
+		t.Error("an unrelated prompt must miss")
+	}
+	if _, ok := c.Lookup(ctx, request("globex", "qual o status do meu pedido"), rule); ok {
+		t.Error("near duplicates must stay within the tenant")
+	}
+
+	// The index keeps the newest MaxEntries prompts
+	c.Store(ctx, request("acme", "cancelar"), rule, answer())
+	c.Store(ctx, request("acme", "cancelar o pedido"), rule, answer())
+	if _, ok := c.Lookup(ctx, request("acme", "qual o status do meu pedido"), rule); ok {
+		t.Error("the oldest prompt must have left the index")
+	}
+}
+
+func TestCache_StoreErrors(t *testing.T) {
+	store := newMemoryStore()
+	store.err = errors.New("cluster down")
+	c, err := New(&Config{TTLSeconds: 60}, store, WithLogger(zaptest.NewLogger(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Store(context.Background(), request("acme", "Qual o status?"), rule, answer())
+	if _, ok := c.Lookup(context.Background(), request("acme", "Qual o status?"), rule); ok {
+		t.Error("store errors must read as misses")
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	config, err := LoadConfig("../../../templates/ai/config/ai-cache.json")
+	if err != nil {
+		t.Fatalf("shipped config rejected: %v", err)
+	}
+	if config.TTL() <= 0 {
+		t.Errorf("unexpected config %+v", config)
+	}
+
+	invalid := map[string]string{
+		"unknown field":     `{"ttl_seconds": 60, "ttl": 60}`,
+		"no ttl":            `{}`,
+		"no provider":       `{"ttl_seconds": 60, "semantic": {"enabled": true, "threshold": 0.9, "max_entries": 10}}`,
+		"bad threshold":     `{"ttl_seconds": 60, "semantic": {"enabled": true, "provider": "local", "threshold": 1.5, "max_entries": 10}}`,
+		"no index capacity": `{"ttl_seconds": 60, "semantic": {"enabled": true, "provider": "local", "threshold": 0.9}}`,
+	}
+	for name, raw := range invalid {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "ai-cache.json")
+			if err := os.WriteFile(path, []byte(raw), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadConfig(path); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Package cache answers repeated AI inference requests from a shared
+// store, such as cache.DistributedCache, instead of calling a provider.
+// Entries are scoped per tenant and keyed on the normalized prompt, the
+// routed model and the call parameters; an optional semantic mode also
+// serves prompts whose embeddings are close to a cached one.
+package cache
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Config is ai-cache.json
+type Config struct {
+	Version    string `json:"version"`
+	TTLSeconds int    `json:"ttl_seconds"`
+	// UseCases lists the use cases answered from the cache; empty means all
+	UseCases []string `json:"use_cases"`
+	Semantic Semantic `json:"semantic"`
+}
+
+// Semantic configures near-duplicate matching. Prompts are embedded with
+// Provider and Model and served from the closest cached prompt of the
+// same tenant and parameters whose cosine similarity reaches Threshold.
+type Semantic struct {
+	Enabled   bool    `json:"enabled"`
+	Provider  string  `json:"provider"`
+	Model     string  `json:"model,omitempty"`
+	Threshold float64 `json:"threshold"`
+	// MaxEntries bounds the prompts compared per tenant and parameters;
+	// the oldest are dropped first
+	MaxEntries int `json:"max_entries"`
+}
+
+// LoadConfig reads and validates ai-cache.json
+func LoadConfig(path string) (*Config, error) {
+	raw, err := os.ReadFile(path) // #nosec G304 -- path comes from configuration
+	if err != nil {
+		return nil, fmt.Errorf("reading AI cache config: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	var config Config
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("decoding AI cache config: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// Validate checks the TTL and the semantic settings
+func (c *Config) Validate() error {
+	if c.TTLSeconds <= 0 {
+		return fmt.Errorf("AI cache: ttl_seconds must be positive")
+	}
+	if !c.Semantic.Enabled {
+		return nil
+	}
+	if c.Semantic.Provider == "" {
+		return fmt.Errorf("AI cache: semantic.provider is required")
+	}
+	if c.Semantic.Threshold <= 0 || c.Semantic.Threshold > 1 {
+		return fmt.Errorf("AI cache: semantic.threshold must be in (0, 1], got %v", c.Semantic.Threshold)
+	}
+	if c.Semantic.MaxEntries <= 0 {
+		return fmt.Errorf("AI cache: semantic.max_entries must be positive")
+	}
+	return nil
+}
+
+// TTL is how long entries are kept
+func (c *Config) TTL() time.Duration {
+	return time.Duration(c.TTLSeconds) * time.Second
+}
+
+// caches reports whether requests of useCase are answered from the cache
+func (c *Config) caches(useCase string) bool {
+	if len(c.UseCases) == 0 {
+		return true
+	}
+	for _, allowed := range c.UseCases {
+		if allowed == useCase {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vertikon/mcp-ultra/pkg/redisx"
+)
+
+// RedisStore keeps entries as JSON in a single Redis server, for
+// deployments without the Redis cluster cache.DistributedCache needs
+type RedisStore struct {
+	client *redisx.Client
+}
+
+var _ Store = (*RedisStore)(nil)
+
+// NewRedisStore creates a store over client
+func NewRedisStore(client *redisx.Client) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+// Get reads and decodes the entry at key
+func (s *RedisStore) Get(ctx context.Context, key string) (interface{}, bool, error) {
+	raw, err := s.client.Get(ctx, key)
+	if errors.Is(err, redisx.ErrKeyNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("reading AI cache entry: %w", err)
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return nil, false, fmt.Errorf("decoding AI cache entry: %w", err)
+	}
+	return value, true, nil
+}
+
+// Set stores value at key for ttl, rounded up to whole seconds
+func (s *RedisStore) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("encoding AI cache entry: %w", err)
+	}
+	seconds := int((ttl + time.Second - 1) / time.Second)
+	if err := s.client.Set(ctx, key, raw, seconds); err != nil {
+		return fmt.Errorf("writing AI cache entry: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+
+	"github.com/vertikon/mcp-ultra/pkg/redisx"
+)
+
+func TestRedisStore(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redisx.NewClient(&redisx.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	store := NewRedisStore(client)
+	ctx := context.Background()
+
+	if _, found, err := store.Get(ctx, "missing"); found || err != nil {
+		t.Fatalf("missing keys must miss without error, got found=%v err=%v", found, err)
+	}
+
+	if err := store.Set(ctx, "k", map[string]int{"n": 1}, 1500*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if ttl := server.TTL("k"); ttl != 2*time.Second {
+		t.Errorf("ttl must round up to whole seconds, got %v", ttl)
+	}
+	value, found, err := store.Get(ctx, "k")
+	if err != nil || !found {
+		t.Fatalf("stored key must hit, got found=%v err=%v", found, err)
+	}
+	if m, ok := value.(map[string]interface{}); !ok || m["n"] != float64(1) {
+		t.Errorf("values must come back as generic JSON, got %#v", value)
+	}
+
+	// The cache reads entries back through the store
+	c, err := New(&Config{TTLSeconds: 60}, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Store(ctx, request("acme", "Qual o status?"), rule, answer())
+	if resp, ok := c.Lookup(ctx, request("acme", "Qual o status?"), rule); !ok || resp.Content != "enviado" {
+		t.Errorf("cached answers must round-trip through Redis, got %+v", resp)
+	}
+}
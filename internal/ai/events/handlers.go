@@ -3,6 +3,7 @@ package events
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -12,10 +13,21 @@ type EventPublisher interface {
 	PublishWithRetry(ctx context.Context, subject string, payload []byte) error
 }
 
+// Subjects the AI events are published on. A ValidatingPublisher adds the
+// schema version, e.g. ultra.ai.router.decision.v1.
+const (
+	SubjectRouterDecision   = "ultra.ai.router.decision"
+	SubjectPolicyBlock      = "ultra.ai.policy.block"
+	SubjectInferenceError   = "ultra.ai.inference.error"
+	SubjectInferenceSummary = "ultra.ai.inference.summary"
+	SubjectConfigReload     = "ultra.ai.config.reload"
+	SubjectDeadLetter       = "ultra.ai.dlq"
+)
+
 type Base struct {
 	TenantID string `json:"tenant_id"`
 	MCPID    string `json:"mcp_id"`
-	SDKName  string `json:"sdk_name,omitempty"`
+	SDKName  string `json:"sdk_name"`
 	Ts       string `json:"timestamp"`
 }
 
@@ -36,8 +48,8 @@ type PolicyBlock struct {
 
 type InferenceError struct {
 	Base
-	Provider string `json:"provider,omitempty"`
-	Model    string `json:"model,omitempty"`
+	Provider string `json:"provider"`
+	Model    string `json:"model"`
 	Code     string `json:"code"`
 	Message  string `json:"message"`
 }
@@ -52,28 +64,58 @@ type InferenceSummary struct {
 	Cached    bool    `json:"cached"`
 }
 
+// ConfigReload reports a reload of the AI flags and router rules. Status is
+// "applied" or "rejected"; a rejected reload keeps the previous checksum live.
+type ConfigReload struct {
+	Ts       string `json:"timestamp"`
+	Trigger  string `json:"trigger"`
+	Status   string `json:"status"`
+	Checksum string `json:"checksum"`
+	Version  string `json:"version,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// DeadLetter carries an event that broke its schema, with every problem
+// found, so it can be inspected and replayed once fixed
+type DeadLetter struct {
+	Ts      string          `json:"timestamp"`
+	Subject string          `json:"subject"`
+	Schema  string          `json:"schema,omitempty"`
+	Errors  []string        `json:"errors"`
+	Payload json.RawMessage `json:"payload"`
+}
+
 func now() string { return time.Now().UTC().Format(time.RFC3339Nano) }
 
 func PublishRouterDecision(ctx context.Context, pub EventPublisher, subject string, e RouterDecision) error {
 	e.Ts = now()
-	b, _ := json.Marshal(e)
-	return pub.PublishWithRetry(ctx, subject, b)
+	return publish(ctx, pub, subject, e)
 }
 
 func PublishPolicyBlock(ctx context.Context, pub EventPublisher, subject string, e PolicyBlock) error {
 	e.Ts = now()
-	b, _ := json.Marshal(e)
-	return pub.PublishWithRetry(ctx, subject, b)
+	return publish(ctx, pub, subject, e)
 }
 
 func PublishInferenceError(ctx context.Context, pub EventPublisher, subject string, e InferenceError) error {
 	e.Ts = now()
-	b, _ := json.Marshal(e)
-	return pub.PublishWithRetry(ctx, subject, b)
+	return publish(ctx, pub, subject, e)
 }
 
 func PublishInferenceSummary(ctx context.Context, pub EventPublisher, subject string, e InferenceSummary) error {
 	e.Ts = now()
-	b, _ := json.Marshal(e)
+	return publish(ctx, pub, subject, e)
+}
+
+func PublishConfigReload(ctx context.Context, pub EventPublisher, subject string, e ConfigReload) error {
+	e.Ts = now()
+	return publish(ctx, pub, subject, e)
+}
+
+func publish(ctx context.Context, pub EventPublisher, subject string, e interface{}) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("marshaling %s event: %w", subject, err)
+	}
 	return pub.PublishWithRetry(ctx, subject, b)
 }
@@ -207,6 +207,28 @@ func TestPublishInferenceSummary(t *testing.T) {
 	}
 }
 
+func TestPublishConfigReload(t *testing.T) {
+	mock := &mockPublisher{}
+
+	err := PublishConfigReload(context.Background(), mock, SubjectConfigReload, ConfigReload{
+		Trigger:  "signal",
+		Status:   "rejected",
+		Checksum: "abc",
+		Error:    "invalid feature flags",
+	})
+	if err != nil {
+		t.Fatalf("PublishConfigReload failed: %v", err)
+	}
+
+	var decoded ConfigReload
+	if err := json.Unmarshal(mock.published[0].payload, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal payload: %v", err)
+	}
+	if decoded.Ts == "" || decoded.Status != "rejected" || decoded.Error != "invalid feature flags" {
+		t.Errorf("unexpected event %+v", decoded)
+	}
+}
+
 func TestMultiplePublishes(t *testing.T) {
 	mock := &mockPublisher{}
 	ctx := context.Background()
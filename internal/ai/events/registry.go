@@ -0,0 +1,200 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/schema"
+)
+
+// versioned splits a schema $id or subject like "ultra.ai.router.decision.v2"
+var versioned = regexp.MustCompile(`^(.+)\.v([1-9][0-9]*)$`)
+
+// Registry holds the JSON Schemas of the AI events by subject and version.
+// Schemas are registered under their $id, which names the subject and
+// version they describe, e.g. ultra.ai.router.decision.v1.
+type Registry struct {
+	mu      sync.RWMutex
+	schemas map[string]map[int]*schema.Schema
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{schemas: make(map[string]map[int]*schema.Schema)}
+}
+
+// LoadRegistry compiles every *.json schema in dir, such as
+// templates/ai/nats-schemas
+func LoadRegistry(dir string) (*Registry, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("listing event schemas: %w", err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no event schemas in %s", dir)
+	}
+
+	r := NewRegistry()
+	for _, path := range paths {
+		raw, err := os.ReadFile(path) // #nosec G304 -- path comes from configuration
+		if err != nil {
+			return nil, fmt.Errorf("reading event schema: %w", err)
+		}
+		compiled, err := schema.Compile(raw)
+		if err != nil {
+			return nil, fmt.Errorf("compiling %s: %w", filepath.Base(path), err)
+		}
+		if err := r.Register(compiled); err != nil {
+			return nil, fmt.Errorf("registering %s: %w", filepath.Base(path), err)
+		}
+	}
+	return r, nil
+}
+
+// Register adds a compiled schema under its $id
+func (r *Registry) Register(s *schema.Schema) error {
+	match := versioned.FindStringSubmatch(s.ID)
+	if match == nil {
+		return fmt.Errorf("schema $id %q does not end in a version like .v1", s.ID)
+	}
+	version, _ := strconv.Atoi(match[2])
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	versions, ok := r.schemas[match[1]]
+	if !ok {
+		versions = make(map[int]*schema.Schema)
+		r.schemas[match[1]] = versions
+	}
+	if _, ok := versions[version]; ok {
+		return fmt.Errorf("schema %s is already registered", s.ID)
+	}
+	versions[version] = s
+	return nil
+}
+
+// Resolve returns the versioned subject to publish on and its schema. A
+// subject without a version, like SubjectRouterDecision, resolves to its
+// latest schema; a versioned one, like "ultra.ai.router.decision.v1",
+// keeps producers on that version while consumers move to the next.
+func (r *Registry) Resolve(subject string) (string, *schema.Schema, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if match := versioned.FindStringSubmatch(subject); match != nil {
+		version, _ := strconv.Atoi(match[2])
+		if s, ok := r.schemas[match[1]][version]; ok {
+			return subject, s, nil
+		}
+		return "", nil, fmt.Errorf("no schema registered for subject %s", subject)
+	}
+
+	versions, ok := r.schemas[subject]
+	if !ok {
+		return "", nil, fmt.Errorf("no schema registered for subject %s", subject)
+	}
+	latest := 0
+	for version := range versions {
+		if version > latest {
+			latest = version
+		}
+	}
+	return fmt.Sprintf("%s.v%d", subject, latest), versions[latest], nil
+}
+
+// Subjects lists the versioned subjects with a registered schema
+func (r *Registry) Subjects() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var subjects []string
+	for base, versions := range r.schemas {
+		for version := range versions {
+			subjects = append(subjects, fmt.Sprintf("%s.v%d", base, version))
+		}
+	}
+	sort.Strings(subjects)
+	return subjects
+}
+
+// InvalidEventError is returned for an event that was dead-lettered
+// instead of published
+type InvalidEventError struct {
+	Subject string
+	Err     error
+}
+
+func (e *InvalidEventError) Error() string {
+	return fmt.Sprintf("event on %s sent to %s: %v", e.Subject, SubjectDeadLetter, e.Err)
+}
+
+func (e *InvalidEventError) Unwrap() error { return e.Err }
+
+// ValidatingPublisher checks every payload against the registry before
+// publishing it on its versioned subject. Payloads that break their
+// schema, or whose subject has none, go to SubjectDeadLetter instead.
+type ValidatingPublisher struct {
+	next     EventPublisher
+	registry *Registry
+	logger   *zap.Logger
+}
+
+var _ EventPublisher = (*ValidatingPublisher)(nil)
+
+// NewValidatingPublisher validates payloads with registry and publishes
+// them with next
+func NewValidatingPublisher(next EventPublisher, registry *Registry, logger *zap.Logger) *ValidatingPublisher {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &ValidatingPublisher{next: next, registry: registry, logger: logger}
+}
+
+// PublishWithRetry publishes a valid payload, or dead-letters an invalid
+// one and returns an *InvalidEventError
+func (p *ValidatingPublisher) PublishWithRetry(ctx context.Context, subject string, payload []byte) error {
+	target, s, err := p.registry.Resolve(subject)
+	if err == nil {
+		err = s.ValidateJSON(payload)
+	}
+	if err == nil {
+		return p.next.PublishWithRetry(ctx, target, payload)
+	}
+
+	p.logger.Warn("Dead-lettering invalid AI event", zap.String("subject", subject), zap.Error(err))
+	if dlqErr := p.deadLetter(ctx, subject, s, payload, err); dlqErr != nil {
+		return fmt.Errorf("dead-lettering event on %s: %w", subject, errors.Join(err, dlqErr))
+	}
+	return &InvalidEventError{Subject: subject, Err: err}
+}
+
+// deadLetter publishes a DeadLetter for payload. It is not validated, so
+// a broken dead-letter schema cannot lose events.
+func (p *ValidatingPublisher) deadLetter(ctx context.Context, subject string, s *schema.Schema, payload []byte, cause error) error {
+	letter := DeadLetter{Ts: now(), Subject: subject, Errors: []string{cause.Error()}, Payload: payload}
+	if s != nil {
+		letter.Schema = s.ID
+	}
+	var validation *schema.ValidationError
+	if errors.As(cause, &validation) {
+		letter.Errors = validation.Problems
+	}
+	if !json.Valid(payload) {
+		letter.Payload, _ = json.Marshal(string(payload))
+	}
+
+	target, _, err := p.registry.Resolve(SubjectDeadLetter)
+	if err != nil {
+		target = SubjectDeadLetter
+	}
+	return publish(ctx, p.next, target, letter)
+}
@@ -0,0 +1,149 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+)
+
+const shippedSchemas = "../../../templates/ai/nats-schemas"
+
+// Every event the AI packages publish matches its shipped v1 schema, even
+// with the optional fields left empty
+func TestValidatingPublisher_ShippedSchemas(t *testing.T) {
+	registry, err := LoadRegistry(shippedSchemas)
+	if err != nil {
+		t.Fatalf("LoadRegistry failed: %v", err)
+	}
+	mock := &mockPublisher{}
+	pub := NewValidatingPublisher(mock, registry, zaptest.NewLogger(t))
+	ctx := context.Background()
+	base := Base{TenantID: "acme", MCPID: "mcp-vendas"}
+
+	publishes := map[string]error{
+		SubjectRouterDecision:   PublishRouterDecision(ctx, pub, SubjectRouterDecision, RouterDecision{Base: base, UseCase: "generation", Provider: "local"}),
+		SubjectPolicyBlock:      PublishPolicyBlock(ctx, pub, SubjectPolicyBlock, PolicyBlock{Base: base, Rule: "pii", Severity: "high"}),
+		SubjectInferenceError:   PublishInferenceError(ctx, pub, SubjectInferenceError, InferenceError{Base: base, Code: "unavailable", Message: "down"}),
+		SubjectInferenceSummary: PublishInferenceSummary(ctx, pub, SubjectInferenceSummary, InferenceSummary{Base: base, UseCase: "generation"}),
+		SubjectConfigReload:     PublishConfigReload(ctx, pub, SubjectConfigReload, ConfigReload{Trigger: "file", Status: "applied", Checksum: "abc"}),
+	}
+	for subject, err := range publishes {
+		if err != nil {
+			t.Errorf("%s: %v", subject, err)
+		}
+	}
+	for _, published := range mock.published {
+		if published.subject == SubjectDeadLetter+".v1" {
+			t.Errorf("unexpected dead letter %s", published.payload)
+		}
+	}
+	if len(mock.published) != len(publishes) {
+		t.Errorf("published %d events, want %d", len(mock.published), len(publishes))
+	}
+}
+
+func TestValidatingPublisher_DeadLetter(t *testing.T) {
+	registry, err := LoadRegistry(shippedSchemas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mock := &mockPublisher{}
+	pub := NewValidatingPublisher(mock, registry, zaptest.NewLogger(t))
+	ctx := context.Background()
+
+	err = PublishPolicyBlock(ctx, pub, SubjectPolicyBlock, PolicyBlock{Base: Base{TenantID: "acme", MCPID: "mcp"}, Rule: "pii", Severity: "critical"})
+	var invalid *InvalidEventError
+	if !errors.As(err, &invalid) || invalid.Subject != SubjectPolicyBlock {
+		t.Fatalf("expected an *InvalidEventError, got %v", err)
+	}
+	if err := pub.PublishWithRetry(ctx, "ultra.ai.unknown", []byte("not json")); !errors.As(err, &invalid) {
+		t.Fatalf("subjects without a schema must be dead-lettered, got %v", err)
+	}
+
+	if len(mock.published) != 2 {
+		t.Fatalf("published %d events, want 2 dead letters", len(mock.published))
+	}
+	var letter DeadLetter
+	if err := json.Unmarshal(mock.published[0].payload, &letter); err != nil {
+		t.Fatal(err)
+	}
+	if mock.published[0].subject != "ultra.ai.dlq.v1" || letter.Subject != SubjectPolicyBlock || letter.Schema != "ultra.ai.policy.block.v1" {
+		t.Errorf("unexpected dead letter on %s: %+v", mock.published[0].subject, letter)
+	}
+	if want := []string{`/severity: must be one of "low", "medium", "high"`}; !reflect.DeepEqual(letter.Errors, want) {
+		t.Errorf("errors = %q, want %q", letter.Errors, want)
+	}
+	if err := json.Unmarshal(mock.published[1].payload, &letter); err != nil {
+		t.Fatal(err)
+	}
+	if string(letter.Payload) != `"not json"` {
+		t.Errorf("a payload that is not JSON must be kept as text, got %s", letter.Payload)
+	}
+	if _, schema, _ := registry.Resolve(SubjectDeadLetter); schema.ValidateJSON(mock.published[1].payload) != nil {
+		t.Error("dead letters must match their own schema")
+	}
+}
+
+func TestRegistry_Versions(t *testing.T) {
+	dir := t.TempDir()
+	schemas := map[string]string{
+		"v1.json": `{"$id": "ultra.ai.router.decision.v1", "type": "object", "required": ["provider"]}`,
+		"v2.json": `{"$id": "ultra.ai.router.decision.v2", "type": "object", "required": ["provider", "chain"]}`,
+	}
+	for name, raw := range schemas {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(raw), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	registry, err := LoadRegistry(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := registry.Subjects(); !reflect.DeepEqual(got, []string{"ultra.ai.router.decision.v1", "ultra.ai.router.decision.v2"}) {
+		t.Errorf("Subjects = %v", got)
+	}
+
+	mock := &mockPublisher{}
+	pub := NewValidatingPublisher(mock, registry, nil)
+	ctx := context.Background()
+	if err := pub.PublishWithRetry(ctx, SubjectRouterDecision, []byte(`{"provider": "local", "chain": []}`)); err != nil {
+		t.Fatalf("unversioned subjects publish on the latest version: %v", err)
+	}
+	if err := pub.PublishWithRetry(ctx, SubjectRouterDecision+".v1", []byte(`{"provider": "local"}`)); err != nil {
+		t.Fatalf("pinned subjects keep their version: %v", err)
+	}
+	if err := pub.PublishWithRetry(ctx, SubjectRouterDecision+".v3", []byte(`{}`)); err == nil {
+		t.Error("unknown versions must be rejected")
+	}
+	if mock.published[0].subject != "ultra.ai.router.decision.v2" || mock.published[1].subject != "ultra.ai.router.decision.v1" {
+		t.Errorf("published on %s and %s", mock.published[0].subject, mock.published[1].subject)
+	}
+
+	invalid := map[string]string{
+		"unversioned id": `{"$id": "ultra.ai.router.decision"}`,
+		"duplicate":      `{"$id": "ultra.ai.router.decision.v1"}`,
+	}
+	for name, raw := range invalid {
+		if err := os.WriteFile(filepath.Join(dir, "extra.json"), []byte(raw), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadRegistry(dir); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestPublish_MarshalError(t *testing.T) {
+	mock := &mockPublisher{}
+	err := PublishInferenceSummary(context.Background(), mock, SubjectInferenceSummary, InferenceSummary{CostBRL: math.NaN()})
+	if err == nil || len(mock.published) != 0 {
+		t.Errorf("an unencodable event must fail before publishing, got %v", err)
+	}
+}
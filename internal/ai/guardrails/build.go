@@ -0,0 +1,134 @@
+package guardrails
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+)
+
+// Settings of the PII manager built when none is given
+const (
+	defaultPIIConfidence     = 0.8
+	defaultPIIReloadInterval = 30 * time.Second
+)
+
+// stageBuilder creates a stage from its StageConfig
+type stageBuilder func(e *Engine, dir string, cfg StageConfig) (Stage, error)
+
+var preStages = map[string]stageBuilder{
+	"pii_check":          newPIICheckStage,
+	"profanity_check":    newProfanityStage,
+	"tenant_constraints": newTenantConstraintsStage,
+}
+
+var postStages = map[string]stageBuilder{
+	"redact_sensitive": newRedactStage,
+	"risk_annotation":  newRiskStage,
+}
+
+// build assembles the phases. Pre runs blocked_patterns and then the pre
+// policies. Post runs blocked_patterns, banned_words and allowed_domains,
+// then the post policies with max_output_chars placed right before
+// risk_annotation, so the risk tier knows whether the text was truncated.
+func (e *Engine) build(dir string, policies *PolicySet, rules *RuleSet) error {
+	blocked, err := newPatternStage(rules.BlockedPatterns)
+	if err != nil {
+		return err
+	}
+
+	pre, err := e.buildStages(PhasePre, preStages, dir, policies.Pre)
+	if err != nil {
+		return err
+	}
+	e.pre = append([]Stage{blocked}, pre...)
+
+	post, err := e.buildStages(PhasePost, postStages, dir, policies.Post)
+	if err != nil {
+		return err
+	}
+	banned, err := newBannedWordsStage(rules.Style.BannedWords)
+	if err != nil {
+		return err
+	}
+	e.post = []Stage{blocked, banned, newDomainStage(rules.AllowedDomains)}
+	limit := &lengthStage{max: rules.MaxOutputChars}
+	placed := false
+	for _, stage := range post {
+		if _, ok := stage.(*riskStage); ok && !placed {
+			e.post = append(e.post, limit)
+			placed = true
+		}
+		e.post = append(e.post, stage)
+	}
+	if !placed {
+		e.post = append(e.post, limit)
+	}
+	return nil
+}
+
+func (e *Engine) buildStages(phase string, builders map[string]stageBuilder, dir string, configs []StageConfig) ([]Stage, error) {
+	stages := make([]Stage, 0, len(configs))
+	for _, cfg := range configs {
+		builder, ok := builders[cfg.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown %s stage %q", phase, cfg.Name)
+		}
+		stage, err := builder(e, dir, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%s stage %s: %w", phase, cfg.Name, err)
+		}
+		stages = append(stages, stage)
+	}
+	return stages, nil
+}
+
+// piiManager returns the engine's PII manager, building one with the
+// detectors of rulesFile on first use
+func (e *Engine) piiManager(rulesFile string) (*compliance.PIIManager, error) {
+	if e.pii != nil {
+		return e.pii, nil
+	}
+	pm, err := compliance.NewPIIManager(compliance.PIIDetectionConfig{
+		Enabled:        true,
+		Confidence:     defaultPIIConfidence,
+		AutoMask:       true,
+		RulesFile:      rulesFile,
+		ReloadInterval: defaultPIIReloadInterval,
+	}, e.logger)
+	if err != nil {
+		return nil, err
+	}
+	e.pii, e.ownsPII = pm, true
+	return pm, nil
+}
+
+// wordPattern matches term as whole words of folded text, allowing any
+// run of spaces between its words
+func wordPattern(term string) (*regexp.Regexp, error) {
+	words := strings.Fields(fold(term))
+	if len(words) == 0 {
+		return nil, fmt.Errorf("empty term")
+	}
+	for i, word := range words {
+		words[i] = regexp.QuoteMeta(word)
+	}
+	return regexp.Compile(`(?:^|[^\p{L}\p{N}])` + strings.Join(words, `\s+`) + `(?:[^\p{L}\p{N}]|$)`)
+}
+
+// accentFolder strips the Portuguese diacritics from lowercase text
+var accentFolder = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// fold lowercases text and strips its accents for matching
+func fold(text string) string {
+	return accentFolder.Replace(strings.ToLower(text))
+}
@@ -0,0 +1,119 @@
+package guardrails
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Files Load reads from the AI config directory
+const (
+	PoliciesFile = "ai-policies.yaml"
+	RulesFile    = "ai-guardrails.json"
+)
+
+// PolicySet is ai-policies.yaml: the stages run before and after the
+// provider call, in order
+type PolicySet struct {
+	Version string        `yaml:"version"`
+	Pre     []StageConfig `yaml:"pre"`
+	Post    []StageConfig `yaml:"post"`
+	// FailClosed blocks the request when a stage fails instead of skipping
+	// the stage
+	FailClosed bool `yaml:"fail_closed"`
+}
+
+// StageConfig names a stage and holds its own settings
+type StageConfig struct {
+	Name   string    `yaml:"name"`
+	Config yaml.Node `yaml:"config"`
+}
+
+// RuleSet is ai-guardrails.json: rules every request is held to on top of
+// the staged policies
+type RuleSet struct {
+	// BlockedPatterns are regular expressions that block prompts and
+	// responses matching them
+	BlockedPatterns []string `json:"blocked_patterns"`
+	// AllowedDomains are the only domains links in responses may point to;
+	// an empty list allows any
+	AllowedDomains []string `json:"allowed_domains"`
+	// MaxOutputChars truncates longer responses; zero disables the limit
+	MaxOutputChars int   `json:"max_output_chars"`
+	Style          Style `json:"style"`
+}
+
+// Style holds the writing rules for responses
+type Style struct {
+	Tone        string   `json:"tone"`
+	BannedWords []string `json:"banned_words"`
+}
+
+// LoadPolicySet reads the staged policies from a YAML file
+func LoadPolicySet(path string) (*PolicySet, error) {
+	var policies PolicySet
+	if err := decodeYAMLFile(path, &policies); err != nil {
+		return nil, fmt.Errorf("loading AI policies: %w", err)
+	}
+	return &policies, nil
+}
+
+// LoadRuleSet reads the guardrail rules from a JSON file
+func LoadRuleSet(path string) (*RuleSet, error) {
+	raw, err := os.ReadFile(path) // #nosec G304 -- path comes from configuration
+	if err != nil {
+		return nil, fmt.Errorf("reading AI guardrails: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	var rules RuleSet
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("decoding AI guardrails: %w", err)
+	}
+	if rules.MaxOutputChars < 0 {
+		return nil, fmt.Errorf("max_output_chars must not be negative")
+	}
+	return &rules, nil
+}
+
+// decodeYAMLFile strictly decodes a YAML file into out
+func decodeYAMLFile(path string, out interface{}) error {
+	raw, err := os.ReadFile(path) // #nosec G304 -- path comes from configuration
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", filepath.Base(path), err)
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(raw))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(out); err != nil {
+		return fmt.Errorf("decoding %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
+
+// decodeNode strictly decodes a stage config into out, so a misspelled
+// setting fails the load instead of being ignored
+func decodeNode(node *yaml.Node, out interface{}) error {
+	if node.Kind == 0 {
+		return nil
+	}
+	raw, err := yaml.Marshal(node)
+	if err != nil {
+		return err
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(raw))
+	decoder.KnownFields(true)
+	return decoder.Decode(out)
+}
+
+// resolvePath makes a policy file path relative to dir
+func resolvePath(dir, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
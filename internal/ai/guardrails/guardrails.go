@@ -0,0 +1,236 @@
+// Package guardrails enforces templates/ai/config/ai-guardrails.json and
+// the staged policies of ai-policies.yaml around AI inference. Each phase
+// runs its stages in order; a stage may rewrite the text, annotate it or
+// block the request.
+package guardrails
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/events"
+	"github.com/vertikon/mcp-ultra/internal/ai/inference"
+	"github.com/vertikon/mcp-ultra/internal/ai/telemetry"
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+)
+
+// Severities of a block
+const (
+	SeverityLow    = "low"
+	SeverityMedium = "medium"
+	SeverityHigh   = "high"
+)
+
+// Phases of the engine
+const (
+	PhasePre  = "pre"
+	PhasePost = "post"
+)
+
+// Exchange is the text a phase checks, together with what earlier stages
+// learned about it
+type Exchange struct {
+	Request     inference.Request
+	Text        string
+	PII         []compliance.PIIFinding
+	Truncated   bool
+	Annotations map[string]string
+}
+
+// Stage is one step of a phase. It returns a *Violation to block the
+// request; any other error is a failure of the stage itself.
+type Stage interface {
+	Name() string
+	Check(ctx context.Context, x *Exchange) error
+}
+
+// Violation is returned by a stage that blocks the request
+type Violation struct {
+	Rule     string
+	Severity string
+	Message  string
+	// Sample is the offending text, published with the block event. It is
+	// left empty when the match is personal data.
+	Sample string
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("%s: %s", v.Rule, v.Message)
+}
+
+// Option configures an Engine
+type Option func(*Engine)
+
+// WithPIIManager detects and masks PII with pm, e.g. the compliance
+// framework's, instead of a manager built from the pii_check policy file
+func WithPIIManager(pm *compliance.PIIManager) Option {
+	return func(e *Engine) {
+		e.pii = pm
+	}
+}
+
+// WithPublisher publishes every block on events.SubjectPolicyBlock
+func WithPublisher(publisher events.EventPublisher) Option {
+	return func(e *Engine) {
+		e.publisher = publisher
+	}
+}
+
+// WithLogger sets the engine logger
+func WithLogger(logger *zap.Logger) Option {
+	return func(e *Engine) {
+		e.logger = logger
+	}
+}
+
+// WithPhases turns the pre and post phases on or off; both run by default
+func WithPhases(pre, post bool) Option {
+	return func(e *Engine) {
+		e.runPre = pre
+		e.runPost = post
+	}
+}
+
+// Engine runs the guardrail phases. It implements inference.Guardrails.
+type Engine struct {
+	pre        []Stage
+	post       []Stage
+	failClosed bool
+	runPre     bool
+	runPost    bool
+
+	pii       *compliance.PIIManager
+	ownsPII   bool
+	publisher events.EventPublisher
+	logger    *zap.Logger
+}
+
+var _ inference.Guardrails = (*Engine)(nil)
+
+// Load builds an engine from ai-policies.yaml and ai-guardrails.json in
+// configDir. Policy files named by the stages are relative to configDir.
+func Load(configDir string, opts ...Option) (*Engine, error) {
+	policies, err := LoadPolicySet(filepath.Join(configDir, PoliciesFile))
+	if err != nil {
+		return nil, err
+	}
+	rules, err := LoadRuleSet(filepath.Join(configDir, RulesFile))
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Engine{
+		failClosed: policies.FailClosed,
+		runPre:     true,
+		runPost:    true,
+		logger:     zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	if err := e.build(configDir, policies, rules); err != nil {
+		e.Close()
+		return nil, err
+	}
+	return e, nil
+}
+
+// Close stops the PII manager the engine built for itself, if any. It is
+// safe on a nil engine.
+func (e *Engine) Close() {
+	if e != nil && e.ownsPII && e.pii != nil {
+		e.pii.Close()
+	}
+}
+
+// Pre checks a prompt and returns it as it must be sent to the provider.
+// Annotations made in this phase are only seen by later pre stages.
+func (e *Engine) Pre(ctx context.Context, req inference.Request) (string, error) {
+	if !e.runPre {
+		return req.Prompt, nil
+	}
+	x := &Exchange{Request: req, Text: req.Prompt, Annotations: make(map[string]string)}
+	if err := e.run(ctx, PhasePre, e.pre, x); err != nil {
+		return "", err
+	}
+	return x.Text, nil
+}
+
+// Post checks a response, rewriting its content and adding annotations
+func (e *Engine) Post(ctx context.Context, req inference.Request, resp *inference.Response) error {
+	if !e.runPost {
+		return nil
+	}
+	x := &Exchange{Request: req, Text: resp.Content, Annotations: make(map[string]string)}
+	if err := e.run(ctx, PhasePost, e.post, x); err != nil {
+		return err
+	}
+
+	resp.Content = x.Text
+	if len(x.Annotations) > 0 && resp.Annotations == nil {
+		resp.Annotations = make(map[string]string, len(x.Annotations))
+	}
+	for key, value := range x.Annotations {
+		resp.Annotations[key] = value
+	}
+	return nil
+}
+
+// run applies stages in order. A failing stage blocks the request when
+// the policies fail closed and is skipped otherwise.
+func (e *Engine) run(ctx context.Context, phase string, stages []Stage, x *Exchange) error {
+	for _, stage := range stages {
+		err := stage.Check(ctx, x)
+		if err == nil {
+			continue
+		}
+
+		var violation *Violation
+		if !errors.As(err, &violation) {
+			fields := []zap.Field{zap.String("phase", phase), zap.String("stage", stage.Name()), zap.Error(err)}
+			if !e.failClosed {
+				e.logger.Warn("Guardrail stage failed, skipping it", fields...)
+				continue
+			}
+			e.logger.Error("Guardrail stage failed, blocking the request", fields...)
+			violation = &Violation{Rule: stage.Name(), Severity: SeverityHigh, Message: "guardrail check failed"}
+		}
+		return e.block(ctx, phase, x.Request, violation)
+	}
+	return nil
+}
+
+// block records a violation and turns it into the error the inference
+// handler answers 403 for
+func (e *Engine) block(ctx context.Context, phase string, req inference.Request, v *Violation) error {
+	telemetry.IncPolicyBlock(telemetry.Labels{
+		TenantID: req.Caller.TenantID,
+		MCPID:    req.Caller.MCPID,
+		SDKName:  req.Caller.SDK,
+		Rule:     v.Rule,
+		Severity: v.Severity,
+	})
+
+	if e.publisher != nil {
+		err := events.PublishPolicyBlock(ctx, e.publisher, events.SubjectPolicyBlock, events.PolicyBlock{
+			Base:     events.Base{TenantID: req.Caller.TenantID, MCPID: req.Caller.MCPID, SDKName: req.Caller.SDK},
+			Rule:     v.Rule,
+			Severity: v.Severity,
+			Sample:   v.Sample,
+		})
+		if err != nil {
+			e.logger.Warn("Failed to publish policy block", zap.String("rule", v.Rule), zap.Error(err))
+		}
+	}
+
+	return &inference.PolicyViolationError{
+		Policy:  v.Rule,
+		Message: v.Message,
+		Details: map[string]interface{}{"phase": phase, "severity": v.Severity},
+	}
+}
@@ -0,0 +1,280 @@
+package guardrails
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/events"
+	"github.com/vertikon/mcp-ultra/internal/ai/inference"
+)
+
+const shippedConfig = "../../../templates/ai/config"
+
+type recordingPublisher struct {
+	mu     sync.Mutex
+	blocks []events.PolicyBlock
+}
+
+func (p *recordingPublisher) PublishWithRetry(_ context.Context, subject string, payload []byte) error {
+	if subject != events.SubjectPolicyBlock {
+		return errors.New("unexpected subject " + subject)
+	}
+	var block events.PolicyBlock
+	if err := json.Unmarshal(payload, &block); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.blocks = append(p.blocks, block)
+	return nil
+}
+
+func loadShipped(t *testing.T, opts ...Option) (*Engine, *recordingPublisher) {
+	t.Helper()
+	publisher := &recordingPublisher{}
+	opts = append([]Option{WithPublisher(publisher), WithLogger(zaptest.NewLogger(t))}, opts...)
+	engine, err := Load(shippedConfig, opts...)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	t.Cleanup(engine.Close)
+	return engine, publisher
+}
+
+func request(mcpID, useCase, prompt string) inference.Request {
+	return inference.Request{
+		Caller:  inference.Caller{TenantID: "tenant-a", MCPID: mcpID, SDK: "go"},
+		Prompt:  prompt,
+		UseCase: useCase,
+	}
+}
+
+func stageNames(stages []Stage) string {
+	names := make([]string, len(stages))
+	for i, stage := range stages {
+		names[i] = stage.Name()
+	}
+	return strings.Join(names, ",")
+}
+
+func TestLoad_ShippedPolicies(t *testing.T) {
+	engine, _ := loadShipped(t)
+
+	if got := stageNames(engine.pre); got != "blocked_patterns,pii_check,profanity_check,tenant_constraints" {
+		t.Errorf("pre stages = %s", got)
+	}
+	if got := stageNames(engine.post); got != "blocked_patterns,banned_words,allowed_domains,redact_sensitive,max_output_chars,risk_annotation" {
+		t.Errorf("post stages = %s", got)
+	}
+	if !engine.failClosed {
+		t.Error("the shipped policies fail closed")
+	}
+}
+
+func TestPre(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    inference.Request
+		policy string
+		sample string
+	}{
+		{"clean prompt", request("mcp-vendas", "generation", "resuma o pedido"), "", ""},
+		{"low severity is allowed", request("mcp-vendas", "generation", "que porcaria de frete"), "", ""},
+		{"high severity ignoring case and accents", request("mcp-vendas", "generation", "VAI SE FODÊR"), "profanity_check", "vai se foder"},
+		{"blocked pattern", request("mcp-vendas", "generation", "meu cpf 123.456.789-09"), "blocked_patterns", ""},
+		{"generation denied", request("mcp-wa-otp", "generation", "gere um codigo"), "tenant_constraints", ""},
+		{"classification allowed", request("mcp-wa-otp", "classification", "gere um codigo"), "", ""},
+		{"strict MCP with PII", request("mcp-wa-autenticacao", "classification", "falar com ana@example.com"), "tenant_constraints", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine, publisher := loadShipped(t)
+
+			prompt, err := engine.Pre(context.Background(), tt.req)
+			if tt.policy == "" {
+				if err != nil {
+					t.Fatalf("unexpected block: %v", err)
+				}
+				if prompt != tt.req.Prompt {
+					t.Errorf("prompt changed to %q", prompt)
+				}
+				return
+			}
+
+			var violation *inference.PolicyViolationError
+			if !errors.As(err, &violation) || violation.Policy != tt.policy {
+				t.Fatalf("expected a %s violation, got %v", tt.policy, err)
+			}
+			if len(publisher.blocks) != 1 {
+				t.Fatalf("published %d blocks", len(publisher.blocks))
+			}
+			block := publisher.blocks[0]
+			if block.Rule != tt.policy || block.MCPID != tt.req.Caller.MCPID || block.Sample != tt.sample {
+				t.Errorf("unexpected block event %+v", block)
+			}
+		})
+	}
+}
+
+func TestPre_MasksPII(t *testing.T) {
+	engine, publisher := loadShipped(t)
+
+	prompt, err := engine.Pre(context.Background(), request("mcp-vendas", "generation", "responda para ana@example.com hoje"))
+	if err != nil {
+		t.Fatalf("Pre failed: %v", err)
+	}
+	if strings.Contains(prompt, "ana@example.com") || !strings.HasPrefix(prompt, "responda para ") || !strings.HasSuffix(prompt, " hoje") {
+		t.Errorf("only the address must be masked, got %q", prompt)
+	}
+	if len(publisher.blocks) != 0 {
+		t.Error("masking is not a block")
+	}
+}
+
+func TestPost(t *testing.T) {
+	engine, _ := loadShipped(t)
+
+	resp := &inference.Response{Content: "Ligue para (11) 98765-4321 ou veja https://exemplo.com/oferta. Mais em https://www.vertikon.com.br/planos."}
+	if err := engine.Post(context.Background(), request("mcp-vendas", "generation", "x"), resp); err != nil {
+		t.Fatalf("Post failed: %v", err)
+	}
+
+	want := "Ligue para ****4321 ou veja [link removido]. Mais em https://www.vertikon.com.br/planos."
+	if resp.Content != want {
+		t.Errorf("content = %q, want %q", resp.Content, want)
+	}
+	// Phones are confidential PII
+	if resp.Annotations["risk_tier"] != "medium" || resp.Annotations["review_required"] != "false" || resp.Annotations["links_removed"] != "1" {
+		t.Errorf("unexpected annotations %v", resp.Annotations)
+	}
+}
+
+func TestPost_RiskTiers(t *testing.T) {
+	engine, _ := loadShipped(t)
+
+	tests := []struct {
+		name    string
+		req     inference.Request
+		content string
+		tier    string
+		review  string
+	}{
+		{"default", request("mcp-vendas", "generation", "x"), "pedido enviado", "low", "false"},
+		{"regulated topic", inference.Request{UseCase: "generation", Metadata: map[string]interface{}{"topic": "financial_advice"}}, "invista", "high", "true"},
+		{"truncated", request("mcp-vendas", "generation", "x"), strings.Repeat("a", 1300), "medium", "false"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &inference.Response{Content: tt.content}
+			if err := engine.Post(context.Background(), tt.req, resp); err != nil {
+				t.Fatalf("Post failed: %v", err)
+			}
+			if resp.Annotations["risk_tier"] != tt.tier || resp.Annotations["review_required"] != tt.review {
+				t.Errorf("annotations = %v, want tier %s", resp.Annotations, tt.tier)
+			}
+			if len([]rune(resp.Content)) > 1200 {
+				t.Errorf("content was not truncated to max_output_chars")
+			}
+		})
+	}
+}
+
+func TestPost_BannedWords(t *testing.T) {
+	engine, publisher := loadShipped(t)
+
+	resp := &inference.Response{Content: "Oferecemos GARANTIA   total no produto"}
+	err := engine.Post(context.Background(), request("mcp-vendas", "generation", "x"), resp)
+
+	var violation *inference.PolicyViolationError
+	if !errors.As(err, &violation) || violation.Policy != "banned_words" || violation.Details["phase"] != PhasePost {
+		t.Fatalf("expected a banned_words violation, got %v", err)
+	}
+	if len(publisher.blocks) != 1 || publisher.blocks[0].Sample != "garantia total" {
+		t.Errorf("unexpected block events %+v", publisher.blocks)
+	}
+}
+
+func TestPhasesDisabled(t *testing.T) {
+	engine, _ := loadShipped(t, WithPhases(false, false))
+
+	prompt, err := engine.Pre(context.Background(), request("mcp-wa-otp", "generation", "vai se foder"))
+	if err != nil || prompt != "vai se foder" {
+		t.Errorf("disabled pre phase must pass the prompt through, got %q, %v", prompt, err)
+	}
+	resp := &inference.Response{Content: "garantia total"}
+	if err := engine.Post(context.Background(), request("mcp-vendas", "generation", "x"), resp); err != nil || resp.Annotations != nil {
+		t.Errorf("disabled post phase must not touch the response, got %+v, %v", resp, err)
+	}
+}
+
+// failingStage fails every check
+type failingStage struct{}
+
+func (failingStage) Name() string { return "failing" }
+
+func (failingStage) Check(context.Context, *Exchange) error {
+	return errors.New("detector unavailable")
+}
+
+func TestFailClosed(t *testing.T) {
+	for _, failClosed := range []bool{true, false} {
+		engine, publisher := loadShipped(t)
+		engine.failClosed = failClosed
+		engine.pre = append([]Stage{failingStage{}}, engine.pre...)
+
+		_, err := engine.Pre(context.Background(), request("mcp-vendas", "generation", "resuma o pedido"))
+		if blocked := err != nil; blocked != failClosed {
+			t.Errorf("fail_closed=%v: blocked = %v (%v)", failClosed, blocked, err)
+		}
+		if failClosed && (len(publisher.blocks) != 1 || publisher.blocks[0].Rule != "failing") {
+			t.Errorf("fail_closed=%v: unexpected block events %+v", failClosed, publisher.blocks)
+		}
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := map[string]string{
+		"unknown stage":   "pre:\n  - name: sentiment_check\n",
+		"unknown setting": "pre:\n  - name: tenant_constraints\n    config: { strict: [a] }\n",
+		"unknown action":  "pre:\n  - name: pii_check\n    config: { action: drop }\n",
+		"missing file":    "post:\n  - name: risk_annotation\n    config: { tiers_file: nope.yaml }\n",
+	}
+	for name, policies := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, PoliciesFile), []byte(policies), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(dir, RulesFile), []byte(`{"max_output_chars": 10}`), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Load(dir); err == nil {
+				t.Error("expected a load error")
+			}
+		})
+	}
+}
+
+func TestMaskPhone(t *testing.T) {
+	tests := map[string]string{
+		"(11) 98765-4321": "****4321",
+		"4321":            "****4321",
+		"21":              "******21",
+	}
+	for phone, want := range tests {
+		if got := maskPhone(phone, "****####"); got != want {
+			t.Errorf("maskPhone(%q) = %q, want %q", phone, got, want)
+		}
+	}
+	if got := shortenName("Maria  da Silva", 2); got != "Ma. da Si." {
+		t.Errorf("shortenName = %q", got)
+	}
+}
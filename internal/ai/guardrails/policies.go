@@ -0,0 +1,455 @@
+package guardrails
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/inference"
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+)
+
+// Actions of the pii_check and profanity_check stages
+const (
+	actionMask     = "mask"
+	actionBlock    = "block"
+	actionAnnotate = "annotate"
+	blockIfPrefix  = "block_if_"
+)
+
+// severityRank orders severities; block_if_<severity> blocks at or above it
+var severityRank = map[string]int{SeverityLow: 1, SeverityMedium: 2, SeverityHigh: 3}
+
+// piiCheckStage finds PII in the text and masks it, blocks on it or only
+// records it for later stages
+type piiCheckStage struct {
+	pm     *compliance.PIIManager
+	action string
+}
+
+type piiCheckConfig struct {
+	// PolicyFile holds the detectors, unless the engine was given a PII
+	// manager
+	PolicyFile string `yaml:"policy_file"`
+	Action     string `yaml:"action"`
+}
+
+func newPIICheckStage(e *Engine, dir string, cfg StageConfig) (Stage, error) {
+	var settings piiCheckConfig
+	if err := decodeNode(&cfg.Config, &settings); err != nil {
+		return nil, err
+	}
+	switch settings.Action {
+	case "":
+		settings.Action = actionMask
+	case actionMask, actionBlock, actionAnnotate:
+	default:
+		return nil, fmt.Errorf("unknown action %q", settings.Action)
+	}
+
+	pm, err := e.piiManager(resolvePath(dir, settings.PolicyFile))
+	if err != nil {
+		return nil, err
+	}
+	return &piiCheckStage{pm: pm, action: settings.Action}, nil
+}
+
+func (s *piiCheckStage) Name() string { return "pii_check" }
+
+func (s *piiCheckStage) Check(_ context.Context, x *Exchange) error {
+	var findings []compliance.PIIFinding
+	if s.action == actionMask {
+		masked, err := s.pm.Mask(&x.Text)
+		if err != nil {
+			return fmt.Errorf("masking PII: %w", err)
+		}
+		findings = masked
+	} else {
+		scanned, err := s.pm.Scan(x.Text)
+		if err != nil {
+			return fmt.Errorf("scanning PII: %w", err)
+		}
+		findings = scanned
+	}
+	if len(findings) == 0 {
+		return nil
+	}
+
+	x.PII = append(x.PII, findings...)
+	x.Annotations["pii_types"] = piiTypes(findings)
+	if s.action == actionBlock {
+		return &Violation{Rule: s.Name(), Severity: SeverityHigh, Message: "content contains personal data: " + piiTypes(findings)}
+	}
+	return nil
+}
+
+// piiTypes lists the distinct types of findings, e.g. "cpf,email"
+func piiTypes(findings []compliance.PIIFinding) string {
+	seen := make(map[string]bool)
+	var types []string
+	for _, finding := range findings {
+		if !seen[string(finding.PIIType)] {
+			seen[string(finding.PIIType)] = true
+			types = append(types, string(finding.PIIType))
+		}
+	}
+	sort.Strings(types)
+	return strings.Join(types, ",")
+}
+
+// profanityStage looks for the terms of profanity.yaml, ignoring case and
+// accents, and blocks from a configured severity on
+type profanityStage struct {
+	terms []profanityTerm
+	// threshold is the lowest severity rank that blocks; zero never blocks
+	threshold int
+}
+
+type profanityTerm struct {
+	term     string
+	severity string
+	pattern  *regexp.Regexp
+}
+
+type profanityConfig struct {
+	PolicyFile string `yaml:"policy_file"`
+	Action     string `yaml:"action"`
+}
+
+// profanityFile is the format of templates/ai/policies/profanity.yaml
+type profanityFile struct {
+	Version    string              `yaml:"version"`
+	Severities map[string][]string `yaml:"severities"`
+}
+
+func newProfanityStage(_ *Engine, dir string, cfg StageConfig) (Stage, error) {
+	var settings profanityConfig
+	if err := decodeNode(&cfg.Config, &settings); err != nil {
+		return nil, err
+	}
+	if settings.PolicyFile == "" {
+		return nil, fmt.Errorf("policy_file is required")
+	}
+
+	stage := &profanityStage{}
+	switch {
+	case settings.Action == actionAnnotate:
+	case settings.Action == actionBlock:
+		stage.threshold = severityRank[SeverityLow]
+	case strings.HasPrefix(settings.Action, blockIfPrefix):
+		stage.threshold = severityRank[strings.TrimPrefix(settings.Action, blockIfPrefix)]
+		if stage.threshold == 0 {
+			return nil, fmt.Errorf("unknown action %q", settings.Action)
+		}
+	default:
+		return nil, fmt.Errorf("unknown action %q", settings.Action)
+	}
+
+	var file profanityFile
+	if err := decodeYAMLFile(resolvePath(dir, settings.PolicyFile), &file); err != nil {
+		return nil, err
+	}
+	for severity, terms := range file.Severities {
+		if severityRank[severity] == 0 {
+			return nil, fmt.Errorf("unknown severity %q", severity)
+		}
+		for _, term := range terms {
+			pattern, err := wordPattern(term)
+			if err != nil {
+				return nil, fmt.Errorf("invalid term %q: %w", term, err)
+			}
+			stage.terms = append(stage.terms, profanityTerm{term: term, severity: severity, pattern: pattern})
+		}
+	}
+	// Check the worst terms first
+	sort.SliceStable(stage.terms, func(i, j int) bool {
+		return severityRank[stage.terms[i].severity] > severityRank[stage.terms[j].severity]
+	})
+	return stage, nil
+}
+
+func (s *profanityStage) Name() string { return "profanity_check" }
+
+func (s *profanityStage) Check(_ context.Context, x *Exchange) error {
+	folded := fold(x.Text)
+	for _, term := range s.terms {
+		if !term.pattern.MatchString(folded) {
+			continue
+		}
+		x.Annotations["profanity"] = term.severity
+		if s.threshold > 0 && severityRank[term.severity] >= s.threshold {
+			return &Violation{Rule: s.Name(), Severity: term.severity, Message: "content contains offensive language", Sample: term.term}
+		}
+		return nil
+	}
+	return nil
+}
+
+// tenantConstraintsStage applies per-MCP restrictions. MCPs in strict_for
+// accept no personal data at all, even masked, so it relies on pii_check
+// running before it; MCPs in deny_generation_for cannot use generation.
+type tenantConstraintsStage struct {
+	strict         map[string]bool
+	denyGeneration map[string]bool
+}
+
+type tenantConstraintsConfig struct {
+	StrictFor         []string `yaml:"strict_for"`
+	DenyGenerationFor []string `yaml:"deny_generation_for"`
+}
+
+func newTenantConstraintsStage(_ *Engine, _ string, cfg StageConfig) (Stage, error) {
+	var settings tenantConstraintsConfig
+	if err := decodeNode(&cfg.Config, &settings); err != nil {
+		return nil, err
+	}
+	return &tenantConstraintsStage{
+		strict:         stringSet(settings.StrictFor),
+		denyGeneration: stringSet(settings.DenyGenerationFor),
+	}, nil
+}
+
+func (s *tenantConstraintsStage) Name() string { return "tenant_constraints" }
+
+func (s *tenantConstraintsStage) Check(_ context.Context, x *Exchange) error {
+	mcpID := x.Request.Caller.MCPID
+	if s.denyGeneration[mcpID] && x.Request.UseCase == inference.UseCaseGeneration {
+		return &Violation{Rule: s.Name(), Severity: SeverityHigh, Message: "generation is not allowed for this MCP"}
+	}
+	if s.strict[mcpID] && len(x.PII) > 0 {
+		return &Violation{Rule: s.Name(), Severity: SeverityHigh, Message: "personal data is not allowed for this MCP"}
+	}
+	return nil
+}
+
+func stringSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
+
+// redactStage masks the PII left in a response. Phones keep the digits
+// phone_mask shows, names are cut to max_name_chars per word and other
+// types go through the PII manager's anonymizers.
+type redactStage struct {
+	pm           *compliance.PIIManager
+	maxNameChars int
+	phoneMask    string
+}
+
+type redactConfig struct {
+	MaxNameChars int `yaml:"max_name_chars"`
+	// PhoneMask is written over phones: "*" stands for a hidden digit and
+	// "#" for a shown one, taken from the end of the number
+	PhoneMask string `yaml:"phone_mask"`
+}
+
+func newRedactStage(e *Engine, _ string, cfg StageConfig) (Stage, error) {
+	var settings redactConfig
+	if err := decodeNode(&cfg.Config, &settings); err != nil {
+		return nil, err
+	}
+	if settings.MaxNameChars < 0 {
+		return nil, fmt.Errorf("max_name_chars must not be negative")
+	}
+	pm, err := e.piiManager("")
+	if err != nil {
+		return nil, err
+	}
+	return &redactStage{pm: pm, maxNameChars: settings.MaxNameChars, phoneMask: settings.PhoneMask}, nil
+}
+
+func (s *redactStage) Name() string { return "redact_sensitive" }
+
+func (s *redactStage) Check(_ context.Context, x *Exchange) error {
+	findings, err := s.pm.Scan(x.Text)
+	if err != nil {
+		return fmt.Errorf("scanning PII: %w", err)
+	}
+	if len(findings) == 0 {
+		return nil
+	}
+	x.PII = append(x.PII, findings...)
+
+	// Replace from the end so earlier offsets stay valid
+	sort.Slice(findings, func(i, j int) bool { return findings[i].Start > findings[j].Start })
+	text := x.Text
+	for _, finding := range findings {
+		replacement, err := s.redact(finding.PIIType, text[finding.Start:finding.End])
+		if err != nil {
+			return fmt.Errorf("redacting %s: %w", finding.PIIType, err)
+		}
+		text = text[:finding.Start] + replacement + text[finding.End:]
+	}
+	x.Text = text
+	x.Annotations["pii_redacted"] = strconv.Itoa(len(findings))
+	return nil
+}
+
+func (s *redactStage) redact(piiType compliance.PIIType, value string) (string, error) {
+	switch {
+	case piiType == compliance.PIITypePhone && s.phoneMask != "":
+		return maskPhone(value, s.phoneMask), nil
+	case piiType == compliance.PIITypeName && s.maxNameChars > 0:
+		return shortenName(value, s.maxNameChars), nil
+	}
+	anonymized, err := s.pm.AnonymizeValue(piiType, value)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprint(anonymized), nil
+}
+
+// maskPhone writes mask over a phone number: "****####" turns
+// "(11) 98765-4321" into "****4321"
+func maskPhone(phone, mask string) string {
+	var digits []rune
+	for _, r := range phone {
+		if unicode.IsDigit(r) {
+			digits = append(digits, r)
+		}
+	}
+	// Short numbers leave the leftmost "#" hidden
+	hidden := strings.Count(mask, "#") - len(digits)
+	tail := digits
+	if hidden < 0 {
+		tail, hidden = digits[-hidden:], 0
+	}
+
+	var masked strings.Builder
+	for _, r := range mask {
+		switch {
+		case r != '#':
+			masked.WriteRune(r)
+		case hidden > 0:
+			masked.WriteRune('*')
+			hidden--
+		default:
+			masked.WriteRune(tail[0])
+			tail = tail[1:]
+		}
+	}
+	return masked.String()
+}
+
+// shortenName cuts every word of a name to n characters followed by a
+// period: "Maria Silva" becomes "Ma. Si."
+func shortenName(name string, n int) string {
+	words := strings.Fields(name)
+	for i, word := range words {
+		if runes := []rune(word); len(runes) > n {
+			words[i] = string(runes[:n]) + "."
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// riskStage annotates responses with the first tier of risk-tiers.yaml
+// whose conditions hold, or the default tier
+type riskStage struct {
+	tiers    []RiskTier
+	fallback *RiskTier
+}
+
+type riskConfig struct {
+	TiersFile string `yaml:"tiers_file"`
+}
+
+// RiskTiers is the format of templates/ai/policies/risk-tiers.yaml
+type RiskTiers struct {
+	Version string     `yaml:"version"`
+	Default string     `yaml:"default"`
+	Tiers   []RiskTier `yaml:"tiers"`
+}
+
+// RiskTier is one risk level. It applies when any of its conditions
+// holds; a tier without conditions is only reached as the default.
+type RiskTier struct {
+	Name           string        `yaml:"name"`
+	Description    string        `yaml:"description"`
+	When           RiskCondition `yaml:"when"`
+	ReviewRequired bool          `yaml:"review_required"`
+}
+
+// RiskCondition lists what puts a response in a tier
+type RiskCondition struct {
+	// PIISensitivity matches PII of these sensitivities found in the
+	// exchange
+	PIISensitivity []compliance.PIISensitivity `yaml:"pii_sensitivity"`
+	// UseCases matches the request use case or its metadata.topic
+	UseCases []string `yaml:"use_cases"`
+	// Truncated matches responses max_output_chars cut
+	Truncated bool `yaml:"truncated"`
+}
+
+func newRiskStage(_ *Engine, dir string, cfg StageConfig) (Stage, error) {
+	var settings riskConfig
+	if err := decodeNode(&cfg.Config, &settings); err != nil {
+		return nil, err
+	}
+	if settings.TiersFile == "" {
+		return nil, fmt.Errorf("tiers_file is required")
+	}
+
+	var file RiskTiers
+	if err := decodeYAMLFile(resolvePath(dir, settings.TiersFile), &file); err != nil {
+		return nil, err
+	}
+	stage := &riskStage{tiers: file.Tiers}
+	if file.Default != "" {
+		for i := range file.Tiers {
+			if file.Tiers[i].Name == file.Default {
+				stage.fallback = &file.Tiers[i]
+			}
+		}
+		if stage.fallback == nil {
+			return nil, fmt.Errorf("default tier %q is not defined", file.Default)
+		}
+	}
+	return stage, nil
+}
+
+func (s *riskStage) Name() string { return "risk_annotation" }
+
+func (s *riskStage) Check(_ context.Context, x *Exchange) error {
+	tier := s.fallback
+	for i := range s.tiers {
+		if s.tiers[i].When.matches(x) {
+			tier = &s.tiers[i]
+			break
+		}
+	}
+	if tier == nil {
+		return nil
+	}
+
+	x.Annotations["risk_tier"] = tier.Name
+	x.Annotations["review_required"] = strconv.FormatBool(tier.ReviewRequired)
+	return nil
+}
+
+func (c RiskCondition) matches(x *Exchange) bool {
+	if c.Truncated && x.Truncated {
+		return true
+	}
+	for _, finding := range x.PII {
+		for _, sensitivity := range c.PIISensitivity {
+			if finding.Sensitivity == sensitivity {
+				return true
+			}
+		}
+	}
+	topic, _ := x.Request.Metadata["topic"].(string)
+	for _, useCase := range c.UseCases {
+		if useCase == x.Request.UseCase || (topic != "" && useCase == topic) {
+			return true
+		}
+	}
+	return false
+}
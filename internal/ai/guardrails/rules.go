@@ -0,0 +1,155 @@
+package guardrails
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// linkReplacement takes the place of links to domains off the allowlist
+const linkReplacement = "[link removido]"
+
+// patternStage blocks text matching any of ai-guardrails.json's
+// blocked_patterns
+type patternStage struct {
+	patterns []*regexp.Regexp
+}
+
+func newPatternStage(patterns []string) (*patternStage, error) {
+	stage := &patternStage{}
+	for _, pattern := range patterns {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blocked pattern %q: %w", pattern, err)
+		}
+		stage.patterns = append(stage.patterns, compiled)
+	}
+	return stage, nil
+}
+
+func (s *patternStage) Name() string { return "blocked_patterns" }
+
+// Check blocks on the first match. The match is not sampled, since the
+// shipped patterns describe card numbers and CPFs.
+func (s *patternStage) Check(_ context.Context, x *Exchange) error {
+	for _, pattern := range s.patterns {
+		if pattern.MatchString(x.Text) {
+			return &Violation{Rule: s.Name(), Severity: SeverityHigh, Message: "content matches a blocked pattern"}
+		}
+	}
+	return nil
+}
+
+// bannedWordsStage blocks responses using style.banned_words, ignoring
+// case and accents
+type bannedWordsStage struct {
+	words    []string
+	patterns []*regexp.Regexp
+}
+
+func newBannedWordsStage(words []string) (*bannedWordsStage, error) {
+	stage := &bannedWordsStage{}
+	for _, word := range words {
+		pattern, err := wordPattern(word)
+		if err != nil {
+			return nil, fmt.Errorf("invalid banned word %q: %w", word, err)
+		}
+		stage.words = append(stage.words, word)
+		stage.patterns = append(stage.patterns, pattern)
+	}
+	return stage, nil
+}
+
+func (s *bannedWordsStage) Name() string { return "banned_words" }
+
+func (s *bannedWordsStage) Check(_ context.Context, x *Exchange) error {
+	folded := fold(x.Text)
+	for i, pattern := range s.patterns {
+		if pattern.MatchString(folded) {
+			return &Violation{Rule: s.Name(), Severity: SeverityMedium, Message: "content uses a banned expression", Sample: s.words[i]}
+		}
+	}
+	return nil
+}
+
+// linkPattern finds web links in free text
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"']+`)
+
+// domainStage removes links to domains outside allowed_domains. Subdomains
+// of an allowed domain are allowed.
+type domainStage struct {
+	allowed []string
+}
+
+func newDomainStage(allowed []string) *domainStage {
+	stage := &domainStage{}
+	for _, domain := range allowed {
+		stage.allowed = append(stage.allowed, strings.ToLower(strings.TrimSpace(domain)))
+	}
+	return stage
+}
+
+func (s *domainStage) Name() string { return "allowed_domains" }
+
+func (s *domainStage) Check(_ context.Context, x *Exchange) error {
+	if len(s.allowed) == 0 {
+		return nil
+	}
+
+	removed := 0
+	x.Text = linkPattern.ReplaceAllStringFunc(x.Text, func(link string) string {
+		// Punctuation closing a sentence is not part of the link
+		trimmed := strings.TrimRight(link, ".,;:!?)")
+		if s.allows(linkHost(trimmed)) {
+			return link
+		}
+		removed++
+		return linkReplacement + link[len(trimmed):]
+	})
+	if removed > 0 {
+		x.Annotations["links_removed"] = strconv.Itoa(removed)
+	}
+	return nil
+}
+
+func (s *domainStage) allows(host string) bool {
+	for _, domain := range s.allowed {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// linkHost returns the lowercase host of a link found by linkPattern
+func linkHost(link string) string {
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(parsed.Hostname())
+}
+
+// lengthStage truncates responses longer than max_output_chars
+type lengthStage struct {
+	max int
+}
+
+func (s *lengthStage) Name() string { return "max_output_chars" }
+
+func (s *lengthStage) Check(_ context.Context, x *Exchange) error {
+	if s.max <= 0 || utf8.RuneCountInString(x.Text) <= s.max {
+		return nil
+	}
+	x.Text = string([]rune(x.Text)[:s.max])
+	x.Truncated = true
+	x.Annotations["truncated"] = "true"
+	return nil
+}
@@ -0,0 +1,189 @@
+package inference
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/provider"
+	"github.com/vertikon/mcp-ultra/internal/ai/router"
+	"github.com/vertikon/mcp-ultra/internal/security"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+	"github.com/vertikon/mcp-ultra/pkg/httpx"
+)
+
+// Headers that label the client of an inference request. The tenant and
+// user come from the authenticated request context, never from headers.
+const (
+	HeaderMCPID   = "X-MCP-ID"
+	HeaderSDKName = "X-SDK-Name"
+)
+
+// Handler serves the AI endpoints of api/openapi.yaml
+type Handler struct {
+	service *Service
+	logger  *zap.Logger
+}
+
+// NewHandler creates the AI HTTP handler
+func NewHandler(service *Service, logger *zap.Logger) *Handler {
+	return &Handler{service: service, logger: logger}
+}
+
+// Routes mounts POST /infer and GET /router/status
+func (h *Handler) Routes() httpx.Router {
+	r := httpx.NewRouter()
+	r.Post("/infer", h.Infer)
+	r.Get("/router/status", h.RouterStatus)
+	return r
+}
+
+// Infer handles POST /ai/infer
+func (h *Handler) Infer(w http.ResponseWriter, r *http.Request) {
+	var req Request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, r, http.StatusBadRequest, "validation_error", "invalid JSON body")
+		return
+	}
+	req.Caller = Caller{
+		TenantID: tenant.ID(r.Context()),
+		MCPID:    r.Header.Get(HeaderMCPID),
+		SDK:      r.Header.Get(HeaderSDKName),
+	}
+	if claims, err := security.GetUserFromContext(r.Context()); err == nil {
+		req.Caller.UserID = claims.UserID
+	}
+
+	resp, err := h.service.Infer(r.Context(), req)
+	if err != nil {
+		h.writeInferError(w, r, err)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, resp)
+}
+
+// RouterStatus handles GET /ai/router/status. Provider health comes from
+// the outcome of their recent calls.
+func (h *Handler) RouterStatus(w http.ResponseWriter, _ *http.Request) {
+	flags := h.service.Router().Flags()
+	providers := make(map[string]ProviderStatus)
+	for _, name := range h.service.Providers().Names() {
+		health := h.service.ProviderHealth(name)
+		providers[name] = ProviderStatus{Healthy: health.Healthy, ErrorRate: health.ErrorRate}
+	}
+	h.writeJSON(w, http.StatusOK, RouterStatusResponse{
+		Enabled:       flags.AI.Enabled,
+		Mode:          flags.AI.Mode,
+		CanaryPercent: float64(flags.AI.CanaryPercent),
+		Providers:     providers,
+	})
+}
+
+// RouterStatusResponse is the body of GET /ai/router/status
+type RouterStatusResponse struct {
+	Enabled       bool                      `json:"enabled"`
+	Mode          string                    `json:"mode"`
+	CanaryPercent float64                   `json:"canary_percent"`
+	Providers     map[string]ProviderStatus `json:"providers"`
+}
+
+// ProviderStatus reports on one registered provider. Healthy is false
+// while its latest call failed on the provider's side; ErrorRate covers its
+// last 100 calls.
+type ProviderStatus struct {
+	Healthy   bool    `json:"healthy"`
+	ErrorRate float64 `json:"error_rate"`
+}
+
+// ErrorResponse is the body of failed AI requests
+type ErrorResponse struct {
+	Error     string `json:"error"`
+	Message   string `json:"message"`
+	Timestamp string `json:"timestamp"`
+	Path      string `json:"path,omitempty"`
+}
+
+// PolicyViolationResponse is the body of requests a policy blocked
+type PolicyViolationResponse struct {
+	Error     string                 `json:"error"`
+	Message   string                 `json:"message"`
+	Policy    string                 `json:"policy"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+	Timestamp string                 `json:"timestamp"`
+}
+
+// BudgetExceededResponse is the body of requests a spend cap stopped
+type BudgetExceededResponse struct {
+	Error        string  `json:"error"`
+	Message      string  `json:"message"`
+	BudgetType   string  `json:"budget_type"`
+	CurrentUsage float64 `json:"current_usage"`
+	Limit        float64 `json:"limit"`
+	ResetAt      string  `json:"reset_at"`
+}
+
+// writeInferError maps pipeline errors to the documented responses
+func (h *Handler) writeInferError(w http.ResponseWriter, r *http.Request, err error) {
+	var validationErr *ValidationError
+	var policyErr *PolicyViolationError
+	var budgetErr *BudgetExceededError
+	var providerErr *provider.Error
+	switch {
+	case errors.As(err, &validationErr):
+		h.writeError(w, r, http.StatusBadRequest, "validation_error", validationErr.Message)
+	case errors.As(err, &policyErr):
+		h.writeJSON(w, http.StatusForbidden, PolicyViolationResponse{
+			Error:     "policy_violation",
+			Message:   policyErr.Message,
+			Policy:    policyErr.Policy,
+			Details:   policyErr.Details,
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+		})
+	case errors.As(err, &budgetErr):
+		h.writeJSON(w, http.StatusTooManyRequests, BudgetExceededResponse{
+			Error:        "budget_exceeded",
+			Message:      "Daily budget limit reached",
+			BudgetType:   budgetErr.Scope,
+			CurrentUsage: budgetErr.SpentBRL,
+			Limit:        budgetErr.CapBRL,
+			ResetAt:      budgetErr.ResetAt.UTC().Format(time.RFC3339),
+		})
+	case errors.Is(err, router.ErrDisabled):
+		h.writeError(w, r, http.StatusServiceUnavailable, "ai_disabled", "AI is disabled")
+	case errors.As(err, &providerErr) && providerErr.Code == provider.CodeInvalidRequest:
+		h.writeError(w, r, http.StatusBadRequest, "validation_error", providerErr.Message)
+	case errors.As(err, &providerErr) && providerErr.Code == provider.CodeContentFiltered:
+		h.writeJSON(w, http.StatusForbidden, PolicyViolationResponse{
+			Error:     "policy_violation",
+			Message:   providerErr.Message,
+			Policy:    "provider_content_filter",
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+		})
+	case errors.As(err, &providerErr):
+		h.logger.Error("AI inference failed", zap.Error(err))
+		h.writeError(w, r, http.StatusBadGateway, "provider_error", "no provider could serve the request")
+	default:
+		h.logger.Error("AI inference failed", zap.Error(err))
+		h.writeError(w, r, http.StatusInternalServerError, "internal_error", "inference failed")
+	}
+}
+
+func (h *Handler) writeError(w http.ResponseWriter, r *http.Request, status int, code, message string) {
+	h.writeJSON(w, status, ErrorResponse{
+		Error:     code,
+		Message:   message,
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Path:      r.URL.Path,
+	})
+}
+
+func (h *Handler) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		h.logger.Error("Failed to encode JSON response", zap.Error(err))
+	}
+}
@@ -0,0 +1,369 @@
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/events"
+	"github.com/vertikon/mcp-ultra/internal/ai/provider"
+	"github.com/vertikon/mcp-ultra/internal/ai/router"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+)
+
+type recordingPublisher struct {
+	mu       sync.Mutex
+	subjects []string
+	payloads [][]byte
+}
+
+func (p *recordingPublisher) PublishWithRetry(_ context.Context, subject string, data []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.subjects = append(p.subjects, subject)
+	p.payloads = append(p.payloads, data)
+	return nil
+}
+
+// failingProvider fails every call with code
+type failingProvider struct {
+	name string
+	code provider.Code
+}
+
+func (p *failingProvider) Name() string { return p.name }
+
+func (p *failingProvider) Complete(context.Context, provider.CompletionRequest) (*provider.Completion, error) {
+	return nil, provider.NewError(p.name, p.code, "failed", nil)
+}
+
+func (p *failingProvider) Classify(context.Context, provider.ClassifyRequest) (*provider.Classification, error) {
+	return nil, provider.NewError(p.name, p.code, "failed", nil)
+}
+
+func (p *failingProvider) Embed(context.Context, provider.EmbedRequest) (*provider.Embedding, error) {
+	return nil, provider.NewError(p.name, p.code, "failed", nil)
+}
+
+// blockingGuardrails blocks prompts containing a word and tags outputs
+type blockingGuardrails struct{}
+
+func (blockingGuardrails) Pre(_ context.Context, req Request) (string, error) {
+	if strings.Contains(req.Prompt, "proibido") {
+		return "", &PolicyViolationError{Policy: "profanity_check", Message: "blocked", Details: map[string]interface{}{"severity": "high"}}
+	}
+	return req.Prompt, nil
+}
+
+func (blockingGuardrails) Post(_ context.Context, _ Request, resp *Response) error {
+	resp.Content += " [ok]"
+	resp.Annotations = map[string]string{"risk_tier": "low"}
+	return nil
+}
+
+// capBudget refuses every paid provider with its breach
+type capBudget struct {
+	degrade bool
+}
+
+func (b capBudget) Reserve(_ context.Context, _ Request, rule router.Rule) (Reservation, error) {
+	if rule.Provider == provider.LocalName {
+		return freeReservation{}, nil
+	}
+	return nil, &BudgetExceededError{Scope: "tenant", SpentBRL: 61, CapBRL: 60, Degrade: b.degrade}
+}
+
+type freeReservation struct{}
+
+func (freeReservation) Commit(context.Context, provider.Usage) float64 { return 0 }
+
+func (freeReservation) Release(context.Context) {}
+
+func newTestHandler(t *testing.T, enabled bool, opts ...Option) (*Handler, *provider.Registry) {
+	t.Helper()
+	var rules router.Rules
+	raw := `{
+		"default": {"generation": {"provider": "openai", "model": "gpt-4o"}, "classification": {"provider": "local"}},
+		"fallbacks": [{"from": {"provider": "openai"}, "to": {"provider": "local"}}]
+	}`
+	if err := json.Unmarshal([]byte(raw), &rules); err != nil {
+		t.Fatal(err)
+	}
+	var flags router.Flags
+	flags.AI.Enabled = enabled
+	flags.AI.Mode = "balanced"
+
+	providers := provider.NewRegistry(provider.NewLocal())
+	service := NewService(router.New(flags, rules), providers, zaptest.NewLogger(t), opts...)
+	return NewHandler(service, zaptest.NewLogger(t)), providers
+}
+
+func postInfer(t *testing.T, h *Handler, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/infer", strings.NewReader(body))
+	req = req.WithContext(tenant.WithID(req.Context(), "tenant-a"))
+	req.Header.Set(HeaderMCPID, "mcp-vendas")
+	rec := httptest.NewRecorder()
+	h.Routes().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestInfer_FallsBackToLocal(t *testing.T) {
+	publisher := &recordingPublisher{}
+	h, providers := newTestHandler(t, true, WithPublisher(publisher), WithGuardrails(blockingGuardrails{}))
+	if err := providers.Register(&failingProvider{name: "openai", code: provider.CodeRateLimited}); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := postInfer(t, h, `{"prompt": "resuma o pedido", "use_case": "generation", "max_tokens": 2}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+	}
+	var resp Response
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Provider != provider.LocalName || resp.Content != "resuma o [ok]" || resp.Annotations["risk_tier"] != "low" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+	if resp.TokensIn != 3 || resp.TokensOut != 2 {
+		t.Errorf("unexpected usage %+v", resp)
+	}
+
+	want := []string{events.SubjectRouterDecision, events.SubjectInferenceError, events.SubjectInferenceSummary}
+	if strings.Join(publisher.subjects, ",") != strings.Join(want, ",") {
+		t.Errorf("published %v, want %v", publisher.subjects, want)
+	}
+}
+
+func TestInfer_CallerFromContext(t *testing.T) {
+	publisher := &recordingPublisher{}
+	h, _ := newTestHandler(t, true, WithPublisher(publisher))
+
+	req := httptest.NewRequest(http.MethodPost, "/infer", strings.NewReader(`{"prompt": "oi", "use_case": "generation"}`))
+	req = req.WithContext(tenant.WithID(req.Context(), "tenant-a"))
+	req.Header.Set("X-Tenant-ID", "tenant-b")
+	req.Header.Set(HeaderSDKName, "sdk-go")
+	rec := httptest.NewRecorder()
+	h.Routes().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+	}
+
+	if len(publisher.payloads) == 0 {
+		t.Fatal("nothing published")
+	}
+	var base events.Base
+	if err := json.Unmarshal(publisher.payloads[0], &base); err != nil {
+		t.Fatal(err)
+	}
+	if base.TenantID != "tenant-a" || base.SDKName != "sdk-go" {
+		t.Errorf("caller = %+v, want the context tenant and the SDK header", base)
+	}
+}
+
+func TestInfer_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		openai  provider.Code
+		body    string
+		status  int
+		code    string
+	}{
+		{"invalid json", true, "", `{`, http.StatusBadRequest, "validation_error"},
+		{"missing prompt", true, "", `{"use_case": "generation"}`, http.StatusBadRequest, "validation_error"},
+		{"unknown use case", true, "", `{"prompt": "x", "use_case": "translation"}`, http.StatusBadRequest, "validation_error"},
+		{"rerank without documents", true, "", `{"prompt": "x", "use_case": "rerank"}`, http.StatusBadRequest, "validation_error"},
+		{"policy violation", true, "", `{"prompt": "texto proibido", "use_case": "generation"}`, http.StatusForbidden, "policy_violation"},
+		{"disabled", false, "", `{"prompt": "x", "use_case": "generation"}`, http.StatusServiceUnavailable, "ai_disabled"},
+		{"non-retryable provider error", true, provider.CodeContentFiltered, `{"prompt": "x", "use_case": "generation"}`, http.StatusForbidden, "policy_violation"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, providers := newTestHandler(t, tt.enabled, WithGuardrails(blockingGuardrails{}))
+			if tt.openai != "" {
+				if err := providers.Register(&failingProvider{name: "openai", code: tt.openai}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			rec := postInfer(t, h, tt.body)
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d, body %s", rec.Code, tt.status, rec.Body)
+			}
+			var body map[string]interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatal(err)
+			}
+			if body["error"] != tt.code {
+				t.Errorf("error = %v, want %s", body["error"], tt.code)
+			}
+		})
+	}
+}
+
+func TestInfer_Budget(t *testing.T) {
+	h, providers := newTestHandler(t, true, WithBudget(capBudget{degrade: true}))
+	if err := providers.Register(&failingProvider{name: "openai", code: provider.CodeInternal}); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := postInfer(t, h, `{"prompt": "resuma o pedido", "use_case": "generation"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+	}
+	var resp Response
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Provider != provider.LocalName || resp.Annotations["budget_degraded"] != "tenant" {
+		t.Errorf("a degrade breach must move on to the local provider, got %+v", resp)
+	}
+
+	h, providers = newTestHandler(t, true, WithBudget(capBudget{degrade: false}))
+	if err := providers.Register(&failingProvider{name: "openai", code: provider.CodeInternal}); err != nil {
+		t.Fatal(err)
+	}
+	rec = postInfer(t, h, `{"prompt": "resuma o pedido", "use_case": "generation"}`)
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+	}
+	var body BudgetExceededResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Error != "budget_exceeded" || body.BudgetType != "tenant" || body.Limit != 60 || body.CurrentUsage != 61 {
+		t.Errorf("unexpected body %+v", body)
+	}
+}
+
+// mapCache keeps answers by prompt and counts lookups
+type mapCache struct {
+	entries map[string]Response
+	lookups int
+}
+
+func (c *mapCache) Lookup(_ context.Context, req Request, _ router.Rule) (*Response, bool) {
+	c.lookups++
+	resp, ok := c.entries[req.Prompt]
+	if !ok {
+		return nil, false
+	}
+	resp.Cached, resp.CostBRL = true, 0
+	return &resp, true
+}
+
+func (c *mapCache) Store(_ context.Context, req Request, _ router.Rule, resp *Response) {
+	c.entries[req.Prompt] = *resp
+}
+
+func TestInfer_Cache(t *testing.T) {
+	cache := &mapCache{entries: make(map[string]Response)}
+	h, providers := newTestHandler(t, true, WithCache(cache), WithGuardrails(blockingGuardrails{}))
+	if err := providers.Register(&failingProvider{name: "openai", code: provider.CodeRateLimited}); err != nil {
+		t.Fatal(err)
+	}
+	infer := func(body string) Response {
+		t.Helper()
+		rec := postInfer(t, h, body)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+		}
+		var resp Response
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	classify := `{"prompt": "gostei do produto", "use_case": "classification", "metadata": {"labels": ["positivo", "negativo"]}}`
+	if resp := infer(classify); resp.Cached {
+		t.Errorf("first request must reach the provider, got %+v", resp)
+	}
+	if _, ok := cache.entries["gostei do produto"]; !ok {
+		t.Fatal("the routed provider's answer was not cached")
+	}
+	if resp := infer(classify); !resp.Cached || resp.CostBRL != 0 || resp.Annotations["risk_tier"] != "low" {
+		t.Errorf("expected a cached hit through the post guardrails, got %+v", resp)
+	}
+
+	bypass := `{"prompt": "gostei do produto", "use_case": "classification", "no_cache": true, "metadata": {"labels": ["positivo"]}}`
+	lookups := cache.lookups
+	if resp := infer(bypass); resp.Cached || cache.lookups != lookups {
+		t.Errorf("no_cache must skip the lookup, got %+v", resp)
+	}
+
+	infer(`{"prompt": "resuma o pedido", "use_case": "generation"}`)
+	if _, ok := cache.entries["resuma o pedido"]; ok {
+		t.Error("a fallback answer must not be cached for the routed rule")
+	}
+}
+
+func TestInfer_Rerank(t *testing.T) {
+	h, _ := newTestHandler(t, true)
+
+	// rerank has no rule, so it goes to the generation rule and falls back
+	// from the unregistered openai provider to local
+	rec := postInfer(t, h, `{"prompt": "frete", "use_case": "rerank", "metadata": {"documents": ["a", "frete", "b"]}}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+	}
+	var resp Response
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	var ranking []rankedDocument
+	if err := json.Unmarshal([]byte(resp.Content), &ranking); err != nil {
+		t.Fatalf("content is not a ranking: %v", err)
+	}
+	if len(ranking) != 3 || ranking[0].Index != 1 {
+		t.Errorf("the identical document must rank first, got %+v", ranking)
+	}
+}
+
+func TestRouterStatus(t *testing.T) {
+	h, _ := newTestHandler(t, true)
+
+	rec := httptest.NewRecorder()
+	h.Routes().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/router/status", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d", rec.Code)
+	}
+	var status RouterStatusResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &status); err != nil {
+		t.Fatal(err)
+	}
+	if !status.Enabled || status.Mode != "balanced" || !status.Providers[provider.LocalName].Healthy {
+		t.Errorf("unexpected status %+v", status)
+	}
+}
+
+func TestRouterStatus_ReportsFailingProviders(t *testing.T) {
+	h, providers := newTestHandler(t, true)
+	if err := providers.Register(&failingProvider{name: "openai", code: provider.CodeUnavailable}); err != nil {
+		t.Fatal(err)
+	}
+	if rec := postInfer(t, h, `{"prompt": "oi", "use_case": "generation"}`); rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+	}
+
+	rec := httptest.NewRecorder()
+	h.Routes().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/router/status", nil))
+	var status RouterStatusResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &status); err != nil {
+		t.Fatal(err)
+	}
+	if openai := status.Providers["openai"]; openai.Healthy || openai.ErrorRate != 1 {
+		t.Errorf("openai = %+v, want unhealthy after its call failed", openai)
+	}
+	if local := status.Providers[provider.LocalName]; !local.Healthy || local.ErrorRate != 0 {
+		t.Errorf("local = %+v, want healthy", local)
+	}
+}
@@ -0,0 +1,82 @@
+package inference
+
+import (
+	"sync"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/provider"
+)
+
+// healthWindow is how many recent calls of a provider its error rate covers
+const healthWindow = 100
+
+// ProviderHealth is what recent calls tell about one provider
+type ProviderHealth struct {
+	// Healthy is false while the provider's latest call failed on its side
+	Healthy bool
+	// ErrorRate is the share of the recent calls that failed on its side
+	ErrorRate float64
+	// Calls is how many recent calls the error rate covers
+	Calls int
+}
+
+// healthTracker records call outcomes per provider. Errors the caller
+// caused, such as invalid requests or filtered content, count as successes.
+type healthTracker struct {
+	mu        sync.Mutex
+	providers map[string]*callWindow
+}
+
+// callWindow is a ring of recent call outcomes, true meaning failed
+type callWindow struct {
+	outcomes [healthWindow]bool
+	next     int
+	calls    int
+	failures int
+	last     bool
+}
+
+func newHealthTracker() *healthTracker {
+	return &healthTracker{providers: make(map[string]*callWindow)}
+}
+
+// record notes the outcome of one call to the named provider
+func (t *healthTracker) record(name string, err error) {
+	failed := err != nil && provider.IsRetryable(err)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	w, ok := t.providers[name]
+	if !ok {
+		w = &callWindow{}
+		t.providers[name] = w
+	}
+	if w.calls == healthWindow && w.outcomes[w.next] {
+		w.failures--
+	}
+	if w.calls < healthWindow {
+		w.calls++
+	}
+	w.outcomes[w.next] = failed
+	w.next = (w.next + 1) % healthWindow
+	if failed {
+		w.failures++
+	}
+	w.last = failed
+}
+
+// get reports the health of the named provider; one never called is healthy
+func (t *healthTracker) get(name string) ProviderHealth {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	w, ok := t.providers[name]
+	if !ok || w.calls == 0 {
+		return ProviderHealth{Healthy: true}
+	}
+	return ProviderHealth{
+		Healthy:   !w.last,
+		ErrorRate: float64(w.failures) / float64(w.calls),
+		Calls:     w.calls,
+	}
+}
@@ -0,0 +1,575 @@
+// Package inference runs AI requests through the router, guardrails and
+// providers, and serves them over HTTP.
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/events"
+	"github.com/vertikon/mcp-ultra/internal/ai/provider"
+	"github.com/vertikon/mcp-ultra/internal/ai/router"
+	"github.com/vertikon/mcp-ultra/internal/ai/telemetry"
+)
+
+// Use cases accepted by Infer
+const (
+	UseCaseClassification = "classification"
+	UseCaseGeneration     = "generation"
+	UseCaseRerank         = "rerank"
+)
+
+// MaxTokensLimit is the largest max_tokens a request may ask for, and the
+// output limit of generations that do not ask
+const MaxTokensLimit = 32000
+
+// Request is one inference call. Caller identifies who is asking and is
+// used for routing, telemetry and events.
+type Request struct {
+	Caller      Caller                 `json:"-"`
+	Prompt      string                 `json:"prompt"`
+	UseCase     string                 `json:"use_case"`
+	Temperature float64                `json:"temperature,omitempty"`
+	MaxTokens   int                    `json:"max_tokens,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	// NoCache skips the cache lookup; the fresh answer is still cached
+	NoCache bool `json:"no_cache,omitempty"`
+}
+
+// Caller identifies the tenant, user, MCP and SDK behind a request
+type Caller struct {
+	TenantID string
+	UserID   string
+	MCPID    string
+	SDK      string
+}
+
+// Response is the result of an inference call
+type Response struct {
+	Content   string  `json:"content"`
+	Provider  string  `json:"provider"`
+	Model     string  `json:"model"`
+	TokensIn  int     `json:"tokens_in"`
+	TokensOut int     `json:"tokens_out"`
+	LatencyMs int64   `json:"latency_ms"`
+	CostBRL   float64 `json:"cost_brl"`
+	Cached    bool    `json:"cached"`
+	// Annotations are notes the guardrails attach, e.g. the risk tier
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ValidationError reports a malformed request
+type ValidationError struct {
+	Message string
+}
+
+func (e *ValidationError) Error() string { return e.Message }
+
+// PolicyViolationError is returned when a guardrail blocks a request or
+// its result
+type PolicyViolationError struct {
+	Policy  string
+	Message string
+	Details map[string]interface{}
+}
+
+func (e *PolicyViolationError) Error() string {
+	return fmt.Sprintf("policy %s: %s", e.Policy, e.Message)
+}
+
+// BudgetExceededError is returned when a spend cap stops a request. A
+// degrade breach lets the service move down the fallback chain to a
+// cheaper rule; a block stops the request.
+type BudgetExceededError struct {
+	Scope    string // global, tenant or mcp
+	SpentBRL float64
+	CapBRL   float64
+	ResetAt  time.Time
+	Degrade  bool
+}
+
+func (e *BudgetExceededError) Error() string {
+	return fmt.Sprintf("%s budget of R$ %.2f exceeded", e.Scope, e.CapBRL)
+}
+
+// Budget holds inference spend to its caps. Reserve is called before each
+// provider attempt and returns a *BudgetExceededError when rule would go
+// over a cap.
+type Budget interface {
+	Reserve(ctx context.Context, req Request, rule router.Rule) (Reservation, error)
+}
+
+// Reservation is spend held for one provider attempt. Commit settles it
+// at what the call used and returns its cost in BRL; Release gives it
+// back when the call failed.
+type Reservation interface {
+	Commit(ctx context.Context, usage provider.Usage) float64
+	Release(ctx context.Context)
+}
+
+// Guardrails check the prompt before it reaches a provider and the response
+// before it reaches the caller. Pre returns the prompt to send, Post may
+// rewrite the content and add annotations; either may mask PII or block
+// with a *PolicyViolationError.
+type Guardrails interface {
+	Pre(ctx context.Context, req Request) (string, error)
+	Post(ctx context.Context, req Request, resp *Response) error
+}
+
+// Cache answers repeated requests without calling a provider. Lookup
+// gets the request after the pre guardrails and the rule it is routed to,
+// and returns hits marked Cached at no cost. Store keeps the answer rule
+// gave before the post guardrails ran.
+type Cache interface {
+	Lookup(ctx context.Context, req Request, rule router.Rule) (*Response, bool)
+	Store(ctx context.Context, req Request, rule router.Rule, resp *Response)
+}
+
+// Option configures optional Service behaviour
+type Option func(*Service)
+
+// WithGuardrails runs guardrails around every provider call
+func WithGuardrails(guardrails Guardrails) Option {
+	return func(s *Service) {
+		s.guardrails = guardrails
+	}
+}
+
+// WithBudget reserves every provider attempt against budget
+func WithBudget(budget Budget) Option {
+	return func(s *Service) {
+		s.budget = budget
+	}
+}
+
+// WithCache answers requests from cache when it has them
+func WithCache(cache Cache) Option {
+	return func(s *Service) {
+		s.cache = cache
+	}
+}
+
+// WithPublisher publishes router decisions, inference errors and summaries
+func WithPublisher(publisher events.EventPublisher) Option {
+	return func(s *Service) {
+		s.publisher = publisher
+	}
+}
+
+// Service runs the inference pipeline: router, pre guardrails, cache,
+// provider fallback chain and shadow call, post guardrails, telemetry and
+// events
+type Service struct {
+	router     *router.Router
+	providers  *provider.Registry
+	guardrails Guardrails
+	budget     Budget
+	cache      Cache
+	publisher  events.EventPublisher
+	health     *healthTracker
+	logger     *zap.Logger
+}
+
+// NewService creates the inference service
+func NewService(r *router.Router, providers *provider.Registry, logger *zap.Logger, opts ...Option) *Service {
+	s := &Service{
+		router:    r,
+		providers: providers,
+		health:    newHealthTracker(),
+		logger:    logger,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Router returns the router the service decides with
+func (s *Service) Router() *router.Router { return s.router }
+
+// Providers returns the providers the service calls
+func (s *Service) Providers() *provider.Registry { return s.providers }
+
+// ProviderHealth reports the health of the named provider from the
+// outcome of its recent calls
+func (s *Service) ProviderHealth(name string) ProviderHealth { return s.health.get(name) }
+
+// Infer runs req through the pipeline. Providers are tried down the
+// router's fallback chain while their errors are retryable, unless the
+// cache answers first. A shadow candidate in the decision is called
+// alongside and only compared.
+func (s *Service) Infer(ctx context.Context, req Request) (*Response, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	decision, err := s.router.Decide(router.Request{
+		TenantID: req.Caller.TenantID,
+		UserID:   req.Caller.UserID,
+		MCPID:    req.Caller.MCPID,
+		SDK:      req.Caller.SDK,
+		UseCase:  req.UseCase,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("routing: %w", err)
+	}
+	s.recordDecision(ctx, req, decision)
+
+	if s.guardrails != nil {
+		prompt, err := s.guardrails.Pre(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		req.Prompt = prompt
+	}
+
+	start := telemetry.ObserveStart()
+	resp, err := s.respond(ctx, req, decision)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.guardrails != nil {
+		if err := s.guardrails.Post(ctx, req, resp); err != nil {
+			return nil, err
+		}
+	}
+
+	end := time.Now()
+	resp.LatencyMs = end.Sub(start).Milliseconds()
+	s.recordInference(ctx, req, resp, start, end)
+	return resp, nil
+}
+
+// respond answers req from the cache or the decision's chain. Only answers
+// of the routed rule are cached, not those of a fallback.
+func (s *Service) respond(ctx context.Context, req Request, decision router.Decision) (*Response, error) {
+	primary := decision.Chain[0]
+	if s.cache != nil && !req.NoCache {
+		if resp, ok := s.cache.Lookup(ctx, req, primary); ok {
+			return resp, nil
+		}
+	}
+
+	report := s.startShadow(ctx, req, decision.Shadow)
+	start := time.Now()
+	resp, err := s.callChain(ctx, req, decision.Chain)
+	report(resp, time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+	if s.cache != nil && servedBy(resp, primary) {
+		s.cache.Store(ctx, req, primary, resp)
+	}
+	return resp, nil
+}
+
+// servedBy reports whether resp came from rule. A rule without a model
+// gets the provider's default, whatever it reports.
+func servedBy(resp *Response, rule router.Rule) bool {
+	return resp.Provider == rule.Provider && (rule.Model == "" || resp.Model == rule.Model)
+}
+
+// Validate checks the request against the API limits
+func (req Request) Validate() error {
+	if req.Prompt == "" {
+		return &ValidationError{Message: "prompt is required"}
+	}
+	switch req.UseCase {
+	case UseCaseClassification, UseCaseGeneration, UseCaseRerank:
+	default:
+		return &ValidationError{Message: fmt.Sprintf("unsupported use_case %q", req.UseCase)}
+	}
+	if req.Temperature < 0 || req.Temperature > 2 {
+		return &ValidationError{Message: "temperature must be between 0 and 2"}
+	}
+	if req.MaxTokens < 0 || req.MaxTokens > MaxTokensLimit {
+		return &ValidationError{Message: fmt.Sprintf("max_tokens must be between 1 and %d", MaxTokensLimit)}
+	}
+	if req.UseCase == UseCaseRerank && len(stringList(req.Metadata["documents"])) == 0 {
+		return &ValidationError{Message: "rerank needs metadata.documents"}
+	}
+	return nil
+}
+
+// callChain tries each rule of the chain in order. A provider missing from
+// the registry, failing with a retryable error or degraded by the budget
+// moves on to the next one.
+func (s *Service) callChain(ctx context.Context, req Request, chain []router.Rule) (*Response, error) {
+	var lastErr error
+	var degraded *BudgetExceededError
+	for _, rule := range chain {
+		p, ok := s.providers.Get(rule.Provider)
+		if !ok {
+			lastErr = provider.NewError(rule.Provider, provider.CodeUnavailable, "provider not registered", nil)
+			continue
+		}
+
+		resp, err := s.attempt(ctx, p, rule, req)
+		if err == nil {
+			if degraded != nil {
+				resp.Annotations = map[string]string{"budget_degraded": degraded.Scope}
+			}
+			return resp, nil
+		}
+		lastErr = err
+		if errors.As(err, &degraded) {
+			if !degraded.Degrade {
+				break
+			}
+			s.logger.Info("AI budget exceeded, degrading to the next rule",
+				zap.String("scope", degraded.Scope),
+				zap.String("provider", rule.Provider),
+				zap.String("model", rule.Model))
+			continue
+		}
+		s.recordError(ctx, req, rule, err)
+		if ctx.Err() != nil || !provider.IsRetryable(err) {
+			break
+		}
+		s.logger.Warn("AI provider failed, trying the next one",
+			zap.String("provider", rule.Provider),
+			zap.String("model", rule.Model),
+			zap.Error(err))
+	}
+	return nil, fmt.Errorf("all providers failed: %w", lastErr)
+}
+
+// attempt calls one rule of the chain, holding its cost against the
+// budget when there is one
+func (s *Service) attempt(ctx context.Context, p provider.Provider, rule router.Rule, req Request) (*Response, error) {
+	if s.budget == nil {
+		return s.call(ctx, p, rule.Model, req)
+	}
+	reservation, err := s.budget.Reserve(ctx, req, rule)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.call(ctx, p, rule.Model, req)
+	if err != nil {
+		reservation.Release(ctx)
+		return nil, err
+	}
+	resp.CostBRL = reservation.Commit(ctx, provider.Usage{TokensIn: resp.TokensIn, TokensOut: resp.TokensOut})
+	return resp, nil
+}
+
+// call runs the use case on one provider, recording the outcome in its
+// health
+func (s *Service) call(ctx context.Context, p provider.Provider, model string, req Request) (*Response, error) {
+	resp, err := s.callUseCase(ctx, p, model, req)
+	s.health.record(p.Name(), err)
+	return resp, err
+}
+
+func (s *Service) callUseCase(ctx context.Context, p provider.Provider, model string, req Request) (*Response, error) {
+	switch req.UseCase {
+	case UseCaseClassification:
+		result, err := p.Classify(ctx, provider.ClassifyRequest{
+			Model:  model,
+			Text:   req.Prompt,
+			Labels: stringList(req.Metadata["labels"]),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return newResponse(p, result.Model, result.Label, result.Usage), nil
+
+	case UseCaseRerank:
+		documents := stringList(req.Metadata["documents"])
+		result, err := p.Embed(ctx, provider.EmbedRequest{
+			Model:  model,
+			Inputs: append([]string{req.Prompt}, documents...),
+		})
+		if err != nil {
+			return nil, err
+		}
+		ranking, err := rank(result.Vectors)
+		if err != nil {
+			return nil, provider.NewError(p.Name(), provider.CodeInternal, "invalid embeddings", err)
+		}
+		return newResponse(p, result.Model, ranking, result.Usage), nil
+
+	default:
+		result, err := p.Complete(ctx, provider.CompletionRequest{
+			Model:       model,
+			Prompt:      req.Prompt,
+			Temperature: req.Temperature,
+			MaxTokens:   outputLimit(req.MaxTokens),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return newResponse(p, result.Model, result.Content, result.Usage), nil
+	}
+}
+
+// outputLimit is the max_tokens sent to providers: the request's, or
+// MaxTokensLimit when it has none, so no output outgrows what a budget
+// reserves for it
+func outputLimit(maxTokens int) int {
+	if maxTokens <= 0 {
+		return MaxTokensLimit
+	}
+	return maxTokens
+}
+
+func newResponse(p provider.Provider, model, content string, usage provider.Usage) *Response {
+	return &Response{
+		Content:   content,
+		Provider:  p.Name(),
+		Model:     model,
+		TokensIn:  usage.TokensIn,
+		TokensOut: usage.TokensOut,
+	}
+}
+
+// rankedDocument is one entry of a rerank result
+type rankedDocument struct {
+	Index int     `json:"index"`
+	Score float64 `json:"score"`
+}
+
+// rank orders the documents by cosine similarity to the query, which is
+// the first vector, and encodes the ranking as JSON
+func rank(vectors [][]float64) (string, error) {
+	if len(vectors) < 2 {
+		return "", errors.New("expected the query and at least one document")
+	}
+	query := vectors[0]
+	ranking := make([]rankedDocument, 0, len(vectors)-1)
+	for i, vector := range vectors[1:] {
+		if len(vector) != len(query) {
+			return "", fmt.Errorf("document %d has %d dimensions, the query %d", i, len(vector), len(query))
+		}
+		ranking = append(ranking, rankedDocument{Index: i, Score: cosine(query, vector)})
+	}
+	sort.SliceStable(ranking, func(i, j int) bool { return ranking[i].Score > ranking[j].Score })
+
+	encoded, err := json.Marshal(ranking)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+func cosine(a, b []float64) float64 {
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// stringList reads a metadata list of strings, skipping other values
+func stringList(value interface{}) []string {
+	switch v := value.(type) {
+	case []string:
+		return v
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	default:
+		return nil
+	}
+}
+
+func (s *Service) labels(req Request) telemetry.Labels {
+	return telemetry.Labels{
+		TenantID: req.Caller.TenantID,
+		MCPID:    req.Caller.MCPID,
+		SDKName:  req.Caller.SDK,
+		UseCase:  req.UseCase,
+	}
+}
+
+func (s *Service) base(req Request) events.Base {
+	return events.Base{
+		TenantID: req.Caller.TenantID,
+		MCPID:    req.Caller.MCPID,
+		SDKName:  req.Caller.SDK,
+	}
+}
+
+func (s *Service) recordDecision(ctx context.Context, req Request, decision router.Decision) {
+	labels := s.labels(req)
+	labels.Provider, labels.Model, labels.Reason = decision.Provider, decision.Model, decision.Reason
+	telemetry.IncRouterDecision(labels)
+
+	if s.publisher == nil {
+		return
+	}
+	err := events.PublishRouterDecision(ctx, s.publisher, events.SubjectRouterDecision, events.RouterDecision{
+		Base:     s.base(req),
+		UseCase:  req.UseCase,
+		Provider: decision.Provider,
+		Model:    decision.Model,
+		Reason:   decision.Reason,
+	})
+	s.logPublishError(events.SubjectRouterDecision, err)
+}
+
+func (s *Service) recordError(ctx context.Context, req Request, rule router.Rule, err error) {
+	if s.publisher == nil {
+		return
+	}
+	publishErr := events.PublishInferenceError(ctx, s.publisher, events.SubjectInferenceError, events.InferenceError{
+		Base:     s.base(req),
+		Provider: rule.Provider,
+		Model:    rule.Model,
+		Code:     string(provider.CodeOf(err)),
+		Message:  err.Error(),
+	})
+	s.logPublishError(events.SubjectInferenceError, publishErr)
+}
+
+func (s *Service) recordInference(ctx context.Context, req Request, resp *Response, start, end time.Time) {
+	labels := s.labels(req)
+	labels.Provider, labels.Model = resp.Provider, resp.Model
+	telemetry.ObserveInference(telemetry.InferenceMeta{
+		Labels:    labels,
+		TokensIn:  resp.TokensIn,
+		TokensOut: resp.TokensOut,
+		CostBRL:   resp.CostBRL,
+		Start:     start,
+		End:       end,
+	})
+
+	if s.publisher == nil {
+		return
+	}
+	err := events.PublishInferenceSummary(ctx, s.publisher, events.SubjectInferenceSummary, events.InferenceSummary{
+		Base:      s.base(req),
+		UseCase:   req.UseCase,
+		TokensIn:  resp.TokensIn,
+		TokensOut: resp.TokensOut,
+		LatencyMs: int(resp.LatencyMs),
+		CostBRL:   resp.CostBRL,
+		Cached:    resp.Cached,
+	})
+	s.logPublishError(events.SubjectInferenceSummary, err)
+}
+
+// logPublishError logs a failed event publish; events never fail a request
+func (s *Service) logPublishError(subject string, err error) {
+	if err != nil {
+		s.logger.Warn("Failed to publish AI event", zap.String("subject", subject), zap.Error(err))
+	}
+}
@@ -0,0 +1,142 @@
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/provider"
+	"github.com/vertikon/mcp-ultra/internal/ai/router"
+	"github.com/vertikon/mcp-ultra/internal/ai/telemetry"
+)
+
+// shadowTimeout bounds a shadow call, which may outlive the request it
+// shadows
+const shadowTimeout = 30 * time.Second
+
+// shadowSample is the primary result a shadow call is compared with,
+// copied before the post guardrails rewrite the response
+type shadowSample struct {
+	ok      bool
+	content string
+	latency time.Duration
+	costBRL float64
+}
+
+// startShadow calls candidate with req in the background and returns the
+// function that hands it the primary result, which must be called once.
+// The comparison is recorded when both are in and the candidate's answer
+// is dropped. Shadow spend counts against the caller's budget; a breach
+// skips the comparison. Without a candidate the function does nothing.
+func (s *Service) startShadow(ctx context.Context, req Request, candidate *router.Rule) func(*Response, time.Duration) {
+	if candidate == nil {
+		return func(*Response, time.Duration) {}
+	}
+	rule := *candidate
+	primary := make(chan shadowSample, 1)
+	shadowCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shadowTimeout)
+
+	go func() {
+		defer cancel()
+		start := time.Now()
+		resp, err := s.shadowCall(shadowCtx, rule, req)
+		latency := time.Since(start)
+		s.recordShadow(req, rule, <-primary, resp, latency, err)
+	}()
+
+	return func(resp *Response, latency time.Duration) {
+		sample := shadowSample{latency: latency}
+		if resp != nil {
+			sample.ok, sample.content, sample.costBRL = true, resp.Content, resp.CostBRL
+		}
+		primary <- sample
+	}
+}
+
+func (s *Service) shadowCall(ctx context.Context, rule router.Rule, req Request) (*Response, error) {
+	p, ok := s.providers.Get(rule.Provider)
+	if !ok {
+		return nil, provider.NewError(rule.Provider, provider.CodeUnavailable, "provider not registered", nil)
+	}
+	return s.attempt(ctx, p, rule, req)
+}
+
+func (s *Service) recordShadow(req Request, rule router.Rule, primary shadowSample, resp *Response, latency time.Duration, err error) {
+	var exceeded *BudgetExceededError
+	if errors.As(err, &exceeded) {
+		s.logger.Debug("AI budget exceeded, skipping shadow call",
+			zap.String("scope", exceeded.Scope),
+			zap.String("provider", rule.Provider),
+			zap.String("model", rule.Model))
+		return
+	}
+
+	labels := s.labels(req)
+	labels.Provider, labels.Model = rule.Provider, rule.Model
+	meta := telemetry.ShadowMeta{Labels: labels, Failed: err != nil}
+	if err != nil {
+		s.logger.Debug("AI shadow call failed",
+			zap.String("provider", rule.Provider),
+			zap.String("model", rule.Model),
+			zap.Error(err))
+		telemetry.ObserveShadow(meta)
+		return
+	}
+
+	meta.PrimaryOK = primary.ok
+	meta.PrimaryLatency, meta.ShadowLatency = primary.latency, latency
+	meta.PrimaryCostBRL, meta.ShadowCostBRL = primary.costBRL, resp.CostBRL
+	meta.Agreement = agreement(req.UseCase, primary.content, resp.Content)
+	telemetry.ObserveShadow(meta)
+}
+
+// agreement scores how close two answers to the same request are, from 0
+// to 1: the share of rerank positions holding the same document, else the
+// overlap of the words of both answers
+func agreement(useCase, primary, shadow string) float64 {
+	if useCase == UseCaseRerank {
+		return rankAgreement(primary, shadow)
+	}
+	return wordOverlap(primary, shadow)
+}
+
+func rankAgreement(primary, shadow string) float64 {
+	var a, b []rankedDocument
+	if json.Unmarshal([]byte(primary), &a) != nil || json.Unmarshal([]byte(shadow), &b) != nil || len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	same := 0
+	for i := range a {
+		if a[i].Index == b[i].Index {
+			same++
+		}
+	}
+	return float64(same) / float64(len(a))
+}
+
+// wordOverlap is the Jaccard index of the case-folded words of a and b
+func wordOverlap(a, b string) float64 {
+	wordsA, wordsB := wordSet(a), wordSet(b)
+	if len(wordsA) == 0 && len(wordsB) == 0 {
+		return 1
+	}
+	shared := 0
+	for word := range wordsA {
+		if wordsB[word] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(wordsA)+len(wordsB)-shared)
+}
+
+func wordSet(text string) map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range strings.Fields(strings.ToLower(text)) {
+		words[word] = true
+	}
+	return words
+}
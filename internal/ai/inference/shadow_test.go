@@ -0,0 +1,84 @@
+package inference
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/provider"
+	"github.com/vertikon/mcp-ultra/internal/ai/router"
+)
+
+// shadowProvider answers completions and reports every prompt it gets
+type shadowProvider struct {
+	failingProvider
+	prompts chan string
+}
+
+func (p *shadowProvider) Complete(_ context.Context, req provider.CompletionRequest) (*provider.Completion, error) {
+	p.prompts <- req.Prompt
+	return &provider.Completion{Model: req.Model, Content: "resposta candidata"}, nil
+}
+
+func TestInfer_Shadow(t *testing.T) {
+	var rules router.Rules
+	raw := `{
+		"default": {"generation": {"provider": "local"}},
+		"candidates": {"generation": {"provider": "qwen", "model": "qwen2.5-72b-instruct"}}
+	}`
+	if err := json.Unmarshal([]byte(raw), &rules); err != nil {
+		t.Fatal(err)
+	}
+	var flags router.Flags
+	flags.AI.Enabled = true
+	flags.AI.Mode = router.ModeShadow
+	flags.AI.CanaryPercent = 100
+
+	candidate := &shadowProvider{failingProvider: failingProvider{name: "qwen", code: provider.CodeInternal}, prompts: make(chan string, 1)}
+	providers := provider.NewRegistry(provider.NewLocal(), candidate)
+	service := NewService(router.New(flags, rules), providers, zaptest.NewLogger(t), WithGuardrails(blockingGuardrails{}))
+
+	req := Request{Caller: Caller{TenantID: "tenant-a", UserID: "user-1"}, Prompt: "resuma o pedido", UseCase: UseCaseGeneration}
+	resp, err := service.Infer(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Infer failed: %v", err)
+	}
+	if resp.Provider != provider.LocalName || resp.Content == "resposta candidata" {
+		t.Errorf("the shadow answer must never reach the caller, got %+v", resp)
+	}
+
+	select {
+	case prompt := <-candidate.prompts:
+		if prompt != req.Prompt {
+			t.Errorf("shadow prompt = %q", prompt)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the candidate was not called")
+	}
+}
+
+func TestAgreement(t *testing.T) {
+	tests := []struct {
+		name            string
+		useCase         string
+		primary, shadow string
+		want            float64
+	}{
+		{"same label ignoring case", UseCaseClassification, "positivo", "Positivo", 1},
+		{"different label", UseCaseClassification, "positivo", "negativo", 0},
+		{"partial overlap", UseCaseGeneration, "pedido enviado hoje", "pedido enviado ontem", 0.5},
+		{"both empty", UseCaseGeneration, "", "", 1},
+		{"same top document", UseCaseRerank, `[{"index":1},{"index":0}]`, `[{"index":1},{"index":2}]`, 0.5},
+		{"invalid ranking", UseCaseRerank, `[{"index":1}]`, "x", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := agreement(tt.useCase, tt.primary, tt.shadow); got != tt.want {
+				t.Errorf("agreement = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
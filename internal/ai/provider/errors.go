@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Code classifies provider failures so callers can decide whether to try
+// the next provider in the fallback chain
+type Code string
+
+const (
+	CodeInvalidRequest  Code = "invalid_request"
+	CodeUnauthorized    Code = "unauthorized"
+	CodeRateLimited     Code = "rate_limited"
+	CodeTimeout         Code = "timeout"
+	CodeUnavailable     Code = "unavailable"
+	CodeContentFiltered Code = "content_filtered"
+	CodeUnsupported     Code = "unsupported"
+	CodeInternal        Code = "internal"
+)
+
+// Error is a failure reported by a provider
+type Error struct {
+	Provider string
+	Code     Code
+	Message  string
+	Err      error
+}
+
+// NewError creates a provider error
+func NewError(provider string, code Code, message string, err error) *Error {
+	return &Error{Provider: provider, Code: code, Message: message, Err: err}
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %s: %v", e.Provider, e.Code, e.Message, e.Err)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.Provider, e.Code, e.Message)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// Retryable reports whether another provider may succeed where this one
+// failed. Invalid or filtered requests fail the same way everywhere.
+func (e *Error) Retryable() bool {
+	switch e.Code {
+	case CodeRateLimited, CodeTimeout, CodeUnavailable, CodeUnsupported, CodeInternal:
+		return true
+	default:
+		return false
+	}
+}
+
+// CodeOf returns the code of the provider error in err's chain, or
+// CodeInternal for any other error
+func CodeOf(err error) Code {
+	var providerErr *Error
+	if errors.As(err, &providerErr) {
+		return providerErr.Code
+	}
+	return CodeInternal
+}
+
+// IsRetryable reports whether err allows falling back to another provider.
+// Errors that are not provider errors are treated as provider outages.
+func IsRetryable(err error) bool {
+	var providerErr *Error
+	if errors.As(err, &providerErr) {
+		return providerErr.Retryable()
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"strings"
+)
+
+// LocalName is the name the router rules use for the local provider
+const LocalName = "local"
+
+const (
+	localModel      = "local-echo"
+	localDimensions = 8
+)
+
+// defaultLabels are used when a classify request brings none
+var defaultLabels = []string{"positive", "neutral", "negative"}
+
+// Local is a deterministic provider that runs in-process: completions echo
+// the prompt, classifications and embeddings are derived from its hash. It
+// costs nothing and needs no network, so the whole inference pipeline can
+// run offline and in tests.
+type Local struct{}
+
+// NewLocal creates the local provider
+func NewLocal() *Local {
+	return &Local{}
+}
+
+// Name returns LocalName
+func (l *Local) Name() string { return LocalName }
+
+// Complete echoes the prompt, cut to MaxTokens words
+func (l *Local) Complete(ctx context.Context, req CompletionRequest) (*Completion, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, NewError(LocalName, CodeTimeout, "request cancelled", err)
+	}
+	if strings.TrimSpace(req.Prompt) == "" {
+		return nil, NewError(LocalName, CodeInvalidRequest, "prompt is required", nil)
+	}
+
+	words := strings.Fields(req.Prompt)
+	if req.MaxTokens > 0 && len(words) > req.MaxTokens {
+		words = words[:req.MaxTokens]
+	}
+	content := strings.Join(words, " ")
+	return &Completion{
+		Model:   modelOrDefault(req.Model),
+		Content: content,
+		Usage:   Usage{TokensIn: CountTokens(req.Prompt), TokensOut: len(words)},
+	}, nil
+}
+
+// Classify picks a label from the hash of the text, so the same text always
+// gets the same label
+func (l *Local) Classify(ctx context.Context, req ClassifyRequest) (*Classification, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, NewError(LocalName, CodeTimeout, "request cancelled", err)
+	}
+	if strings.TrimSpace(req.Text) == "" {
+		return nil, NewError(LocalName, CodeInvalidRequest, "text is required", nil)
+	}
+
+	labels := req.Labels
+	if len(labels) == 0 {
+		labels = defaultLabels
+	}
+	sum := sha256.Sum256([]byte(req.Text))
+	label := labels[binary.BigEndian.Uint64(sum[:8])%uint64(len(labels))]
+	return &Classification{
+		Model:      modelOrDefault(req.Model),
+		Label:      label,
+		Confidence: 1,
+		Usage:      Usage{TokensIn: CountTokens(req.Text), TokensOut: 1},
+	}, nil
+}
+
+// Embed derives a small vector with values in [-1, 1) from the hash of
+// each input
+func (l *Local) Embed(ctx context.Context, req EmbedRequest) (*Embedding, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, NewError(LocalName, CodeTimeout, "request cancelled", err)
+	}
+	if len(req.Inputs) == 0 {
+		return nil, NewError(LocalName, CodeInvalidRequest, "inputs are required", nil)
+	}
+
+	embedding := &Embedding{Model: modelOrDefault(req.Model), Vectors: make([][]float64, len(req.Inputs))}
+	for i, input := range req.Inputs {
+		sum := sha256.Sum256([]byte(input))
+		vector := make([]float64, localDimensions)
+		for d := range vector {
+			vector[d] = float64(binary.BigEndian.Uint32(sum[d*4:d*4+4]))/float64(1<<32)*2 - 1
+		}
+		embedding.Vectors[i] = vector
+		embedding.Usage.TokensIn += CountTokens(input)
+	}
+	return embedding, nil
+}
+
+func modelOrDefault(model string) string {
+	if model == "" {
+		return localModel
+	}
+	return model
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestLocal_Complete(t *testing.T) {
+	local := NewLocal()
+
+	got, err := local.Complete(context.Background(), CompletionRequest{Prompt: "um dois  tres quatro", MaxTokens: 3})
+	if err != nil {
+		t.Fatalf("Complete failed: %v", err)
+	}
+	if got.Content != "um dois tres" {
+		t.Errorf("content = %q", got.Content)
+	}
+	if got.Usage != (Usage{TokensIn: 4, TokensOut: 3}) {
+		t.Errorf("usage = %+v", got.Usage)
+	}
+	if got.Model != localModel {
+		t.Errorf("model = %q", got.Model)
+	}
+
+	_, err = local.Complete(context.Background(), CompletionRequest{Prompt: " "})
+	if CodeOf(err) != CodeInvalidRequest || IsRetryable(err) {
+		t.Errorf("empty prompt must be a non-retryable invalid request, got %v", err)
+	}
+}
+
+func TestLocal_Deterministic(t *testing.T) {
+	local := NewLocal()
+	ctx := context.Background()
+
+	first, err := local.Classify(ctx, ClassifyRequest{Text: "otimo produto", Labels: []string{"a", "b", "c"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _ := local.Classify(ctx, ClassifyRequest{Text: "otimo produto", Labels: []string{"a", "b", "c"}})
+	if first.Label != second.Label {
+		t.Errorf("labels differ: %q, %q", first.Label, second.Label)
+	}
+
+	embedding, err := local.Embed(ctx, EmbedRequest{Inputs: []string{"x", "y", "x"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(embedding.Vectors) != 3 || len(embedding.Vectors[0]) != localDimensions {
+		t.Fatalf("unexpected vectors %v", embedding.Vectors)
+	}
+	if !reflect.DeepEqual(embedding.Vectors[0], embedding.Vectors[2]) || reflect.DeepEqual(embedding.Vectors[0], embedding.Vectors[1]) {
+		t.Error("equal inputs must embed equally and different ones differently")
+	}
+}
+
+func TestLocal_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewLocal().Complete(ctx, CompletionRequest{Prompt: "oi"})
+	if CodeOf(err) != CodeTimeout || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected a timeout wrapping the cancellation, got %v", err)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry(NewLocal())
+	if err := registry.Register(NewLocal()); err == nil {
+		t.Error("duplicate names must be rejected")
+	}
+	if _, ok := registry.Get(LocalName); !ok {
+		t.Error("local provider not found")
+	}
+	if names := registry.Names(); !reflect.DeepEqual(names, []string{LocalName}) {
+		t.Errorf("names = %v", names)
+	}
+}
+
+func TestError_Retryable(t *testing.T) {
+	tests := map[Code]bool{
+		CodeRateLimited:     true,
+		CodeTimeout:         true,
+		CodeUnavailable:     true,
+		CodeInternal:        true,
+		CodeInvalidRequest:  false,
+		CodeUnauthorized:    false,
+		CodeContentFiltered: false,
+	}
+	for code, retryable := range tests {
+		if got := NewError("p", code, "m", nil).Retryable(); got != retryable {
+			t.Errorf("%s retryable = %v, want %v", code, got, retryable)
+		}
+	}
+	if !IsRetryable(errors.New("connection reset")) {
+		t.Error("unknown errors count as outages")
+	}
+}
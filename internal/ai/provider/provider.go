@@ -0,0 +1,121 @@
+// Package provider abstracts the AI model providers the router sends
+// requests to.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Provider runs inference on one backend
+type Provider interface {
+	Name() string
+	Complete(ctx context.Context, req CompletionRequest) (*Completion, error)
+	Classify(ctx context.Context, req ClassifyRequest) (*Classification, error)
+	Embed(ctx context.Context, req EmbedRequest) (*Embedding, error)
+}
+
+// Usage is the token accounting of one call
+type Usage struct {
+	TokensIn  int `json:"tokens_in"`
+	TokensOut int `json:"tokens_out"`
+}
+
+// CompletionRequest asks for generated text
+type CompletionRequest struct {
+	Model       string
+	Prompt      string
+	Temperature float64
+	MaxTokens   int
+}
+
+// Completion is generated text
+type Completion struct {
+	Model   string
+	Content string
+	Usage   Usage
+}
+
+// ClassifyRequest asks which of Labels fits Text best. Without labels the
+// provider uses its own.
+type ClassifyRequest struct {
+	Model  string
+	Text   string
+	Labels []string
+}
+
+// Classification is the chosen label and the provider's confidence in it
+type Classification struct {
+	Model      string
+	Label      string
+	Confidence float64
+	Usage      Usage
+}
+
+// EmbedRequest asks for one vector per input
+type EmbedRequest struct {
+	Model  string
+	Inputs []string
+}
+
+// Embedding holds the vectors in input order
+type Embedding struct {
+	Model   string
+	Vectors [][]float64
+	Usage   Usage
+}
+
+// CountTokens approximates the tokens in text by its words. Providers that
+// report their own counts should use those.
+func CountTokens(text string) int {
+	return len(strings.Fields(text))
+}
+
+// Registry holds the providers by name
+type Registry struct {
+	mu        sync.RWMutex
+	providers map[string]Provider
+}
+
+// NewRegistry creates a registry holding providers
+func NewRegistry(providers ...Provider) *Registry {
+	r := &Registry{providers: make(map[string]Provider)}
+	for _, p := range providers {
+		r.providers[p.Name()] = p
+	}
+	return r
+}
+
+// Register adds p; a provider with the same name is an error
+func (r *Registry) Register(p Provider) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.providers[p.Name()]; exists {
+		return fmt.Errorf("provider %s already registered", p.Name())
+	}
+	r.providers[p.Name()] = p
+	return nil
+}
+
+// Get returns the provider called name
+func (r *Registry) Get(name string) (Provider, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.providers[name]
+	return p, ok
+}
+
+// Names returns the registered provider names, sorted
+func (r *Registry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
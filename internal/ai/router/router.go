@@ -1,19 +1,79 @@
 package router
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+
+	"github.com/cespare/xxhash/v2"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/schema"
+)
+
+// Files the router reads, relative to the AI templates directory
+const (
+	FlagsFile = "feature_flags.json"
+	RulesFile = "config/ai-router.rules.json"
 )
 
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// The schemas every flag and rule file is validated against before use
+var (
+	flagsSchema = mustLoadSchema("schemas/feature_flags.v1.json")
+	rulesSchema = mustLoadSchema("schemas/ai-router.rules.v1.json")
+)
+
+func mustLoadSchema(name string) *schema.Schema {
+	raw, err := schemaFiles.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return schema.MustCompile(raw)
+}
+
+// ModeStrict keeps a request on its chosen provider: the fallback chain
+// holds only the primary rule, so data never moves to another provider
+const ModeStrict = "strict"
+
+// ModeShadow keeps the canary cohort on the primary rule and calls the
+// candidate next to it; the candidate's answer is only compared, never
+// returned
+const ModeShadow = "shadow"
+
+// canarySalt is hashed with the caller so the canary cohort does not line
+// up with the rollouts of other feature flags
+const canarySalt = "ai_canary"
+
+// ErrDisabled is returned by Decide while the AI flag is off
+var ErrDisabled = errors.New("ai disabled")
+
 type Flags struct {
 	AI struct {
 		Enabled       bool   `json:"enabled"`
 		Mode          string `json:"mode"`
 		CanaryPercent int    `json:"canary_percent"`
 		Router        string `json:"router"`
+		Guardrails    struct {
+			Pre  bool `json:"pre"`
+			Post bool `json:"post"`
+		} `json:"guardrails"`
+		Budgets struct {
+			Enforce          bool `json:"enforce"`
+			HardStopOnBreach bool `json:"hard_stop_on_breach"`
+		} `json:"budgets"`
+		Cache struct {
+			Enabled bool `json:"enabled"`
+		} `json:"cache"`
 	} `json:"ai"`
 }
 
@@ -22,59 +82,473 @@ type Rule struct {
 	Model    string `json:"model"`
 }
 
+// Request is what a routing decision is made for. UserID, or TenantID
+// without one, keeps the caller in the same canary cohort on every request.
+type Request struct {
+	TenantID string `json:"tenant_id"`
+	UserID   string `json:"user_id"`
+	MCPID    string `json:"mcp_id"`
+	SDK      string `json:"sdk"`
+	UseCase  string `json:"use_case"`
+}
+
+// Matcher selects the requests an override applies to. Empty fields match
+// any value; every field that is set must equal the request's.
+type Matcher struct {
+	TenantID string `json:"tenant_id,omitempty"`
+	MCPID    string `json:"mcp_id,omitempty"`
+	SDK      string `json:"sdk,omitempty"`
+	UseCase  string `json:"use_case,omitempty"`
+}
+
+// UnmarshalJSON rejects unknown conditions, which would otherwise be
+// ignored and widen the override to every request
+func (m *Matcher) UnmarshalJSON(data []byte) error {
+	type plain Matcher
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var matcher plain
+	if err := decoder.Decode(&matcher); err != nil {
+		return fmt.Errorf("invalid override condition: %w", err)
+	}
+	*m = Matcher(matcher)
+	return nil
+}
+
+// Matches reports whether req meets every condition of m
+func (m Matcher) Matches(req Request) bool {
+	return matchField(m.TenantID, req.TenantID) &&
+		matchField(m.MCPID, req.MCPID) &&
+		matchField(m.SDK, req.SDK) &&
+		matchField(m.UseCase, req.UseCase)
+}
+
+// String lists the conditions of m, e.g. "mcp_id=mcp-wa-autenticacao"
+func (m Matcher) String() string {
+	var conditions []string
+	for _, condition := range []struct{ key, value string }{
+		{"tenant_id", m.TenantID},
+		{"mcp_id", m.MCPID},
+		{"sdk", m.SDK},
+		{"use_case", m.UseCase},
+	} {
+		if condition.value != "" {
+			conditions = append(conditions, condition.key+"="+condition.value)
+		}
+	}
+	return strings.Join(conditions, ",")
+}
+
+func matchField(want, got string) bool {
+	return want == "" || want == got
+}
+
+// OverrideUse is what a matching override changes: the rule per use case
+// and, optionally, the routing mode
+type OverrideUse struct {
+	Mode  string
+	Rules map[string]Rule
+}
+
+// UnmarshalJSON reads the "use" object, where "mode" sits next to the
+// use case rules
+func (u *OverrideUse) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	u.Rules = make(map[string]Rule, len(fields))
+	for key, raw := range fields {
+		if key == "mode" {
+			if err := json.Unmarshal(raw, &u.Mode); err != nil {
+				return fmt.Errorf("invalid mode: %w", err)
+			}
+			continue
+		}
+		var rule Rule
+		if err := json.Unmarshal(raw, &rule); err != nil {
+			return fmt.Errorf("invalid rule for %s: %w", key, err)
+		}
+		u.Rules[key] = rule
+	}
+	return nil
+}
+
+// MarshalJSON writes the "use" object back in its file layout
+func (u OverrideUse) MarshalJSON() ([]byte, error) {
+	fields := make(map[string]interface{}, len(u.Rules)+1)
+	for useCase, rule := range u.Rules {
+		fields[useCase] = rule
+	}
+	if u.Mode != "" {
+		fields["mode"] = u.Mode
+	}
+	return json.Marshal(fields)
+}
+
+// Override replaces the default rules for the requests it matches
+type Override struct {
+	When Matcher     `json:"when"`
+	Use  OverrideUse `json:"use"`
+}
+
+// Fallback sends a request to To when From fails. An empty From.Model
+// matches every model of the provider; an empty To.Model leaves the model
+// to the provider's default.
+type Fallback struct {
+	From Rule `json:"from"`
+	To   Rule `json:"to"`
+}
+
 type Rules struct {
 	Version   string          `json:"version"`
 	Default   map[string]Rule `json:"default"` // use_case -> rule
-	Overrides []any           `json:"overrides"`
-	Fallbacks []struct {
-		From Rule `json:"from"`
-		To   Rule `json:"to"`
-	} `json:"fallbacks"`
+	Overrides []Override      `json:"overrides"`
+	Fallbacks []Fallback      `json:"fallbacks"`
+	// Candidates are the rules under evaluation per use case, served to or
+	// shadowed for the canary cohort
+	Candidates map[string]Rule `json:"candidates"` // use_case -> rule
+}
+
+// Validate checks that overrides are scoped and every rule names a provider
+func (rules Rules) Validate() error {
+	var problems []string
+	for useCase, rule := range rules.Default {
+		if rule.Provider == "" {
+			problems = append(problems, fmt.Sprintf("default %s: provider is required", useCase))
+		}
+	}
+	for i, override := range rules.Overrides {
+		if override.When == (Matcher{}) {
+			problems = append(problems, fmt.Sprintf("override %d: when needs at least one condition", i))
+		}
+		for useCase, rule := range override.Use.Rules {
+			if rule.Provider == "" {
+				problems = append(problems, fmt.Sprintf("override %d %s: provider is required", i, useCase))
+			}
+		}
+	}
+	for useCase, rule := range rules.Candidates {
+		if rule.Provider == "" {
+			problems = append(problems, fmt.Sprintf("candidate %s: provider is required", useCase))
+		}
+	}
+	for i, fallback := range rules.Fallbacks {
+		if fallback.From.Provider == "" || fallback.To.Provider == "" {
+			problems = append(problems, fmt.Sprintf("fallback %d: from and to need a provider", i))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid router rules: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// LoadRules reads and validates a rules file
+func LoadRules(path string) (Rules, error) {
+	raw, err := os.ReadFile(path) // #nosec G304 -- path comes from configuration
+	if err != nil {
+		return Rules{}, fmt.Errorf("failed to read router rules: %w", err)
+	}
+	return parseRules(raw)
+}
+
+func parseRules(raw []byte) (Rules, error) {
+	if err := rulesSchema.ValidateJSON(raw); err != nil {
+		return Rules{}, fmt.Errorf("invalid router rules: %w", err)
+	}
+	var rules Rules
+	if err := json.Unmarshal(raw, &rules); err != nil {
+		return Rules{}, fmt.Errorf("failed to parse router rules: %w", err)
+	}
+	if err := rules.Validate(); err != nil {
+		return Rules{}, err
+	}
+	return rules, nil
+}
+
+// LoadFlags reads and validates a feature flags file
+func LoadFlags(path string) (Flags, error) {
+	raw, err := os.ReadFile(path) // #nosec G304 -- path comes from configuration
+	if err != nil {
+		return Flags{}, fmt.Errorf("failed to read feature flags: %w", err)
+	}
+	return parseFlags(raw)
+}
+
+func parseFlags(raw []byte) (Flags, error) {
+	if err := flagsSchema.ValidateJSON(raw); err != nil {
+		return Flags{}, fmt.Errorf("invalid feature flags: %w", err)
+	}
+	var flags Flags
+	if err := json.Unmarshal(raw, &flags); err != nil {
+		return Flags{}, fmt.Errorf("failed to parse feature flags: %w", err)
+	}
+	return flags, nil
 }
 
+// Decision is where a request goes. Provider and Model are the primary
+// choice, Chain lists it followed by the fallbacks to try in order. Shadow,
+// when set, is a candidate to call next to the chain and compare against.
 type Decision struct {
 	Provider string
 	Model    string
+	Mode     string
+	Chain    []Rule
 	Reason   string
+	Shadow   *Rule
 }
 
 type Router struct {
-	flags Flags
-	rules Rules
-	mu    sync.RWMutex
+	flags    Flags
+	rules    Rules
+	checksum string
+	mu       sync.RWMutex
+
+	// flagsPath and rulesPath are set by Load, so Reload can read them again
+	flagsPath string
+	rulesPath string
 }
 
-func Load(basePath string) (*Router, error) {
-	r := &Router{}
-	ff := filepath.Join(basePath, "feature_flags.json")
-	rules := filepath.Join(basePath, "config", "ai-router.rules.json")
+// New creates a router from already loaded flags and rules
+func New(flags Flags, rules Rules) *Router {
+	return &Router{flags: flags, rules: rules}
+}
 
-	if b, err := os.ReadFile(ff); err == nil {
-		_ = json.Unmarshal(b, &r.flags)
+// Load creates a router from the flag and rule files under basePath, the
+// AI templates directory. Missing files leave AI disabled; invalid ones are
+// an error.
+func Load(basePath string) (*Router, error) {
+	r := &Router{
+		flagsPath: filepath.Join(basePath, FlagsFile),
+		rulesPath: filepath.Join(basePath, filepath.FromSlash(RulesFile)),
 	}
-	if b, err := os.ReadFile(rules); err == nil {
-		_ = json.Unmarshal(b, &r.rules)
+	if err := r.Reload(); err != nil {
+		return nil, err
 	}
 	return r, nil
 }
 
+// Reload reads and validates the flag and rule files Load read, then swaps
+// both in at once, so no decision sees new flags with old rules. A missing
+// file reads as empty. When either file is invalid nothing is swapped and
+// the running configuration stays live.
+func (r *Router) Reload() error {
+	if r.flagsPath == "" {
+		return errors.New("router was not loaded from files")
+	}
+	rawFlags, err := readOptional(r.flagsPath)
+	if err != nil {
+		return fmt.Errorf("failed to read feature flags: %w", err)
+	}
+	rawRules, err := readOptional(r.rulesPath)
+	if err != nil {
+		return fmt.Errorf("failed to read router rules: %w", err)
+	}
+
+	var flags Flags
+	if rawFlags != nil {
+		if flags, err = parseFlags(rawFlags); err != nil {
+			return fmt.Errorf("%s: %w", r.flagsPath, err)
+		}
+	}
+	var rules Rules
+	if rawRules != nil {
+		if rules, err = parseRules(rawRules); err != nil {
+			return fmt.Errorf("%s: %w", r.rulesPath, err)
+		}
+	}
+
+	sum := sha256.New()
+	sum.Write(rawFlags)
+	sum.Write([]byte{0})
+	sum.Write(rawRules)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.flags, r.rules = flags, rules
+	r.checksum = hex.EncodeToString(sum.Sum(nil))
+	return nil
+}
+
+// Files returns the flag and rule files the router was loaded from, empty
+// for routers created with New
+func (r *Router) Files() (flagsPath, rulesPath string) {
+	return r.flagsPath, r.rulesPath
+}
+
+// Checksum is a SHA-256 of the flag and rule files in use, empty for
+// routers created with New
+func (r *Router) Checksum() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.checksum
+}
+
+// Version returns the version of the rules in use
+func (r *Router) Version() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.rules.Version
+}
+
+// readOptional reads path, returning nil without an error when it does not
+// exist
+func readOptional(path string) ([]byte, error) {
+	raw, err := os.ReadFile(path) // #nosec G304 -- path comes from configuration
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return raw, err
+}
+
 func (r *Router) Enabled() bool {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	return r.flags.AI.Enabled
 }
 
-func (r *Router) Decide(useCase string) (Decision, error) {
+// Flags returns the feature flags the router runs with
+func (r *Router) Flags() Flags {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.flags
+}
+
+// Decide routes req. The first override matching req that has a rule for
+// the use case wins over the defaults; without one the default rule for the
+// use case applies, then the "generation" rule. The mode comes from the
+// first matching override that sets one, else from the flags. Outside
+// strict mode the chain follows the fallbacks from the chosen provider.
+//
+// Requests routed by the defaults whose caller falls in the canary cohort
+// go to the use case's candidate instead, falling back to the default
+// chain; in shadow mode they stay on the default and the candidate is
+// returned as Shadow.
+func (r *Router) Decide(req Request) (Decision, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	if !r.flags.AI.Enabled {
-		return Decision{}, errors.New("ai disabled")
+		return Decision{}, ErrDisabled
+	}
+
+	rule, mode, reason, err := r.resolve(req)
+	if err != nil {
+		return Decision{}, err
+	}
+
+	chain := []Rule{rule}
+	if mode != ModeStrict {
+		chain = r.fallbackChain(rule)
+	}
+	decision := Decision{
+		Provider: rule.Provider,
+		Model:    rule.Model,
+		Mode:     mode,
+		Chain:    chain,
+		Reason:   reason,
+	}
+	if mode != ModeStrict && !strings.HasPrefix(reason, "override:") {
+		r.applyCandidate(req, &decision)
+	}
+	return decision, nil
+}
+
+// resolve picks the rule, mode and reason for req from the overrides and
+// defaults
+func (r *Router) resolve(req Request) (Rule, string, string, error) {
+	mode := r.flags.AI.Mode
+	modeSet := false
+	var rule Rule
+	reason := ""
+	for _, override := range r.rules.Overrides {
+		if !override.When.Matches(req) {
+			continue
+		}
+		if override.Use.Mode != "" && !modeSet {
+			mode, modeSet = override.Use.Mode, true
+		}
+		if overrideRule, ok := override.Use.Rules[req.UseCase]; ok && reason == "" {
+			rule, reason = overrideRule, "override:"+override.When.String()
+		}
+	}
+	if reason != "" {
+		return rule, mode, reason, nil
+	}
+
+	if defaultRule, ok := r.rules.Default[req.UseCase]; ok {
+		return defaultRule, mode, "rule:default", nil
 	}
-	if rule, ok := r.rules.Default[useCase]; ok {
-		return Decision{Provider: rule.Provider, Model: rule.Model, Reason: "rule:default"}, nil
+	if defaultRule, ok := r.rules.Default["generation"]; ok {
+		return defaultRule, mode, "fallback:generation", nil
 	}
-	if rule, ok := r.rules.Default["generation"]; ok {
-		return Decision{Provider: rule.Provider, Model: rule.Model, Reason: "fallback:generation"}, nil
+	return Rule{}, "", "", errors.New("no rule found")
+}
+
+// applyCandidate serves or shadows the use case's candidate when the
+// caller is in the canary cohort
+func (r *Router) applyCandidate(req Request, decision *Decision) {
+	candidate, ok := r.rules.Candidates[req.UseCase]
+	if !ok || candidate == decision.Chain[0] || !InCanary(req, r.flags.AI.CanaryPercent) {
+		return
+	}
+	if decision.Mode == ModeShadow {
+		decision.Shadow = &candidate
+		return
+	}
+
+	chain := []Rule{candidate}
+	for _, rule := range decision.Chain {
+		if rule != candidate {
+			chain = append(chain, rule)
+		}
+	}
+	decision.Provider, decision.Model = candidate.Provider, candidate.Model
+	decision.Chain = chain
+	decision.Reason = "canary"
+}
+
+// InCanary reports whether the caller of req falls in a canary of percent.
+// Callers are bucketed like features.InMemoryManager rollouts, by an xxhash
+// of the user, or of the tenant without one, so they keep their cohort
+// across requests and instances. Anonymous callers are never in it.
+func InCanary(req Request, percent int) bool {
+	subject := req.UserID
+	if subject == "" {
+		subject = req.TenantID
+	}
+	if subject == "" || percent <= 0 {
+		return false
+	}
+	return xxhash.Sum64String(subject+canarySalt)%100 < uint64(percent)
+}
+
+// fallbackChain follows the fallbacks from primary, stopping before a
+// rule repeats
+func (r *Router) fallbackChain(primary Rule) []Rule {
+	chain := []Rule{primary}
+	visited := map[Rule]bool{primary: true}
+	current := primary
+	for {
+		next, ok := r.nextFallback(current)
+		if !ok || visited[next] {
+			return chain
+		}
+		visited[next] = true
+		chain = append(chain, next)
+		current = next
+	}
+}
+
+func (r *Router) nextFallback(current Rule) (Rule, bool) {
+	for _, fallback := range r.rules.Fallbacks {
+		if fallback.From.Provider != current.Provider {
+			continue
+		}
+		if fallback.From.Model != "" && fallback.From.Model != current.Model {
+			continue
+		}
+		return fallback.To, true
 	}
-	return Decision{}, errors.New("no rule found")
+	return Rule{}, false
 }
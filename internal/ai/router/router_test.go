@@ -0,0 +1,218 @@
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// shippedRules is the rules file the AI templates ship with
+const shippedRules = "../../../templates/ai/config/ai-router.rules.json"
+
+func newShippedRouter(t *testing.T, mode string) *Router {
+	t.Helper()
+	rules, err := LoadRules(shippedRules)
+	if err != nil {
+		t.Fatalf("LoadRules failed: %v", err)
+	}
+	var flags Flags
+	flags.AI.Enabled = true
+	flags.AI.Mode = mode
+	return New(flags, rules)
+}
+
+func TestDecide_ShippedRules(t *testing.T) {
+	r := newShippedRouter(t, "balanced")
+
+	tests := []struct {
+		name   string
+		req    Request
+		want   Decision
+		reason string
+	}{
+		{
+			name: "default with fallback chain",
+			req:  Request{MCPID: "mcp-vendas", UseCase: "generation"},
+			want: Decision{
+				Provider: "openai",
+				Model:    "gpt-4o",
+				Mode:     "balanced",
+				Chain:    []Rule{{"openai", "gpt-4o"}, {"qwen", ""}, {"local", ""}},
+				Reason:   "rule:default",
+			},
+		},
+		{
+			name: "override in strict mode",
+			req:  Request{TenantID: "t1", MCPID: "mcp-wa-autenticacao", UseCase: "generation"},
+			want: Decision{
+				Provider: "local",
+				Model:    "gguf-phi4",
+				Mode:     ModeStrict,
+				Chain:    []Rule{{"local", "gguf-phi4"}},
+				Reason:   "override:mcp_id=mcp-wa-autenticacao",
+			},
+		},
+		{
+			name: "override mode applies to default rules",
+			req:  Request{MCPID: "mcp-wa-autenticacao", UseCase: "classification"},
+			want: Decision{
+				Provider: "openai",
+				Model:    "gpt-4o-mini",
+				Mode:     ModeStrict,
+				Chain:    []Rule{{"openai", "gpt-4o-mini"}},
+				Reason:   "rule:default",
+			},
+		},
+		{
+			name: "unknown use case falls back to generation",
+			req:  Request{UseCase: "summarization"},
+			want: Decision{
+				Provider: "openai",
+				Model:    "gpt-4o",
+				Mode:     "balanced",
+				Chain:    []Rule{{"openai", "gpt-4o"}, {"qwen", ""}, {"local", ""}},
+				Reason:   "fallback:generation",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.Decide(tt.req)
+			if err != nil {
+				t.Fatalf("Decide failed: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Decide(%+v) = %+v, want %+v", tt.req, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecide_StrictFlags(t *testing.T) {
+	r := newShippedRouter(t, ModeStrict)
+
+	got, err := r.Decide(Request{UseCase: "generation"})
+	if err != nil {
+		t.Fatalf("Decide failed: %v", err)
+	}
+	if len(got.Chain) != 1 {
+		t.Errorf("strict mode must not fall back, got chain %+v", got.Chain)
+	}
+}
+
+func TestDecide_Canary(t *testing.T) {
+	r := newShippedRouter(t, "balanced")
+	r.flags.AI.CanaryPercent = 30
+	candidate := Rule{"qwen", "qwen2.5-72b-instruct"}
+
+	canary := 0
+	for i := 0; i < 1000; i++ {
+		req := Request{TenantID: "acme", UserID: fmt.Sprintf("user-%d", i), UseCase: "generation"}
+		got, err := r.Decide(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		again, _ := r.Decide(req)
+		if !reflect.DeepEqual(got, again) {
+			t.Fatalf("canary assignment must be sticky, got %+v then %+v", got, again)
+		}
+		if got.Reason != "canary" {
+			continue
+		}
+		canary++
+		if want := []Rule{candidate, {"openai", "gpt-4o"}, {"qwen", ""}, {"local", ""}}; !reflect.DeepEqual(got.Chain, want) {
+			t.Fatalf("canary chain = %+v, want the candidate then the default chain", got.Chain)
+		}
+	}
+	if canary < 250 || canary > 350 {
+		t.Errorf("%d of 1000 users in a 30%% canary", canary)
+	}
+
+	// Overrides and requests without a caller keep their rule
+	r.flags.AI.CanaryPercent = 100
+	for _, req := range []Request{
+		{TenantID: "acme", MCPID: "mcp-wa-autenticacao", UseCase: "generation"},
+		{UseCase: "generation"},
+		{TenantID: "acme", UseCase: "classification"},
+	} {
+		if got, _ := r.Decide(req); got.Reason == "canary" || got.Shadow != nil {
+			t.Errorf("Decide(%+v) = %+v, want no candidate", req, got)
+		}
+	}
+}
+
+func TestDecide_Shadow(t *testing.T) {
+	r := newShippedRouter(t, ModeShadow)
+	r.flags.AI.CanaryPercent = 100
+
+	got, err := r.Decide(Request{TenantID: "acme", UseCase: "generation"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Provider != "openai" || got.Reason != "rule:default" || got.Shadow == nil || *got.Shadow != (Rule{"qwen", "qwen2.5-72b-instruct"}) {
+		t.Errorf("shadow mode must keep the default and shadow the candidate, got %+v", got)
+	}
+}
+
+func TestDecide_OverrideOrder(t *testing.T) {
+	var rules Rules
+	raw := `{
+		"default": {"generation": {"provider": "openai", "model": "gpt-4o"}},
+		"overrides": [
+			{"when": {"tenant_id": "acme", "sdk": "go"}, "use": {"generation": {"provider": "qwen", "model": "qwen-max"}}},
+			{"when": {"tenant_id": "acme"}, "use": {"generation": {"provider": "local", "model": "gguf-phi4"}}}
+		],
+		"fallbacks": [
+			{"from": {"provider": "qwen"}, "to": {"provider": "local"}},
+			{"from": {"provider": "local"}, "to": {"provider": "qwen"}}
+		]
+	}`
+	if err := json.Unmarshal([]byte(raw), &rules); err != nil {
+		t.Fatal(err)
+	}
+	var flags Flags
+	flags.AI.Enabled = true
+	r := New(flags, rules)
+
+	got, err := r.Decide(Request{TenantID: "acme", SDK: "go", UseCase: "generation"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Reason != "override:tenant_id=acme,sdk=go" || got.Provider != "qwen" {
+		t.Errorf("first matching override must win, got %+v", got)
+	}
+	// Cycles in the fallbacks end the chain
+	if want := []Rule{{"qwen", "qwen-max"}, {"local", ""}, {"qwen", ""}}; !reflect.DeepEqual(got.Chain, want) {
+		t.Errorf("chain = %+v, want %+v", got.Chain, want)
+	}
+
+	got, err = r.Decide(Request{TenantID: "acme", SDK: "python", UseCase: "generation"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Provider != "local" {
+		t.Errorf("expected the tenant override, got %+v", got)
+	}
+}
+
+func TestRules_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"unknown condition": `{"overrides": [{"when": {"region": "br"}, "use": {"mode": "strict"}}]}`,
+		"empty condition":   `{"overrides": [{"when": {}, "use": {"mode": "strict"}}]}`,
+		"missing provider":  `{"default": {"generation": {"model": "gpt-4o"}}}`,
+		"bad fallback":      `{"fallbacks": [{"from": {"provider": "openai"}, "to": {}}]}`,
+		"bad candidate":     `{"candidates": {"generation": {"model": "qwen-max"}}}`,
+	}
+	for name, raw := range tests {
+		t.Run(name, func(t *testing.T) {
+			var rules Rules
+			if err := json.Unmarshal([]byte(raw), &rules); err == nil {
+				err = rules.Validate()
+				if err == nil {
+					t.Error("expected an error")
+				}
+			}
+		})
+	}
+}
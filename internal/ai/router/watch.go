@@ -0,0 +1,219 @@
+package router
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/events"
+)
+
+// What made a Watcher reload
+const (
+	TriggerFile   = "file"
+	TriggerSignal = "signal"
+)
+
+// Outcomes of a reload, as published in events.ConfigReload
+const (
+	ReloadApplied  = "applied"
+	ReloadRejected = "rejected"
+)
+
+// ReloadStatus is what a Watcher reports about the configuration it keeps
+// live. LastError is the rejection of the latest reload, cleared by the
+// next one that applies.
+type ReloadStatus struct {
+	Checksum    string    `json:"checksum"`
+	Version     string    `json:"version"`
+	LoadedAt    time.Time `json:"loaded_at"`
+	LastAttempt time.Time `json:"last_attempt"`
+	LastError   string    `json:"last_error,omitempty"`
+	Reloads     int       `json:"reloads"`
+	Failures    int       `json:"failures"`
+}
+
+// WatchOption configures a Watcher
+type WatchOption func(*Watcher)
+
+// WithInterval sets how often the files are checked for changes; zero
+// disables polling and leaves only SIGHUP
+func WithInterval(interval time.Duration) WatchOption {
+	return func(w *Watcher) {
+		w.interval = interval
+	}
+}
+
+// WithPublisher publishes an events.ConfigReload for every reload
+func WithPublisher(publisher events.EventPublisher) WatchOption {
+	return func(w *Watcher) {
+		w.publisher = publisher
+	}
+}
+
+// WithLogger sets the logger; reloads are not logged without one
+func WithLogger(logger *zap.Logger) WatchOption {
+	return func(w *Watcher) {
+		w.logger = logger
+	}
+}
+
+// Watcher reloads a router loaded with Load when its files change or the
+// process gets SIGHUP
+type Watcher struct {
+	router    *Router
+	interval  time.Duration
+	publisher events.EventPublisher
+	logger    *zap.Logger
+
+	mu     sync.Mutex
+	status ReloadStatus
+	stop   chan struct{}
+	done   chan struct{}
+}
+
+// NewWatcher creates a watcher for r; call Start to begin watching
+func NewWatcher(r *Router, opts ...WatchOption) *Watcher {
+	w := &Watcher{router: r, logger: zap.NewNop()}
+	for _, opt := range opts {
+		opt(w)
+	}
+	now := time.Now()
+	w.status = ReloadStatus{Checksum: r.Checksum(), Version: r.Version(), LoadedAt: now, LastAttempt: now}
+	return w
+}
+
+// Start watches until ctx is done or Stop is called
+func (w *Watcher) Start(ctx context.Context) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.stop != nil {
+		return
+	}
+	w.stop, w.done = make(chan struct{}), make(chan struct{})
+
+	// Subscribe and take the file versions before returning, so a signal
+	// or change right after Start is not missed
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	flagsPath, rulesPath := w.router.Files()
+	last := [2]fileVersion{versionOf(flagsPath), versionOf(rulesPath)}
+	go w.run(ctx, hangup, last, w.stop, w.done)
+}
+
+// Stop ends watching and waits for a reload in progress. It is safe on a
+// nil or stopped watcher.
+func (w *Watcher) Stop() {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	stop, done := w.stop, w.done
+	w.stop, w.done = nil, nil
+	w.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+}
+
+// Status returns the reload status
+func (w *Watcher) Status() ReloadStatus {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.status
+}
+
+func (w *Watcher) run(ctx context.Context, hangup chan os.Signal, last [2]fileVersion, stop, done chan struct{}) {
+	defer close(done)
+	defer signal.Stop(hangup)
+
+	var tick <-chan time.Time
+	if w.interval > 0 {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	flagsPath, rulesPath := w.router.Files()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-stop:
+			return
+		case <-hangup:
+			_ = w.Reload(ctx, TriggerSignal)
+		case <-tick:
+			current := [2]fileVersion{versionOf(flagsPath), versionOf(rulesPath)}
+			if current == last {
+				continue
+			}
+			last = current
+			_ = w.Reload(ctx, TriggerFile)
+		}
+	}
+}
+
+// Reload reloads the router now, records the outcome and publishes it. A
+// rejected reload returns its error and keeps the previous configuration.
+func (w *Watcher) Reload(ctx context.Context, trigger string) error {
+	err := w.router.Reload()
+	event := events.ConfigReload{
+		Trigger:  trigger,
+		Status:   ReloadApplied,
+		Checksum: w.router.Checksum(),
+		Version:  w.router.Version(),
+	}
+
+	w.mu.Lock()
+	w.status.LastAttempt = time.Now()
+	if err != nil {
+		event.Status, event.Error = ReloadRejected, err.Error()
+		w.status.LastError = err.Error()
+		w.status.Failures++
+	} else {
+		w.status.Checksum, w.status.Version = event.Checksum, event.Version
+		w.status.LoadedAt = w.status.LastAttempt
+		w.status.LastError = ""
+		w.status.Reloads++
+	}
+	w.mu.Unlock()
+
+	if err != nil {
+		w.logger.Error("Rejected AI config reload, keeping the previous config",
+			zap.String("trigger", trigger),
+			zap.String("checksum", event.Checksum),
+			zap.Error(err))
+	} else {
+		w.logger.Info("AI config reloaded",
+			zap.String("trigger", trigger),
+			zap.String("checksum", event.Checksum),
+			zap.String("version", event.Version))
+	}
+
+	if w.publisher != nil {
+		if publishErr := events.PublishConfigReload(ctx, w.publisher, events.SubjectConfigReload, event); publishErr != nil {
+			w.logger.Warn("Failed to publish AI event", zap.String("subject", events.SubjectConfigReload), zap.Error(publishErr))
+		}
+	}
+	return err
+}
+
+// fileVersion tells file changes apart by modification time and size
+type fileVersion struct {
+	modified time.Time
+	size     int64
+}
+
+func versionOf(path string) fileVersion {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileVersion{size: -1}
+	}
+	return fileVersion{modified: info.ModTime(), size: info.Size()}
+}
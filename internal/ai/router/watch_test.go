@@ -0,0 +1,185 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/events"
+)
+
+type reloadRecorder struct {
+	mu      sync.Mutex
+	reloads []events.ConfigReload
+}
+
+func (p *reloadRecorder) PublishWithRetry(_ context.Context, subject string, payload []byte) error {
+	if subject != events.SubjectConfigReload {
+		return errors.New("unexpected subject " + subject)
+	}
+	var reload events.ConfigReload
+	if err := json.Unmarshal(payload, &reload); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.reloads = append(p.reloads, reload)
+	return nil
+}
+
+func (p *reloadRecorder) last() (events.ConfigReload, int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.reloads) == 0 {
+		return events.ConfigReload{}, 0
+	}
+	return p.reloads[len(p.reloads)-1], len(p.reloads)
+}
+
+// writeConfig writes a templates directory with the given flag and rule
+// files
+func writeConfig(t *testing.T, dir, flags, rules string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Join(dir, "config"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, FlagsFile), []byte(flags), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, filepath.FromSlash(RulesFile)), []byte(rules), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+const (
+	testFlags = `{"ai": {"enabled": true, "mode": "balanced"}}`
+	testRules = `{"version": "1", "default": {"generation": {"provider": "openai", "model": "gpt-4o"}}}`
+)
+
+func TestLoad_Shipped(t *testing.T) {
+	r, err := Load("../../../templates/ai")
+	if err != nil {
+		t.Fatalf("the shipped flags and rules must pass their schemas: %v", err)
+	}
+	if r.Enabled() || r.Version() != "1.0" || len(r.Checksum()) != 64 {
+		t.Errorf("unexpected router: enabled %v, version %q, checksum %q", r.Enabled(), r.Version(), r.Checksum())
+	}
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	tests := map[string][2]string{
+		"unknown flag":        {`{"ai": {"enabled": true, "canary": 5}}`, testRules},
+		"canary out of range": {`{"ai": {"enabled": true, "canary_percent": 150}}`, testRules},
+		"unknown mode":        {`{"ai": {"enabled": true, "mode": "shadw"}}`, testRules},
+		"rule without model":  {testFlags, `{"default": {"generation": {"model": "gpt-4o"}}}`},
+		"malformed json":      {testFlags, `{"default": `},
+	}
+	for name, files := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeConfig(t, dir, files[0], files[1])
+			if _, err := Load(dir); err == nil {
+				t.Error("expected a load error")
+			}
+		})
+	}
+
+	// Missing files leave AI off
+	r, err := Load(t.TempDir())
+	if err != nil || r.Enabled() {
+		t.Errorf("missing files must load disabled, got %v", err)
+	}
+}
+
+func TestWatcher_Reload(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, testFlags, testRules)
+	r, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publisher := &reloadRecorder{}
+	w := NewWatcher(r, WithInterval(10*time.Millisecond), WithPublisher(publisher), WithLogger(zaptest.NewLogger(t)))
+	w.Start(context.Background())
+	defer w.Stop()
+	initial := w.Status()
+
+	// An invalid file is rejected and the old config keeps routing
+	writeConfig(t, dir, `{"ai": {"enabled": true, "canary_percent": "5"}}`, testRules)
+	waitFor(t, func() bool { event, _ := publisher.last(); return event.Status == ReloadRejected })
+	status := w.Status()
+	if status.Failures != 1 || !strings.Contains(status.LastError, "/ai/canary_percent") || status.Checksum != initial.Checksum {
+		t.Errorf("unexpected status after a rejected reload %+v", status)
+	}
+	if decision, err := r.Decide(Request{UseCase: "generation"}); err != nil || decision.Provider != "openai" {
+		t.Errorf("the previous rules must stay live, got %+v, %v", decision, err)
+	}
+
+	// A valid change swaps flags and rules in together
+	writeConfig(t, dir, `{"ai": {"enabled": true, "mode": "strict"}}`, `{"version": "2", "default": {"generation": {"provider": "local"}}}`)
+	waitFor(t, func() bool { event, _ := publisher.last(); return event.Status == ReloadApplied })
+	decision, err := r.Decide(Request{UseCase: "generation"})
+	if err != nil || decision.Provider != "local" || decision.Mode != ModeStrict {
+		t.Errorf("reloaded config not in use, got %+v, %v", decision, err)
+	}
+	status = w.Status()
+	if status.Version != "2" || status.LastError != "" || status.Reloads == 0 || status.Checksum == initial.Checksum {
+		t.Errorf("unexpected status after a reload %+v", status)
+	}
+	if event, _ := publisher.last(); event.Trigger != TriggerFile || event.Checksum != status.Checksum {
+		t.Errorf("unexpected reload event %+v", event)
+	}
+}
+
+func TestWatcher_Signal(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, dir, testFlags, testRules)
+	r, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publisher := &reloadRecorder{}
+	w := NewWatcher(r, WithPublisher(publisher))
+	w.Start(context.Background())
+	defer w.Stop()
+
+	process, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := process.Signal(syscall.SIGHUP); err != nil {
+		t.Skipf("cannot signal the test process: %v", err)
+	}
+	waitFor(t, func() bool { event, n := publisher.last(); return n == 1 && event.Trigger == TriggerSignal })
+}
+
+func TestWatcher_StopIsSafe(t *testing.T) {
+	var w *Watcher
+	w.Stop()
+
+	w = NewWatcher(New(Flags{}, Rules{}))
+	w.Stop()
+	if err := w.Reload(context.Background(), TriggerSignal); err == nil {
+		t.Error("routers created with New have nothing to reload")
+	}
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met in time")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
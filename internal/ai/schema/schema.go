@@ -0,0 +1,431 @@
+// Package schema validates JSON documents against the subset of JSON Schema
+// the AI templates use: types, properties, required, additionalProperties,
+// items, enum, numeric, length and size bounds, patterns, date-time formats
+// and $ref pointers into the same schema. Schemas using other keywords are
+// rejected at compile time rather than half enforced.
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// annotations are keywords that document a schema without constraining it
+var annotations = map[string]bool{
+	"$id": true, "$schema": true, "$defs": true, "definitions": true,
+	"title": true, "description": true, "default": true, "examples": true,
+}
+
+// Schema is a compiled JSON Schema
+type Schema struct {
+	ID   string
+	root *node
+}
+
+type node struct {
+	types         []string
+	properties    map[string]*node
+	required      []string
+	additional    *node
+	noAdditional  bool
+	items         *node
+	enum          []interface{}
+	minimum       *float64
+	maximum       *float64
+	minLength     *int
+	maxLength     *int
+	minItems      *int
+	minProperties *int
+	pattern       *regexp.Regexp
+	format        string
+}
+
+// ValidationError lists every way a document breaks its schema, each as
+// "<JSON pointer>: <problem>"
+type ValidationError struct {
+	Schema   string
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("does not match schema %s: %s", e.Schema, strings.Join(e.Problems, "; "))
+}
+
+// Compile parses a JSON Schema
+func Compile(raw []byte) (*Schema, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("parsing schema: %w", err)
+	}
+	c := &compiler{doc: doc, refs: make(map[string]*node)}
+	root, err := c.compile(doc, "#")
+	if err != nil {
+		return nil, err
+	}
+	id, _ := doc["$id"].(string)
+	return &Schema{ID: id, root: root}, nil
+}
+
+// MustCompile is Compile for schemas shipped with the code; it panics on
+// an invalid schema
+func MustCompile(raw []byte) *Schema {
+	s, err := Compile(raw)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// ValidateJSON decodes raw and validates it
+func (s *Schema) ValidateJSON(raw []byte) error {
+	var doc interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return fmt.Errorf("decoding document: %w", err)
+	}
+	return s.Validate(doc)
+}
+
+// Validate checks a document decoded by encoding/json into interface{}
+// values. It returns a *ValidationError listing every problem.
+func (s *Schema) Validate(doc interface{}) error {
+	var problems []string
+	s.root.validate(doc, "", &problems)
+	if len(problems) > 0 {
+		return &ValidationError{Schema: s.ID, Problems: problems}
+	}
+	return nil
+}
+
+type compiler struct {
+	doc  map[string]interface{}
+	refs map[string]*node
+}
+
+func (c *compiler) compile(value interface{}, at string) (*node, error) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("schema %s: expected an object", at)
+	}
+	if ref, ok := object["$ref"].(string); ok {
+		return c.resolve(ref)
+	}
+
+	n := &node{}
+	for _, key := range sortedKeys(object) {
+		if annotations[key] {
+			continue
+		}
+		if err := c.keyword(n, key, object[key], at+"/"+key); err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
+// keyword compiles one keyword of a schema object into n
+func (c *compiler) keyword(n *node, key string, value interface{}, at string) error {
+	var err error
+	switch key {
+	case "type":
+		n.types, err = typeList(value, at)
+	case "properties":
+		n.properties, err = c.compileMap(value, at)
+	case "required":
+		n.required, err = stringList(value, at)
+	case "additionalProperties":
+		if allowed, ok := value.(bool); ok {
+			n.noAdditional = !allowed
+			return nil
+		}
+		n.additional, err = c.compile(value, at)
+	case "items":
+		n.items, err = c.compile(value, at)
+	case "enum":
+		list, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("schema %s: expected an array", at)
+		}
+		n.enum = list
+	default:
+		return bound(n, key, value, at)
+	}
+	return err
+}
+
+// bound compiles a keyword that limits a number, string, array or object
+func bound(n *node, key string, value interface{}, at string) error {
+	var err error
+	switch key {
+	case "minimum":
+		n.minimum, err = number(value, at)
+	case "maximum":
+		n.maximum, err = number(value, at)
+	case "minLength":
+		n.minLength, err = count(value, at)
+	case "maxLength":
+		n.maxLength, err = count(value, at)
+	case "minItems":
+		n.minItems, err = count(value, at)
+	case "minProperties":
+		n.minProperties, err = count(value, at)
+	case "pattern":
+		n.pattern, err = compilePattern(value, at)
+	case "format":
+		n.format, _ = value.(string)
+		if n.format != "date-time" {
+			return fmt.Errorf("schema %s: unsupported format %v", at, value)
+		}
+	default:
+		return fmt.Errorf("schema %s: unsupported keyword", at)
+	}
+	return err
+}
+
+// resolve compiles a "#/..." pointer once, so recursive schemas terminate
+func (c *compiler) resolve(ref string) (*node, error) {
+	if n, ok := c.refs[ref]; ok {
+		return n, nil
+	}
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("schema: only local $ref pointers are supported, got %q", ref)
+	}
+	var target interface{} = c.doc
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#"), "/")[1:] {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		object, ok := target.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("schema: unresolvable $ref %q", ref)
+		}
+		if target, ok = object[token]; !ok {
+			return nil, fmt.Errorf("schema: unresolvable $ref %q", ref)
+		}
+	}
+
+	n := &node{}
+	c.refs[ref] = n
+	compiled, err := c.compile(target, ref)
+	if err != nil {
+		return nil, err
+	}
+	*n = *compiled
+	return n, nil
+}
+
+func (c *compiler) compileMap(value interface{}, at string) (map[string]*node, error) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("schema %s: expected an object", at)
+	}
+	nodes := make(map[string]*node, len(object))
+	for name, child := range object {
+		n, err := c.compile(child, at+"/"+name)
+		if err != nil {
+			return nil, err
+		}
+		nodes[name] = n
+	}
+	return nodes, nil
+}
+
+func (n *node) validate(value interface{}, path string, problems *[]string) {
+	fail := func(format string, args ...interface{}) {
+		*problems = append(*problems, pointer(path)+": "+fmt.Sprintf(format, args...))
+	}
+	if len(n.types) > 0 && !matchesType(n.types, value) {
+		fail("expected %s, got %s", strings.Join(n.types, " or "), typeOf(value))
+		return
+	}
+	if len(n.enum) > 0 && !inEnum(n.enum, value) {
+		fail("must be one of %s", enumList(n.enum))
+	}
+
+	switch v := value.(type) {
+	case float64:
+		n.validateNumber(v, fail)
+	case string:
+		n.validateString(v, fail)
+	case []interface{}:
+		if n.minItems != nil && len(v) < *n.minItems {
+			fail("must have at least %d items", *n.minItems)
+		}
+		if n.items != nil {
+			for i, item := range v {
+				n.items.validate(item, fmt.Sprintf("%s/%d", path, i), problems)
+			}
+		}
+	case map[string]interface{}:
+		n.validateObject(v, path, fail, problems)
+	}
+}
+
+func (n *node) validateNumber(v float64, fail func(string, ...interface{})) {
+	if n.minimum != nil && v < *n.minimum {
+		fail("must be >= %v", *n.minimum)
+	}
+	if n.maximum != nil && v > *n.maximum {
+		fail("must be <= %v", *n.maximum)
+	}
+}
+
+func (n *node) validateString(v string, fail func(string, ...interface{})) {
+	length := len([]rune(v))
+	if n.minLength != nil && length < *n.minLength {
+		fail("must be at least %d characters", *n.minLength)
+	}
+	if n.maxLength != nil && length > *n.maxLength {
+		fail("must be at most %d characters", *n.maxLength)
+	}
+	if n.pattern != nil && !n.pattern.MatchString(v) {
+		fail("must match %s", n.pattern)
+	}
+	if n.format == "date-time" {
+		if _, err := time.Parse(time.RFC3339Nano, v); err != nil {
+			fail("must be an RFC 3339 date-time")
+		}
+	}
+}
+
+func (n *node) validateObject(v map[string]interface{}, path string, fail func(string, ...interface{}), problems *[]string) {
+	if n.minProperties != nil && len(v) < *n.minProperties {
+		fail("must have at least %d properties", *n.minProperties)
+	}
+	for _, name := range n.required {
+		if _, ok := v[name]; !ok {
+			fail("missing required property %q", name)
+		}
+	}
+	for _, name := range sortedKeys(v) {
+		child := path + "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+		switch property, ok := n.properties[name]; {
+		case ok:
+			property.validate(v[name], child, problems)
+		case n.additional != nil:
+			n.additional.validate(v[name], child, problems)
+		case n.noAdditional:
+			fail("unknown property %q", name)
+		}
+	}
+}
+
+func matchesType(types []string, value interface{}) bool {
+	actual := typeOf(value)
+	for _, t := range types {
+		if t == actual || (t == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func typeOf(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) && !math.IsInf(v, 0) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func inEnum(enum []interface{}, value interface{}) bool {
+	for _, allowed := range enum {
+		if reflect.DeepEqual(allowed, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func enumList(enum []interface{}) string {
+	values := make([]string, len(enum))
+	for i, value := range enum {
+		encoded, _ := json.Marshal(value)
+		values[i] = string(encoded)
+	}
+	return strings.Join(values, ", ")
+}
+
+// pointer renders a JSON pointer, "/" for the document itself
+func pointer(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return path
+}
+
+func typeList(value interface{}, at string) ([]string, error) {
+	if t, ok := value.(string); ok {
+		return []string{t}, nil
+	}
+	return stringList(value, at)
+}
+
+func stringList(value interface{}, at string) ([]string, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("schema %s: expected an array of strings", at)
+	}
+	strs := make([]string, len(list))
+	for i, item := range list {
+		if strs[i], ok = item.(string); !ok {
+			return nil, fmt.Errorf("schema %s: expected an array of strings", at)
+		}
+	}
+	return strs, nil
+}
+
+func number(value interface{}, at string) (*float64, error) {
+	v, ok := value.(float64)
+	if !ok {
+		return nil, fmt.Errorf("schema %s: expected a number", at)
+	}
+	return &v, nil
+}
+
+func count(value interface{}, at string) (*int, error) {
+	v, ok := value.(float64)
+	if !ok || v < 0 || v != math.Trunc(v) {
+		return nil, fmt.Errorf("schema %s: expected a non-negative integer", at)
+	}
+	n := int(v)
+	return &n, nil
+}
+
+func compilePattern(value interface{}, at string) (*regexp.Regexp, error) {
+	source, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("schema %s: expected a string", at)
+	}
+	pattern, err := regexp.Compile(source)
+	if err != nil {
+		return nil, fmt.Errorf("schema %s: %w", at, err)
+	}
+	return pattern, nil
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,106 @@
+package schema
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const testSchema = `{
+	"$id": "test.v1",
+	"type": "object",
+	"additionalProperties": false,
+	"required": ["name", "rules"],
+	"properties": {
+		"name": {"type": "string", "minLength": 1, "pattern": "^[a-z]+$"},
+		"percent": {"type": "integer", "minimum": 0, "maximum": 100},
+		"mode": {"enum": ["strict", "balanced"]},
+		"at": {"type": "string", "format": "date-time"},
+		"rules": {"type": "object", "minProperties": 1, "additionalProperties": {"$ref": "#/$defs/rule"}},
+		"chain": {"type": "array", "items": {"$ref": "#/$defs/rule"}}
+	},
+	"$defs": {
+		"rule": {
+			"type": "object",
+			"additionalProperties": false,
+			"required": ["provider"],
+			"properties": {"provider": {"type": "string", "minLength": 1}, "model": {"type": ["string", "null"]}}
+		}
+	}
+}`
+
+func TestValidate(t *testing.T) {
+	s, err := Compile([]byte(testSchema))
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+
+	valid := `{"name": "ok", "percent": 5, "mode": "strict", "at": "2026-10-18T10:00:00Z",
+		"rules": {"generation": {"provider": "openai", "model": null}}, "chain": [{"provider": "local"}]}`
+	if err := s.ValidateJSON([]byte(valid)); err != nil {
+		t.Errorf("valid document rejected: %v", err)
+	}
+
+	invalid := `{"name": "Not Ok", "percent": 5.5, "mode": "fast", "at": "ontem", "extra": 1,
+		"rules": {"generation": {"model": "gpt-4o"}}, "chain": [{"provider": ""}, "local"]}`
+	err = s.ValidateJSON([]byte(invalid))
+	var validation *ValidationError
+	if !errors.As(err, &validation) {
+		t.Fatalf("expected a *ValidationError, got %v", err)
+	}
+	want := []string{
+		`/at: must be an RFC 3339 date-time`,
+		`/chain/0/provider: must be at least 1 characters`,
+		`/chain/1: expected object, got string`,
+		`/: unknown property "extra"`,
+		`/mode: must be one of "strict", "balanced"`,
+		`/name: must match ^[a-z]+$`,
+		`/percent: expected integer, got number`,
+		`/rules/generation: missing required property "provider"`,
+	}
+	if !reflect.DeepEqual(validation.Problems, want) {
+		t.Errorf("problems = %q\nwant %q", validation.Problems, want)
+	}
+
+	if err := s.ValidateJSON([]byte(`{"name": "x", "rules": {}}`)); err == nil {
+		t.Error("minProperties must be enforced")
+	}
+}
+
+func TestCompile_Errors(t *testing.T) {
+	tests := map[string]string{
+		"unsupported keyword": `{"oneOf": []}`,
+		"unsupported format":  `{"format": "email"}`,
+		"remote ref":          `{"$ref": "other.json#/rule"}`,
+		"missing ref":         `{"properties": {"a": {"$ref": "#/$defs/nope"}}}`,
+		"bad pattern":         `{"pattern": "("}`,
+		"negative bound":      `{"minLength": -1}`,
+	}
+	for name, raw := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Compile([]byte(raw)); err == nil {
+				t.Error("expected a compile error")
+			}
+		})
+	}
+}
+
+// The NATS event schemas shipped with the templates stay within the
+// supported keywords
+func TestCompile_ShippedSchemas(t *testing.T) {
+	paths, err := filepath.Glob("../../../templates/ai/nats-schemas/*.json")
+	if err != nil || len(paths) == 0 {
+		t.Fatalf("no shipped schemas: %v", err)
+	}
+	for _, path := range paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Compile(raw); err != nil {
+			t.Errorf("%s: %v", filepath.Base(path), err)
+		}
+	}
+}
@@ -20,6 +20,11 @@ var (
 	policyBlocks    *prometheus.CounterVec
 	routerDecisions *prometheus.CounterVec
 	budgetBreaches  *prometheus.CounterVec
+	shadowRequests  *prometheus.CounterVec
+	shadowLatency   *prometheus.HistogramVec
+	shadowCost      *prometheus.CounterVec
+	shadowAgreement *prometheus.HistogramVec
+	cacheLookups    *prometheus.CounterVec
 )
 
 type Labels struct {
@@ -73,6 +78,28 @@ func Init(reg prometheus.Registerer) {
 			Name: "ai_budget_breaches_total",
 			Help: "Ocorrências de violação de orçamento (global/tenant/mcp)",
 		}, []string{"scope"})
+		shadowRequests = promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Name: "ai_shadow_requests_total",
+			Help: "Total de chamadas shadow ao modelo candidato (ok/error)",
+		}, []string{"use_case", "candidate", "outcome"})
+		shadowLatency = promauto.With(reg).NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "ai_shadow_latency_ms",
+			Help:    "Latência em milissegundos do primário e do candidato nas mesmas requisições",
+			Buckets: []float64{50, 100, 200, 400, 800, 1600, 3200, 6400, 12800},
+		}, []string{"use_case", "candidate", "arm"})
+		shadowCost = promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Name: "ai_shadow_cost_brl_total",
+			Help: "Custo em BRL do primário e do candidato nas mesmas requisições",
+		}, []string{"use_case", "candidate", "arm"})
+		shadowAgreement = promauto.With(reg).NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "ai_shadow_agreement_ratio",
+			Help:    "Similaridade entre as respostas do primário e do candidato (0 a 1)",
+			Buckets: []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1},
+		}, []string{"use_case", "candidate"})
+		cacheLookups = promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Name: "ai_cache_lookups_total",
+			Help: "Consultas ao cache de inferência (hit/semantic_hit/miss)",
+		}, []string{"tenant_id", "use_case", "result"})
 	})
 }
 
@@ -129,3 +156,54 @@ func IncBudgetBreach(scope string) {
 	}
 	budgetBreaches.WithLabelValues(scope).Inc()
 }
+
+// Arms of a shadow comparison
+const (
+	ArmPrimary = "primary"
+	ArmShadow  = "shadow"
+)
+
+// ShadowMeta compares a shadow call with the primary call of the same
+// request. Labels.Provider and Labels.Model name the candidate. Latency,
+// cost and agreement are only recorded when both calls succeeded.
+type ShadowMeta struct {
+	Labels         Labels
+	Failed         bool
+	PrimaryOK      bool
+	PrimaryLatency time.Duration
+	ShadowLatency  time.Duration
+	PrimaryCostBRL float64
+	ShadowCostBRL  float64
+	Agreement      float64
+}
+
+func ObserveShadow(meta ShadowMeta) {
+	if shadowRequests == nil {
+		return
+	}
+	l := meta.Labels
+	candidate := l.Provider + "/" + l.Model
+	if meta.Failed {
+		shadowRequests.WithLabelValues(l.UseCase, candidate, "error").Inc()
+		return
+	}
+	shadowRequests.WithLabelValues(l.UseCase, candidate, "ok").Inc()
+	if !meta.PrimaryOK {
+		return
+	}
+
+	shadowLatency.WithLabelValues(l.UseCase, candidate, ArmPrimary).Observe(float64(meta.PrimaryLatency.Milliseconds()))
+	shadowLatency.WithLabelValues(l.UseCase, candidate, ArmShadow).Observe(float64(meta.ShadowLatency.Milliseconds()))
+	shadowCost.WithLabelValues(l.UseCase, candidate, ArmPrimary).Add(meta.PrimaryCostBRL)
+	shadowCost.WithLabelValues(l.UseCase, candidate, ArmShadow).Add(meta.ShadowCostBRL)
+	shadowAgreement.WithLabelValues(l.UseCase, candidate).Observe(meta.Agreement)
+}
+
+// IncCacheLookup counts an inference cache lookup; result is hit,
+// semantic_hit or miss
+func IncCacheLookup(l Labels, result string) {
+	if cacheLookups == nil {
+		return
+	}
+	cacheLookups.WithLabelValues(l.TenantID, l.UseCase, result).Inc()
+}
@@ -3,6 +3,8 @@ package telemetry
 import (
 	"testing"
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
 )
 
 func TestInit(t *testing.T) {
@@ -123,6 +125,48 @@ func TestIncBudgetBreach(t *testing.T) {
 	}
 }
 
+func TestObserveShadow(t *testing.T) {
+	Init(nil)
+
+	labels := Labels{Provider: "qwen", Model: "qwen2.5-72b-instruct", UseCase: "test-shadow"}
+	ObserveShadow(ShadowMeta{
+		Labels:         labels,
+		PrimaryOK:      true,
+		PrimaryLatency: 300 * time.Millisecond,
+		ShadowLatency:  500 * time.Millisecond,
+		PrimaryCostBRL: 0.02,
+		ShadowCostBRL:  0.01,
+		Agreement:      0.8,
+	})
+	ObserveShadow(ShadowMeta{Labels: labels, Failed: true})
+	// Without a primary answer there is nothing to compare against
+	ObserveShadow(ShadowMeta{Labels: labels, ShadowCostBRL: 1})
+
+	candidate := "qwen/qwen2.5-72b-instruct"
+	if got := testutil.ToFloat64(shadowRequests.WithLabelValues("test-shadow", candidate, "ok")); got != 2 {
+		t.Errorf("ok shadow requests = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(shadowRequests.WithLabelValues("test-shadow", candidate, "error")); got != 1 {
+		t.Errorf("failed shadow requests = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(shadowCost.WithLabelValues("test-shadow", candidate, ArmShadow)); got != 0.01 {
+		t.Errorf("shadow cost = %v, want 0.01", got)
+	}
+}
+
+func TestIncCacheLookup(t *testing.T) {
+	Init(nil)
+
+	labels := Labels{TenantID: "acme", UseCase: "test-cache"}
+	IncCacheLookup(labels, "hit")
+	IncCacheLookup(labels, "miss")
+	IncCacheLookup(labels, "miss")
+
+	if got := testutil.ToFloat64(cacheLookups.WithLabelValues("acme", "test-cache", "miss")); got != 2 {
+		t.Errorf("cache misses = %v, want 2", got)
+	}
+}
+
 func TestNoOpWhenNotInitialized(_ *testing.T) {
 	// Create a new registry to isolate this test
 	// Don't reset the global once - it would break other tests
@@ -152,6 +196,11 @@ func TestNoOpWhenNotInitialized(_ *testing.T) {
 	IncRouterDecision(Labels{})
 	IncBudgetBreach("global")
 
+	oldShadow := shadowRequests
+	shadowRequests = nil
+	ObserveShadow(ShadowMeta{})
+	shadowRequests = oldShadow
+
 	// Restore state
 	infRequests = oldRequests
 }
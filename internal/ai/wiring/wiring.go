@@ -2,25 +2,54 @@ package wiring
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
 
+	"github.com/vertikon/mcp-ultra/internal/ai/budget"
+	aicache "github.com/vertikon/mcp-ultra/internal/ai/cache"
+	"github.com/vertikon/mcp-ultra/internal/ai/events"
+	"github.com/vertikon/mcp-ultra/internal/ai/guardrails"
+	"github.com/vertikon/mcp-ultra/internal/ai/inference"
+	"github.com/vertikon/mcp-ultra/internal/ai/provider"
 	"github.com/vertikon/mcp-ultra/internal/ai/router"
 	"github.com/vertikon/mcp-ultra/internal/ai/telemetry"
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+	"github.com/vertikon/mcp-ultra/pkg/redisx"
 )
 
 type Config struct {
 	BasePathAI string // path to templates/ai
 	Registry   prometheus.Registerer
+	Logger     *zap.Logger
+	// Publisher is optional; AI events are not published without it. Events
+	// are validated against nats-schemas and published on versioned subjects.
+	Publisher  events.EventPublisher
+	PIIManager *compliance.PIIManager // optional; guardrails build their own from ai-policies.yaml without it
+	Redis      *redisx.Client         // holds the budget ledger; required while budgets are enforced
+	// Cache holds cached inference answers, e.g. a cache.DistributedCache
+	// or an aicache.RedisStore; required while the cache flag is on
+	Cache aicache.Store
+	// ReloadInterval is how often feature_flags.json and the router rules
+	// are checked for changes; zero leaves reloading to SIGHUP
+	ReloadInterval time.Duration
 }
 
-// Service holds minimal IA singletons (router + telemetry enabled flag).
+// Service holds the IA singletons: router, providers, guardrails and the
+// inference pipeline over them.
 type Service struct {
-	Router  *router.Router
-	Enabled bool
+	Router     *router.Router
+	Providers  *provider.Registry
+	Guardrails *guardrails.Engine // nil while AI or both guardrail phases are off
+	Budget     *budget.Guard      // nil while AI is off or budgets are not enforced
+	Cache      *aicache.Cache     // nil while AI or the cache is off
+	Inference  *inference.Service
+	Watcher    *router.Watcher // reloads Router on file changes and SIGHUP
+	Enabled    bool            // the AI flag at Init; Router.Enabled follows reloads
 }
 
 func Init(ctx context.Context, cfg Config) (*Service, error) {
@@ -31,11 +60,156 @@ func Init(ctx context.Context, cfg Config) (*Service, error) {
 		base = filepath.Join(cwd, "templates", "ai")
 	}
 
-	r, _ := router.Load(base)
+	r, err := router.Load(base)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AI router: %w", err)
+	}
 	telemetry.Init(cfg.Registry)
 
-	svc := &Service{Router: r, Enabled: r != nil && r.Enabled()}
-	_ = ctx // reserved for future async init
-	time.AfterFunc(0, func() { /* noop */ })
+	logger := cfg.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	var opts []inference.Option
+	if cfg.Publisher != nil {
+		registry, err := events.LoadRegistry(filepath.Join(base, "nats-schemas"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load AI event schemas: %w", err)
+		}
+		cfg.Publisher = events.NewValidatingPublisher(cfg.Publisher, registry, logger)
+		opts = append(opts, inference.WithPublisher(cfg.Publisher))
+	}
+
+	// The local provider is always available, so the pipeline runs offline
+	providers := provider.NewRegistry(provider.NewLocal())
+
+	// Guardrails, budgets and the cache are built from the flags at Init;
+	// changing their flags takes a restart
+	engine, err := loadGuardrails(base, r, cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	if engine != nil {
+		opts = append(opts, inference.WithGuardrails(engine))
+	}
+	guard, err := loadBudget(base, r, cfg, logger)
+	if err != nil {
+		engine.Close()
+		return nil, err
+	}
+	if guard != nil {
+		opts = append(opts, inference.WithBudget(guard))
+	}
+	answers, err := loadCache(base, r, providers, cfg, logger)
+	if err != nil {
+		engine.Close()
+		return nil, err
+	}
+	if answers != nil {
+		opts = append(opts, inference.WithCache(answers))
+	}
+
+	watchOpts := []router.WatchOption{router.WithInterval(cfg.ReloadInterval), router.WithLogger(logger)}
+	if cfg.Publisher != nil {
+		watchOpts = append(watchOpts, router.WithPublisher(cfg.Publisher))
+	}
+	watcher := router.NewWatcher(r, watchOpts...)
+	watcher.Start(ctx)
+
+	svc := &Service{
+		Router:     r,
+		Providers:  providers,
+		Guardrails: engine,
+		Budget:     guard,
+		Cache:      answers,
+		Inference:  inference.NewService(r, providers, logger, opts...),
+		Watcher:    watcher,
+		Enabled:    r.Enabled(),
+	}
 	return svc, nil
 }
+
+// ReloadStatus reports the flags and router rules in use and the outcome
+// of the latest reload
+func (s *Service) ReloadStatus() router.ReloadStatus {
+	return s.Watcher.Status()
+}
+
+// Close stops the reload watcher and releases what the guardrails hold
+func (s *Service) Close() {
+	s.Watcher.Stop()
+	s.Guardrails.Close()
+}
+
+// loadGuardrails loads the guardrail phases the feature flags turn on.
+// Nothing is loaded while AI is disabled, since no request gets that far.
+func loadGuardrails(base string, r *router.Router, cfg Config, logger *zap.Logger) (*guardrails.Engine, error) {
+	flags := r.Flags().AI
+	if !flags.Enabled || (!flags.Guardrails.Pre && !flags.Guardrails.Post) {
+		return nil, nil
+	}
+
+	opts := []guardrails.Option{
+		guardrails.WithPhases(flags.Guardrails.Pre, flags.Guardrails.Post),
+		guardrails.WithLogger(logger),
+	}
+	if cfg.PIIManager != nil {
+		opts = append(opts, guardrails.WithPIIManager(cfg.PIIManager))
+	}
+	if cfg.Publisher != nil {
+		opts = append(opts, guardrails.WithPublisher(cfg.Publisher))
+	}
+	engine, err := guardrails.Load(filepath.Join(base, "config"), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AI guardrails: %w", err)
+	}
+	return engine, nil
+}
+
+// loadBudget loads ai-budgets.json when the feature flags enforce it
+func loadBudget(base string, r *router.Router, cfg Config, logger *zap.Logger) (*budget.Guard, error) {
+	flags := r.Flags().AI
+	if !flags.Enabled || !flags.Budgets.Enforce {
+		return nil, nil
+	}
+	if cfg.Redis == nil {
+		return nil, fmt.Errorf("AI budgets are enforced but no Redis client is configured")
+	}
+
+	budgets, err := budget.LoadBudgets(filepath.Join(base, "config", "ai-budgets.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AI budgets: %w", err)
+	}
+	location, err := budgets.Location()
+	if err != nil {
+		return nil, err
+	}
+	ledger := budget.NewLedger(cfg.Redis, budget.WithLocation(location))
+	return budget.NewGuard(budgets, ledger, logger, budget.WithHardStop(flags.Budgets.HardStopOnBreach)), nil
+}
+
+// loadCache loads ai-cache.json when the feature flags turn the cache on.
+// Semantic mode embeds prompts with a registered provider.
+func loadCache(base string, r *router.Router, providers *provider.Registry, cfg Config, logger *zap.Logger) (*aicache.Cache, error) {
+	flags := r.Flags().AI
+	if !flags.Enabled || !flags.Cache.Enabled {
+		return nil, nil
+	}
+	if cfg.Cache == nil {
+		return nil, fmt.Errorf("AI cache is enabled but no cache store is configured")
+	}
+
+	config, err := aicache.LoadConfig(filepath.Join(base, "config", "ai-cache.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AI cache: %w", err)
+	}
+	opts := []aicache.Option{aicache.WithLogger(logger)}
+	if config.Semantic.Enabled {
+		embedder, ok := providers.Get(config.Semantic.Provider)
+		if !ok {
+			return nil, fmt.Errorf("AI cache: semantic provider %s is not registered", config.Semantic.Provider)
+		}
+		opts = append(opts, aicache.WithEmbedder(embedder))
+	}
+	return aicache.New(config, cfg.Cache, opts...)
+}
@@ -5,8 +5,11 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/vertikon/mcp-ultra/internal/ai/router"
 )
 
 func TestInit_AIDisabled(t *testing.T) {
@@ -100,7 +103,7 @@ func TestInit_AIEnabled(t *testing.T) {
 	}
 
 	// Test router decision
-	dec, err := svc.Router.Decide("generation")
+	dec, err := svc.Router.Decide(router.Request{UseCase: "generation"})
 	if err != nil {
 		t.Fatalf("Decide failed: %v", err)
 	}
@@ -139,3 +142,112 @@ func TestInit_MissingConfig(t *testing.T) {
 		t.Error("AI should be disabled when config is missing")
 	}
 }
+
+func TestInit_InvalidFlags(t *testing.T) {
+	aiDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(aiDir, "feature_flags.json"), []byte(`{"ai":{"enabled":"yes"}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Init(context.Background(), Config{BasePathAI: aiDir, Registry: prometheus.NewRegistry()}); err == nil {
+		t.Error("Init must reject flags that break their schema")
+	}
+}
+
+func TestInit_ReloadStatus(t *testing.T) {
+	aiDir := t.TempDir()
+	flagsPath := filepath.Join(aiDir, "feature_flags.json")
+	if err := os.WriteFile(flagsPath, []byte(`{"ai":{"enabled":false}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	svc, err := Init(context.Background(), Config{BasePathAI: aiDir, Registry: prometheus.NewRegistry()})
+	if err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	defer svc.Close()
+
+	initial := svc.ReloadStatus()
+	if initial.Checksum == "" || initial.Reloads != 0 {
+		t.Errorf("unexpected initial status %+v", initial)
+	}
+
+	if err := os.WriteFile(flagsPath, []byte(`{"ai":{"enabled":true}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.Watcher.Reload(context.Background(), router.TriggerSignal); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if status := svc.ReloadStatus(); status.Reloads != 1 || status.Checksum == initial.Checksum || !svc.Router.Enabled() {
+		t.Errorf("unexpected status after reload %+v", status)
+	}
+}
+
+// nullStore never has an answer
+type nullStore struct{}
+
+func (nullStore) Get(context.Context, string) (interface{}, bool, error) { return nil, false, nil }
+
+func (nullStore) Set(context.Context, string, interface{}, time.Duration) error { return nil }
+
+func TestInit_Cache(t *testing.T) {
+	aiDir := t.TempDir()
+	configDir := filepath.Join(aiDir, "config")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	flags := `{"ai":{"enabled":true,"budgets":{"enforce":false},"cache":{"enabled":true}}}`
+	if err := os.WriteFile(filepath.Join(aiDir, "feature_flags.json"), []byte(flags), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cacheConfig := `{"ttl_seconds": 60, "semantic": {"enabled": true, "provider": "local", "threshold": 0.9, "max_entries": 10}}`
+	if err := os.WriteFile(filepath.Join(configDir, "ai-cache.json"), []byte(cacheConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Init(context.Background(), Config{BasePathAI: aiDir, Registry: prometheus.NewRegistry()}); err == nil {
+		t.Error("Init must fail when the cache is on without a store")
+	}
+
+	svc, err := Init(context.Background(), Config{BasePathAI: aiDir, Registry: prometheus.NewRegistry(), Cache: nullStore{}})
+	if err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	defer svc.Close()
+	if svc.Cache == nil {
+		t.Error("the cache flag must build the cache")
+	}
+}
+
+// recordingPublisher keeps the subjects it publishes on
+type recordingPublisher struct {
+	subjects []string
+}
+
+func (p *recordingPublisher) PublishWithRetry(_ context.Context, subject string, _ []byte) error {
+	p.subjects = append(p.subjects, subject)
+	return nil
+}
+
+func TestInit_EventSchemas(t *testing.T) {
+	aiDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(aiDir, "feature_flags.json"), []byte(`{"ai":{"enabled":false}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	publisher := &recordingPublisher{}
+	if _, err := Init(context.Background(), Config{BasePathAI: aiDir, Registry: prometheus.NewRegistry(), Publisher: publisher}); err == nil {
+		t.Fatal("Init must fail when a publisher has no event schemas")
+	}
+
+	svc, err := Init(context.Background(), Config{BasePathAI: "../../../templates/ai", Registry: prometheus.NewRegistry(), Publisher: publisher})
+	if err != nil {
+		t.Fatalf("Init failed: %v", err)
+	}
+	defer svc.Close()
+	if err := svc.Watcher.Reload(context.Background(), router.TriggerSignal); err != nil {
+		t.Fatal(err)
+	}
+	if len(publisher.subjects) != 1 || publisher.subjects[0] != "ultra.ai.config.reload.v1" {
+		t.Errorf("published on %v", publisher.subjects)
+	}
+}
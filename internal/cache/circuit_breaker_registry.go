@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// NamedCircuitBreaker is a circuit breaker registered under a name
+type NamedCircuitBreaker struct {
+	Name    string
+	Service string
+	Breaker *CircuitBreaker
+}
+
+// CircuitBreakerRegistry keeps the process's circuit breakers by name so
+// they can be inspected and operated at runtime
+type CircuitBreakerRegistry struct {
+	mu       sync.RWMutex
+	breakers map[string]NamedCircuitBreaker
+}
+
+// NewCircuitBreakerRegistry creates an empty registry
+func NewCircuitBreakerRegistry() *CircuitBreakerRegistry {
+	return &CircuitBreakerRegistry{
+		breakers: make(map[string]NamedCircuitBreaker),
+	}
+}
+
+// Register adds a circuit breaker. service names the dependency it guards.
+func (r *CircuitBreakerRegistry) Register(name, service string, breaker *CircuitBreaker) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.breakers[name]; exists {
+		return fmt.Errorf("circuit breaker %q already registered", name)
+	}
+	r.breakers[name] = NamedCircuitBreaker{Name: name, Service: service, Breaker: breaker}
+	return nil
+}
+
+// Get returns the circuit breaker registered under name
+func (r *CircuitBreakerRegistry) Get(name string) (*CircuitBreaker, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	nb, ok := r.breakers[name]
+	return nb.Breaker, ok
+}
+
+// List returns the registered circuit breakers sorted by name
+func (r *CircuitBreakerRegistry) List() []NamedCircuitBreaker {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]NamedCircuitBreaker, 0, len(r.breakers))
+	for _, nb := range r.breakers {
+		list = append(list, nb)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
@@ -303,3 +303,24 @@ func TestAdaptiveCircuitBreaker_RecordRequest(t *testing.T) {
 	assert.Greater(t, rate, 0.0, "Failure rate should be greater than 0")
 	assert.Less(t, rate, 1.0, "Failure rate should be less than 1")
 }
+
+func TestCircuitBreakerRegistry(t *testing.T) {
+	registry := NewCircuitBreakerRegistry()
+	redis := NewCircuitBreaker(3, time.Second, 1)
+
+	assert.NoError(t, registry.Register("redis", "cache", redis))
+	assert.NoError(t, registry.Register("nats", "events", NewCircuitBreaker(3, time.Second, 1)))
+	assert.Error(t, registry.Register("redis", "cache", redis))
+
+	got, ok := registry.Get("redis")
+	assert.True(t, ok)
+	assert.Same(t, redis, got)
+
+	_, ok = registry.Get("missing")
+	assert.False(t, ok)
+
+	list := registry.List()
+	assert.Len(t, list, 2)
+	assert.Equal(t, "nats", list[0].Name)
+	assert.Equal(t, "cache", list[1].Service)
+}
@@ -496,6 +496,11 @@ func (dc *DistributedCache) Clear(ctx context.Context, pattern string) error {
 	return nil
 }
 
+// Breaker returns the circuit breaker guarding the cluster
+func (dc *DistributedCache) Breaker() *CircuitBreaker {
+	return dc.breaker
+}
+
 // GetStats returns cache performance statistics
 func (dc *DistributedCache) GetStats() Stats {
 	dc.mu.RLock()
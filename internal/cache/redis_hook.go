@@ -0,0 +1,62 @@
+package cache
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrCircuitOpen is returned for calls refused by an open circuit breaker
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// RedisHook returns a go-redis hook guarding a client with cb. While cb is
+// open, commands fail with ErrCircuitOpen without reaching Redis. Replies
+// from the server, including missing keys, count as successes: only
+// failing to reach it counts against the breaker.
+func (cb *CircuitBreaker) RedisHook() redis.Hook {
+	return breakerHook{breaker: cb}
+}
+
+type breakerHook struct {
+	breaker *CircuitBreaker
+}
+
+func (h breakerHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (h breakerHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if !h.breaker.Allow() {
+			cmd.SetErr(ErrCircuitOpen)
+			return ErrCircuitOpen
+		}
+		err := next(ctx, cmd)
+		h.record(err)
+		return err
+	}
+}
+
+func (h breakerHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if !h.breaker.Allow() {
+			for _, cmd := range cmds {
+				cmd.SetErr(ErrCircuitOpen)
+			}
+			return ErrCircuitOpen
+		}
+		err := next(ctx, cmds)
+		h.record(err)
+		return err
+	}
+}
+
+func (h breakerHook) record(err error) {
+	var reply redis.Error
+	if err == nil || errors.As(err, &reply) {
+		h.breaker.RecordSuccess()
+		return
+	}
+	h.breaker.RecordFailure()
+}
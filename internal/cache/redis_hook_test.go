@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker_RedisHook(t *testing.T) {
+	server := miniredis.RunT(t)
+	cb := NewCircuitBreaker(2, time.Minute, 1)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	client.AddHook(cb.RedisHook())
+	ctx := context.Background()
+
+	// Missing keys and error replies mean Redis is up
+	assert.ErrorIs(t, client.Get(ctx, "missing").Err(), redis.Nil)
+	require.NoError(t, client.Set(ctx, "k", "v", 0).Err())
+	assert.Error(t, client.Incr(ctx, "k").Err())
+	assert.Equal(t, CircuitBreakerClosed, cb.State())
+
+	server.Close()
+	for i := 0; i < 2; i++ {
+		assert.Error(t, client.Get(ctx, "k").Err())
+	}
+	assert.Equal(t, CircuitBreakerOpen, cb.State())
+	assert.ErrorIs(t, client.Get(ctx, "k").Err(), ErrCircuitOpen)
+
+	_, err := client.Pipelined(ctx, func(p redis.Pipeliner) error {
+		p.Get(ctx, "k")
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+}
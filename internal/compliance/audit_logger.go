@@ -21,6 +21,7 @@ type AuditLogger struct {
 	logger        *zap.Logger
 	auditLogger   *zap.Logger
 	encryptionKey []byte
+	store         AuditStore
 }
 
 // AuditEvent represents an audit event
@@ -182,7 +183,7 @@ func (al *AuditLogger) LogDataProcessing(ctx context.Context, subjectID, purpose
 		}
 	}
 
-	return al.logEvent(event)
+	return al.logEvent(ctx, event)
 }
 
 // LogConsentAction logs consent-related actions
@@ -215,7 +216,7 @@ func (al *AuditLogger) LogConsentAction(ctx context.Context, subjectID string, c
 	}
 
 	al.extractContextInfo(ctx, &event)
-	return al.logEvent(event)
+	return al.logEvent(ctx, event)
 }
 
 // LogConsent is a convenience method to log consent actions with minimal parameters
@@ -266,7 +267,93 @@ func (al *AuditLogger) LogDataRightsRequest(ctx context.Context, subjectID strin
 	}
 
 	al.extractContextInfo(ctx, &event)
-	return al.logEvent(event)
+	return al.logEvent(ctx, event)
+}
+
+// LogDataRightsFulfilment logs what the data sources did for a data rights
+// request. eventType is the data_access, data_export, data_delete or
+// data_rectify event matching the request.
+func (al *AuditLogger) LogDataRightsFulfilment(ctx context.Context, request DataRightRequest, eventType AuditEventType) error {
+	if !al.config.Enabled {
+		return nil
+	}
+
+	result := AuditResultSuccess
+	if failed := len(request.FailedSources()); failed > 0 {
+		result = AuditResultPartialSuccess
+		if failed == len(request.Sources) {
+			result = AuditResultFailure
+		}
+	}
+
+	sources := make(map[string]interface{}, len(request.Sources))
+	for _, source := range request.Sources {
+		sources[source.Source] = map[string]interface{}{
+			"status":  source.Status,
+			"records": source.Records,
+		}
+	}
+
+	event := AuditEvent{
+		ID:        al.generateEventID(),
+		Timestamp: time.Now(),
+		EventType: eventType,
+		SubjectID: request.SubjectID,
+		Result:    result,
+		Details: map[string]interface{}{
+			"request_id":   request.ID,
+			"request_type": request.Type,
+			"sources":      sources,
+		},
+		Service: "mcp-ultra",
+		Version: "1.0.0",
+	}
+
+	al.extractContextInfo(ctx, &event)
+	return al.logEvent(ctx, event)
+}
+
+// LogRetentionEnforcement logs what a retention action did to a record's
+// data. result is blocked when a legal hold stopped the action; reason
+// holds the hold reason or the action's error.
+func (al *AuditLogger) LogRetentionEnforcement(ctx context.Context, record RetentionRecord, results []DataSourceResult, result AuditResult, reason string) error {
+	if !al.config.Enabled {
+		return nil
+	}
+
+	targets := make(map[string]interface{}, len(results))
+	for _, target := range results {
+		targets[target.Source] = map[string]interface{}{
+			"status":  target.Status,
+			"records": target.Records,
+		}
+	}
+
+	details := map[string]interface{}{
+		"record_id": record.ID,
+		"policy_id": record.PolicyID,
+		"action":    record.Action,
+		"targets":   targets,
+	}
+	if reason != "" {
+		details["reason"] = reason
+	}
+
+	event := AuditEvent{
+		ID:             al.generateEventID(),
+		Timestamp:      time.Now(),
+		EventType:      AuditEventRetentionPolicy,
+		SubjectID:      record.SubjectID,
+		DataCategories: []string{record.DataType},
+		ProcessingType: string(record.Action),
+		Result:         result,
+		Details:        details,
+		Service:        "mcp-ultra",
+		Version:        "1.0.0",
+	}
+
+	al.extractContextInfo(ctx, &event)
+	return al.logEvent(ctx, event)
 }
 
 // LogPIIDetection logs PII detection events
@@ -291,7 +378,7 @@ func (al *AuditLogger) LogPIIDetection(ctx context.Context, subjectID string, cl
 	}
 
 	al.extractContextInfo(ctx, &event)
-	return al.logEvent(event)
+	return al.logEvent(ctx, event)
 }
 
 // LogSecurityIncident logs security incidents related to compliance
@@ -316,7 +403,7 @@ func (al *AuditLogger) LogSecurityIncident(ctx context.Context, incidentType, de
 	}
 
 	al.extractContextInfo(ctx, &event)
-	return al.logEvent(event)
+	return al.logEvent(ctx, event)
 }
 
 // LogComplianceCheck logs compliance validation results
@@ -349,22 +436,38 @@ func (al *AuditLogger) LogComplianceCheck(ctx context.Context, checkType string,
 	}
 
 	al.extractContextInfo(ctx, &event)
-	return al.logEvent(event)
+	return al.logEvent(ctx, event)
 }
 
-// QueryAuditLogs queries audit logs (simplified implementation)
-func (al *AuditLogger) QueryAuditLogs(_ context.Context, _ map[string]interface{}, _ int) ([]AuditEvent, error) {
+// QueryAuditLogs returns one page of persisted audit events in
+// chronological order. Without an audit store nothing is persisted and the
+// result is always empty.
+func (al *AuditLogger) QueryAuditLogs(ctx context.Context, filter AuditFilter) ([]AuditEvent, error) {
 	if !al.config.Enabled {
 		return nil, fmt.Errorf("audit logging is disabled")
 	}
+	if al.store == nil {
+		return []AuditEvent{}, nil
+	}
 
-	// In a real implementation, this would query from a persistent audit store
-	// For now, return empty results
-	return []AuditEvent{}, nil
+	events, err := al.store.Query(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit store: %w", err)
+	}
+	return events, nil
 }
 
-// logEvent writes an audit event to the audit log
-func (al *AuditLogger) logEvent(event AuditEvent) error {
+// VerifyAuditLog checks the hash chain of the audit store. See
+// VerifyAuditChain for anchor.
+func (al *AuditLogger) VerifyAuditLog(ctx context.Context, anchor string) (*AuditVerification, error) {
+	if al.store == nil {
+		return nil, fmt.Errorf("audit store is not configured")
+	}
+	return VerifyAuditChain(ctx, al.store, anchor)
+}
+
+// logEvent writes an audit event to the audit log, persisting it with ctx
+func (al *AuditLogger) logEvent(ctx context.Context, event AuditEvent) error {
 	// Encrypt sensitive details if encryption is enabled
 	if al.config.EncryptionEnabled && al.encryptionKey != nil {
 		if err := al.encryptSensitiveData(&event); err != nil {
@@ -380,6 +483,12 @@ func (al *AuditLogger) logEvent(event AuditEvent) error {
 		return fmt.Errorf("failed to marshal audit event: %w", err)
 	}
 
+	if al.store != nil {
+		if _, err := al.store.Append(ctx, event); err != nil {
+			return fmt.Errorf("failed to persist audit event: %w", err)
+		}
+	}
+
 	// Log the event
 	switch al.config.DetailLevel {
 	case "minimal":
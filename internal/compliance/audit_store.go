@@ -0,0 +1,320 @@
+package compliance
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+)
+
+// Audit store kinds selectable with AuditLoggingConfig.Store
+const (
+	AuditStoreNone     = "none"
+	AuditStoreFile     = "file"
+	AuditStorePostgres = "postgres"
+)
+
+// GenesisHash is the previous hash of the first record in an audit chain
+const GenesisHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// ErrAuditChainBroken is returned when an audit chain fails verification
+var ErrAuditChainBroken = errors.New("audit chain broken")
+
+// AuditRecord is an audit event as persisted. Each record hashes the one
+// before it, so deleting, reordering or editing a record breaks the chain.
+type AuditRecord struct {
+	Sequence     int64           `json:"sequence"`
+	PreviousHash string          `json:"previous_hash"`
+	Hash         string          `json:"hash"`
+	Event        json.RawMessage `json:"event"`
+}
+
+// Decode returns the stored audit event
+func (r AuditRecord) Decode() (AuditEvent, error) {
+	var event AuditEvent
+	if err := json.Unmarshal(r.Event, &event); err != nil {
+		return AuditEvent{}, fmt.Errorf("decoding audit record %d: %w", r.Sequence, err)
+	}
+	return event, nil
+}
+
+// ComputeHash returns the chain hash of the record: SHA-256 over the
+// previous hash, the sequence number and the event exactly as stored
+func (r AuditRecord) ComputeHash() string {
+	h := sha256.New()
+	h.Write([]byte(r.PreviousHash))
+	h.Write([]byte{'\n'})
+	h.Write([]byte(strconv.FormatInt(r.Sequence, 10)))
+	h.Write([]byte{'\n'})
+	h.Write(r.Event)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// AuditStore persists audit events as a hash chain
+type AuditStore interface {
+	// Append adds an event to the end of the chain
+	Append(ctx context.Context, event AuditEvent) (AuditRecord, error)
+	// Query returns one page of matching events in chronological order
+	Query(ctx context.Context, filter AuditFilter) ([]AuditEvent, error)
+	// Scan calls fn for every record in sequence order
+	Scan(ctx context.Context, fn func(AuditRecord) error) error
+	Close() error
+}
+
+// Matches reports whether event satisfies the filter. Action is matched
+// against the event's processing type.
+func (f AuditFilter) Matches(event AuditEvent) bool {
+	return (f.SubjectID == "" || f.SubjectID == event.SubjectID) &&
+		(f.EventType == "" || f.EventType == string(event.EventType)) &&
+		(f.Action == "" || f.Action == event.ProcessingType) &&
+		(f.StartTime.IsZero() || !event.Timestamp.Before(f.StartTime)) &&
+		(f.EndTime.IsZero() || event.Timestamp.Before(f.EndTime))
+}
+
+// AuditVerification is the result of checking an audit chain
+type AuditVerification struct {
+	Records int64 `json:"records"`
+	// Head is the hash of the last record. Keep it outside the store:
+	// comparing it on the next verification detects truncation.
+	Head string `json:"head"`
+	// BrokenAt is the sequence number of the first bad record, 0 when the
+	// chain is intact
+	BrokenAt int64  `json:"broken_at,omitempty"`
+	Problem  string `json:"problem,omitempty"`
+}
+
+// Valid reports whether the chain verified
+func (v AuditVerification) Valid() bool {
+	return v.BrokenAt == 0
+}
+
+// VerifyAuditChain walks the whole chain and reports the first record that
+// was removed, reordered or modified. anchor, when set, is a head recorded
+// by an earlier verification; the chain must still contain it, which
+// detects truncation and wholesale rewrites. The error wraps
+// ErrAuditChainBroken when the chain is broken.
+func VerifyAuditChain(ctx context.Context, store AuditStore, anchor string) (*AuditVerification, error) {
+	result := &AuditVerification{Head: GenesisHash}
+	anchorFound := anchor == "" || anchor == GenesisHash
+
+	err := store.Scan(ctx, func(record AuditRecord) error {
+		expected := result.Records + 1
+		var problem string
+		switch {
+		case record.Sequence != expected:
+			problem = fmt.Sprintf("expected sequence %d, found %d", expected, record.Sequence)
+		case record.PreviousHash != result.Head:
+			problem = "previous hash does not match the preceding record"
+		case record.ComputeHash() != record.Hash:
+			problem = "record hash does not match its contents"
+		}
+		if problem != "" {
+			result.BrokenAt = expected
+			result.Problem = problem
+			return fmt.Errorf("%w at record %d: %s", ErrAuditChainBroken, expected, problem)
+		}
+
+		result.Records++
+		result.Head = record.Hash
+		anchorFound = anchorFound || record.Hash == anchor
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, ErrAuditChainBroken) && result.BrokenAt == 0 {
+			// The store could not even read the record
+			result.BrokenAt = result.Records + 1
+			result.Problem = err.Error()
+		}
+		return result, err
+	}
+
+	if !anchorFound {
+		result.BrokenAt = result.Records + 1
+		result.Problem = "anchor hash not found; records were removed from the end or the chain was rewritten"
+		return result, fmt.Errorf("%w: %s", ErrAuditChainBroken, result.Problem)
+	}
+	return result, nil
+}
+
+// nextAuditRecord chains event after the record with sequence and hash
+func nextAuditRecord(event AuditEvent, sequence int64, previousHash string) (AuditRecord, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return AuditRecord{}, fmt.Errorf("failed to marshal audit event: %w", err)
+	}
+	record := AuditRecord{
+		Sequence:     sequence + 1,
+		PreviousHash: previousHash,
+		Event:        payload,
+	}
+	record.Hash = record.ComputeHash()
+	return record, nil
+}
+
+// FileAuditStore keeps the audit chain in a local append-only file, one
+// JSON record per line
+type FileAuditStore struct {
+	mu       sync.Mutex
+	path     string
+	file     *os.File
+	sequence int64
+	head     string
+}
+
+// NewFileAuditStore creates a store over the audit file at path. Nothing is
+// created until the first append, so the store can also be used to verify
+// a log read-only.
+func NewFileAuditStore(path string) (*FileAuditStore, error) {
+	if path == "" {
+		return nil, fmt.Errorf("audit file path is required")
+	}
+	return &FileAuditStore{path: path, head: GenesisHash}, nil
+}
+
+// Append writes the event and syncs the file before returning
+func (s *FileAuditStore) Append(ctx context.Context, event AuditEvent) (AuditRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.open(ctx); err != nil {
+		return AuditRecord{}, err
+	}
+
+	record, err := nextAuditRecord(event, s.sequence, s.head)
+	if err != nil {
+		return AuditRecord{}, err
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return AuditRecord{}, fmt.Errorf("failed to marshal audit record: %w", err)
+	}
+
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return AuditRecord{}, fmt.Errorf("writing audit record: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return AuditRecord{}, fmt.Errorf("syncing audit file: %w", err)
+	}
+
+	s.sequence = record.Sequence
+	s.head = record.Hash
+	return record, nil
+}
+
+// open reads the existing chain to find where to continue and opens the
+// file for appending. An unreadable chain is not extended.
+func (s *FileAuditStore) open(ctx context.Context) error {
+	if s.file != nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o750); err != nil {
+		return fmt.Errorf("creating audit directory: %w", err)
+	}
+
+	err := s.scan(ctx, func(record AuditRecord) error {
+		s.sequence = record.Sequence
+		s.head = record.Hash
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening audit file: %w", err)
+	}
+	s.file = file
+	return nil
+}
+
+// Query reads the file and returns one page of matching events
+func (s *FileAuditStore) Query(ctx context.Context, filter AuditFilter) ([]AuditEvent, error) {
+	events := make([]AuditEvent, 0)
+	skipped := 0
+	errPageFull := errors.New("page full")
+
+	err := s.Scan(ctx, func(record AuditRecord) error {
+		event, err := record.Decode()
+		if err != nil {
+			return err
+		}
+		if !filter.Matches(event) {
+			return nil
+		}
+		if skipped < filter.Offset {
+			skipped++
+			return nil
+		}
+		events = append(events, event)
+		if filter.Limit > 0 && len(events) >= filter.Limit {
+			return errPageFull
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errPageFull) {
+		return nil, err
+	}
+	return events, nil
+}
+
+// Scan reads the file from the start. Appends wait until it returns.
+func (s *FileAuditStore) Scan(ctx context.Context, fn func(AuditRecord) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.scan(ctx, fn)
+}
+
+func (s *FileAuditStore) scan(ctx context.Context, fn func(AuditRecord) error) error {
+	file, err := os.Open(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("opening audit file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	reader := bufio.NewReader(file)
+	for line := 1; ; line++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		data, err := reader.ReadBytes('\n')
+		if len(data) > 0 {
+			var record AuditRecord
+			if jsonErr := json.Unmarshal(data, &record); jsonErr != nil {
+				return fmt.Errorf("%w: line %d is not an audit record: %v", ErrAuditChainBroken, line, jsonErr)
+			}
+			if fnErr := fn(record); fnErr != nil {
+				return fnErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading audit file: %w", err)
+		}
+	}
+}
+
+// Close closes the file
+func (s *FileAuditStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
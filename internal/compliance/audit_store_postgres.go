@@ -0,0 +1,156 @@
+package compliance
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// auditChainLockID serializes appends to the audit chain across instances
+const auditChainLockID = 0x61756469 // "audi"
+
+// PostgresAuditStore keeps the audit chain in the compliance_audit_log
+// table (migration 004_compliance_audit_log). The table rejects
+// updates and deletes; the hash chain exposes changes made around that.
+type PostgresAuditStore struct {
+	db *sql.DB
+}
+
+// NewPostgresAuditStore creates an audit store over db
+func NewPostgresAuditStore(db *sql.DB) *PostgresAuditStore {
+	return &PostgresAuditStore{db: db}
+}
+
+// Append chains the event after the current head. An advisory lock keeps
+// concurrent writers from forking the chain.
+func (s *PostgresAuditStore) Append(ctx context.Context, event AuditEvent) (AuditRecord, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return AuditRecord{}, fmt.Errorf("beginning audit transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, auditChainLockID); err != nil {
+		return AuditRecord{}, fmt.Errorf("locking audit chain: %w", err)
+	}
+
+	sequence, head := int64(0), GenesisHash
+	err = tx.QueryRowContext(ctx,
+		`SELECT sequence, hash FROM compliance_audit_log ORDER BY sequence DESC LIMIT 1`,
+	).Scan(&sequence, &head)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return AuditRecord{}, fmt.Errorf("reading audit chain head: %w", err)
+	}
+
+	record, err := nextAuditRecord(event, sequence, head)
+	if err != nil {
+		return AuditRecord{}, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO compliance_audit_log
+			(sequence, event_id, event_type, subject_id, occurred_at, event, previous_hash, hash)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, record.Sequence, event.ID, string(event.EventType), event.SubjectID, event.Timestamp,
+		string(record.Event), record.PreviousHash, record.Hash)
+	if err != nil {
+		return AuditRecord{}, fmt.Errorf("inserting audit record: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return AuditRecord{}, fmt.Errorf("committing audit record: %w", err)
+	}
+	return record, nil
+}
+
+// Query returns one page of matching events in chronological order
+func (s *PostgresAuditStore) Query(ctx context.Context, filter AuditFilter) ([]AuditEvent, error) {
+	var conditions []string
+	var args []interface{}
+	where := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.SubjectID != "" {
+		where("subject_id = $%d", filter.SubjectID)
+	}
+	if filter.EventType != "" {
+		where("event_type = $%d", filter.EventType)
+	}
+	if filter.Action != "" {
+		where("event::jsonb->>'processing_type' = $%d", filter.Action)
+	}
+	if !filter.StartTime.IsZero() {
+		where("occurred_at >= $%d", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		where("occurred_at < $%d", filter.EndTime)
+	}
+
+	query := `SELECT sequence, previous_hash, hash, event FROM compliance_audit_log`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY sequence"
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	if filter.Offset > 0 {
+		args = append(args, filter.Offset)
+		query += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+
+	events := make([]AuditEvent, 0)
+	err := s.scanRows(ctx, query, args, func(record AuditRecord) error {
+		event, err := record.Decode()
+		if err != nil {
+			return err
+		}
+		events = append(events, event)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// Scan streams every record in sequence order
+func (s *PostgresAuditStore) Scan(ctx context.Context, fn func(AuditRecord) error) error {
+	return s.scanRows(ctx,
+		`SELECT sequence, previous_hash, hash, event FROM compliance_audit_log ORDER BY sequence`,
+		nil, fn)
+}
+
+// Close is a no-op; the database handle belongs to the caller
+func (s *PostgresAuditStore) Close() error {
+	return nil
+}
+
+func (s *PostgresAuditStore) scanRows(ctx context.Context, query string, args []interface{}, fn func(AuditRecord) error) error {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("querying audit log: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var record AuditRecord
+		var event string
+		if err := rows.Scan(&record.Sequence, &record.PreviousHash, &record.Hash, &event); err != nil {
+			return fmt.Errorf("scanning audit record: %w", err)
+		}
+		record.Event = []byte(event)
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterating audit log: %w", err)
+	}
+	return nil
+}
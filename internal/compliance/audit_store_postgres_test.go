@@ -0,0 +1,129 @@
+//go:build integration
+// +build integration
+
+package compliance
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"go.uber.org/zap/zaptest"
+)
+
+func newTestPostgresDB(t *testing.T) *sql.DB {
+	t.Helper()
+	ctx := context.Background()
+
+	container, err := postgres.Run(ctx, "postgres:16-alpine",
+		postgres.WithDatabase("test_mcp_ultra"),
+		postgres.WithInitScripts(
+			"../repository/postgres/migrations/004_compliance_audit_log.up.sql",
+			"../repository/postgres/migrations/005_compliance_consent_retention.up.sql",
+			"../repository/postgres/migrations/007_compliance_data_rights.up.sql",
+		),
+		postgres.BasicWaitStrategies(),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+	dsn, err := container.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	db, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func TestPostgresAuditStore(t *testing.T) {
+	db := newTestPostgresDB(t)
+	store := NewPostgresAuditStore(db)
+	ctx := context.Background()
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	appendTestEvents(t, store, base)
+
+	events, err := store.Query(ctx, AuditFilter{SubjectID: "subject-a"})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, "event-0", events[0].ID)
+
+	events, err = store.Query(ctx, AuditFilter{StartTime: base.Add(time.Minute), EndTime: base.Add(3 * time.Minute)})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	page, err := store.Query(ctx, AuditFilter{EventType: string(AuditEventDataProcessing), Limit: 2, Offset: 2})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, "event-2", page[0].ID)
+
+	result, err := VerifyAuditChain(ctx, store, "")
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, result.Records)
+
+	_, err = db.ExecContext(ctx, `DELETE FROM compliance_audit_log WHERE sequence = 2`)
+	assert.Error(t, err, "the table is append-only")
+
+	// Tampering around the trigger is still detected
+	_, err = db.ExecContext(ctx, `ALTER TABLE compliance_audit_log DISABLE TRIGGER compliance_audit_log_no_update`)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, `UPDATE compliance_audit_log SET event = replace(event, 'subject-b', 'subject-x') WHERE sequence = 2`)
+	require.NoError(t, err)
+
+	result, err = VerifyAuditChain(ctx, store, "")
+	assert.ErrorIs(t, err, ErrAuditChainBroken)
+	assert.EqualValues(t, 2, result.BrokenAt)
+}
+
+func TestPostgresConsentRepository(t *testing.T) {
+	testConsentRepository(t, NewPostgresConsentRepository(newTestPostgresDB(t)))
+}
+
+func TestPostgresRetentionRepository(t *testing.T) {
+	testRetentionRepository(t, NewPostgresRetentionRepository(newTestPostgresDB(t)))
+}
+
+func TestPostgresDataRightRepository(t *testing.T) {
+	testDataRightRepository(t, NewPostgresDataRightRepository(newTestPostgresDB(t)))
+}
+
+func TestPostgresConsentRepository_SurvivesRestart(t *testing.T) {
+	db := newTestPostgresDB(t)
+	config := Config{Enabled: true, Consent: ConsentConfig{Enabled: true, Store: RepositoryPostgres, TTL: time.Hour}}
+	ctx := context.Background()
+
+	first, err := NewFramework(config, zaptest.NewLogger(t), WithDatabase(db))
+	require.NoError(t, err)
+	require.NoError(t, first.GetConsentManager().RecordConsent(ctx, "subject-1", "analytics", "web"))
+
+	second, err := NewFramework(config, zaptest.NewLogger(t), WithDatabase(db))
+	require.NoError(t, err)
+	valid, err := second.GetConsentManager().HasValidConsent(ctx, "subject-1", "analytics")
+	require.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestPostgresAuditStore_ConcurrentAppends(t *testing.T) {
+	store := NewPostgresAuditStore(newTestPostgresDB(t))
+	ctx := context.Background()
+
+	errs := make(chan error, 20)
+	for i := 0; i < cap(errs); i++ {
+		go func(i int) {
+			_, err := store.Append(ctx, AuditEvent{ID: fmt.Sprintf("event-%d", i), Timestamp: time.Now(), EventType: AuditEventComplianceCheck})
+			errs <- err
+		}(i)
+	}
+	for i := 0; i < cap(errs); i++ {
+		require.NoError(t, <-errs)
+	}
+
+	result, err := VerifyAuditChain(ctx, store, "")
+	require.NoError(t, err)
+	assert.EqualValues(t, cap(errs), result.Records)
+}
@@ -0,0 +1,279 @@
+package compliance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func newTestFileAuditStore(t *testing.T) (*FileAuditStore, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	store, err := NewFileAuditStore(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = store.Close() })
+	return store, path
+}
+
+func appendTestEvents(t *testing.T, store AuditStore, base time.Time) {
+	t.Helper()
+	for i := 0; i < 5; i++ {
+		subject := "subject-a"
+		if i%2 == 1 {
+			subject = "subject-b"
+		}
+		eventType := AuditEventDataProcessing
+		if i == 4 {
+			eventType = AuditEventDataDelete
+		}
+		_, err := store.Append(context.Background(), AuditEvent{
+			ID:        fmt.Sprintf("event-%d", i),
+			Timestamp: base.Add(time.Duration(i) * time.Minute),
+			EventType: eventType,
+			SubjectID: subject,
+			Result:    AuditResultSuccess,
+			Details:   map[string]interface{}{"index": i},
+		})
+		require.NoError(t, err)
+	}
+}
+
+// contextAuditStore records the context each event is appended with
+type contextAuditStore struct {
+	AuditStore
+	contexts []context.Context
+}
+
+func (s *contextAuditStore) Append(ctx context.Context, event AuditEvent) (AuditRecord, error) {
+	s.contexts = append(s.contexts, ctx)
+	return s.AuditStore.Append(ctx, event)
+}
+
+func rewriteAuditFile(t *testing.T, path string, edit func(lines []string) []string) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	lines = edit(lines)
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o600))
+}
+
+func TestFileAuditStore_Query(t *testing.T) {
+	store, _ := newTestFileAuditStore(t)
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	appendTestEvents(t, store, base)
+	ctx := context.Background()
+
+	events, err := store.Query(ctx, AuditFilter{SubjectID: "subject-a"})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, "event-0", events[0].ID, "chronological order")
+
+	events, err = store.Query(ctx, AuditFilter{EventType: string(AuditEventDataDelete)})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "event-4", events[0].ID)
+
+	events, err = store.Query(ctx, AuditFilter{StartTime: base.Add(time.Minute), EndTime: base.Add(3 * time.Minute)})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "event-1", events[0].ID)
+	assert.Equal(t, "event-2", events[1].ID)
+
+	page, err := store.Query(ctx, AuditFilter{Limit: 2, Offset: 2})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, "event-2", page[0].ID)
+	assert.Equal(t, "event-3", page[1].ID)
+}
+
+func TestFileAuditStore_ContinuesChainAfterReopen(t *testing.T) {
+	store, path := newTestFileAuditStore(t)
+	appendTestEvents(t, store, time.Now())
+	require.NoError(t, store.Close())
+
+	reopened, err := NewFileAuditStore(path)
+	require.NoError(t, err)
+	defer reopened.Close()
+	record, err := reopened.Append(context.Background(), AuditEvent{ID: "event-5", EventType: AuditEventComplianceCheck})
+	require.NoError(t, err)
+	assert.EqualValues(t, 6, record.Sequence)
+
+	result, err := VerifyAuditChain(context.Background(), reopened, "")
+	require.NoError(t, err)
+	assert.True(t, result.Valid())
+	assert.EqualValues(t, 6, result.Records)
+	assert.Equal(t, record.Hash, result.Head)
+}
+
+func TestVerifyAuditChain_DetectsTampering(t *testing.T) {
+	tests := []struct {
+		name     string
+		edit     func(t *testing.T, lines []string) []string
+		brokenAt int64
+	}{
+		{
+			name: "modified event",
+			edit: func(t *testing.T, lines []string) []string {
+				lines[2] = strings.Replace(lines[2], "subject-a", "subject-x", 1)
+				return lines
+			},
+			brokenAt: 3,
+		},
+		{
+			name: "deleted record",
+			edit: func(t *testing.T, lines []string) []string {
+				return append(lines[:1], lines[2:]...)
+			},
+			brokenAt: 2,
+		},
+		{
+			name: "rehashed record",
+			edit: func(t *testing.T, lines []string) []string {
+				// Recomputing the edited record's hash still breaks its successor
+				var record AuditRecord
+				require.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+				record.Event = json.RawMessage(strings.Replace(string(record.Event), "subject-b", "subject-x", 1))
+				record.Hash = record.ComputeHash()
+				line, err := json.Marshal(record)
+				require.NoError(t, err)
+				lines[1] = string(line)
+				return lines
+			},
+			brokenAt: 3,
+		},
+		{
+			name: "garbage line",
+			edit: func(t *testing.T, lines []string) []string {
+				lines[3] = "not json"
+				return lines
+			},
+			brokenAt: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, path := newTestFileAuditStore(t)
+			appendTestEvents(t, store, time.Now())
+			rewriteAuditFile(t, path, func(lines []string) []string { return tt.edit(t, lines) })
+
+			result, err := VerifyAuditChain(context.Background(), store, "")
+			assert.ErrorIs(t, err, ErrAuditChainBroken)
+			assert.False(t, result.Valid())
+			assert.Equal(t, tt.brokenAt, result.BrokenAt)
+		})
+	}
+}
+
+func TestVerifyAuditChain_AnchorDetectsTruncation(t *testing.T) {
+	store, path := newTestFileAuditStore(t)
+	appendTestEvents(t, store, time.Now())
+	ctx := context.Background()
+
+	intact, err := VerifyAuditChain(ctx, store, "")
+	require.NoError(t, err)
+
+	_, err = VerifyAuditChain(ctx, store, intact.Head)
+	require.NoError(t, err, "the anchor is the current head")
+
+	rewriteAuditFile(t, path, func(lines []string) []string { return lines[:3] })
+	result, err := VerifyAuditChain(ctx, store, "")
+	require.NoError(t, err, "truncation alone leaves a valid chain")
+	assert.EqualValues(t, 3, result.Records)
+
+	result, err = VerifyAuditChain(ctx, store, intact.Head)
+	assert.ErrorIs(t, err, ErrAuditChainBroken)
+	assert.False(t, result.Valid())
+}
+
+func TestFramework_AuditStore(t *testing.T) {
+	framework := createTestFramework(t)
+	ctx := context.Background()
+
+	// createTestFramework encrypts audit details; queries still filter on
+	// the plain fields
+	store, _ := newTestFileAuditStore(t)
+	config := framework.config
+	config.AuditLogging.Store = AuditStoreFile
+	withStore, err := NewFramework(config, zaptest.NewLogger(t), WithAuditStore(store))
+	require.NoError(t, err)
+
+	code, err := withStore.IssueVerificationCode(ctx, "subject-1")
+	require.NoError(t, err)
+	request, err := withStore.SubmitDataRightRequest(ctx, DataRightRequest{
+		SubjectID:        "subject-1",
+		Type:             DataRightAccess,
+		VerificationCode: code,
+	})
+	require.NoError(t, err)
+
+	events, err := withStore.GetAuditLogs(ctx, AuditFilter{SubjectID: "subject-1", EventType: string(AuditEventRightsRequest)})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.True(t, events[0].Encrypted)
+	assert.Equal(t, AuditResult(request.Status), events[0].Result)
+
+	result, err := withStore.VerifyAuditLog(ctx, "")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, result.Records)
+
+	// Without a store nothing is persisted
+	events, err = framework.GetAuditLogs(ctx, AuditFilter{SubjectID: "subject-1"})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	_, err = framework.VerifyAuditLog(ctx, "")
+	assert.Error(t, err)
+}
+
+func TestAuditLogger_AppendsWithCallerContext(t *testing.T) {
+	fileStore, _ := newTestFileAuditStore(t)
+	store := &contextAuditStore{AuditStore: fileStore}
+	auditLogger, err := NewAuditLogger(AuditLoggingConfig{Enabled: true, DetailLevel: "minimal"}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	auditLogger.store = store
+
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "request-1")
+	require.NoError(t, auditLogger.LogSecurityIncident(ctx, "brute_force", "too many attempts", "high"))
+	require.Len(t, store.contexts, 1)
+	assert.Equal(t, "request-1", store.contexts[0].Value(key{}))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	require.NoError(t, auditLogger.LogSecurityIncident(canceled, "brute_force", "too many attempts", "high"))
+	require.Len(t, store.contexts, 2)
+	assert.ErrorIs(t, store.contexts[1].Err(), context.Canceled, "the caller's cancellation reaches the store")
+}
+
+func TestNewFramework_AuditStoreConfig(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	config := Config{Enabled: true, AuditLogging: AuditLoggingConfig{Enabled: true, DetailLevel: "minimal"}}
+
+	config.AuditLogging.Store = AuditStorePostgres
+	_, err := NewFramework(config, logger)
+	assert.ErrorContains(t, err, "database connection")
+
+	config.AuditLogging.Store = "s3"
+	_, err = NewFramework(config, logger)
+	assert.ErrorContains(t, err, "unknown audit store")
+
+	config.AuditLogging.Store = AuditStoreFile
+	config.AuditLogging.FilePath = filepath.Join(t.TempDir(), "audit.log")
+	framework, err := NewFramework(config, logger)
+	require.NoError(t, err)
+	require.NoError(t, framework.LogAuditEvent(context.Background(), AuditEvent{ID: "e1", EventType: AuditEventComplianceCheck}))
+	require.NoError(t, framework.Close())
+
+	_, err = os.Stat(config.AuditLogging.FilePath)
+	assert.NoError(t, err)
+}
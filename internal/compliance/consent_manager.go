@@ -2,10 +2,14 @@ package compliance
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/pkg/types"
 )
 
 // ConsentManager handles user consent for data processing
@@ -15,14 +19,34 @@ type ConsentManager struct {
 	repository ConsentRepository
 }
 
-// ConsentRepository interface for storing consent data
+// Consent repository errors
+var (
+	ErrConsentNotFound        = errors.New("consent not found")
+	ErrConsentVersionConflict = errors.New("consent version conflict")
+)
+
+// Consent repository kinds selectable with ConsentConfig.Store and
+// DataRetentionConfig.Store
+const (
+	RepositoryMemory   = "memory"
+	RepositoryPostgres = "postgres"
+)
+
+// ConsentRepository interface for storing consent data. Every stored
+// version is kept: GetConsent returns the latest one and GetConsentHistory
+// all of them, oldest first.
 type ConsentRepository interface {
 	StoreConsent(ctx context.Context, consent ConsentRecord) error
 	GetConsent(ctx context.Context, subjectID, purpose string) (*ConsentRecord, error)
 	GetAllConsents(ctx context.Context, subjectID string) ([]ConsentRecord, error)
+	// UpdateConsent stores a new version; consent.Version must be exactly
+	// one above the stored version, else ErrConsentVersionConflict
 	UpdateConsent(ctx context.Context, consent ConsentRecord) error
 	DeleteConsent(ctx context.Context, subjectID, purpose string) error
 	GetConsentHistory(ctx context.Context, subjectID, purpose string) ([]ConsentRecord, error)
+	// GetExpiredConsents returns granted, unwithdrawn consents whose
+	// expiry is before beforeTime
+	GetExpiredConsents(ctx context.Context, beforeTime time.Time) ([]ConsentRecord, error)
 }
 
 // ConsentRecord represents a consent record in storage
@@ -106,12 +130,16 @@ const (
 	LegalBasisLGPDCreditProtection LegalBasis = "lgpd_credit_protection" // Article 7(X)
 )
 
-// NewConsentManager creates a new consent manager
+// NewConsentManager creates a new consent manager that keeps consents in
+// memory
 func NewConsentManager(config ConsentConfig, logger *zap.Logger) (*ConsentManager, error) {
-	// In a real implementation, you would inject the repository
-	// For now, we'll use a mock repository
-	repository := &InMemoryConsentRepository{
-		consents: make(map[string][]ConsentRecord),
+	return NewConsentManagerWithRepository(config, logger, NewInMemoryConsentRepository())
+}
+
+// NewConsentManagerWithRepository creates a consent manager over repository
+func NewConsentManagerWithRepository(config ConsentConfig, logger *zap.Logger, repository ConsentRepository) (*ConsentManager, error) {
+	if repository == nil {
+		return nil, fmt.Errorf("consent repository is required")
 	}
 
 	return &ConsentManager{
@@ -138,6 +166,9 @@ func (cm *ConsentManager) GrantConsent(ctx context.Context, request ConsentReque
 		// Update existing consent
 		return cm.updateExistingConsent(ctx, existing, request)
 	}
+	if err != nil && !errors.Is(err, ErrConsentNotFound) {
+		return nil, fmt.Errorf("failed to load consent: %w", err)
+	}
 
 	// Create new consent record
 	consent := ConsentRecord{
@@ -314,6 +345,16 @@ func (cm *ConsentManager) GetAllConsents(ctx context.Context, subjectID string)
 	return cm.repository.GetAllConsents(ctx, subjectID)
 }
 
+// GetExpiredConsents returns granted consents that expired before
+// beforeTime and were not withdrawn
+func (cm *ConsentManager) GetExpiredConsents(ctx context.Context, beforeTime time.Time) ([]ConsentRecord, error) {
+	if !cm.config.Enabled {
+		return nil, fmt.Errorf("consent management is disabled")
+	}
+
+	return cm.repository.GetExpiredConsents(ctx, beforeTime)
+}
+
 // HealthCheck returns the health status of the consent manager
 func (cm *ConsentManager) HealthCheck(_ context.Context) map[string]interface{} {
 	return map[string]interface{}{
@@ -371,32 +412,54 @@ func (cm *ConsentManager) updateExistingConsent(ctx context.Context, existing *C
 }
 
 func (cm *ConsentManager) generateConsentID() string {
-	return fmt.Sprintf("consent_%d", time.Now().UnixNano())
+	return "consent_" + types.New().String()
 }
 
 // InMemoryConsentRepository is a simple in-memory implementation for development/testing
 type InMemoryConsentRepository struct {
+	mu       sync.RWMutex
 	consents map[string][]ConsentRecord
 }
 
+// NewInMemoryConsentRepository creates an empty in-memory consent repository
+func NewInMemoryConsentRepository() *InMemoryConsentRepository {
+	return &InMemoryConsentRepository{consents: make(map[string][]ConsentRecord)}
+}
+
+func consentKey(subjectID, purpose string) string {
+	return fmt.Sprintf("%s:%s", subjectID, purpose)
+}
+
 func (r *InMemoryConsentRepository) StoreConsent(ctx context.Context, consent ConsentRecord) error {
-	key := fmt.Sprintf("%s:%s", consent.SubjectID, consent.Purpose)
-	r.consents[key] = append(r.consents[key], consent)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := consentKey(consent.SubjectID, consent.Purpose)
+	if len(r.consents[key]) > 0 {
+		return fmt.Errorf("consent already exists for subject %s and purpose %s", consent.SubjectID, consent.Purpose)
+	}
+	r.consents[key] = []ConsentRecord{consent}
 	return nil
 }
 
 func (r *InMemoryConsentRepository) GetConsent(ctx context.Context, subjectID, purpose string) (*ConsentRecord, error) {
-	key := fmt.Sprintf("%s:%s", subjectID, purpose)
-	consents, exists := r.consents[key]
-	if !exists || len(consents) == 0 {
-		return nil, fmt.Errorf("consent not found")
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	consents := r.consents[consentKey(subjectID, purpose)]
+	if len(consents) == 0 {
+		return nil, ErrConsentNotFound
 	}
 
 	// Return the most recent consent
-	return &consents[len(consents)-1], nil
+	consent := consents[len(consents)-1]
+	return &consent, nil
 }
 
 func (r *InMemoryConsentRepository) GetAllConsents(ctx context.Context, subjectID string) ([]ConsentRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	var allConsents []ConsentRecord
 	for _, consents := range r.consents {
 		if len(consents) > 0 && consents[0].SubjectID == subjectID {
@@ -408,27 +471,53 @@ func (r *InMemoryConsentRepository) GetAllConsents(ctx context.Context, subjectI
 }
 
 func (r *InMemoryConsentRepository) UpdateConsent(ctx context.Context, consent ConsentRecord) error {
-	key := fmt.Sprintf("%s:%s", consent.SubjectID, consent.Purpose)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := consentKey(consent.SubjectID, consent.Purpose)
 	consents := r.consents[key]
-	if len(consents) > 0 {
-		// Replace the most recent consent
-		consents[len(consents)-1] = consent
-		r.consents[key] = consents
+	if len(consents) == 0 {
+		return ErrConsentNotFound
 	}
+	if current := consents[len(consents)-1].Version; consent.Version != current+1 {
+		return fmt.Errorf("%w: stored version is %d, got %d", ErrConsentVersionConflict, current, consent.Version)
+	}
+	r.consents[key] = append(consents, consent)
 	return nil
 }
 
 func (r *InMemoryConsentRepository) DeleteConsent(ctx context.Context, subjectID, purpose string) error {
-	key := fmt.Sprintf("%s:%s", subjectID, purpose)
-	delete(r.consents, key)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.consents, consentKey(subjectID, purpose))
 	return nil
 }
 
 func (r *InMemoryConsentRepository) GetConsentHistory(ctx context.Context, subjectID, purpose string) ([]ConsentRecord, error) {
-	key := fmt.Sprintf("%s:%s", subjectID, purpose)
-	consents, exists := r.consents[key]
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	consents, exists := r.consents[consentKey(subjectID, purpose)]
 	if !exists {
-		return nil, fmt.Errorf("consent history not found")
+		return nil, fmt.Errorf("consent history not found: %w", ErrConsentNotFound)
+	}
+	return append([]ConsentRecord(nil), consents...), nil
+}
+
+func (r *InMemoryConsentRepository) GetExpiredConsents(ctx context.Context, beforeTime time.Time) ([]ConsentRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var expired []ConsentRecord
+	for _, consents := range r.consents {
+		if len(consents) == 0 {
+			continue
+		}
+		consent := consents[len(consents)-1]
+		if consent.Granted && consent.WithdrawnAt == nil && consent.ExpiresAt != nil && consent.ExpiresAt.Before(beforeTime) {
+			expired = append(expired, consent)
+		}
 	}
-	return consents, nil
+	return expired, nil
 }
@@ -0,0 +1,247 @@
+package compliance
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const consentColumns = `id, subject_id, purpose, granted, legal_basis, consent_source, consented_at,
+	expires_at, withdrawn_at, ip_address, user_agent, consent_string, metadata, version,
+	created_at, updated_at`
+
+const consentHistoryColumns = `consent_id, subject_id, purpose, granted, legal_basis, consent_source, consented_at,
+	expires_at, withdrawn_at, ip_address, user_agent, consent_string, metadata, version,
+	created_at, updated_at`
+
+// PostgresConsentRepository keeps the current version of each consent in
+// compliance_consents and every version in compliance_consent_history
+// (migration 005_compliance_consent_retention)
+type PostgresConsentRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresConsentRepository creates a consent repository over db
+func NewPostgresConsentRepository(db *sql.DB) *PostgresConsentRepository {
+	return &PostgresConsentRepository{db: db}
+}
+
+// StoreConsent stores the first version of a consent
+func (r *PostgresConsentRepository) StoreConsent(ctx context.Context, consent ConsentRecord) error {
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		args, err := consentArgs(consent)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO compliance_consents (`+consentColumns+`)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		`, args...)
+		if err != nil {
+			return fmt.Errorf("inserting consent: %w", err)
+		}
+		return insertConsentHistory(ctx, tx, args)
+	})
+}
+
+// GetConsent returns the current version
+func (r *PostgresConsentRepository) GetConsent(ctx context.Context, subjectID, purpose string) (*ConsentRecord, error) {
+	consents, err := r.query(ctx, `
+		SELECT `+consentColumns+` FROM compliance_consents
+		WHERE subject_id = $1 AND purpose = $2
+	`, subjectID, purpose)
+	if err != nil {
+		return nil, err
+	}
+	if len(consents) == 0 {
+		return nil, ErrConsentNotFound
+	}
+	return &consents[0], nil
+}
+
+// GetAllConsents returns the current version for every purpose of a subject
+func (r *PostgresConsentRepository) GetAllConsents(ctx context.Context, subjectID string) ([]ConsentRecord, error) {
+	return r.query(ctx, `
+		SELECT `+consentColumns+` FROM compliance_consents
+		WHERE subject_id = $1 ORDER BY purpose
+	`, subjectID)
+}
+
+// UpdateConsent replaces the current version and appends it to the
+// history. The version check makes concurrent updates of the same consent
+// fail instead of overwriting each other.
+func (r *PostgresConsentRepository) UpdateConsent(ctx context.Context, consent ConsentRecord) error {
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		args, err := consentArgs(consent)
+		if err != nil {
+			return err
+		}
+		result, err := tx.ExecContext(ctx, `
+			UPDATE compliance_consents SET
+				granted = $4, legal_basis = $5, consent_source = $6, consented_at = $7,
+				expires_at = $8, withdrawn_at = $9, ip_address = $10, user_agent = $11,
+				consent_string = $12, metadata = $13, version = $14, updated_at = $16
+			WHERE id = $1 AND subject_id = $2 AND purpose = $3 AND version = $14 - 1
+		`, args...)
+		if err != nil {
+			return fmt.Errorf("updating consent: %w", err)
+		}
+		if rows, err := result.RowsAffected(); err != nil {
+			return fmt.Errorf("updating consent: %w", err)
+		} else if rows == 0 {
+			return r.updateConflict(ctx, tx, consent)
+		}
+		return insertConsentHistory(ctx, tx, args)
+	})
+}
+
+// updateConflict explains why an update matched no row
+func (r *PostgresConsentRepository) updateConflict(ctx context.Context, tx *sql.Tx, consent ConsentRecord) error {
+	var version int
+	err := tx.QueryRowContext(ctx,
+		`SELECT version FROM compliance_consents WHERE id = $1`, consent.ID,
+	).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrConsentNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("reading consent version: %w", err)
+	}
+	return fmt.Errorf("%w: stored version is %d, got %d", ErrConsentVersionConflict, version, consent.Version)
+}
+
+// DeleteConsent removes the consent and its history
+func (r *PostgresConsentRepository) DeleteConsent(ctx context.Context, subjectID, purpose string) error {
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx,
+			`DELETE FROM compliance_consent_history WHERE subject_id = $1 AND purpose = $2`,
+			subjectID, purpose); err != nil {
+			return fmt.Errorf("deleting consent history: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx,
+			`DELETE FROM compliance_consents WHERE subject_id = $1 AND purpose = $2`,
+			subjectID, purpose); err != nil {
+			return fmt.Errorf("deleting consent: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetConsentHistory returns every version, oldest first
+func (r *PostgresConsentRepository) GetConsentHistory(ctx context.Context, subjectID, purpose string) ([]ConsentRecord, error) {
+	history, err := r.query(ctx, `
+		SELECT `+consentHistoryColumns+` FROM compliance_consent_history
+		WHERE subject_id = $1 AND purpose = $2 ORDER BY version
+	`, subjectID, purpose)
+	if err != nil {
+		return nil, err
+	}
+	if len(history) == 0 {
+		return nil, fmt.Errorf("consent history not found: %w", ErrConsentNotFound)
+	}
+	return history, nil
+}
+
+// GetExpiredConsents returns granted, unwithdrawn consents that expired
+// before beforeTime, oldest expiry first
+func (r *PostgresConsentRepository) GetExpiredConsents(ctx context.Context, beforeTime time.Time) ([]ConsentRecord, error) {
+	return r.query(ctx, `
+		SELECT `+consentColumns+` FROM compliance_consents
+		WHERE granted AND withdrawn_at IS NULL AND expires_at < $1
+		ORDER BY expires_at
+	`, beforeTime)
+}
+
+func (r *PostgresConsentRepository) inTx(ctx context.Context, fn func(*sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning consent transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing consent transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresConsentRepository) query(ctx context.Context, query string, args ...interface{}) ([]ConsentRecord, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying consents: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	consents := make([]ConsentRecord, 0)
+	for rows.Next() {
+		var consent ConsentRecord
+		var source string
+		var expiresAt, withdrawnAt sql.NullTime
+		var metadata []byte
+		if err := rows.Scan(
+			&consent.ID, &consent.SubjectID, &consent.Purpose, &consent.Granted, &consent.LegalBasis,
+			&source, &consent.Timestamp, &expiresAt, &withdrawnAt, &consent.IPAddress,
+			&consent.UserAgent, &consent.ConsentString, &metadata, &consent.Version,
+			&consent.CreatedAt, &consent.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning consent: %w", err)
+		}
+		consent.ConsentSource = ConsentSource(source)
+		consent.ExpiresAt = nullTimePtr(expiresAt)
+		consent.WithdrawnAt = nullTimePtr(withdrawnAt)
+		if err := unmarshalJSONColumn(metadata, &consent.Metadata); err != nil {
+			return nil, fmt.Errorf("decoding consent %s metadata: %w", consent.ID, err)
+		}
+		consents = append(consents, consent)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating consents: %w", err)
+	}
+	return consents, nil
+}
+
+// consentArgs returns the consent in consentColumns order
+func consentArgs(consent ConsentRecord) ([]interface{}, error) {
+	metadata, err := json.Marshal(consent.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal consent metadata: %w", err)
+	}
+	return []interface{}{
+		consent.ID, consent.SubjectID, consent.Purpose, consent.Granted, consent.LegalBasis,
+		string(consent.ConsentSource), consent.Timestamp, consent.ExpiresAt, consent.WithdrawnAt,
+		consent.IPAddress, consent.UserAgent, consent.ConsentString, string(metadata),
+		consent.Version, consent.CreatedAt, consent.UpdatedAt,
+	}, nil
+}
+
+func insertConsentHistory(ctx context.Context, tx *sql.Tx, args []interface{}) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO compliance_consent_history (`+consentHistoryColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+	`, args...)
+	if err != nil {
+		return fmt.Errorf("inserting consent history: %w", err)
+	}
+	return nil
+}
+
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// unmarshalJSONColumn decodes a nullable JSONB column into v
+func unmarshalJSONColumn(data []byte, v interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
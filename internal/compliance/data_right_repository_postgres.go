@@ -0,0 +1,233 @@
+package compliance
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const dataRightColumns = `id, subject_id, type, status, requested_at, completed_at, due_at,
+	reason, data, updates, sources, export`
+
+// PostgresDataRightRepository keeps data rights requests in the
+// compliance_data_right_requests table (migration 007_compliance_data_rights)
+type PostgresDataRightRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresDataRightRepository creates a data rights repository over db
+func NewPostgresDataRightRepository(db *sql.DB) *PostgresDataRightRepository {
+	return &PostgresDataRightRepository{db: db}
+}
+
+// StoreRequest inserts a new request
+func (r *PostgresDataRightRepository) StoreRequest(ctx context.Context, request DataRightRequest) error {
+	args, err := dataRightArgs(request)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO compliance_data_right_requests (`+dataRightColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`, args...)
+	if err != nil {
+		return fmt.Errorf("inserting data right request: %w", err)
+	}
+	return nil
+}
+
+// GetRequest returns the request with the given ID
+func (r *PostgresDataRightRepository) GetRequest(ctx context.Context, id string) (*DataRightRequest, error) {
+	requests, err := r.query(ctx, `
+		SELECT `+dataRightColumns+` FROM compliance_data_right_requests WHERE id = $1
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(requests) == 0 {
+		return nil, ErrDataRightRequestNotFound
+	}
+	return &requests[0], nil
+}
+
+// ListRequests returns one page of matching requests, newest first, and
+// the total number of matches
+func (r *PostgresDataRightRepository) ListRequests(ctx context.Context, filter DataRightFilter) ([]DataRightRequest, int, error) {
+	var conditions []string
+	var args []interface{}
+	where := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.SubjectID != "" {
+		where("subject_id = $%d", filter.SubjectID)
+	}
+	if filter.Type != "" {
+		where("type = $%d", string(filter.Type))
+	}
+	if filter.Status != "" {
+		where("status = $%d", string(filter.Status))
+	}
+	clause := ""
+	if len(conditions) > 0 {
+		clause = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM compliance_data_right_requests`+clause, args...,
+	).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("counting data right requests: %w", err)
+	}
+
+	query := `SELECT ` + dataRightColumns + ` FROM compliance_data_right_requests` + clause +
+		` ORDER BY requested_at DESC, id`
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	if filter.Offset > 0 {
+		args = append(args, filter.Offset)
+		query += fmt.Sprintf(" OFFSET $%d", len(args))
+	}
+
+	requests, err := r.query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return requests, total, nil
+}
+
+// UpdateRequest replaces every mutable field of the request
+func (r *PostgresDataRightRepository) UpdateRequest(ctx context.Context, request DataRightRequest) error {
+	args, err := dataRightArgs(request)
+	if err != nil {
+		return err
+	}
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE compliance_data_right_requests SET
+			subject_id = $2, type = $3, status = $4, requested_at = $5, completed_at = $6,
+			due_at = $7, reason = $8, data = $9, updates = $10, sources = $11, export = $12
+		WHERE id = $1
+	`, args...)
+	if err != nil {
+		return fmt.Errorf("updating data right request: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking data right request %s: %w", request.ID, err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: %s", ErrDataRightRequestNotFound, request.ID)
+	}
+	return nil
+}
+
+// StoreVerification upserts the verification code of a subject
+func (r *PostgresDataRightRepository) StoreVerification(ctx context.Context, verification DataRightVerification) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO compliance_data_right_verifications (subject_id, code_hash, expires_at, attempts)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (subject_id) DO UPDATE SET
+			code_hash = EXCLUDED.code_hash, expires_at = EXCLUDED.expires_at, attempts = EXCLUDED.attempts
+	`, verification.SubjectID, verification.CodeHash, verification.ExpiresAt, verification.Attempts)
+	if err != nil {
+		return fmt.Errorf("storing data right verification: %w", err)
+	}
+	return nil
+}
+
+// GetVerification returns the verification code of a subject
+func (r *PostgresDataRightRepository) GetVerification(ctx context.Context, subjectID string) (*DataRightVerification, error) {
+	verification := DataRightVerification{SubjectID: subjectID}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT code_hash, expires_at, attempts FROM compliance_data_right_verifications WHERE subject_id = $1
+	`, subjectID).Scan(&verification.CodeHash, &verification.ExpiresAt, &verification.Attempts)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDataRightVerificationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("querying data right verification: %w", err)
+	}
+	return &verification, nil
+}
+
+// DeleteVerification removes the verification code of a subject
+func (r *PostgresDataRightRepository) DeleteVerification(ctx context.Context, subjectID string) error {
+	if _, err := r.db.ExecContext(ctx, `
+		DELETE FROM compliance_data_right_verifications WHERE subject_id = $1
+	`, subjectID); err != nil {
+		return fmt.Errorf("deleting data right verification: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresDataRightRepository) query(ctx context.Context, query string, args ...interface{}) ([]DataRightRequest, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying data right requests: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	requests := make([]DataRightRequest, 0)
+	for rows.Next() {
+		var request DataRightRequest
+		var requestType, status string
+		var completedAt sql.NullTime
+		var data, updates, sources, export []byte
+		if err := rows.Scan(
+			&request.ID, &request.SubjectID, &requestType, &status, &request.RequestedAt,
+			&completedAt, &request.DueAt, &request.Reason, &data, &updates, &sources, &export,
+		); err != nil {
+			return nil, fmt.Errorf("scanning data right request: %w", err)
+		}
+		request.Type = DataRightType(requestType)
+		request.Status = DataRightStatus(status)
+		request.CompletedAt = nullTimePtr(completedAt)
+		if err := unmarshalJSONColumn(data, &request.Data); err != nil {
+			return nil, fmt.Errorf("decoding data right request %s data: %w", request.ID, err)
+		}
+		if err := unmarshalJSONColumn(updates, &request.Updates); err != nil {
+			return nil, fmt.Errorf("decoding data right request %s updates: %w", request.ID, err)
+		}
+		if err := unmarshalJSONColumn(sources, &request.Sources); err != nil {
+			return nil, fmt.Errorf("decoding data right request %s sources: %w", request.ID, err)
+		}
+		if err := unmarshalJSONColumn(export, &request.Export); err != nil {
+			return nil, fmt.Errorf("decoding data right request %s export: %w", request.ID, err)
+		}
+		requests = append(requests, request)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating data right requests: %w", err)
+	}
+	return requests, nil
+}
+
+// dataRightArgs returns the request in dataRightColumns order
+func dataRightArgs(request DataRightRequest) ([]interface{}, error) {
+	data, err := json.Marshal(request.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data right request data: %w", err)
+	}
+	updates, err := json.Marshal(request.Updates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data right request updates: %w", err)
+	}
+	sources, err := json.Marshal(request.Sources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data right request sources: %w", err)
+	}
+	export, err := json.Marshal(request.Export)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal data right request export: %w", err)
+	}
+	return []interface{}{
+		request.ID, request.SubjectID, string(request.Type), string(request.Status), request.RequestedAt,
+		request.CompletedAt, request.DueAt, request.Reason, string(data), string(updates), string(sources), string(export),
+	}, nil
+}
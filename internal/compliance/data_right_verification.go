@@ -0,0 +1,133 @@
+package compliance
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultVerificationTTL is how long an issued verification code is
+	// accepted when DataRightsConfig.VerificationTTL is unset
+	DefaultVerificationTTL = 15 * time.Minute
+	// maxVerificationAttempts is how many wrong codes a subject may submit
+	// before its code is revoked
+	maxVerificationAttempts = 5
+	verificationCodeDigits  = 6
+)
+
+var (
+	// ErrInvalidVerificationCode is returned when a request's verification
+	// code does not match the code issued to its subject, has expired or
+	// was already used
+	ErrInvalidVerificationCode = errors.New("invalid verification code")
+	// ErrDataRightVerificationNotFound is returned when no verification
+	// code was issued to the subject
+	ErrDataRightVerificationNotFound = errors.New("data right verification not found")
+)
+
+// DataRightVerification is the verification code issued to a data subject.
+// Only its hash is stored; the code itself is delivered to the subject out
+// of band.
+type DataRightVerification struct {
+	SubjectID string    `json:"subject_id"`
+	CodeHash  string    `json:"code_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// Attempts counts the wrong codes submitted so far
+	Attempts int `json:"attempts"`
+}
+
+// IssueVerificationCode issues a new single-use verification code to a
+// data subject, replacing any earlier one, and returns it for delivery to
+// the subject. SubmitDataRightRequest accepts it until
+// DataRights.VerificationTTL elapses.
+func (cf *Framework) IssueVerificationCode(ctx context.Context, subjectID string) (string, error) {
+	if err := cf.checkDataRights(); err != nil {
+		return "", err
+	}
+	if subjectID == "" {
+		return "", fmt.Errorf("invalid verification: subject_id is required")
+	}
+
+	code, err := newVerificationCode()
+	if err != nil {
+		return "", err
+	}
+	ttl := cf.config.DataRights.VerificationTTL
+	if ttl <= 0 {
+		ttl = DefaultVerificationTTL
+	}
+	verification := DataRightVerification{
+		SubjectID: subjectID,
+		CodeHash:  hashVerificationCode(code),
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	if err := cf.dataRights.StoreVerification(ctx, verification); err != nil {
+		return "", fmt.Errorf("failed to store verification code: %w", err)
+	}
+	return code, nil
+}
+
+// verifyDataRightCode consumes the code issued to subjectID when code
+// matches it. A wrong code counts as an attempt; the code is revoked once
+// it expires or the attempts run out.
+func (cf *Framework) verifyDataRightCode(ctx context.Context, subjectID, code string) error {
+	verification, err := cf.dataRights.GetVerification(ctx, subjectID)
+	if errors.Is(err, ErrDataRightVerificationNotFound) {
+		return ErrInvalidVerificationCode
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load verification code: %w", err)
+	}
+
+	if time.Now().After(verification.ExpiresAt) || verification.Attempts >= maxVerificationAttempts {
+		cf.revokeVerification(ctx, subjectID)
+		return ErrInvalidVerificationCode
+	}
+	if subtle.ConstantTimeCompare([]byte(hashVerificationCode(code)), []byte(verification.CodeHash)) != 1 {
+		verification.Attempts++
+		if err := cf.dataRights.StoreVerification(ctx, *verification); err != nil {
+			return fmt.Errorf("failed to record verification attempt: %w", err)
+		}
+		return ErrInvalidVerificationCode
+	}
+
+	// Codes are single use
+	if err := cf.dataRights.DeleteVerification(ctx, subjectID); err != nil {
+		return fmt.Errorf("failed to consume verification code: %w", err)
+	}
+	return nil
+}
+
+func (cf *Framework) revokeVerification(ctx context.Context, subjectID string) {
+	if err := cf.dataRights.DeleteVerification(ctx, subjectID); err != nil {
+		cf.logger.Warn("Failed to revoke verification code",
+			zap.String("subject_id", subjectID), zap.Error(err))
+	}
+}
+
+// newVerificationCode returns a random numeric code
+func newVerificationCode() (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < verificationCodeDigits; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate verification code: %w", err)
+	}
+	return fmt.Sprintf("%0*d", verificationCodeDigits, n), nil
+}
+
+func hashVerificationCode(code string) string {
+	hash := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(hash[:])
+}
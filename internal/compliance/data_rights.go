@@ -0,0 +1,394 @@
+package compliance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+var (
+	// ErrDataRightRequestNotFound is returned when no request has the given ID
+	ErrDataRightRequestNotFound = errors.New("data right request not found")
+	// ErrDataRightRequestClosed is returned when updating a completed or rejected request
+	ErrDataRightRequestClosed = errors.New("data right request is already closed")
+	// ErrVerificationRequired is returned when a request lacks the verification
+	// code required by DataRightsConfig.VerificationRequired
+	ErrVerificationRequired = errors.New("verification code is required")
+	// ErrLegalHold is returned when erasing data that is under legal hold
+	ErrLegalHold = errors.New("subject data is under legal hold")
+	// ErrDataSourcesFailed is returned when some data sources could not
+	// process a request
+	ErrDataSourcesFailed = errors.New("data sources failed")
+	// ErrNoExport is returned when a request has no export bundle
+	ErrNoExport = errors.New("data right request has no export")
+)
+
+// DataRightRequestUpdate records a status change of a data rights request
+type DataRightRequestUpdate struct {
+	Timestamp time.Time       `json:"timestamp"`
+	Status    DataRightStatus `json:"status"`
+	Message   string          `json:"message,omitempty"`
+	UpdatedBy string          `json:"updated_by,omitempty"`
+}
+
+// DataRightFilter selects data rights requests; zero fields match everything
+type DataRightFilter struct {
+	SubjectID string          `json:"subject_id,omitempty"`
+	Type      DataRightType   `json:"type,omitempty"`
+	Status    DataRightStatus `json:"status,omitempty"`
+	Limit     int             `json:"limit,omitempty"`
+	Offset    int             `json:"offset,omitempty"`
+}
+
+// Matches reports whether request satisfies the filter
+func (f DataRightFilter) Matches(request DataRightRequest) bool {
+	return (f.SubjectID == "" || f.SubjectID == request.SubjectID) &&
+		(f.Type == "" || f.Type == request.Type) &&
+		(f.Status == "" || f.Status == request.Status)
+}
+
+// DataRightRepository interface for storing data rights requests
+type DataRightRepository interface {
+	StoreRequest(ctx context.Context, request DataRightRequest) error
+	GetRequest(ctx context.Context, id string) (*DataRightRequest, error)
+	// ListRequests returns one page of matching requests, newest first, and
+	// the total number of matches
+	ListRequests(ctx context.Context, filter DataRightFilter) ([]DataRightRequest, int, error)
+	UpdateRequest(ctx context.Context, request DataRightRequest) error
+	// StoreVerification stores the verification code of a subject,
+	// replacing the previous one
+	StoreVerification(ctx context.Context, verification DataRightVerification) error
+	// GetVerification returns the verification code of a subject or
+	// ErrDataRightVerificationNotFound
+	GetVerification(ctx context.Context, subjectID string) (*DataRightVerification, error)
+	DeleteVerification(ctx context.Context, subjectID string) error
+}
+
+// FailedSources returns the names of the data sources that failed the
+// request
+func (r DataRightRequest) FailedSources() []string {
+	var failed []string
+	for _, result := range r.Sources {
+		if result.Status == DataSourceStatusFailed {
+			failed = append(failed, result.Source)
+		}
+	}
+	return failed
+}
+
+// IsValid reports whether t is a known data right type
+func (t DataRightType) IsValid() bool {
+	switch t {
+	case DataRightAccess, DataRightRectification, DataRightErasure, DataRightPortability,
+		DataRightRestriction, DataRightObjection, DataRightWithdrawConsent:
+		return true
+	}
+	return false
+}
+
+// IsValid reports whether s is a known data right status
+func (s DataRightStatus) IsValid() bool {
+	switch s {
+	case DataRightStatusPending, DataRightStatusInProgress, DataRightStatusCompleted,
+		DataRightStatusRejected, DataRightStatusPartial:
+		return true
+	}
+	return false
+}
+
+// IsClosed reports whether no further updates are accepted in status s
+func (s DataRightStatus) IsClosed() bool {
+	return s == DataRightStatusCompleted || s == DataRightStatusRejected
+}
+
+// SubmitDataRightRequest registers a new data subject rights request. The
+// request starts pending; with DataRights.AutoFulfillment it is processed
+// right away.
+func (cf *Framework) SubmitDataRightRequest(ctx context.Context, request DataRightRequest) (*DataRightRequest, error) {
+	if err := cf.checkDataRights(); err != nil {
+		return nil, err
+	}
+	if request.SubjectID == "" {
+		return nil, fmt.Errorf("invalid data right request: subject_id is required")
+	}
+	if !request.Type.IsValid() {
+		return nil, fmt.Errorf("invalid data right request: unsupported type %q", request.Type)
+	}
+	if cf.config.DataRights.VerificationRequired {
+		if request.VerificationCode == "" {
+			return nil, ErrVerificationRequired
+		}
+		if err := cf.verifyDataRightCode(ctx, request.SubjectID, request.VerificationCode); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	request.ID = types.New().String()
+	request.Status = DataRightStatusPending
+	request.RequestedAt = now
+	request.DueAt = now.Add(cf.config.DataRights.ResponseTime)
+	request.CompletedAt = nil
+	request.VerificationCode = ""
+	request.Sources = nil
+	request.Export = nil
+	request.Updates = []DataRightRequestUpdate{{
+		Timestamp: now,
+		Status:    DataRightStatusPending,
+		Message:   "request received",
+		UpdatedBy: "system",
+	}}
+
+	if err := cf.dataRights.StoreRequest(ctx, request); err != nil {
+		return nil, fmt.Errorf("failed to store data right request: %w", err)
+	}
+	if err := cf.auditLogger.LogDataRightsRequest(ctx, request.SubjectID, request); err != nil {
+		cf.logger.Warn("Failed to audit data rights request", zap.Error(err))
+	}
+
+	if !cf.config.DataRights.AutoFulfillment {
+		return &request, nil
+	}
+
+	return cf.ProcessDataRightRequest(ctx, request.ID, "system")
+}
+
+// ProcessDataRightRequest fulfils a request against every registered data
+// source. The request is completed when all sources succeed, left partial
+// when some fail so it can be processed again, and rejected when it cannot
+// be fulfilled at all (e.g. erasure of data under legal hold).
+func (cf *Framework) ProcessDataRightRequest(ctx context.Context, id, processedBy string) (*DataRightRequest, error) {
+	if err := cf.checkDataRights(); err != nil {
+		return nil, err
+	}
+
+	request, err := cf.UpdateDataRightRequest(ctx, id, DataRightStatusInProgress, "processing", processedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	status, message := DataRightStatusCompleted, "fulfilled"
+	if err := cf.fulfilDataRightRequest(ctx, request); err != nil {
+		status, message = DataRightStatusRejected, err.Error()
+		if errors.Is(err, ErrDataSourcesFailed) {
+			status = DataRightStatusPartial
+		}
+	}
+
+	return cf.transitionDataRightRequest(ctx, request, status, message, processedBy)
+}
+
+// GetDataRightExport returns the export bundle of an access or portability
+// request encoded in format
+func (cf *Framework) GetDataRightExport(ctx context.Context, id string, format ExportFormat) ([]byte, error) {
+	request, err := cf.GetDataRightRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Export == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoExport, request.ID)
+	}
+	return request.Export.Encode(format)
+}
+
+// GetDataRightRequest returns a data rights request by ID
+func (cf *Framework) GetDataRightRequest(ctx context.Context, id string) (*DataRightRequest, error) {
+	if err := cf.checkDataRights(); err != nil {
+		return nil, err
+	}
+	return cf.dataRights.GetRequest(ctx, id)
+}
+
+// ListDataRightRequests returns a page of data rights requests and the total
+// number of matches
+func (cf *Framework) ListDataRightRequests(ctx context.Context, filter DataRightFilter) ([]DataRightRequest, int, error) {
+	if err := cf.checkDataRights(); err != nil {
+		return nil, 0, err
+	}
+	return cf.dataRights.ListRequests(ctx, filter)
+}
+
+// UpdateDataRightRequest moves a data rights request to status and records
+// who did it and why
+func (cf *Framework) UpdateDataRightRequest(ctx context.Context, id string, status DataRightStatus, message, updatedBy string) (*DataRightRequest, error) {
+	if err := cf.checkDataRights(); err != nil {
+		return nil, err
+	}
+	if !status.IsValid() {
+		return nil, fmt.Errorf("invalid data right request: unsupported status %q", status)
+	}
+
+	request, err := cf.dataRights.GetRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status.IsClosed() {
+		return nil, fmt.Errorf("%w: %s", ErrDataRightRequestClosed, request.Status)
+	}
+
+	return cf.transitionDataRightRequest(ctx, request, status, message, updatedBy)
+}
+
+func (cf *Framework) transitionDataRightRequest(ctx context.Context, request *DataRightRequest, status DataRightStatus, message, updatedBy string) (*DataRightRequest, error) {
+	now := time.Now()
+	request.Status = status
+	request.Updates = append(request.Updates, DataRightRequestUpdate{
+		Timestamp: now,
+		Status:    status,
+		Message:   message,
+		UpdatedBy: updatedBy,
+	})
+	if status.IsClosed() {
+		request.CompletedAt = &now
+	}
+
+	if err := cf.dataRights.UpdateRequest(ctx, *request); err != nil {
+		return nil, fmt.Errorf("failed to update data right request: %w", err)
+	}
+	if err := cf.auditLogger.LogDataRightsRequest(ctx, request.SubjectID, *request); err != nil {
+		cf.logger.Warn("Failed to audit data rights request", zap.Error(err))
+	}
+
+	cf.logger.Info("Data right request updated",
+		zap.String("request_id", request.ID),
+		zap.String("status", string(status)),
+		zap.String("updated_by", updatedBy))
+
+	return request, nil
+}
+
+func (cf *Framework) checkDataRights() error {
+	if !cf.config.Enabled {
+		return fmt.Errorf("compliance framework is disabled")
+	}
+	if !cf.config.DataRights.Enabled {
+		return fmt.Errorf("data rights handling is disabled")
+	}
+	return nil
+}
+
+// InMemoryDataRightRepository keeps data rights requests in memory
+type InMemoryDataRightRepository struct {
+	mu            sync.RWMutex
+	requests      map[string]DataRightRequest
+	verifications map[string]DataRightVerification
+}
+
+// NewInMemoryDataRightRepository creates an empty in-memory repository
+func NewInMemoryDataRightRepository() *InMemoryDataRightRepository {
+	return &InMemoryDataRightRepository{
+		requests:      make(map[string]DataRightRequest),
+		verifications: make(map[string]DataRightVerification),
+	}
+}
+
+func (r *InMemoryDataRightRepository) StoreRequest(_ context.Context, request DataRightRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.requests[request.ID]; exists {
+		return fmt.Errorf("data right request %s already exists", request.ID)
+	}
+	r.requests[request.ID] = copyDataRightRequest(request)
+	return nil
+}
+
+func (r *InMemoryDataRightRepository) GetRequest(_ context.Context, id string) (*DataRightRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	request, exists := r.requests[id]
+	if !exists {
+		return nil, ErrDataRightRequestNotFound
+	}
+	request = copyDataRightRequest(request)
+	return &request, nil
+}
+
+func (r *InMemoryDataRightRepository) ListRequests(_ context.Context, filter DataRightFilter) ([]DataRightRequest, int, error) {
+	r.mu.RLock()
+	var matches []DataRightRequest
+	for _, request := range r.requests {
+		if filter.Matches(request) {
+			matches = append(matches, copyDataRightRequest(request))
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(matches, func(i, j int) bool {
+		if !matches[i].RequestedAt.Equal(matches[j].RequestedAt) {
+			return matches[i].RequestedAt.After(matches[j].RequestedAt)
+		}
+		return matches[i].ID < matches[j].ID
+	})
+
+	total := len(matches)
+	if filter.Offset >= total {
+		return []DataRightRequest{}, total, nil
+	}
+	matches = matches[filter.Offset:]
+	if filter.Limit > 0 && len(matches) > filter.Limit {
+		matches = matches[:filter.Limit]
+	}
+	return matches, total, nil
+}
+
+func (r *InMemoryDataRightRepository) UpdateRequest(_ context.Context, request DataRightRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.requests[request.ID]; !exists {
+		return ErrDataRightRequestNotFound
+	}
+	r.requests[request.ID] = copyDataRightRequest(request)
+	return nil
+}
+
+func (r *InMemoryDataRightRepository) StoreVerification(_ context.Context, verification DataRightVerification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.verifications[verification.SubjectID] = verification
+	return nil
+}
+
+func (r *InMemoryDataRightRepository) GetVerification(_ context.Context, subjectID string) (*DataRightVerification, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	verification, exists := r.verifications[subjectID]
+	if !exists {
+		return nil, ErrDataRightVerificationNotFound
+	}
+	return &verification, nil
+}
+
+func (r *InMemoryDataRightRepository) DeleteVerification(_ context.Context, subjectID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.verifications, subjectID)
+	return nil
+}
+
+// copyDataRightRequest detaches the update history and source results so
+// stored requests are not modified through returned values
+func copyDataRightRequest(request DataRightRequest) DataRightRequest {
+	request.Updates = append([]DataRightRequestUpdate(nil), request.Updates...)
+	request.Sources = append([]DataSourceResult(nil), request.Sources...)
+	return request
+}
+
+func newExportBundle(request DataRightRequest, records []SubjectRecord) *ExportBundle {
+	if records == nil {
+		records = []SubjectRecord{}
+	}
+	return &ExportBundle{
+		RequestID:   request.ID,
+		SubjectID:   request.SubjectID,
+		Type:        request.Type,
+		GeneratedAt: time.Now(),
+		Records:     records,
+	}
+}
@@ -0,0 +1,179 @@
+package compliance
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// SubjectRecord is one piece of personal data a data source holds about a
+// data subject
+type SubjectRecord struct {
+	Source string                 `json:"source"`
+	Type   string                 `json:"type"`
+	ID     string                 `json:"id"`
+	Data   map[string]interface{} `json:"data"`
+}
+
+// SubjectDataSource is a system holding personal data that takes part in data
+// subject rights requests. Implementations must be idempotent: a request
+// that partially failed is processed again from the start.
+type SubjectDataSource interface {
+	// Name identifies the source in request results and export bundles
+	Name() string
+	// Find returns every record held about the subject (right of access)
+	Find(ctx context.Context, subjectID string) ([]SubjectRecord, error)
+	// Export returns the records the subject provided, for portability
+	Export(ctx context.Context, subjectID string) ([]SubjectRecord, error)
+	// Rectify applies field corrections and returns how many records changed.
+	// Fields the source does not hold are ignored.
+	Rectify(ctx context.Context, subjectID string, corrections map[string]interface{}) (int, error)
+	// Erase deletes or irreversibly anonymizes the subject's records and
+	// returns how many were affected
+	Erase(ctx context.Context, subjectID string) (int, error)
+}
+
+// DataSourceRegistry holds the data sources consulted by data rights requests
+type DataSourceRegistry struct {
+	mu      sync.RWMutex
+	sources []SubjectDataSource
+}
+
+// NewDataSourceRegistry creates an empty registry
+func NewDataSourceRegistry() *DataSourceRegistry {
+	return &DataSourceRegistry{}
+}
+
+// Register adds a data source. Sources are processed in registration order,
+// so caches should be registered before the stores they are derived from.
+func (r *DataSourceRegistry) Register(source SubjectDataSource) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.sources {
+		if existing.Name() == source.Name() {
+			return fmt.Errorf("data source %q already registered", source.Name())
+		}
+	}
+	r.sources = append(r.sources, source)
+	return nil
+}
+
+// Sources returns the registered sources in registration order
+func (r *DataSourceRegistry) Sources() []SubjectDataSource {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]SubjectDataSource(nil), r.sources...)
+}
+
+// DataSourceStatus is the outcome of a data rights request at one source
+type DataSourceStatus string
+
+const (
+	DataSourceStatusCompleted DataSourceStatus = "completed"
+	DataSourceStatusFailed    DataSourceStatus = "failed"
+)
+
+// DataSourceResult records how one data source handled a data rights request
+type DataSourceResult struct {
+	Source      string           `json:"source"`
+	Status      DataSourceStatus `json:"status"`
+	Records     int              `json:"records"`
+	Error       string           `json:"error,omitempty"`
+	CompletedAt time.Time        `json:"completed_at"`
+}
+
+// ExportFormat is an encoding of an export bundle
+type ExportFormat string
+
+const (
+	ExportFormatJSON ExportFormat = "json"
+	ExportFormatCSV  ExportFormat = "csv"
+)
+
+// ContentType returns the MIME type of the format
+func (f ExportFormat) ContentType() string {
+	if f == ExportFormatCSV {
+		return "text/csv"
+	}
+	return "application/json"
+}
+
+// ExportBundle is the data handed to a subject for an access or portability
+// request
+type ExportBundle struct {
+	RequestID   string          `json:"request_id"`
+	SubjectID   string          `json:"subject_id"`
+	Type        DataRightType   `json:"type"`
+	GeneratedAt time.Time       `json:"generated_at"`
+	Records     []SubjectRecord `json:"records"`
+}
+
+// Encode renders the bundle. CSV output has one row per record field:
+// source, type, id, field, value; non-string values are JSON encoded.
+func (b *ExportBundle) Encode(format ExportFormat) ([]byte, error) {
+	switch format {
+	case ExportFormatJSON, "":
+		data, err := json.MarshalIndent(b, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("encoding export bundle: %w", err)
+		}
+		return data, nil
+	case ExportFormatCSV:
+		return b.encodeCSV()
+	default:
+		return nil, fmt.Errorf("invalid export format %q", format)
+	}
+}
+
+func (b *ExportBundle) encodeCSV() ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{"source", "type", "id", "field", "value"}); err != nil {
+		return nil, err
+	}
+
+	for _, record := range b.Records {
+		fields := make([]string, 0, len(record.Data))
+		for field := range record.Data {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			value, err := csvValue(record.Data[field])
+			if err != nil {
+				return nil, fmt.Errorf("encoding %s.%s: %w", record.ID, field, err)
+			}
+			if err := w.Write([]string{record.Source, record.Type, record.ID, field, value}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("encoding export bundle: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func csvValue(v interface{}) (string, error) {
+	switch value := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+}
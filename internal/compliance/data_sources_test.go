@@ -0,0 +1,196 @@
+package compliance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// fakeDataSource holds one record per subject
+type fakeDataSource struct {
+	name    string
+	records map[string]map[string]interface{}
+	err     error
+}
+
+func newFakeDataSource(name string) *fakeDataSource {
+	return &fakeDataSource{name: name, records: make(map[string]map[string]interface{})}
+}
+
+func (s *fakeDataSource) Name() string { return s.name }
+
+func (s *fakeDataSource) Find(_ context.Context, subjectID string) ([]SubjectRecord, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	data, ok := s.records[subjectID]
+	if !ok {
+		return nil, nil
+	}
+	return []SubjectRecord{{Type: "profile", ID: subjectID, Data: data}}, nil
+}
+
+func (s *fakeDataSource) Export(ctx context.Context, subjectID string) ([]SubjectRecord, error) {
+	return s.Find(ctx, subjectID)
+}
+
+func (s *fakeDataSource) Rectify(_ context.Context, subjectID string, corrections map[string]interface{}) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	data, ok := s.records[subjectID]
+	if !ok {
+		return 0, nil
+	}
+	for field, value := range corrections {
+		data[field] = value
+	}
+	return 1, nil
+}
+
+func (s *fakeDataSource) Erase(_ context.Context, subjectID string) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	if _, ok := s.records[subjectID]; !ok {
+		return 0, nil
+	}
+	delete(s.records, subjectID)
+	return 1, nil
+}
+
+func submitVerified(t *testing.T, framework *Framework, request DataRightRequest) *DataRightRequest {
+	t.Helper()
+	code, err := framework.IssueVerificationCode(context.Background(), request.SubjectID)
+	require.NoError(t, err)
+	request.VerificationCode = code
+	submitted, err := framework.SubmitDataRightRequest(context.Background(), request)
+	require.NoError(t, err)
+	return submitted
+}
+
+func TestDataSourceRegistry_RejectsDuplicates(t *testing.T) {
+	registry := NewDataSourceRegistry()
+	require.NoError(t, registry.Register(newFakeDataSource("users")))
+	require.NoError(t, registry.Register(newFakeDataSource("tasks")))
+	assert.Error(t, registry.Register(newFakeDataSource("users")))
+
+	sources := registry.Sources()
+	require.Len(t, sources, 2)
+	assert.Equal(t, "users", sources[0].Name())
+	assert.Equal(t, "tasks", sources[1].Name())
+}
+
+func TestFramework_ProcessDataRightRequest_Access(t *testing.T) {
+	framework := createTestFramework(t)
+	ctx := context.Background()
+
+	users := newFakeDataSource("users")
+	users.records["subject-1"] = map[string]interface{}{"email": "ana@example.com", "age": 30}
+	require.NoError(t, framework.RegisterDataSource(users))
+
+	request := submitVerified(t, framework, DataRightRequest{SubjectID: "subject-1", Type: DataRightAccess})
+	assert.False(t, request.DueAt.IsZero())
+
+	processed, err := framework.ProcessDataRightRequest(ctx, request.ID, "dpo")
+	require.NoError(t, err)
+	assert.Equal(t, DataRightStatusCompleted, processed.Status)
+	require.Len(t, processed.Sources, 1)
+	assert.Equal(t, DataSourceStatusCompleted, processed.Sources[0].Status)
+	assert.Equal(t, 1, processed.Sources[0].Records)
+
+	raw, err := framework.GetDataRightExport(ctx, request.ID, ExportFormatJSON)
+	require.NoError(t, err)
+	var bundle ExportBundle
+	require.NoError(t, json.Unmarshal(raw, &bundle))
+	require.Len(t, bundle.Records, 1)
+	assert.Equal(t, "users", bundle.Records[0].Source)
+	assert.Equal(t, "ana@example.com", bundle.Records[0].Data["email"])
+
+	csv, err := framework.GetDataRightExport(ctx, request.ID, ExportFormatCSV)
+	require.NoError(t, err)
+	assert.Equal(t,
+		"source,type,id,field,value\nusers,profile,subject-1,age,30\nusers,profile,subject-1,email,ana@example.com\n",
+		string(csv))
+
+	_, err = framework.GetDataRightExport(ctx, request.ID, "xml")
+	assert.Error(t, err)
+}
+
+func TestFramework_ProcessDataRightRequest_PartialFailure(t *testing.T) {
+	framework := createTestFramework(t)
+	ctx := context.Background()
+
+	users := newFakeDataSource("users")
+	users.records["subject-1"] = map[string]interface{}{"email": "ana@example.com"}
+	events := newFakeDataSource("events")
+	events.err = errors.New("event store unreachable")
+	require.NoError(t, framework.RegisterDataSource(events))
+	require.NoError(t, framework.RegisterDataSource(users))
+
+	request := submitVerified(t, framework, DataRightRequest{SubjectID: "subject-1", Type: DataRightErasure})
+	processed, err := framework.ProcessDataRightRequest(ctx, request.ID, "dpo")
+	require.NoError(t, err)
+	assert.Equal(t, DataRightStatusPartial, processed.Status)
+	assert.Equal(t, []string{"events"}, processed.FailedSources())
+	assert.Empty(t, users.records, "healthy sources still erase")
+
+	// A partial request is processed again once the failing source recovers
+	events.err = nil
+	processed, err = framework.ProcessDataRightRequest(ctx, request.ID, "dpo")
+	require.NoError(t, err)
+	assert.Equal(t, DataRightStatusCompleted, processed.Status)
+	assert.Empty(t, processed.FailedSources())
+
+	_, err = framework.GetDataRightExport(ctx, request.ID, ExportFormatJSON)
+	assert.ErrorIs(t, err, ErrNoExport)
+}
+
+func TestFramework_ProcessDataRightRequest_LegalHold(t *testing.T) {
+	framework := createTestFramework(t)
+	ctx := context.Background()
+
+	subjectID := types.New()
+	users := newFakeDataSource("users")
+	users.records[subjectID.String()] = map[string]interface{}{"email": "ana@example.com"}
+	require.NoError(t, framework.RegisterDataSource(users))
+
+	require.NoError(t, framework.RecordDataCreation(ctx, subjectID, "personal", map[string]interface{}{"email": "ana@example.com"}))
+	require.NoError(t, framework.GetRetentionManager().PlaceLegalHold(ctx, subjectID.String(), "litigation"))
+
+	request := submitVerified(t, framework, DataRightRequest{SubjectID: subjectID.String(), Type: DataRightErasure})
+	processed, err := framework.ProcessDataRightRequest(ctx, request.ID, "dpo")
+	require.NoError(t, err)
+	assert.Equal(t, DataRightStatusRejected, processed.Status)
+	assert.Contains(t, processed.Updates[len(processed.Updates)-1].Message, "litigation")
+	assert.NotEmpty(t, users.records, "data under legal hold is kept")
+}
+
+func TestFramework_ProcessDataRightRequest_Rectification(t *testing.T) {
+	framework := createTestFramework(t)
+	ctx := context.Background()
+
+	users := newFakeDataSource("users")
+	users.records["subject-1"] = map[string]interface{}{"email": "old@example.com"}
+	require.NoError(t, framework.RegisterDataSource(users))
+
+	request := submitVerified(t, framework, DataRightRequest{SubjectID: "subject-1", Type: DataRightRectification})
+	processed, err := framework.ProcessDataRightRequest(ctx, request.ID, "dpo")
+	require.NoError(t, err)
+	assert.Equal(t, DataRightStatusRejected, processed.Status, "corrections are required")
+
+	request = submitVerified(t, framework, DataRightRequest{
+		SubjectID: "subject-1",
+		Type:      DataRightRectification,
+		Data:      map[string]interface{}{"corrections": map[string]interface{}{"email": "new@example.com"}},
+	})
+	processed, err = framework.ProcessDataRightRequest(ctx, request.ID, "dpo")
+	require.NoError(t, err)
+	assert.Equal(t, DataRightStatusCompleted, processed.Status)
+	assert.Equal(t, "new@example.com", users.records["subject-1"]["email"])
+}
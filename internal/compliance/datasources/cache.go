@@ -0,0 +1,118 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+)
+
+// CacheSource exposes cached copies of the subject's tasks. Cached values
+// duplicate the task store, so access requests list the keys only.
+type CacheSource struct {
+	cache domain.CacheRepository
+	tasks domain.TaskRepository
+}
+
+// NewCacheSource creates a data source over the cache. The task repository
+// resolves which keys hold the subject's data.
+func NewCacheSource(cache domain.CacheRepository, tasks domain.TaskRepository) *CacheSource {
+	return &CacheSource{cache: cache, tasks: tasks}
+}
+
+// Name returns "cache"
+func (s *CacheSource) Name() string {
+	return "cache"
+}
+
+// Find returns the cache keys currently holding the subject's tasks
+func (s *CacheSource) Find(ctx context.Context, subjectID string) ([]compliance.SubjectRecord, error) {
+	tasks, err := s.subjectTasks(tenant.WithAll(ctx), subjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []compliance.SubjectRecord
+	for _, task := range tasks {
+		taskCtx, key := cacheKey(ctx, task)
+		exists, err := s.cache.Exists(taskCtx, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check cache key %s: %w", key, err)
+		}
+		if exists {
+			records = append(records, compliance.SubjectRecord{
+				Source: s.Name(),
+				Type:   "cache_entry",
+				ID:     key,
+				Data:   map[string]interface{}{"key": key, "tenant_id": task.TenantID},
+			})
+		}
+	}
+	return records, nil
+}
+
+// Export returns nothing: the cache holds no data of its own
+func (s *CacheSource) Export(context.Context, string) ([]compliance.SubjectRecord, error) {
+	return nil, nil
+}
+
+// Rectify evicts the subject's cached tasks so no stale copy outlives the
+// correction
+func (s *CacheSource) Rectify(ctx context.Context, subjectID string, _ map[string]interface{}) (int, error) {
+	return s.evict(ctx, subjectID)
+}
+
+// Erase evicts the subject's cached tasks
+func (s *CacheSource) Erase(ctx context.Context, subjectID string) (int, error) {
+	return s.evict(ctx, subjectID)
+}
+
+func (s *CacheSource) evict(ctx context.Context, subjectID string) (int, error) {
+	tasks, err := s.subjectTasks(tenant.WithAll(ctx), subjectID)
+	if err != nil {
+		return 0, err
+	}
+	return s.evictTasks(ctx, tasks)
+}
+
+// evictTasks deletes the cached copies of tasks, returning how many there
+// were
+func (s *CacheSource) evictTasks(ctx context.Context, tasks []*domain.Task) (int, error) {
+	evicted := 0
+	for _, task := range tasks {
+		taskCtx, key := cacheKey(ctx, task)
+		exists, err := s.cache.Exists(taskCtx, key)
+		if err != nil {
+			return evicted, fmt.Errorf("failed to check cache key %s: %w", key, err)
+		}
+		if !exists {
+			continue
+		}
+		if err := s.cache.Delete(taskCtx, key); err != nil {
+			return evicted, fmt.Errorf("failed to delete cache key %s: %w", key, err)
+		}
+		evicted++
+	}
+	return evicted, nil
+}
+
+// subjectTasks returns the tasks the subject created or is assigned to
+func (s *CacheSource) subjectTasks(ctx context.Context, subjectID string) ([]*domain.Task, error) {
+	id, ok := parseSubject(subjectID)
+	if !ok {
+		return nil, nil
+	}
+	created, assigned, err := subjectTasks(ctx, s.tasks, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	return append(created, assigned...), nil
+}
+
+// cacheKey returns the key the task service caches task under, and ctx
+// scoped to the task's tenant, whose cache holds that key
+func cacheKey(ctx context.Context, task *domain.Task) (context.Context, string) {
+	return tenant.WithID(ctx, task.TenantID), fmt.Sprintf("task:%s", task.ID)
+}
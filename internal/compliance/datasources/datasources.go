@@ -0,0 +1,109 @@
+// Package datasources adapts the service's repositories to the compliance
+// framework so data subject rights requests reach every copy of a user's
+// personal data.
+//
+// The subject of a request is the user ID. Register the sources with
+// compliance.Framework.RegisterDataSource in the order returned by Sources:
+// cached copies are removed before the stores they were read from.
+//
+// Users are shared by every tenant, so the sources and retention targets
+// act across tenants: a subject's tasks and events are found wherever they
+// are stored, and cached copies evicted from their tenant's cache.
+package datasources
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// pageSize bounds the tasks read per repository call
+const pageSize = 100
+
+// TaskService deletes and unassigns tasks the way the API does, so the
+// task.deleted and task.updated events are published.
+// services.TaskService implements it.
+type TaskService interface {
+	DeleteTask(ctx context.Context, id types.UUID) error
+	UnassignTask(ctx context.Context, id types.UUID) (*domain.Task, error)
+}
+
+// Sources returns the data sources for the task, user, event and cache
+// repositories in registration order. Tasks are read from the repository
+// and erased through service.
+func Sources(
+	tasks domain.TaskRepository,
+	service TaskService,
+	users domain.UserRepository,
+	events EventStore,
+	cache domain.CacheRepository,
+) []compliance.SubjectDataSource {
+	return []compliance.SubjectDataSource{
+		NewCacheSource(cache, tasks),
+		NewEventSource(events, tasks),
+		NewTaskSource(tasks, service),
+		NewUserSource(users),
+	}
+}
+
+// parseSubject converts a subject ID into a user ID. Subjects that are not
+// user IDs hold no data in these repositories.
+func parseSubject(subjectID string) (types.UUID, bool) {
+	id, err := types.Parse(subjectID)
+	if err != nil {
+		return types.Nil, false
+	}
+	return id, true
+}
+
+// subjectTasks returns the tasks the subject created and the tasks assigned
+// to them that they did not create
+func subjectTasks(ctx context.Context, tasks domain.TaskRepository, subjectID types.UUID) (created, assigned []*domain.Task, err error) {
+	created, err = listTasks(ctx, tasks, domain.TaskFilter{CreatedBy: &subjectID})
+	if err != nil {
+		return nil, nil, err
+	}
+	all, err := listTasks(ctx, tasks, domain.TaskFilter{AssigneeID: &subjectID})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, task := range all {
+		if task.CreatedBy != subjectID {
+			assigned = append(assigned, task)
+		}
+	}
+	return created, assigned, nil
+}
+
+func listTasks(ctx context.Context, tasks domain.TaskRepository, filter domain.TaskFilter) ([]*domain.Task, error) {
+	var result []*domain.Task
+	filter.Limit = pageSize
+	filter.Count = domain.TaskCountNone
+	for {
+		page, _, err := tasks.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page...)
+		if len(page) < filter.Limit {
+			return result, nil
+		}
+		filter.After = filter.NextCursor(page[len(page)-1])
+	}
+}
+
+// isNotFound reports whether err is a repository's not found error
+func isNotFound(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "not found")
+}
+
+func formatTime(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
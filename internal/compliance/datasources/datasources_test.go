@@ -0,0 +1,215 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/repository/memory"
+	"github.com/vertikon/mcp-ultra/internal/services"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+type fixture struct {
+	tasks     *memory.TaskRepository
+	users     *memory.UserRepository
+	events    *memory.EventRepository
+	cache     *memory.CacheRepository
+	service   *services.TaskService
+	framework *compliance.Framework
+
+	mu        sync.Mutex
+	published []*domain.Event
+
+	subject   *domain.User
+	other     *domain.User
+	ownTask   *domain.Task
+	otherTask *domain.Task
+}
+
+// newFixture stores a subject who created one task and is assigned to a
+// task created by another user
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	ctx := context.Background()
+
+	f := &fixture{
+		tasks:  memory.NewTaskRepository(),
+		users:  memory.NewUserRepository(),
+		events: memory.NewEventRepository(),
+		cache:  memory.NewCacheRepository(),
+	}
+	bus := memory.NewEventBus()
+	bus.Subscribe(func(_ context.Context, event *domain.Event) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.published = append(f.published, event)
+	})
+	f.service = services.NewTaskService(f.tasks, f.users, f.events, f.cache, zaptest.NewLogger(t), bus)
+
+	framework, err := compliance.NewFramework(compliance.Config{
+		Enabled:      true,
+		PIIDetection: compliance.PIIDetectionConfig{Enabled: true},
+		Consent:      compliance.ConsentConfig{Enabled: true, TTL: time.Hour},
+		AuditLogging: compliance.AuditLoggingConfig{Enabled: true, DetailLevel: "minimal"},
+		DataRights:   compliance.DataRightsConfig{Enabled: true, ResponseTime: 15 * 24 * time.Hour},
+	}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	for _, source := range Sources(f.tasks, f.service, f.users, f.events, f.cache) {
+		require.NoError(t, framework.RegisterDataSource(source))
+	}
+	f.framework = framework
+
+	now := time.Now()
+	f.subject = &domain.User{ID: types.New(), Email: "ana@example.com", Name: "Ana", Role: domain.RoleUser, Active: true, CreatedAt: now, UpdatedAt: now}
+	f.other = &domain.User{ID: types.New(), Email: "bia@example.com", Name: "Bia", Role: domain.RoleUser, Active: true, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, f.users.Create(ctx, f.subject))
+	require.NoError(t, f.users.Create(ctx, f.other))
+
+	f.ownTask = &domain.Task{ID: types.New(), Title: "Ana's task", Status: domain.TaskStatusPending, Priority: domain.PriorityLow, CreatedBy: f.subject.ID, CreatedAt: now, UpdatedAt: now}
+	f.otherTask = &domain.Task{ID: types.New(), Title: "Bia's task", Status: domain.TaskStatusPending, Priority: domain.PriorityLow, CreatedBy: f.other.ID, AssigneeID: &f.subject.ID, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, f.tasks.Create(ctx, f.ownTask))
+	require.NoError(t, f.tasks.Create(ctx, f.otherTask))
+
+	for _, aggregateID := range []types.UUID{f.subject.ID, f.ownTask.ID, f.otherTask.ID} {
+		require.NoError(t, f.events.Store(ctx, &domain.Event{ID: types.New(), Type: "test.created", AggregateID: aggregateID, OccurredAt: now, Version: 1}))
+	}
+	require.NoError(t, f.cache.Set(ctx, "task:"+f.ownTask.ID.String(), "{}", 300))
+	require.NoError(t, f.cache.Set(ctx, "task:"+f.otherTask.ID.String(), "{}", 300))
+
+	return f
+}
+
+func (f *fixture) process(t *testing.T, request compliance.DataRightRequest) *compliance.DataRightRequest {
+	t.Helper()
+	ctx := context.Background()
+
+	request.SubjectID = f.subject.ID.String()
+	submitted, err := f.framework.SubmitDataRightRequest(ctx, request)
+	require.NoError(t, err)
+	processed, err := f.framework.ProcessDataRightRequest(ctx, submitted.ID, "dpo")
+	require.NoError(t, err)
+	require.Equal(t, compliance.DataRightStatusCompleted, processed.Status, processed.Updates[len(processed.Updates)-1].Message)
+	return processed
+}
+
+// publishedTypes returns the type of each published event by aggregate
+func (f *fixture) publishedTypes() map[types.UUID][]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	published := make(map[types.UUID][]string)
+	for _, event := range f.published {
+		published[event.AggregateID] = append(published[event.AggregateID], event.Type)
+	}
+	return published
+}
+
+func recordsBySource(request *compliance.DataRightRequest) map[string]int {
+	counts := make(map[string]int)
+	for _, source := range request.Sources {
+		counts[source.Source] = source.Records
+	}
+	return counts
+}
+
+func TestSources_Access(t *testing.T) {
+	f := newFixture(t)
+
+	processed := f.process(t, compliance.DataRightRequest{Type: compliance.DataRightAccess})
+	assert.Equal(t, map[string]int{"cache": 2, "events": 2, "tasks": 2, "users": 1}, recordsBySource(processed))
+
+	raw, err := f.framework.GetDataRightExport(context.Background(), processed.ID, compliance.ExportFormatJSON)
+	require.NoError(t, err)
+	var bundle compliance.ExportBundle
+	require.NoError(t, json.Unmarshal(raw, &bundle))
+	assert.Len(t, bundle.Records, 7)
+}
+
+func TestSources_Portability(t *testing.T) {
+	f := newFixture(t)
+
+	processed := f.process(t, compliance.DataRightRequest{Type: compliance.DataRightPortability})
+	assert.Equal(t, map[string]int{"cache": 0, "events": 0, "tasks": 1, "users": 1}, recordsBySource(processed))
+}
+
+func TestSources_Rectification(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	f.process(t, compliance.DataRightRequest{
+		Type: compliance.DataRightRectification,
+		Data: map[string]interface{}{"corrections": map[string]interface{}{"email": "ana.silva@example.com"}},
+	})
+
+	user, err := f.users.GetByID(ctx, f.subject.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "ana.silva@example.com", user.Email)
+	exists, err := f.cache.Exists(ctx, "task:"+f.ownTask.ID.String())
+	require.NoError(t, err)
+	assert.False(t, exists, "stale cached copies are evicted")
+}
+
+func TestSources_Erasure(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	processed := f.process(t, compliance.DataRightRequest{Type: compliance.DataRightErasure})
+	assert.Equal(t, map[string]int{"cache": 2, "events": 2, "tasks": 2, "users": 1}, recordsBySource(processed))
+
+	_, err := f.users.GetByID(ctx, f.subject.ID)
+	assert.Error(t, err)
+	_, err = f.tasks.GetByID(ctx, f.ownTask.ID)
+	assert.Error(t, err)
+
+	otherTask, err := f.tasks.GetByID(ctx, f.otherTask.ID)
+	require.NoError(t, err)
+	assert.Nil(t, otherTask.AssigneeID, "tasks of other users are kept but unassigned")
+	otherEvents, err := f.events.GetByAggregateID(ctx, f.otherTask.ID)
+	require.NoError(t, err)
+	assert.Len(t, otherEvents, 2, "the unassignment is recorded")
+
+	assert.Equal(t, map[types.UUID][]string{
+		f.ownTask.ID:   {"task.deleted"},
+		f.otherTask.ID: {"task.updated"},
+	}, f.publishedTypes(), "subscribers see the erasure")
+
+	events, err := f.events.GetByAggregateID(ctx, f.subject.ID)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	events, err = f.events.GetByAggregateID(ctx, f.ownTask.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 1, "only the deletion of the erased task is left")
+	assert.Equal(t, "task.deleted", events[0].Type)
+
+	_, err = f.users.GetByID(ctx, f.other.ID)
+	assert.NoError(t, err, "other users are untouched")
+}
+
+func TestSources_ErasureAcrossTenants(t *testing.T) {
+	f := newFixture(t)
+	acme := tenant.WithID(context.Background(), "acme")
+
+	now := time.Now()
+	acmeTask := &domain.Task{ID: types.New(), Title: "Ana's acme task", Status: domain.TaskStatusPending, Priority: domain.PriorityLow, CreatedBy: f.subject.ID, CreatedAt: now, UpdatedAt: now}
+	require.NoError(t, f.tasks.Create(acme, acmeTask))
+	require.NoError(t, f.cache.Set(acme, "task:"+acmeTask.ID.String(), "{}", 300))
+
+	processed := f.process(t, compliance.DataRightRequest{Type: compliance.DataRightErasure})
+	assert.Equal(t, 3, recordsBySource(processed)["tasks"])
+	assert.Equal(t, 3, recordsBySource(processed)["cache"])
+
+	_, err := f.tasks.GetByID(acme, acmeTask.ID)
+	assert.Error(t, err)
+	exists, err := f.cache.Exists(acme, "task:"+acmeTask.ID.String())
+	require.NoError(t, err)
+	assert.False(t, exists, "cached copies are evicted from their tenant's cache")
+}
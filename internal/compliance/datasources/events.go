@@ -0,0 +1,124 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// EventStore is an event repository that can erase an aggregate's history
+type EventStore interface {
+	domain.EventRepository
+	// DeleteByAggregateID removes every event of an aggregate and returns
+	// how many were removed
+	DeleteByAggregateID(ctx context.Context, aggregateID types.UUID) (int, error)
+	// ReplaceData overwrites the payload of one event
+	ReplaceData(ctx context.Context, eventID types.UUID, data map[string]interface{}) error
+}
+
+// EventSource exposes the domain events about a subject: events of their
+// user aggregate and of the tasks they created
+type EventSource struct {
+	events EventStore
+	tasks  domain.TaskRepository
+}
+
+// NewEventSource creates a data source over the event store. The task
+// repository resolves which task aggregates belong to the subject, so this
+// source must run before the task source erases them.
+func NewEventSource(events EventStore, tasks domain.TaskRepository) *EventSource {
+	return &EventSource{events: events, tasks: tasks}
+}
+
+// Name returns "events"
+func (s *EventSource) Name() string {
+	return "events"
+}
+
+// Find returns the events of the subject's aggregates
+func (s *EventSource) Find(ctx context.Context, subjectID string) ([]compliance.SubjectRecord, error) {
+	ctx = tenant.WithAll(ctx)
+
+	aggregates, err := s.aggregates(ctx, subjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []compliance.SubjectRecord
+	for _, aggregateID := range aggregates {
+		events, err := s.events.GetByAggregateID(ctx, aggregateID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get events of %s: %w", aggregateID, err)
+		}
+		for _, event := range events {
+			records = append(records, compliance.SubjectRecord{
+				Source: s.Name(),
+				Type:   "event",
+				ID:     event.ID.String(),
+				Data: map[string]interface{}{
+					"type":         event.Type,
+					"aggregate_id": event.AggregateID.String(),
+					"data":         event.Data,
+					"occurred_at":  formatTime(&event.OccurredAt),
+					"version":      event.Version,
+				},
+			})
+		}
+	}
+	return records, nil
+}
+
+// Export returns nothing: events are generated by the service, not provided
+// by the subject
+func (s *EventSource) Export(context.Context, string) ([]compliance.SubjectRecord, error) {
+	return nil, nil
+}
+
+// Rectify is a no-op: events record what happened and are not rewritten
+func (s *EventSource) Rectify(context.Context, string, map[string]interface{}) (int, error) {
+	return 0, nil
+}
+
+// Erase removes the events of the subject's aggregates
+func (s *EventSource) Erase(ctx context.Context, subjectID string) (int, error) {
+	ctx = tenant.WithAll(ctx)
+
+	aggregates, err := s.aggregates(ctx, subjectID)
+	if err != nil {
+		return 0, err
+	}
+
+	erased := 0
+	for _, aggregateID := range aggregates {
+		deleted, err := s.events.DeleteByAggregateID(ctx, aggregateID)
+		erased += deleted
+		if err != nil {
+			return erased, fmt.Errorf("failed to delete events of %s: %w", aggregateID, err)
+		}
+	}
+	return erased, nil
+}
+
+// aggregates returns the subject's user ID followed by the IDs of the tasks
+// they created
+func (s *EventSource) aggregates(ctx context.Context, subjectID string) ([]types.UUID, error) {
+	id, ok := parseSubject(subjectID)
+	if !ok {
+		return nil, nil
+	}
+	created, err := listTasks(ctx, s.tasks, domain.TaskFilter{CreatedBy: &id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	aggregates := make([]types.UUID, 0, len(created)+1)
+	aggregates = append(aggregates, id)
+	for _, task := range created {
+		aggregates = append(aggregates, task.ID)
+	}
+	return aggregates, nil
+}
@@ -0,0 +1,269 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// RetentionTargets returns the retention targets for the task, event and
+// cache repositories in registration order. Like Sources, the cache and
+// events resolve the subject's tasks, so they run before the tasks go.
+// Expired tasks are deleted through service.
+func RetentionTargets(
+	tasks domain.TaskRepository,
+	service TaskService,
+	events EventStore,
+	cache domain.CacheRepository,
+) []compliance.RetentionTarget {
+	return []compliance.RetentionTarget{
+		NewCacheSource(cache, tasks),
+		NewEventSource(events, tasks),
+		NewTaskSource(tasks, service),
+	}
+}
+
+// handlesTasks reports whether a retention data type covers tasks
+func handlesTasks(dataType string) bool {
+	return dataType == "task_data" || dataType == "operational_data"
+}
+
+// retainedTasks returns the tasks a retention record covers: the task it
+// names, or every task the subject created before the record's retention
+// started. Tasks created later are covered by their own records.
+func retainedTasks(ctx context.Context, tasks domain.TaskRepository, record compliance.RetentionRecord) ([]*domain.Task, error) {
+	subjectID, ok := parseSubject(record.SubjectID)
+	if !ok {
+		return nil, nil
+	}
+
+	if resource := record.Resource(); resource != "" {
+		taskID, err := types.Parse(resource)
+		if err != nil {
+			return nil, nil
+		}
+		task, err := tasks.GetByID(ctx, taskID)
+		if isNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get task %s: %w", taskID, err)
+		}
+		if task.CreatedBy != subjectID {
+			return nil, nil
+		}
+		return []*domain.Task{task}, nil
+	}
+
+	created, err := listTasks(ctx, tasks, domain.TaskFilter{CreatedBy: &subjectID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	retained := created[:0]
+	for _, task := range created {
+		if !task.CreatedAt.After(record.RetentionStart) {
+			retained = append(retained, task)
+		}
+	}
+	return retained, nil
+}
+
+// Handles reports whether dataType covers tasks
+func (s *TaskSource) Handles(dataType string) bool {
+	return handlesTasks(dataType)
+}
+
+// Retained returns the tasks the record covers
+func (s *TaskSource) Retained(ctx context.Context, record compliance.RetentionRecord) ([]compliance.SubjectRecord, error) {
+	ctx = tenant.WithAll(ctx)
+
+	tasks, err := retainedTasks(ctx, s.tasks, record)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]compliance.SubjectRecord, 0, len(tasks))
+	for _, task := range tasks {
+		records = append(records, s.record(task, "creator"))
+	}
+	return records, nil
+}
+
+// DeleteRetained deletes the tasks the record covers
+func (s *TaskSource) DeleteRetained(ctx context.Context, record compliance.RetentionRecord) (int, error) {
+	ctx = tenant.WithAll(ctx)
+
+	tasks, err := retainedTasks(ctx, s.tasks, record)
+	if err != nil {
+		return 0, err
+	}
+	deleted := 0
+	for _, task := range tasks {
+		if err := s.service.DeleteTask(tenant.WithID(ctx, task.TenantID), task.ID); err != nil && !isNotFound(err) {
+			return deleted, fmt.Errorf("failed to delete task %s: %w", task.ID, err)
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// AnonymizeRetained rewrites the free text of the tasks the record covers.
+// Title and description are always anonymized, metadata where PII is
+// detected; status, dates and IDs stay for reporting.
+func (s *TaskSource) AnonymizeRetained(ctx context.Context, record compliance.RetentionRecord, anonymize compliance.AnonymizeFunc) (int, error) {
+	ctx = tenant.WithAll(ctx)
+
+	tasks, err := retainedTasks(ctx, s.tasks, record)
+	if err != nil {
+		return 0, err
+	}
+	anonymized := 0
+	for _, task := range tasks {
+		text, err := anonymize(map[string]interface{}{
+			"title":       task.Title,
+			"description": task.Description,
+		}, "title", "description")
+		if err != nil {
+			return anonymized, fmt.Errorf("failed to anonymize task %s: %w", task.ID, err)
+		}
+		metadata, err := anonymize(task.Metadata)
+		if err != nil {
+			return anonymized, fmt.Errorf("failed to anonymize task %s metadata: %w", task.ID, err)
+		}
+
+		task.Title = fmt.Sprint(text["title"])
+		task.Description = fmt.Sprint(text["description"])
+		task.Metadata = metadata
+		task.UpdatedAt = time.Now()
+		if err := s.tasks.Update(ctx, task); err != nil && !isNotFound(err) {
+			return anonymized, fmt.Errorf("failed to update task %s: %w", task.ID, err)
+		}
+		anonymized++
+	}
+	return anonymized, nil
+}
+
+// Handles reports whether dataType covers tasks, whose events this source
+// holds
+func (s *EventSource) Handles(dataType string) bool {
+	return handlesTasks(dataType)
+}
+
+// Retained returns the events of the tasks the record covers
+func (s *EventSource) Retained(ctx context.Context, record compliance.RetentionRecord) ([]compliance.SubjectRecord, error) {
+	ctx = tenant.WithAll(ctx)
+
+	events, err := s.retainedEvents(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]compliance.SubjectRecord, 0, len(events))
+	for _, event := range events {
+		records = append(records, compliance.SubjectRecord{
+			Source: s.Name(),
+			Type:   "event",
+			ID:     event.ID.String(),
+			Data: map[string]interface{}{
+				"type":         event.Type,
+				"aggregate_id": event.AggregateID.String(),
+				"data":         event.Data,
+				"occurred_at":  formatTime(&event.OccurredAt),
+				"version":      event.Version,
+			},
+		})
+	}
+	return records, nil
+}
+
+// DeleteRetained removes the events of the tasks the record covers
+func (s *EventSource) DeleteRetained(ctx context.Context, record compliance.RetentionRecord) (int, error) {
+	ctx = tenant.WithAll(ctx)
+
+	tasks, err := retainedTasks(ctx, s.tasks, record)
+	if err != nil {
+		return 0, err
+	}
+	deleted := 0
+	for _, task := range tasks {
+		count, err := s.events.DeleteByAggregateID(ctx, task.ID)
+		deleted += count
+		if err != nil {
+			return deleted, fmt.Errorf("failed to delete events of %s: %w", task.ID, err)
+		}
+	}
+	return deleted, nil
+}
+
+// AnonymizeRetained rewrites the payloads of the events of the tasks the
+// record covers. Task events carry the title and description, so those are
+// always anonymized.
+func (s *EventSource) AnonymizeRetained(ctx context.Context, record compliance.RetentionRecord, anonymize compliance.AnonymizeFunc) (int, error) {
+	ctx = tenant.WithAll(ctx)
+
+	events, err := s.retainedEvents(ctx, record)
+	if err != nil {
+		return 0, err
+	}
+	anonymized := 0
+	for _, event := range events {
+		data, err := anonymize(event.Data, "title", "description")
+		if err != nil {
+			return anonymized, fmt.Errorf("failed to anonymize event %s: %w", event.ID, err)
+		}
+		if err := s.events.ReplaceData(ctx, event.ID, data); err != nil {
+			return anonymized, fmt.Errorf("failed to update event %s: %w", event.ID, err)
+		}
+		anonymized++
+	}
+	return anonymized, nil
+}
+
+func (s *EventSource) retainedEvents(ctx context.Context, record compliance.RetentionRecord) ([]*domain.Event, error) {
+	tasks, err := retainedTasks(ctx, s.tasks, record)
+	if err != nil {
+		return nil, err
+	}
+	var events []*domain.Event
+	for _, task := range tasks {
+		taskEvents, err := s.events.GetByAggregateID(ctx, task.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get events of %s: %w", task.ID, err)
+		}
+		events = append(events, taskEvents...)
+	}
+	return events, nil
+}
+
+// Handles reports whether dataType covers tasks, which this source caches
+func (s *CacheSource) Handles(dataType string) bool {
+	return handlesTasks(dataType)
+}
+
+// Retained returns nothing: cached tasks are copies of the task store,
+// which archives them
+func (s *CacheSource) Retained(context.Context, compliance.RetentionRecord) ([]compliance.SubjectRecord, error) {
+	return nil, nil
+}
+
+// DeleteRetained evicts the cached copies of the tasks the record covers
+func (s *CacheSource) DeleteRetained(ctx context.Context, record compliance.RetentionRecord) (int, error) {
+	return s.evictRetained(ctx, record)
+}
+
+// AnonymizeRetained evicts the cached copies of the tasks the record
+// covers, so reads fall through to the anonymized tasks
+func (s *CacheSource) AnonymizeRetained(ctx context.Context, record compliance.RetentionRecord, _ compliance.AnonymizeFunc) (int, error) {
+	return s.evictRetained(ctx, record)
+}
+
+func (s *CacheSource) evictRetained(ctx context.Context, record compliance.RetentionRecord) (int, error) {
+	tasks, err := retainedTasks(tenant.WithAll(ctx), s.tasks, record)
+	if err != nil {
+		return 0, err
+	}
+	return s.evictTasks(ctx, tasks)
+}
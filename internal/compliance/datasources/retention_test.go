@@ -0,0 +1,132 @@
+package datasources
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// newRetentionFixture extends the fixture with a framework enforcing
+// retention over its repositories
+func newRetentionFixture(t *testing.T) (*fixture, *compliance.InMemoryRetentionRepository) {
+	t.Helper()
+	f := newFixture(t)
+
+	records := compliance.NewInMemoryRetentionRepository()
+	framework, err := compliance.NewFramework(compliance.Config{
+		Enabled:      true,
+		PIIDetection: compliance.PIIDetectionConfig{Enabled: true},
+		DataRetention: compliance.DataRetentionConfig{
+			Enabled:       true,
+			DefaultPeriod: time.Hour,
+			ArchivePath:   filepath.Join(t.TempDir(), "archive.log"),
+		},
+		AuditLogging: compliance.AuditLoggingConfig{Enabled: true, DetailLevel: "minimal"},
+	}, zaptest.NewLogger(t), compliance.WithRetentionRepository(records))
+	require.NoError(t, err)
+	for _, target := range RetentionTargets(f.tasks, f.service, f.events, f.cache) {
+		require.NoError(t, framework.RegisterRetentionTarget(target))
+	}
+	f.framework = framework
+	return f, records
+}
+
+// expire ends the retention of every record of the subject with action
+func expire(t *testing.T, records compliance.RetentionRepository, subjectID string, action compliance.RetentionAction) {
+	t.Helper()
+	ctx := context.Background()
+	stored, err := records.GetRetentionRecords(ctx, subjectID)
+	require.NoError(t, err)
+	require.NotEmpty(t, stored)
+	for _, record := range stored {
+		record.RetentionEnd = time.Now().Add(-time.Minute)
+		record.GraceEnd = nil
+		record.Action = action
+		require.NoError(t, records.UpdateRetentionRecord(ctx, record))
+	}
+}
+
+func TestRetentionTargets_DeleteTask(t *testing.T) {
+	f, records := newRetentionFixture(t)
+	ctx := context.Background()
+
+	require.NoError(t, f.framework.RecordDataCreation(ctx, f.subject.ID, "task_data", map[string]interface{}{
+		"task_id": f.ownTask.ID.String(),
+	}))
+	expire(t, records, f.subject.ID.String(), compliance.RetentionActionDelete)
+
+	summary, err := f.framework.EnforceRetention(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, summary.Failed)
+	assert.Equal(t, summary.Expired, summary.Completed)
+
+	_, err = f.tasks.GetByID(ctx, f.ownTask.ID)
+	assert.Error(t, err)
+	events, err := f.events.GetByAggregateID(ctx, f.ownTask.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 1, "only the deletion of the task is left")
+	assert.Equal(t, "task.deleted", events[0].Type)
+	assert.Equal(t, []string{"task.deleted"}, f.publishedTypes()[f.ownTask.ID])
+	exists, err := f.cache.Exists(ctx, "task:"+f.ownTask.ID.String())
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// Only the task the record names is removed
+	_, err = f.tasks.GetByID(ctx, f.otherTask.ID)
+	assert.NoError(t, err)
+	exists, err = f.cache.Exists(ctx, "task:"+f.otherTask.ID.String())
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestRetentionTargets_AnonymizeSubjectTasks(t *testing.T) {
+	f, records := newRetentionFixture(t)
+	ctx := context.Background()
+
+	f.ownTask.Description = "Call ana@example.com"
+	require.NoError(t, f.tasks.Update(ctx, f.ownTask))
+	require.NoError(t, f.events.Store(ctx, &domain.Event{
+		ID: types.New(), Type: "task.updated", AggregateID: f.ownTask.ID, Version: 2, OccurredAt: time.Now(),
+		Data: map[string]interface{}{"title": f.ownTask.Title, "status": "pending"},
+	}))
+
+	require.NoError(t, f.framework.RecordDataCreation(ctx, f.subject.ID, "task_data", map[string]interface{}{}))
+	expire(t, records, f.subject.ID.String(), compliance.RetentionActionAnonymize)
+
+	// Tasks created after the record's retention started are not covered
+	later := &domain.Task{ID: types.New(), Title: "Ana's later task", Status: domain.TaskStatusPending, Priority: domain.PriorityLow, CreatedBy: f.subject.ID, CreatedAt: time.Now().Add(time.Minute), UpdatedAt: time.Now()}
+	require.NoError(t, f.tasks.Create(ctx, later))
+
+	summary, err := f.framework.EnforceRetention(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, summary.Failed)
+	assert.Equal(t, summary.Expired, summary.Completed)
+
+	task, err := f.tasks.GetByID(ctx, f.ownTask.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, "Ana's task", task.Title)
+	assert.NotContains(t, task.Description, "ana@example.com")
+	assert.Equal(t, domain.TaskStatusPending, task.Status)
+
+	events, err := f.events.GetByAggregateID(ctx, f.ownTask.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.NotEqual(t, "Ana's task", events[1].Data["title"])
+	assert.Equal(t, "pending", events[1].Data["status"])
+
+	kept, err := f.tasks.GetByID(ctx, later.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Ana's later task", kept.Title)
+	other, err := f.tasks.GetByID(ctx, f.otherTask.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Bia's task", other.Title)
+}
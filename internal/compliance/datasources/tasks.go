@@ -0,0 +1,129 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+)
+
+// TaskSource exposes the tasks a subject created or is assigned to. Tasks
+// are deleted and unassigned through the task service, in the tenant of
+// each task, so subscribers see the changes; the task.deleted events this
+// leaves in the event store hold the task ID only.
+type TaskSource struct {
+	tasks   domain.TaskRepository
+	service TaskService
+}
+
+// NewTaskSource creates a data source over the task repository that
+// changes tasks through service
+func NewTaskSource(tasks domain.TaskRepository, service TaskService) *TaskSource {
+	return &TaskSource{tasks: tasks, service: service}
+}
+
+// Name returns "tasks"
+func (s *TaskSource) Name() string {
+	return "tasks"
+}
+
+// Find returns the tasks the subject created or is assigned to
+func (s *TaskSource) Find(ctx context.Context, subjectID string) ([]compliance.SubjectRecord, error) {
+	ctx = tenant.WithAll(ctx)
+
+	id, ok := parseSubject(subjectID)
+	if !ok {
+		return nil, nil
+	}
+	created, assigned, err := subjectTasks(ctx, s.tasks, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	records := make([]compliance.SubjectRecord, 0, len(created)+len(assigned))
+	for _, task := range created {
+		records = append(records, s.record(task, "creator"))
+	}
+	for _, task := range assigned {
+		records = append(records, s.record(task, "assignee"))
+	}
+	return records, nil
+}
+
+// Export returns the tasks the subject created
+func (s *TaskSource) Export(ctx context.Context, subjectID string) ([]compliance.SubjectRecord, error) {
+	ctx = tenant.WithAll(ctx)
+
+	id, ok := parseSubject(subjectID)
+	if !ok {
+		return nil, nil
+	}
+	created, err := listTasks(ctx, s.tasks, domain.TaskFilter{CreatedBy: &id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	records := make([]compliance.SubjectRecord, 0, len(created))
+	for _, task := range created {
+		records = append(records, s.record(task, "creator"))
+	}
+	return records, nil
+}
+
+// Rectify is a no-op: tasks reference the subject by ID only, so profile
+// corrections do not reach them
+func (s *TaskSource) Rectify(context.Context, string, map[string]interface{}) (int, error) {
+	return 0, nil
+}
+
+// Erase deletes the tasks the subject created and unassigns them from tasks
+// created by others
+func (s *TaskSource) Erase(ctx context.Context, subjectID string) (int, error) {
+	ctx = tenant.WithAll(ctx)
+
+	id, ok := parseSubject(subjectID)
+	if !ok {
+		return 0, nil
+	}
+	created, assigned, err := subjectTasks(ctx, s.tasks, id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	erased := 0
+	for _, task := range created {
+		if err := s.service.DeleteTask(tenant.WithID(ctx, task.TenantID), task.ID); err != nil && !isNotFound(err) {
+			return erased, fmt.Errorf("failed to delete task %s: %w", task.ID, err)
+		}
+		erased++
+	}
+	for _, task := range assigned {
+		if _, err := s.service.UnassignTask(tenant.WithID(ctx, task.TenantID), task.ID); err != nil && !isNotFound(err) {
+			return erased, fmt.Errorf("failed to unassign task %s: %w", task.ID, err)
+		}
+		erased++
+	}
+	return erased, nil
+}
+
+func (s *TaskSource) record(task *domain.Task, relation string) compliance.SubjectRecord {
+	return compliance.SubjectRecord{
+		Source: s.Name(),
+		Type:   "task",
+		ID:     task.ID.String(),
+		Data: map[string]interface{}{
+			"relation":     relation,
+			"title":        task.Title,
+			"description":  task.Description,
+			"status":       string(task.Status),
+			"priority":     string(task.Priority),
+			"tags":         task.Tags,
+			"metadata":     task.Metadata,
+			"created_at":   formatTime(&task.CreatedAt),
+			"due_date":     formatTime(task.DueDate),
+			"completed_at": formatTime(task.CompletedAt),
+		},
+	}
+}
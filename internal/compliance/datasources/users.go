@@ -0,0 +1,133 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+)
+
+// UserSource exposes the subject's user account
+type UserSource struct {
+	users domain.UserRepository
+}
+
+// NewUserSource creates a data source over the user repository
+func NewUserSource(users domain.UserRepository) *UserSource {
+	return &UserSource{users: users}
+}
+
+// Name returns "users"
+func (s *UserSource) Name() string {
+	return "users"
+}
+
+// Find returns the subject's account
+func (s *UserSource) Find(ctx context.Context, subjectID string) ([]compliance.SubjectRecord, error) {
+	user, err := s.user(ctx, subjectID)
+	if err != nil || user == nil {
+		return nil, err
+	}
+	return []compliance.SubjectRecord{{
+		Source: s.Name(),
+		Type:   "user",
+		ID:     user.ID.String(),
+		Data: map[string]interface{}{
+			"email":      user.Email,
+			"name":       user.Name,
+			"role":       string(user.Role),
+			"active":     user.Active,
+			"created_at": formatTime(&user.CreatedAt),
+			"updated_at": formatTime(&user.UpdatedAt),
+		},
+	}}, nil
+}
+
+// Export returns the profile fields the subject provided
+func (s *UserSource) Export(ctx context.Context, subjectID string) ([]compliance.SubjectRecord, error) {
+	user, err := s.user(ctx, subjectID)
+	if err != nil || user == nil {
+		return nil, err
+	}
+	return []compliance.SubjectRecord{{
+		Source: s.Name(),
+		Type:   "user",
+		ID:     user.ID.String(),
+		Data: map[string]interface{}{
+			"email": user.Email,
+			"name":  user.Name,
+		},
+	}}, nil
+}
+
+// Rectify corrects the subject's name and email
+func (s *UserSource) Rectify(ctx context.Context, subjectID string, corrections map[string]interface{}) (int, error) {
+	user, err := s.user(ctx, subjectID)
+	if err != nil || user == nil {
+		return 0, err
+	}
+
+	changed := false
+	for field, value := range corrections {
+		var target *string
+		switch field {
+		case "name":
+			target = &user.Name
+		case "email":
+			target = &user.Email
+		default:
+			continue
+		}
+		text, ok := value.(string)
+		if !ok || strings.TrimSpace(text) == "" {
+			return 0, fmt.Errorf("invalid correction for %s: expected a non-empty string", field)
+		}
+		if *target != text {
+			*target = text
+			changed = true
+		}
+	}
+	if !changed {
+		return 0, nil
+	}
+
+	user.UpdatedAt = time.Now()
+	if err := s.users.Update(ctx, user); err != nil {
+		return 0, fmt.Errorf("failed to update user: %w", err)
+	}
+	return 1, nil
+}
+
+// Erase deletes the subject's account
+func (s *UserSource) Erase(ctx context.Context, subjectID string) (int, error) {
+	user, err := s.user(ctx, subjectID)
+	if err != nil || user == nil {
+		return 0, err
+	}
+	if err := s.users.Delete(ctx, user.ID); err != nil {
+		if isNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to delete user: %w", err)
+	}
+	return 1, nil
+}
+
+// user returns the subject's account, or nil when there is none
+func (s *UserSource) user(ctx context.Context, subjectID string) (*domain.User, error) {
+	id, ok := parseSubject(subjectID)
+	if !ok {
+		return nil, nil
+	}
+	user, err := s.users.GetByID(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user, nil
+}
@@ -2,7 +2,9 @@ package compliance
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -19,6 +21,50 @@ type Framework struct {
 	auditLogger  *AuditLogger
 	dataMapper   *DataMapper
 	retentionMgr *RetentionManager
+	dataRights   DataRightRepository
+	dataSources  *DataSourceRegistry
+	auditStore   AuditStore
+}
+
+// Option configures optional Framework dependencies
+type Option func(*frameworkOptions)
+
+type frameworkOptions struct {
+	db                  *sql.DB
+	auditStore          AuditStore
+	consentRepository   ConsentRepository
+	retentionRepository RetentionRepository
+	dataRightRepository DataRightRepository
+}
+
+// WithDatabase provides the PostgreSQL connection used by stores configured
+// as "postgres"
+func WithDatabase(db *sql.DB) Option {
+	return func(o *frameworkOptions) { o.db = db }
+}
+
+// WithAuditStore persists audit events to store regardless of
+// AuditLoggingConfig.Store
+func WithAuditStore(store AuditStore) Option {
+	return func(o *frameworkOptions) { o.auditStore = store }
+}
+
+// WithConsentRepository stores consents in repository regardless of
+// ConsentConfig.Store
+func WithConsentRepository(repository ConsentRepository) Option {
+	return func(o *frameworkOptions) { o.consentRepository = repository }
+}
+
+// WithRetentionRepository stores retention records in repository regardless
+// of DataRetentionConfig.Store
+func WithRetentionRepository(repository RetentionRepository) Option {
+	return func(o *frameworkOptions) { o.retentionRepository = repository }
+}
+
+// WithDataRightRepository stores data rights requests in repository
+// regardless of DataRightsConfig.Store
+func WithDataRightRepository(repository DataRightRepository) Option {
+	return func(o *frameworkOptions) { o.dataRightRepository = repository }
 }
 
 // Config holds all compliance-related configuration
@@ -42,6 +88,13 @@ type PIIDetectionConfig struct {
 	ClassificationAPI string   `yaml:"classification_api"`
 	Confidence        float64  `yaml:"confidence" default:"0.8"`
 	AutoMask          bool     `yaml:"auto_mask" default:"true"`
+	// RulesFile adds YAML detector rules to the built-in detectors
+	RulesFile string `yaml:"rules_file"`
+	// ReloadInterval is how often RulesFile is checked for changes; zero
+	// disables reloading
+	ReloadInterval time.Duration `yaml:"reload_interval" default:"30s"`
+	// MaskOnSave masks PII spans in tasks before they are stored
+	MaskOnSave bool `yaml:"mask_on_save"`
 }
 
 // ConsentConfig configures consent management
@@ -50,6 +103,7 @@ type ConsentConfig struct {
 	DefaultPurposes []string      `yaml:"default_purposes"`
 	TTL             time.Duration `yaml:"ttl" default:"2y"`
 	GranularLevel   string        `yaml:"granular_level" default:"purpose"` // purpose, field, operation
+	Store           string        `yaml:"store" default:"memory"`           // memory, postgres
 }
 
 // DataRetentionConfig configures data retention policies
@@ -59,6 +113,12 @@ type DataRetentionConfig struct {
 	CategoryPeriods map[string]time.Duration `yaml:"category_periods"`
 	AutoDelete      bool                     `yaml:"auto_delete" default:"true"`
 	BackupRetention time.Duration            `yaml:"backup_retention" default:"7y"`
+	Store           string                   `yaml:"store" default:"memory"` // memory, postgres
+	// EnforcementInterval is how often expired records are enforced when
+	// AutoDelete is on
+	EnforcementInterval time.Duration `yaml:"enforcement_interval" default:"24h"`
+	// ArchivePath is where the archive action keeps data; empty disables it
+	ArchivePath string `yaml:"archive_path" default:"data/retention/archive.log"`
 }
 
 // AuditLoggingConfig configures compliance audit logging
@@ -69,6 +129,8 @@ type AuditLoggingConfig struct {
 	EncryptionEnabled bool          `yaml:"encryption_enabled" default:"true"`
 	ExternalLogging   bool          `yaml:"external_logging" default:"false"`
 	ExternalEndpoint  string        `yaml:"external_endpoint"`
+	Store             string        `yaml:"store" default:"none"` // none, file, postgres
+	FilePath          string        `yaml:"file_path" default:"data/audit/compliance-audit.log"`
 }
 
 // LGPDConfig specific configuration for Brazilian LGPD compliance
@@ -106,7 +168,9 @@ type DataRightsConfig struct {
 	ResponseTime         time.Duration `yaml:"response_time" default:"720h"` // 30 days
 	AutoFulfillment      bool          `yaml:"auto_fulfillment" default:"false"`
 	VerificationRequired bool          `yaml:"verification_required" default:"true"`
+	VerificationTTL      time.Duration `yaml:"verification_ttl" default:"15m"` // validity of IssueVerificationCode codes
 	NotificationChannels []string      `yaml:"notification_channels"`
+	Store                string        `yaml:"store" default:"memory"` // memory, postgres
 }
 
 // DataSubject represents an individual whose data is being processed
@@ -133,14 +197,19 @@ type ConsentInfo struct {
 
 // DataRightRequest represents a data subject's rights request
 type DataRightRequest struct {
-	ID               string                 `json:"id"`
-	Type             DataRightType          `json:"type"`
-	Status           DataRightStatus        `json:"status"`
-	RequestedAt      time.Time              `json:"requested_at"`
-	CompletedAt      *time.Time             `json:"completed_at,omitempty"`
-	Data             map[string]interface{} `json:"data,omitempty"`
-	Reason           string                 `json:"reason,omitempty"`
-	VerificationCode string                 `json:"verification_code,omitempty"`
+	ID               string                   `json:"id"`
+	SubjectID        string                   `json:"subject_id"`
+	Type             DataRightType            `json:"type"`
+	Status           DataRightStatus          `json:"status"`
+	RequestedAt      time.Time                `json:"requested_at"`
+	CompletedAt      *time.Time               `json:"completed_at,omitempty"`
+	Data             map[string]interface{}   `json:"data,omitempty"`
+	Reason           string                   `json:"reason,omitempty"`
+	VerificationCode string                   `json:"-"` // issued by IssueVerificationCode; checked on submission, never stored
+	Updates          []DataRightRequestUpdate `json:"updates,omitempty"`
+	DueAt            time.Time                `json:"due_at"`
+	Sources          []DataSourceResult       `json:"sources,omitempty"`
+	Export           *ExportBundle            `json:"export,omitempty"`
 }
 
 // DataRightType represents the type of data rights request
@@ -168,11 +237,17 @@ const (
 )
 
 // NewFramework creates a new compliance framework instance
-func NewFramework(config Config, logger *zap.Logger) (*Framework, error) {
+func NewFramework(config Config, logger *zap.Logger, opts ...Option) (*Framework, error) {
+	var options frameworkOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	if !config.Enabled {
 		return &Framework{
-			config: config,
-			logger: logger,
+			config:      config,
+			logger:      logger,
+			dataSources: NewDataSourceRegistry(),
 		}, nil
 	}
 
@@ -183,7 +258,11 @@ func NewFramework(config Config, logger *zap.Logger) (*Framework, error) {
 	}
 
 	// Initialize Consent Manager
-	consentMgr, err := NewConsentManager(config.Consent, logger)
+	consentRepository, err := newConsentRepository(config.Consent, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize consent repository: %w", err)
+	}
+	consentMgr, err := NewConsentManagerWithRepository(config.Consent, logger, consentRepository)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize consent manager: %w", err)
 	}
@@ -201,10 +280,35 @@ func NewFramework(config Config, logger *zap.Logger) (*Framework, error) {
 	}
 
 	// Initialize Retention Manager
-	retentionMgr, err := NewRetentionManager(config.DataRetention, logger)
+	retentionRepository, err := newRetentionRepository(config.DataRetention, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize retention repository: %w", err)
+	}
+	retentionMgr, err := NewRetentionManagerWithRepository(config.DataRetention, logger, retentionRepository)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize retention manager: %w", err)
 	}
+	retentionMgr.anonymize = piiManager.Anonymize
+	retentionMgr.auditLogger = auditLogger
+	if config.DataRetention.ArchivePath != "" {
+		archive, err := NewFileRetentionArchive(config.DataRetention.ArchivePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize retention archive: %w", err)
+		}
+		retentionMgr.archive = archive
+	}
+
+	dataRightRepository, err := newDataRightRepository(config.DataRights, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize data rights repository: %w", err)
+	}
+
+	// Opened last so no earlier failure leaves it open
+	auditStore, err := newAuditStore(config.AuditLogging, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize audit store: %w", err)
+	}
+	auditLogger.store = auditStore
 
 	return &Framework{
 		config:       config,
@@ -214,9 +318,114 @@ func NewFramework(config Config, logger *zap.Logger) (*Framework, error) {
 		auditLogger:  auditLogger,
 		dataMapper:   dataMapper,
 		retentionMgr: retentionMgr,
+		dataRights:   dataRightRepository,
+		dataSources:  NewDataSourceRegistry(),
+		auditStore:   auditStore,
 	}, nil
 }
 
+// newAuditStore returns the audit store selected by config, or nil when
+// events are only logged
+func newAuditStore(config AuditLoggingConfig, options frameworkOptions) (AuditStore, error) {
+	if options.auditStore != nil {
+		return options.auditStore, nil
+	}
+	if !config.Enabled {
+		return nil, nil
+	}
+
+	switch config.Store {
+	case "", AuditStoreNone:
+		return nil, nil
+	case AuditStoreFile:
+		if config.FilePath == "" {
+			return nil, fmt.Errorf("audit_logging.file_path is required for the file store")
+		}
+		return NewFileAuditStore(config.FilePath)
+	case AuditStorePostgres:
+		if options.db == nil {
+			return nil, fmt.Errorf("the postgres audit store requires a database connection")
+		}
+		return NewPostgresAuditStore(options.db), nil
+	default:
+		return nil, fmt.Errorf("unknown audit store %q", config.Store)
+	}
+}
+
+// newConsentRepository returns the consent repository selected by config
+func newConsentRepository(config ConsentConfig, options frameworkOptions) (ConsentRepository, error) {
+	if options.consentRepository != nil {
+		return options.consentRepository, nil
+	}
+
+	switch config.Store {
+	case "", RepositoryMemory:
+		return NewInMemoryConsentRepository(), nil
+	case RepositoryPostgres:
+		if options.db == nil {
+			return nil, fmt.Errorf("the postgres consent store requires a database connection")
+		}
+		return NewPostgresConsentRepository(options.db), nil
+	default:
+		return nil, fmt.Errorf("unknown consent store %q", config.Store)
+	}
+}
+
+// newRetentionRepository returns the retention repository selected by config
+func newRetentionRepository(config DataRetentionConfig, options frameworkOptions) (RetentionRepository, error) {
+	if options.retentionRepository != nil {
+		return options.retentionRepository, nil
+	}
+
+	switch config.Store {
+	case "", RepositoryMemory:
+		return NewInMemoryRetentionRepository(), nil
+	case RepositoryPostgres:
+		if options.db == nil {
+			return nil, fmt.Errorf("the postgres retention store requires a database connection")
+		}
+		return NewPostgresRetentionRepository(options.db), nil
+	default:
+		return nil, fmt.Errorf("unknown retention store %q", config.Store)
+	}
+}
+
+// newDataRightRepository returns the data rights repository selected by
+// config
+func newDataRightRepository(config DataRightsConfig, options frameworkOptions) (DataRightRepository, error) {
+	if options.dataRightRepository != nil {
+		return options.dataRightRepository, nil
+	}
+
+	switch config.Store {
+	case "", RepositoryMemory:
+		return NewInMemoryDataRightRepository(), nil
+	case RepositoryPostgres:
+		if options.db == nil {
+			return nil, fmt.Errorf("the postgres data rights store requires a database connection")
+		}
+		return NewPostgresDataRightRepository(options.db), nil
+	default:
+		return nil, fmt.Errorf("unknown data rights store %q", config.Store)
+	}
+}
+
+// PIIManager returns the PII manager the framework detects and masks with
+func (cf *Framework) PIIManager() *PIIManager {
+	return cf.piiManager
+}
+
+// Close stops the PII rule watcher and releases the audit store
+func (cf *Framework) Close() error {
+	if cf.piiManager != nil {
+		cf.piiManager.Close()
+	}
+	if cf.auditStore == nil {
+		return nil
+	}
+	return cf.auditStore.Close()
+}
+
 // ProcessData processes data through the compliance pipeline
 func (cf *Framework) ProcessData(ctx context.Context, subjectID string, data map[string]interface{}, purpose string) (map[string]interface{}, error) {
 	if !cf.config.Enabled {
@@ -275,7 +484,9 @@ func (cf *Framework) ProcessData(ctx context.Context, subjectID string, data map
 	return processedData, nil
 }
 
-// HandleDataRightRequest processes a data subject rights request
+// HandleDataRightRequest processes a data subject rights request against the
+// registered data sources. Source failures are reported as an error; use
+// ProcessDataRightRequest to keep per-source results on a tracked request.
 func (cf *Framework) HandleDataRightRequest(ctx context.Context, subjectID string, request DataRightRequest) error {
 	if !cf.config.Enabled {
 		return fmt.Errorf("compliance framework is disabled")
@@ -286,20 +497,14 @@ func (cf *Framework) HandleDataRightRequest(ctx context.Context, subjectID strin
 		cf.logger.Warn("Failed to audit data rights request", zap.Error(err))
 	}
 
-	switch request.Type {
-	case DataRightAccess:
-		return cf.handleAccessRequest(ctx, subjectID, request)
-	case DataRightErasure:
-		return cf.handleErasureRequest(ctx, subjectID, request)
-	case DataRightRectification:
-		return cf.handleRectificationRequest(ctx, subjectID, request)
-	case DataRightPortability:
-		return cf.handlePortabilityRequest(ctx, subjectID, request)
-	case DataRightWithdrawConsent:
-		return cf.handleConsentWithdrawal(ctx, subjectID, request)
-	default:
-		return fmt.Errorf("unsupported data right type: %s", request.Type)
-	}
+	request.SubjectID = subjectID
+	return cf.fulfilDataRightRequest(ctx, &request)
+}
+
+// RegisterDataSource adds a system holding personal data to data rights
+// processing
+func (cf *Framework) RegisterDataSource(source SubjectDataSource) error {
+	return cf.dataSources.Register(source)
 }
 
 // GetComplianceStatus returns the current compliance status
@@ -337,38 +542,172 @@ func (cf *Framework) GetComplianceStatus(ctx context.Context) (map[string]interf
 }
 
 // Helper methods for handling specific data rights requests
-func (cf *Framework) handleAccessRequest(_ context.Context, subjectID string, request DataRightRequest) error {
-	// Implementation for access request
-	cf.logger.Info("Processing access request", zap.String("subject_id", subjectID), zap.String("request_id", request.ID))
-	// TODO: Implement data extraction and anonymization
+func (cf *Framework) fulfilDataRightRequest(ctx context.Context, request *DataRightRequest) error {
+	var err error
+	switch request.Type {
+	case DataRightAccess:
+		err = cf.handleAccessRequest(ctx, request)
+	case DataRightErasure:
+		err = cf.handleErasureRequest(ctx, request)
+	case DataRightRectification:
+		err = cf.handleRectificationRequest(ctx, request)
+	case DataRightPortability:
+		err = cf.handlePortabilityRequest(ctx, request)
+	case DataRightWithdrawConsent:
+		err = cf.handleConsentWithdrawal(ctx, request)
+	default:
+		err = fmt.Errorf("unsupported data right type: %s", request.Type)
+	}
+	if err != nil {
+		return err
+	}
+
+	if failed := request.FailedSources(); len(failed) > 0 {
+		return fmt.Errorf("%w: %s", ErrDataSourcesFailed, strings.Join(failed, ", "))
+	}
 	return nil
 }
 
-func (cf *Framework) handleErasureRequest(_ context.Context, subjectID string, request DataRightRequest) error {
-	// Implementation for erasure request (right to be forgotten)
-	cf.logger.Info("Processing erasure request", zap.String("subject_id", subjectID), zap.String("request_id", request.ID))
-	// TODO: Implement data deletion across all systems
+func (cf *Framework) handleAccessRequest(ctx context.Context, request *DataRightRequest) error {
+	cf.logger.Info("Processing access request", zap.String("subject_id", request.SubjectID), zap.String("request_id", request.ID))
+
+	records := cf.runDataSources(ctx, request, func(source SubjectDataSource) ([]SubjectRecord, int, error) {
+		records, err := source.Find(ctx, request.SubjectID)
+		return records, len(records), err
+	})
+	request.Export = newExportBundle(*request, records)
+
+	cf.auditDataRightOutcome(ctx, *request, AuditEventDataAccess)
 	return nil
 }
 
-func (cf *Framework) handleRectificationRequest(_ context.Context, subjectID string, request DataRightRequest) error {
-	// Implementation for rectification request
-	cf.logger.Info("Processing rectification request", zap.String("subject_id", subjectID), zap.String("request_id", request.ID))
-	// TODO: Implement data correction
+func (cf *Framework) handleErasureRequest(ctx context.Context, request *DataRightRequest) error {
+	cf.logger.Info("Processing erasure request", zap.String("subject_id", request.SubjectID), zap.String("request_id", request.ID))
+
+	// Data under legal hold must be kept even against the subject's wishes
+	// (Art. 17(3) GDPR / Art. 16 LGPD)
+	reason, held, err := cf.legalHold(ctx, request.SubjectID)
+	if err != nil {
+		return fmt.Errorf("failed to check legal holds: %w", err)
+	}
+	if held {
+		return fmt.Errorf("%w: %s", ErrLegalHold, reason)
+	}
+
+	cf.runDataSources(ctx, request, func(source SubjectDataSource) ([]SubjectRecord, int, error) {
+		erased, err := source.Erase(ctx, request.SubjectID)
+		return nil, erased, err
+	})
+
+	cf.auditDataRightOutcome(ctx, *request, AuditEventDataDelete)
 	return nil
 }
 
-func (cf *Framework) handlePortabilityRequest(_ context.Context, subjectID string, request DataRightRequest) error {
-	// Implementation for portability request
-	cf.logger.Info("Processing portability request", zap.String("subject_id", subjectID), zap.String("request_id", request.ID))
-	// TODO: Implement data export in portable format
+func (cf *Framework) handleRectificationRequest(ctx context.Context, request *DataRightRequest) error {
+	cf.logger.Info("Processing rectification request", zap.String("subject_id", request.SubjectID), zap.String("request_id", request.ID))
+
+	corrections, _ := request.Data["corrections"].(map[string]interface{})
+	if len(corrections) == 0 {
+		return fmt.Errorf("invalid data right request: data.corrections is required for rectification")
+	}
+
+	cf.runDataSources(ctx, request, func(source SubjectDataSource) ([]SubjectRecord, int, error) {
+		rectified, err := source.Rectify(ctx, request.SubjectID, corrections)
+		return nil, rectified, err
+	})
+
+	cf.auditDataRightOutcome(ctx, *request, AuditEventDataRectify)
 	return nil
 }
 
-func (cf *Framework) handleConsentWithdrawal(ctx context.Context, subjectID string, request DataRightRequest) error {
-	// Implementation for consent withdrawal
-	cf.logger.Info("Processing consent withdrawal", zap.String("subject_id", subjectID), zap.String("request_id", request.ID))
-	return cf.consentMgr.WithdrawConsent(ctx, subjectID, request.Data["purpose"].(string))
+func (cf *Framework) handlePortabilityRequest(ctx context.Context, request *DataRightRequest) error {
+	cf.logger.Info("Processing portability request", zap.String("subject_id", request.SubjectID), zap.String("request_id", request.ID))
+
+	records := cf.runDataSources(ctx, request, func(source SubjectDataSource) ([]SubjectRecord, int, error) {
+		records, err := source.Export(ctx, request.SubjectID)
+		return records, len(records), err
+	})
+	request.Export = newExportBundle(*request, records)
+
+	cf.auditDataRightOutcome(ctx, *request, AuditEventDataExport)
+	return nil
+}
+
+func (cf *Framework) handleConsentWithdrawal(ctx context.Context, request *DataRightRequest) error {
+	cf.logger.Info("Processing consent withdrawal", zap.String("subject_id", request.SubjectID), zap.String("request_id", request.ID))
+	purpose, _ := request.Data["purpose"].(string)
+	if purpose == "" {
+		return fmt.Errorf("invalid data right request: data.purpose is required to withdraw consent")
+	}
+	return cf.consentMgr.WithdrawConsent(ctx, request.SubjectID, purpose)
+}
+
+// runDataSources applies op to every registered source, recording a result
+// per source. A failing source does not stop the others.
+func (cf *Framework) runDataSources(ctx context.Context, request *DataRightRequest, op func(SubjectDataSource) ([]SubjectRecord, int, error)) []SubjectRecord {
+	var collected []SubjectRecord
+	request.Sources = nil
+
+	for _, source := range cf.dataSources.Sources() {
+		result := DataSourceResult{Source: source.Name(), Status: DataSourceStatusCompleted}
+
+		records, affected, err := op(source)
+		if err == nil {
+			err = ctx.Err()
+		}
+		if err != nil {
+			result.Status = DataSourceStatusFailed
+			result.Error = err.Error()
+			cf.logger.Error("Data source failed to process data right request",
+				zap.String("request_id", request.ID),
+				zap.String("source", source.Name()),
+				zap.String("type", string(request.Type)),
+				zap.Error(err))
+		} else {
+			result.Records = affected
+			for _, record := range records {
+				if record.Source == "" {
+					record.Source = source.Name()
+				}
+				collected = append(collected, record)
+			}
+		}
+
+		result.CompletedAt = time.Now()
+		request.Sources = append(request.Sources, result)
+	}
+
+	return collected
+}
+
+// legalHold reports whether any retention record of the subject is under
+// legal hold, and why
+func (cf *Framework) legalHold(ctx context.Context, subjectID string) (string, bool, error) {
+	if cf.retentionMgr == nil || !cf.config.DataRetention.Enabled {
+		return "", false, nil
+	}
+
+	records, err := cf.retentionMgr.GetRetentionStatus(ctx, subjectID)
+	if err != nil {
+		return "", false, err
+	}
+	for _, record := range records {
+		if record.LegalHold {
+			return record.LegalHoldReason, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+func (cf *Framework) auditDataRightOutcome(ctx context.Context, request DataRightRequest, eventType AuditEventType) {
+	if err := cf.auditLogger.LogDataRightsFulfilment(ctx, request, eventType); err != nil {
+		cf.logger.Warn("Failed to audit data rights fulfilment", zap.Error(err))
+	}
+}
+
+// IsEnabled reports whether compliance processing is enabled
+func (cf *Framework) IsEnabled() bool {
+	return cf.config.Enabled
 }
 
 // GetConsentManager returns the consent manager for direct access
@@ -402,59 +741,72 @@ type PIIScanResult struct {
 	Classifications map[string]PIIClassification `json:"classifications"`
 	TotalFields     int                          `json:"total_fields"`
 	PIIFields       int                          `json:"pii_fields"`
+	Findings        []PIIFinding                 `json:"findings"`
 }
 
-// ScanForPII scans data for Personally Identifiable Information
+// ScanForPII scans data for Personally Identifiable Information. data may
+// be any value: maps, slices and structs are scanned recursively and free
+// text is searched for PII spans. DetectedFields and Classifications are
+// keyed by the path of each value holding PII.
 func (cf *Framework) ScanForPII(_ context.Context, data interface{}) (*PIIScanResult, error) {
 	if !cf.config.Enabled || cf.piiManager == nil {
 		return &PIIScanResult{
 			DetectedFields:  []string{},
 			Classifications: make(map[string]PIIClassification),
+			Findings:        []PIIFinding{},
 		}, nil
 	}
 
-	// Convert data to map if needed
-	dataMap, ok := data.(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("data must be a map[string]interface{}")
+	scanner := cf.piiManager.scan(data)
+	if scanner.err != nil {
+		return nil, fmt.Errorf("failed to scan PII: %w", scanner.err)
 	}
 
 	result := &PIIScanResult{
 		DetectedFields:  []string{},
 		Classifications: make(map[string]PIIClassification),
-		TotalFields:     len(dataMap),
-		PIIFields:       0,
+		TotalFields:     scanner.leaves,
+		Findings:        scanner.findings,
 	}
 
-	// Scan each field for PII
-	for fieldName, value := range dataMap {
-		if value == nil {
+	// Classify each path by its most confident finding
+	for _, finding := range scanner.findings {
+		current, seen := result.Classifications[finding.Path]
+		if !seen {
+			result.DetectedFields = append(result.DetectedFields, finding.Path)
+		}
+		if seen && current.Confidence >= finding.Confidence {
 			continue
 		}
-
-		// Use PIIManager's internal detection
-		for piiType, detector := range cf.piiManager.detectors {
-			detected, confidence, context := detector.Detect(fieldName, value)
-			if detected && confidence >= cf.config.PIIDetection.Confidence {
-				result.DetectedFields = append(result.DetectedFields, fieldName)
-				result.PIIFields++
-				result.Classifications[fieldName] = PIIClassification{
-					FieldName:     fieldName,
-					PIIType:       piiType,
-					Sensitivity:   detector.GetSensitivity(),
-					Confidence:    confidence,
-					OriginalValue: value,
-					Timestamp:     time.Now(),
-					Context:       context,
-				}
-				break // Use first match
-			}
+		result.Classifications[finding.Path] = PIIClassification{
+			FieldName:   finding.Path,
+			PIIType:     finding.PIIType,
+			Sensitivity: finding.Sensitivity,
+			Confidence:  finding.Confidence,
+			Timestamp:   time.Now(),
+			Context:     finding.Context,
 		}
 	}
+	result.PIIFields = len(result.DetectedFields)
 
 	return result, nil
 }
 
+// MaskPII masks the PII found in data in place, replacing only the matched
+// spans of free text. data must be a pointer, map or slice. It returns what
+// was masked.
+func (cf *Framework) MaskPII(_ context.Context, data interface{}) ([]PIIFinding, error) {
+	if !cf.config.Enabled || cf.piiManager == nil {
+		return nil, nil
+	}
+
+	findings, err := cf.piiManager.Mask(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mask PII: %w", err)
+	}
+	return findings, nil
+}
+
 // RecordConsent records user consent for specified purposes
 func (cf *Framework) RecordConsent(ctx context.Context, userID types.UUID, purposes []string, source string) error {
 	if !cf.config.Enabled || cf.consentMgr == nil {
@@ -560,6 +912,24 @@ func (cf *Framework) ShouldDeleteData(ctx context.Context, userID types.UUID, da
 	return cf.retentionMgr.ShouldDeleteData(ctx, userID.String(), dataCategory)
 }
 
+// RegisterRetentionTarget adds a store that expired retention records are
+// enforced against. Nothing is enforced while compliance is disabled.
+func (cf *Framework) RegisterRetentionTarget(target RetentionTarget) error {
+	if cf.retentionMgr == nil {
+		return nil
+	}
+	return cf.retentionMgr.RegisterTarget(target)
+}
+
+// EnforceRetention deletes, anonymizes or archives the data of every
+// expired retention record
+func (cf *Framework) EnforceRetention(ctx context.Context) (*RetentionRunSummary, error) {
+	if !cf.config.Enabled || cf.retentionMgr == nil {
+		return &RetentionRunSummary{}, nil
+	}
+	return cf.retentionMgr.EnforceRetention(ctx)
+}
+
 // DataAccessRequest represents a request to access personal data
 type DataAccessRequest struct {
 	SubjectID string                 `json:"subject_id"`
@@ -610,13 +980,13 @@ func (cf *Framework) ProcessDataAccessRequest(ctx context.Context, req DataAcces
 		zap.String("request_id", req.RequestID),
 		zap.String("scope", req.Scope))
 
-	// TODO: Implement actual data extraction and export
-	// 1. Gather all data for subject_id across systems
-	// 2. Format according to req.Format
-	// 3. Apply PII handling if needed
-	// 4. Return structured data
-
-	return nil
+	return cf.HandleDataRightRequest(ctx, req.SubjectID, DataRightRequest{
+		ID:          req.RequestID,
+		Type:        DataRightAccess,
+		Status:      DataRightStatusInProgress,
+		RequestedAt: time.Now(),
+		Data:        req.Metadata,
+	})
 }
 
 // ProcessDataDeletionRequest processes a data deletion request (Right to be forgotten)
@@ -630,13 +1000,14 @@ func (cf *Framework) ProcessDataDeletionRequest(ctx context.Context, req DataDel
 		zap.String("request_id", req.RequestID),
 		zap.String("reason", req.Reason))
 
-	// TODO: Implement actual data deletion
-	// 1. Identify all data for subject_id
-	// 2. Check retention policies and legal holds
-	// 3. Delete or anonymize data
-	// 4. Log deletion audit event
-
-	return nil
+	return cf.HandleDataRightRequest(ctx, req.SubjectID, DataRightRequest{
+		ID:          req.RequestID,
+		Type:        DataRightErasure,
+		Status:      DataRightStatusInProgress,
+		RequestedAt: time.Now(),
+		Reason:      req.Reason,
+		Data:        req.Metadata,
+	})
 }
 
 // AnonymizeData anonymizes personal data for a subject
@@ -668,7 +1039,7 @@ func (cf *Framework) LogAuditEvent(ctx context.Context, event AuditEvent) error
 		zap.String("subject_id", event.SubjectID))
 
 	// Route to audit logger
-	return cf.auditLogger.logEvent(event)
+	return cf.auditLogger.logEvent(ctx, event)
 }
 
 // GetAuditLogs retrieves audit logs based on filters
@@ -681,24 +1052,20 @@ func (cf *Framework) GetAuditLogs(ctx context.Context, filter AuditFilter) ([]Au
 		zap.String("subject_id", filter.SubjectID),
 		zap.String("event_type", filter.EventType))
 
-	// Convert AuditFilter to map for the query
-	filters := make(map[string]interface{})
-	if filter.SubjectID != "" {
-		filters["subject_id"] = filter.SubjectID
-	}
-	if filter.EventType != "" {
-		filters["event_type"] = filter.EventType
-	}
-	if filter.Action != "" {
-		filters["action"] = filter.Action
+	if filter.Limit == 0 {
+		filter.Limit = 100 // default limit
 	}
 
-	limit := filter.Limit
-	if limit == 0 {
-		limit = 100 // default limit
-	}
+	return cf.auditLogger.QueryAuditLogs(ctx, filter)
+}
 
-	return cf.auditLogger.QueryAuditLogs(ctx, filters, limit)
+// VerifyAuditLog checks that the persisted audit log was not edited. See
+// VerifyAuditChain for anchor.
+func (cf *Framework) VerifyAuditLog(ctx context.Context, anchor string) (*AuditVerification, error) {
+	if !cf.config.Enabled || cf.auditLogger == nil {
+		return nil, fmt.Errorf("compliance framework is disabled")
+	}
+	return cf.auditLogger.VerifyAuditLog(ctx, anchor)
 }
 
 // ValidateCompliance validates compliance requirements for an operation
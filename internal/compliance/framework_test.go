@@ -357,8 +357,6 @@ func TestFramework_ValidateCompliance(t *testing.T) {
 }
 
 func TestFramework_ConcurrentOperations(t *testing.T) {
-	t.Skip("Skipping: InMemoryConsentRepository has race condition - requires mutex protection")
-
 	framework := createTestFramework(t)
 	ctx := context.Background()
 
@@ -412,3 +410,95 @@ func TestFramework_ConfigValidation(t *testing.T) {
 		assert.False(t, framework.config.PIIDetection.AutoMask)
 	}
 }
+
+func TestFramework_DataRightRequests(t *testing.T) {
+	framework := createTestFramework(t)
+	ctx := context.Background()
+
+	_, err := framework.SubmitDataRightRequest(ctx, DataRightRequest{SubjectID: "subject-1", Type: DataRightAccess})
+	assert.ErrorIs(t, err, ErrVerificationRequired)
+
+	code, err := framework.IssueVerificationCode(ctx, "subject-1")
+	require.NoError(t, err)
+	request, err := framework.SubmitDataRightRequest(ctx, DataRightRequest{
+		SubjectID:        "subject-1",
+		Type:             DataRightAccess,
+		VerificationCode: code,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, DataRightStatusPending, request.Status)
+	assert.Empty(t, request.VerificationCode, "codes are not kept on requests")
+
+	_, err = framework.UpdateDataRightRequest(ctx, request.ID, DataRightStatusInProgress, "collecting data", "dpo")
+	require.NoError(t, err)
+	done, err := framework.UpdateDataRightRequest(ctx, request.ID, DataRightStatusCompleted, "export sent", "dpo")
+	require.NoError(t, err)
+	require.NotNil(t, done.CompletedAt)
+	assert.Len(t, done.Updates, 3)
+
+	_, err = framework.UpdateDataRightRequest(ctx, request.ID, DataRightStatusRejected, "", "dpo")
+	assert.ErrorIs(t, err, ErrDataRightRequestClosed)
+
+	requests, total, err := framework.ListDataRightRequests(ctx, DataRightFilter{SubjectID: "subject-1"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, DataRightStatusCompleted, requests[0].Status)
+}
+
+func TestFramework_DataRightVerification(t *testing.T) {
+	framework := createTestFramework(t)
+	ctx := context.Background()
+	submit := func(subjectID, code string) error {
+		_, err := framework.SubmitDataRightRequest(ctx, DataRightRequest{SubjectID: subjectID, Type: DataRightAccess, VerificationCode: code})
+		return err
+	}
+
+	assert.ErrorIs(t, submit("subject-1", "123456"), ErrInvalidVerificationCode, "no code was issued")
+
+	code, err := framework.IssueVerificationCode(ctx, "subject-1")
+	require.NoError(t, err)
+	assert.Len(t, code, 6)
+	stored, err := framework.dataRights.GetVerification(ctx, "subject-1")
+	require.NoError(t, err)
+	assert.NotContains(t, stored.CodeHash, code, "only the hash is stored")
+
+	wrong := "000000"
+	if code == wrong {
+		wrong = "111111"
+	}
+	assert.ErrorIs(t, submit("subject-1", wrong), ErrInvalidVerificationCode)
+	assert.ErrorIs(t, submit("subject-2", code), ErrInvalidVerificationCode, "codes belong to their subject")
+	require.NoError(t, submit("subject-1", code))
+	assert.ErrorIs(t, submit("subject-1", code), ErrInvalidVerificationCode, "codes are single use")
+
+	code, err = framework.IssueVerificationCode(ctx, "subject-1")
+	require.NoError(t, err)
+	for i := 0; i < maxVerificationAttempts; i++ {
+		assert.ErrorIs(t, submit("subject-1", wrong), ErrInvalidVerificationCode)
+	}
+	assert.ErrorIs(t, submit("subject-1", code), ErrInvalidVerificationCode, "too many wrong codes revoke the code")
+
+	framework.config.DataRights.VerificationTTL = time.Nanosecond
+	code, err = framework.IssueVerificationCode(ctx, "subject-1")
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	assert.ErrorIs(t, submit("subject-1", code), ErrInvalidVerificationCode, "expired codes are rejected")
+}
+
+func TestFramework_DataRightRequests_AutoFulfillment(t *testing.T) {
+	framework := createTestFramework(t)
+	framework.config.DataRights.AutoFulfillment = true
+	ctx := context.Background()
+
+	// Withdrawing consent needs data.purpose; without it the request is rejected
+	code, err := framework.IssueVerificationCode(ctx, "subject-1")
+	require.NoError(t, err)
+	request, err := framework.SubmitDataRightRequest(ctx, DataRightRequest{
+		SubjectID:        "subject-1",
+		Type:             DataRightWithdrawConsent,
+		VerificationCode: code,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, DataRightStatusRejected, request.Status)
+	assert.Contains(t, request.Updates[len(request.Updates)-1].Message, "data.purpose")
+}
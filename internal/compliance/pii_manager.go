@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -14,11 +15,16 @@ import (
 
 // PIIManager handles detection, classification, and protection of PII data
 type PIIManager struct {
-	config              PIIDetectionConfig
-	logger              *zap.Logger
+	config PIIDetectionConfig
+	logger *zap.Logger
+
+	// mu guards detectors and methods, which LoadRules replaces
+	mu                  sync.RWMutex
 	detectors           map[PIIType]PIIDetector
+	methods             map[PIIType]AnonymizationMethod
 	anonymizers         map[AnonymizationMethod]Anonymizer
 	classificationCache map[string]PIIClassification
+	stopWatch           chan struct{}
 }
 
 // PIIType represents different types of personally identifiable information
@@ -100,6 +106,10 @@ func NewPIIManager(config PIIDetectionConfig, logger *zap.Logger) (*PIIManager,
 		classificationCache: make(map[string]PIIClassification),
 	}
 
+	// Anonymizers are stateless and also serve retention enforcement, so
+	// they are available even when detection is disabled
+	pm.initializeAnonymizers()
+
 	if !config.Enabled {
 		return pm, nil
 	}
@@ -107,8 +117,12 @@ func NewPIIManager(config PIIDetectionConfig, logger *zap.Logger) (*PIIManager,
 	// Initialize detectors
 	pm.initializeDetectors()
 
-	// Initialize anonymizers
-	pm.initializeAnonymizers()
+	if config.RulesFile != "" {
+		if err := pm.LoadRules(config.RulesFile); err != nil {
+			return nil, fmt.Errorf("failed to load PII rules: %w", err)
+		}
+		pm.WatchRules(config.RulesFile, config.ReloadInterval)
+	}
 
 	return pm, nil
 }
@@ -165,8 +179,60 @@ func (pm *PIIManager) ProcessData(ctx context.Context, data map[string]interface
 	return processedData, nil
 }
 
+// Anonymize returns a copy of data in which every detected PII value and
+// every field listed in fields is anonymized, whatever the AutoMask setting.
+// Nested maps are anonymized recursively; a listed field that holds a map
+// has all of its values anonymized.
+func (pm *PIIManager) Anonymize(data map[string]interface{}, fields ...string) (map[string]interface{}, error) {
+	forced := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		forced[field] = true
+	}
+	return pm.anonymizeMap(data, forced, false)
+}
+
+func (pm *PIIManager) anonymizeMap(data map[string]interface{}, forced map[string]bool, all bool) (map[string]interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	anonymized := make(map[string]interface{}, len(data))
+	for fieldName, value := range data {
+		force := all || forced[fieldName]
+		switch v := value.(type) {
+		case nil:
+			anonymized[fieldName] = nil
+		case map[string]interface{}:
+			nested, err := pm.anonymizeMap(v, forced, force)
+			if err != nil {
+				return nil, err
+			}
+			anonymized[fieldName] = nested
+		default:
+			piiType := PIITypeCustom
+			classification, detected := pm.detectPII(fieldName, value)
+			if detected {
+				piiType = classification.PIIType
+			}
+			if !detected && !force {
+				anonymized[fieldName] = value
+				continue
+			}
+			processed, err := pm.anonymizeValue(piiType, value, classification.Context)
+			if err != nil {
+				return nil, fmt.Errorf("failed to anonymize %s: %w", fieldName, err)
+			}
+			anonymized[fieldName] = processed
+		}
+	}
+	return anonymized, nil
+}
+
 // detectPII detects PII in a given field and value
 func (pm *PIIManager) detectPII(fieldName string, value interface{}) (PIIClassification, bool) {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
 	var bestMatch PIIClassification
 	var maxConfidence float64 = 0
 
@@ -186,7 +252,14 @@ func (pm *PIIManager) detectPII(fieldName string, value interface{}) (PIIClassif
 		}
 	}
 
-	return bestMatch, maxConfidence >= pm.config.Confidence
+	// A zero confidence threshold must not turn "no detector matched" into
+	// a detection
+	return bestMatch, maxConfidence > 0 && maxConfidence >= pm.config.Confidence
+}
+
+// AnonymizeValue masks value with the anonymizer configured for piiType
+func (pm *PIIManager) AnonymizeValue(piiType PIIType, value interface{}) (interface{}, error) {
+	return pm.anonymizeValue(piiType, value, nil)
 }
 
 // anonymizeValue anonymizes a value based on its PII type
@@ -204,6 +277,13 @@ func (pm *PIIManager) anonymizeValue(piiType PIIType, value interface{}, context
 
 // getAnonymizationMethod returns the appropriate anonymization method for a PII type
 func (pm *PIIManager) getAnonymizationMethod(piiType PIIType) AnonymizationMethod {
+	pm.mu.RLock()
+	method, configured := pm.methods[piiType]
+	pm.mu.RUnlock()
+	if configured {
+		return method
+	}
+
 	switch piiType {
 	case PIITypeEmail:
 		return AnonymizationHash
@@ -224,13 +304,18 @@ func (pm *PIIManager) getAnonymizationMethod(piiType PIIType) AnonymizationMetho
 
 // initializeDetectors sets up all PII detectors
 func (pm *PIIManager) initializeDetectors() {
-	pm.detectors[PIITypeEmail] = &EmailDetector{}
-	pm.detectors[PIITypeCPF] = &CPFDetector{}
-	pm.detectors[PIITypeCNPJ] = &CNPJDetector{}
-	pm.detectors[PIITypePhone] = &PhoneDetector{}
-	pm.detectors[PIITypeCreditCard] = &CreditCardDetector{}
-	pm.detectors[PIITypeIPAddress] = &IPAddressDetector{}
-	pm.detectors[PIITypeName] = &NameDetector{}
+	pm.initializeDetectorsInto(pm.detectors)
+}
+
+// initializeDetectorsInto adds the built-in detectors to detectors
+func (pm *PIIManager) initializeDetectorsInto(detectors map[PIIType]PIIDetector) {
+	detectors[PIITypeEmail] = &EmailDetector{}
+	detectors[PIITypeCPF] = &CPFDetector{}
+	detectors[PIITypeCNPJ] = &CNPJDetector{}
+	detectors[PIITypePhone] = &PhoneDetector{}
+	detectors[PIITypeCreditCard] = &CreditCardDetector{}
+	detectors[PIITypeIPAddress] = &IPAddressDetector{}
+	detectors[PIITypeName] = &NameDetector{}
 }
 
 // initializeAnonymizers sets up all anonymizers
@@ -261,12 +346,16 @@ func (pm *PIIManager) sanitizeClassifications(classifications []PIIClassificatio
 
 // HealthCheck returns the health status of the PII manager
 func (pm *PIIManager) HealthCheck(ctx context.Context) map[string]interface{} {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
 	return map[string]interface{}{
 		"enabled":              pm.config.Enabled,
 		"auto_mask":            pm.config.AutoMask,
 		"confidence_threshold": pm.config.Confidence,
 		"detectors_count":      len(pm.detectors),
 		"anonymizers_count":    len(pm.anonymizers),
+		"rules_file":           pm.config.RulesFile,
 		"status":               "healthy",
 	}
 }
@@ -324,49 +413,7 @@ func (d *CPFDetector) Detect(field string, value interface{}) (bool, float64, ma
 }
 
 func (d *CPFDetector) isValidCPF(cpf string) bool {
-	// CPF validation algorithm
-	if len(cpf) != 11 {
-		return false
-	}
-
-	// Check if all digits are the same
-	allSame := true
-	for i := 1; i < len(cpf); i++ {
-		if cpf[i] != cpf[0] {
-			allSame = false
-			break
-		}
-	}
-	if allSame {
-		return false
-	}
-
-	// Validate check digits
-	sum := 0
-	for i := 0; i < 9; i++ {
-		digit := int(cpf[i] - '0')
-		sum += digit * (10 - i)
-	}
-	checkDigit1 := (sum * 10) % 11
-	if checkDigit1 == 10 {
-		checkDigit1 = 0
-	}
-
-	if int(cpf[9]-'0') != checkDigit1 {
-		return false
-	}
-
-	sum = 0
-	for i := 0; i < 10; i++ {
-		digit := int(cpf[i] - '0')
-		sum += digit * (11 - i)
-	}
-	checkDigit2 := (sum * 10) % 11
-	if checkDigit2 == 10 {
-		checkDigit2 = 0
-	}
-
-	return int(cpf[10]-'0') == checkDigit2
+	return validCPF(cpf)
 }
 
 func (d *CPFDetector) GetType() PIIType               { return PIITypeCPF }
@@ -0,0 +1,419 @@
+package compliance
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
+)
+
+// Default confidences of rule matches
+const (
+	defaultRuleConfidence      = 0.9
+	defaultRuleFieldConfidence = 0.7
+)
+
+// PIIRuleSet is a declarative set of PII detectors, loaded from YAML (see
+// templates/ai/policies/pii-detectors.yaml)
+type PIIRuleSet struct {
+	Version   string    `yaml:"version"`
+	Detectors []PIIRule `yaml:"detectors"`
+}
+
+// PIIRule describes one detector. A value is detected when it matches one
+// of the patterns and, if a validator is named, the match passes it; a
+// field is detected when its name contains one of the field hints.
+type PIIRule struct {
+	Type        PIIType `yaml:"type"`
+	Description string  `yaml:"description"`
+	// Patterns are regular expressions matched against string values
+	Patterns []string `yaml:"patterns"`
+	// Validator is a checksum the match must pass: cpf, cnpj, luhn, pis
+	// or iban
+	Validator string `yaml:"validator"`
+	// FieldHints are lowercase substrings of field names holding this type
+	FieldHints      []string            `yaml:"field_hints"`
+	Confidence      float64             `yaml:"confidence"`
+	FieldConfidence float64             `yaml:"field_confidence"`
+	Sensitivity     PIISensitivity      `yaml:"sensitivity"`
+	Anonymizer      AnonymizationMethod `yaml:"anonymizer"`
+}
+
+// piiValidators are the checksums a rule can name
+var piiValidators = map[string]func(string) bool{
+	"cpf":  validCPF,
+	"cnpj": validCNPJ,
+	"luhn": validLuhn,
+	"pis":  validPIS,
+	"iban": validIBAN,
+}
+
+// LoadPIIRules reads and validates a rule set from a YAML file
+func LoadPIIRules(path string) (*PIIRuleSet, error) {
+	raw, err := os.ReadFile(path) // #nosec G304 -- path comes from configuration
+	if err != nil {
+		return nil, fmt.Errorf("reading PII rules: %w", err)
+	}
+	return ParsePIIRules(raw)
+}
+
+// ParsePIIRules parses and validates a YAML rule set
+func ParsePIIRules(raw []byte) (*PIIRuleSet, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(raw))
+	decoder.KnownFields(true)
+
+	var rules PIIRuleSet
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("decoding PII rules: %w", err)
+	}
+	if _, err := rules.Compile(); err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+// Compile builds a detector per rule. Every rule is checked, so the error
+// lists all invalid rules at once.
+func (s *PIIRuleSet) Compile() ([]*RuleDetector, error) {
+	var problems []string
+	seen := make(map[PIIType]bool, len(s.Detectors))
+	detectors := make([]*RuleDetector, 0, len(s.Detectors))
+
+	for i, rule := range s.Detectors {
+		detector, err := newRuleDetector(rule)
+		if err == nil && seen[rule.Type] {
+			err = fmt.Errorf("duplicate type")
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("detectors[%d] (%s): %v", i, rule.Type, err))
+			continue
+		}
+		seen[rule.Type] = true
+		detectors = append(detectors, detector)
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid PII rules: %s", strings.Join(problems, "; "))
+	}
+	return detectors, nil
+}
+
+// RuleDetector is a PIIDetector built from a PIIRule
+type RuleDetector struct {
+	rule     PIIRule
+	patterns []*regexp.Regexp
+	validate func(string) bool
+}
+
+func newRuleDetector(rule PIIRule) (*RuleDetector, error) {
+	if rule.Type == "" {
+		return nil, fmt.Errorf("type is required")
+	}
+	if len(rule.Patterns) == 0 && len(rule.FieldHints) == 0 {
+		return nil, fmt.Errorf("patterns or field_hints are required")
+	}
+
+	detector := &RuleDetector{rule: rule}
+	for _, pattern := range rule.Patterns {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		detector.patterns = append(detector.patterns, compiled)
+	}
+
+	if rule.Validator != "" {
+		validate, ok := piiValidators[rule.Validator]
+		if !ok {
+			return nil, fmt.Errorf("unknown validator %q", rule.Validator)
+		}
+		if len(rule.Patterns) == 0 {
+			return nil, fmt.Errorf("validator %q needs patterns", rule.Validator)
+		}
+		detector.validate = validate
+	}
+
+	switch rule.Sensitivity {
+	case "":
+		detector.rule.Sensitivity = PIISensitivityConfidential
+	case PIISensitivityPublic, PIISensitivityInternal, PIISensitivityConfidential, PIISensitivityRestricted:
+	default:
+		return nil, fmt.Errorf("unknown sensitivity %q", rule.Sensitivity)
+	}
+
+	switch rule.Anonymizer {
+	case "", AnonymizationHash, AnonymizationTokenize, AnonymizationRedact, AnonymizationGeneralize:
+	default:
+		return nil, fmt.Errorf("unsupported anonymizer %q", rule.Anonymizer)
+	}
+
+	for _, confidence := range []float64{rule.Confidence, rule.FieldConfidence} {
+		if confidence < 0 || confidence > 1 {
+			return nil, fmt.Errorf("confidence must be between 0 and 1")
+		}
+	}
+	if detector.rule.Confidence == 0 {
+		detector.rule.Confidence = defaultRuleConfidence
+	}
+	if detector.rule.FieldConfidence == 0 {
+		detector.rule.FieldConfidence = defaultRuleFieldConfidence
+	}
+
+	detector.rule.FieldHints = make([]string, len(rule.FieldHints))
+	for i, hint := range rule.FieldHints {
+		detector.rule.FieldHints[i] = strings.ToLower(hint)
+	}
+	return detector, nil
+}
+
+// Detect matches the value against the patterns, then the field name
+// against the hints
+func (d *RuleDetector) Detect(field string, value interface{}) (bool, float64, map[string]string) {
+	if str, ok := value.(string); ok {
+		for _, pattern := range d.patterns {
+			for _, match := range pattern.FindAllString(str, -1) {
+				if d.validate == nil || d.validate(match) {
+					return true, d.rule.Confidence, map[string]string{"pattern": "rule", "rule": string(d.rule.Type)}
+				}
+			}
+		}
+	}
+
+	fieldLower := strings.ToLower(field)
+	for _, hint := range d.rule.FieldHints {
+		if strings.Contains(fieldLower, hint) {
+			return true, d.rule.FieldConfidence, map[string]string{"pattern": "field_name", "rule": string(d.rule.Type)}
+		}
+	}
+	return false, 0, nil
+}
+
+// GetType returns the rule's PII type
+func (d *RuleDetector) GetType() PIIType { return d.rule.Type }
+
+// GetSensitivity returns the rule's sensitivity
+func (d *RuleDetector) GetSensitivity() PIISensitivity { return d.rule.Sensitivity }
+
+// Anonymizer returns the rule's anonymizer, or "" for the type's default
+func (d *RuleDetector) Anonymizer() AnonymizationMethod { return d.rule.Anonymizer }
+
+// LoadRules replaces the detectors of the types the rule file defines and
+// adds the new ones; built-in detectors of other types stay. An invalid
+// file leaves the current detectors in place.
+func (pm *PIIManager) LoadRules(path string) error {
+	rules, err := LoadPIIRules(path)
+	if err != nil {
+		return err
+	}
+	detectors, err := rules.Compile()
+	if err != nil {
+		return err
+	}
+
+	builtin := make(map[PIIType]PIIDetector)
+	pm.initializeDetectorsInto(builtin)
+	methods := make(map[PIIType]AnonymizationMethod)
+	for _, detector := range detectors {
+		builtin[detector.GetType()] = detector
+		if method := detector.Anonymizer(); method != "" {
+			methods[detector.GetType()] = method
+		}
+	}
+
+	pm.mu.Lock()
+	pm.detectors = builtin
+	pm.methods = methods
+	pm.mu.Unlock()
+
+	pm.logger.Info("PII rules loaded",
+		zap.String("path", path),
+		zap.String("version", rules.Version),
+		zap.Int("rules", len(detectors)))
+	return nil
+}
+
+// WatchRules reloads the rule file every interval when its modification
+// time or size changed, until Close. Reload errors are logged and the
+// previous rules stay active.
+func (pm *PIIManager) WatchRules(path string, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	lastModified, lastSize := rulesFileVersion(path)
+
+	pm.mu.Lock()
+	if pm.stopWatch == nil {
+		pm.stopWatch = make(chan struct{})
+	}
+	stop := pm.stopWatch
+	pm.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				modified, size := rulesFileVersion(path)
+				if modified.Equal(lastModified) && size == lastSize {
+					continue
+				}
+				lastModified, lastSize = modified, size
+				if err := pm.LoadRules(path); err != nil {
+					pm.logger.Error("Failed to reload PII rules, keeping the previous rules",
+						zap.String("path", path),
+						zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// Close stops watching the rule file
+func (pm *PIIManager) Close() {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+
+	if pm.stopWatch != nil {
+		close(pm.stopWatch)
+		pm.stopWatch = nil
+	}
+}
+
+func rulesFileVersion(path string) (time.Time, int64) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}, -1
+	}
+	return info.ModTime(), info.Size()
+}
+
+// digitsOf returns the decimal digits of s
+func digitsOf(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// validCPF checks the two check digits of a Brazilian CPF
+func validCPF(value string) bool {
+	cpf := digitsOf(value)
+	if len(cpf) != 11 || allSameDigit(cpf) {
+		return false
+	}
+	return mod11CheckDigit(cpf[:9], 10) == int(cpf[9]-'0') &&
+		mod11CheckDigit(cpf[:10], 11) == int(cpf[10]-'0')
+}
+
+// mod11CheckDigit weights digits from firstWeight down to 2
+func mod11CheckDigit(digits string, firstWeight int) int {
+	sum := 0
+	for i := range digits {
+		sum += int(digits[i]-'0') * (firstWeight - i)
+	}
+	check := (sum * 10) % 11
+	if check == 10 {
+		return 0
+	}
+	return check
+}
+
+// validCNPJ checks the two check digits of a Brazilian CNPJ
+func validCNPJ(value string) bool {
+	cnpj := digitsOf(value)
+	if len(cnpj) != 14 || allSameDigit(cnpj) {
+		return false
+	}
+	first := []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	second := append([]int{6}, first...)
+	return weightedCheckDigit(cnpj[:12], first) == int(cnpj[12]-'0') &&
+		weightedCheckDigit(cnpj[:13], second) == int(cnpj[13]-'0')
+}
+
+// validPIS checks the check digit of a Brazilian PIS/PASEP/NIT
+func validPIS(value string) bool {
+	pis := digitsOf(value)
+	if len(pis) != 11 || allSameDigit(pis) {
+		return false
+	}
+	return weightedCheckDigit(pis[:10], []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) == int(pis[10]-'0')
+}
+
+// weightedCheckDigit is the mod 11 check digit used by CNPJ and PIS
+func weightedCheckDigit(digits string, weights []int) int {
+	sum := 0
+	for i := range digits {
+		sum += int(digits[i]-'0') * weights[i]
+	}
+	remainder := sum % 11
+	if remainder < 2 {
+		return 0
+	}
+	return 11 - remainder
+}
+
+// validLuhn checks a payment card number with the Luhn algorithm
+func validLuhn(value string) bool {
+	number := digitsOf(value)
+	if len(number) < 12 || len(number) > 19 {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		digit := int(number[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// validIBAN checks an IBAN with the ISO 13616 mod 97 rule
+func validIBAN(value string) bool {
+	iban := strings.ToUpper(strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, value))
+	if len(iban) < 15 || len(iban) > 34 {
+		return false
+	}
+
+	var numeric strings.Builder
+	for _, r := range iban[4:] + iban[:4] {
+		switch {
+		case r >= '0' && r <= '9':
+			numeric.WriteRune(r)
+		case r >= 'A' && r <= 'Z':
+			fmt.Fprintf(&numeric, "%d", r-'A'+10)
+		default:
+			return false
+		}
+	}
+	n, ok := new(big.Int).SetString(numeric.String(), 10)
+	return ok && new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
+
+func allSameDigit(digits string) bool {
+	return strings.Count(digits, digits[:1]) == len(digits)
+}
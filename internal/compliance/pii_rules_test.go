@@ -0,0 +1,130 @@
+package compliance
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// shippedPIIRules is the rule file referenced by ai-policies.yaml
+const shippedPIIRules = "../../templates/ai/policies/pii-detectors.yaml"
+
+func TestPIIValidators(t *testing.T) {
+	tests := []struct {
+		validator string
+		valid     []string
+		invalid   []string
+	}{
+		{"cpf", []string{"529.982.247-25", "52998224725"}, []string{"529.982.247-24", "111.111.111-11", "123"}},
+		{"cnpj", []string{"11.222.333/0001-81", "11222333000181"}, []string{"11.222.333/0001-82", "00000000000000"}},
+		{"pis", []string{"120.56048.74-6", "12056048746"}, []string{"120.56048.74-5", "11111111111"}},
+		{"luhn", []string{"4111 1111 1111 1111", "5500-0000-0000-0004"}, []string{"4111 1111 1111 1112", "4111"}},
+		{"iban", []string{"GB82 WEST 1234 5698 7654 32", "DE89370400440532013000"}, []string{"GB82 WEST 1234 5698 7654 33", "GB82"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.validator, func(t *testing.T) {
+			validate := piiValidators[tt.validator]
+			for _, value := range tt.valid {
+				assert.True(t, validate(value), value)
+			}
+			for _, value := range tt.invalid {
+				assert.False(t, validate(value), value)
+			}
+		})
+	}
+}
+
+func TestParsePIIRules_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"unknown field":     "detectors:\n  - type: rg\n    field_hint: [rg]\n",
+		"missing type":      "detectors:\n  - patterns: ['\\d+']\n",
+		"no match criteria": "detectors:\n  - type: rg\n",
+		"bad pattern":       "detectors:\n  - type: rg\n    patterns: ['(']\n",
+		"unknown validator": "detectors:\n  - type: rg\n    patterns: ['\\d+']\n    validator: mod7\n",
+		"bad sensitivity":   "detectors:\n  - type: rg\n    field_hints: [rg]\n    sensitivity: secret\n",
+		"bad anonymizer":    "detectors:\n  - type: rg\n    field_hints: [rg]\n    anonymizer: shuffle\n",
+		"bad confidence":    "detectors:\n  - type: rg\n    field_hints: [rg]\n    confidence: 2\n",
+		"duplicate type":    "detectors:\n  - type: rg\n    field_hints: [rg]\n  - type: rg\n    field_hints: [identidade]\n",
+	}
+	for name, raw := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParsePIIRules([]byte(raw))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPIIManager_ShippedRules(t *testing.T) {
+	pm, err := NewPIIManager(PIIDetectionConfig{Enabled: true, Confidence: 0.8, RulesFile: shippedPIIRules}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	defer pm.Close()
+
+	tests := []struct {
+		field, value string
+		piiType      PIIType
+	}{
+		{"notes", "CNPJ 11.222.333/0001-81", "cnpj"},
+		{"notes", "PIS 120.56048.74-6", "pis"},
+		{"notes", "CEP 01310-100", "cep"},
+		{"notes", "Placa ABC1D23", "vehicle_plate"},
+		{"notes", "IBAN GB82 WEST 1234 5698 7654 32", "iban"},
+		{"notes", "Cartao 4111 1111 1111 1111", PIITypeCreditCard},
+		{"notes", "CPF 529.982.247-25", PIITypeCPF},
+		{"rg", "12.345.678-9", "rg"},
+		{"contact", "ana@example.com", PIITypeEmail},
+	}
+	for _, tt := range tests {
+		classification, detected := pm.detectPII(tt.field, tt.value)
+		if assert.True(t, detected, tt.value) {
+			assert.Equal(t, tt.piiType, classification.PIIType, tt.value)
+		}
+	}
+
+	_, detected := pm.detectPII("notes", "CNPJ 11.222.333/0001-82")
+	assert.False(t, detected, "matches failing the checksum are not PII")
+
+	// Rule anonymizers override the type's default method
+	assert.Equal(t, AnonymizationRedact, pm.getAnonymizationMethod("vehicle_plate"))
+	assert.Equal(t, AnonymizationHash, pm.getAnonymizationMethod("unknown"))
+}
+
+func TestPIIManager_ReloadsRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pii-detectors.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("version: \"1\"\ndetectors:\n  - type: rg\n    field_hints: [rg]\n"), 0o600))
+
+	pm, err := NewPIIManager(PIIDetectionConfig{Enabled: true, Confidence: 0.5, RulesFile: path, ReloadInterval: 10 * time.Millisecond}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	defer pm.Close()
+
+	_, detected := pm.detectPII("rg", "12.345.678-9")
+	assert.True(t, detected)
+	_, detected = pm.detectPII("plate", "ABC1D23")
+	assert.False(t, detected)
+
+	// A new detector is picked up without a restart
+	require.NoError(t, os.WriteFile(path, []byte("version: \"2\"\ndetectors:\n  - type: rg\n    field_hints: [rg]\n  - type: vehicle_plate\n    patterns: ['[A-Z]{3}\\d[A-Z0-9]\\d{2}']\n"), 0o600))
+	assert.Eventually(t, func() bool {
+		_, detected := pm.detectPII("plate", "ABC1D23")
+		return detected
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// An invalid file keeps the previous rules
+	require.NoError(t, os.WriteFile(path, []byte("detectors:\n  - type: vehicle_plate\n    patterns: ['(']\n"), 0o600))
+	time.Sleep(100 * time.Millisecond)
+	_, detected = pm.detectPII("plate", "ABC1D23")
+	assert.True(t, detected)
+
+	// Built-in detectors of other types stay
+	_, detected = pm.detectPII("contact", "ana@example.com")
+	assert.True(t, detected)
+}
+
+func TestNewPIIManager_InvalidRulesFile(t *testing.T) {
+	_, err := NewPIIManager(PIIDetectionConfig{Enabled: true, RulesFile: filepath.Join(t.TempDir(), "missing.yaml")}, zaptest.NewLogger(t))
+	assert.Error(t, err)
+}
@@ -0,0 +1,458 @@
+package compliance
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ErrPIICycle is returned when a scanned value contains itself
+var ErrPIICycle = errors.New("cyclic value")
+
+// PIIFinding is a piece of PII located inside a value. Path addresses the
+// value from the scanned root ("metadata.contact.email", "tags[1]") and
+// Start/End are byte offsets of the match within the string at Path; a
+// detection that covers the whole value, such as one made from the field
+// name, spans the entire string.
+type PIIFinding struct {
+	Path        string            `json:"path"`
+	PIIType     PIIType           `json:"pii_type"`
+	Sensitivity PIISensitivity    `json:"sensitivity"`
+	Confidence  float64           `json:"confidence"`
+	Start       int               `json:"start"`
+	End         int               `json:"end"`
+	Context     map[string]string `json:"context,omitempty"`
+}
+
+// PIISpan is a match a SpanDetector found inside free text
+type PIISpan struct {
+	Start      int
+	End        int
+	Confidence float64
+	Context    map[string]string
+}
+
+// SpanDetector is a PIIDetector that can also locate its matches inside
+// free text, so they can be reported and masked without touching the rest
+// of the text
+type SpanDetector interface {
+	PIIDetector
+	FindSpans(text string) []PIISpan
+}
+
+// Scan walks data recursively and returns the PII found in it. Maps,
+// slices, arrays, pointers and structs are followed; struct fields are
+// named by their json tag. Strings are searched for span matches first and
+// fall back to whole-value detection, which also covers field names. A
+// value that contains itself fails with ErrPIICycle.
+func (pm *PIIManager) Scan(data interface{}) ([]PIIFinding, error) {
+	scanner := pm.scan(data)
+	return scanner.findings, scanner.err
+}
+
+// Mask walks data like Scan and replaces every match in place with the
+// output of its type's anonymizer. Only the matched span of a string is
+// replaced, so the surrounding text stays readable. data must be a
+// pointer, map or slice for the changes to be visible to the caller.
+func (pm *PIIManager) Mask(data interface{}) ([]PIIFinding, error) {
+	if !pm.config.Enabled {
+		return nil, nil
+	}
+
+	scanner := pm.newScanner(true)
+	_, changed := scanner.walk("", "", reflect.ValueOf(data))
+	if scanner.err != nil {
+		return scanner.findings, scanner.err
+	}
+	if changed {
+		return scanner.findings, fmt.Errorf("cannot mask %T in place, pass a pointer", data)
+	}
+	return scanner.findings, nil
+}
+
+// piiScanner holds the state of one Scan or Mask walk
+type piiScanner struct {
+	pm       *PIIManager
+	mask     bool
+	findings []PIIFinding
+	leaves   int
+	err      error
+	// visiting holds the pointers, maps and slices on the current path, so
+	// a value reached again from inside itself is reported as a cycle
+	visiting map[visit]bool
+}
+
+// visit identifies a reference value; slices sharing a backing array
+// differ by length, as in encoding/json
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+func (pm *PIIManager) newScanner(mask bool) *piiScanner {
+	return &piiScanner{pm: pm, mask: mask, findings: []PIIFinding{}, visiting: make(map[visit]bool)}
+}
+
+// enter marks the reference value v as being walked and reports whether
+// the walk may descend into it; leave must be called when it returns
+func (s *piiScanner) enter(path string, v reflect.Value) bool {
+	key := visit{ptr: v.Pointer(), typ: v.Type()}
+	if v.Kind() == reflect.Slice {
+		key.len = v.Len()
+	}
+	if s.visiting[key] {
+		if path == "" {
+			path = "root"
+		}
+		s.err = fmt.Errorf("%w at %s", ErrPIICycle, path)
+		return false
+	}
+	s.visiting[key] = true
+	return true
+}
+
+func (s *piiScanner) leave(v reflect.Value) {
+	key := visit{ptr: v.Pointer(), typ: v.Type()}
+	if v.Kind() == reflect.Slice {
+		key.len = v.Len()
+	}
+	delete(s.visiting, key)
+}
+
+// scan runs a read-only walk over data
+func (pm *PIIManager) scan(data interface{}) *piiScanner {
+	scanner := pm.newScanner(false)
+	scanner.walk("", "", reflect.ValueOf(data))
+	return scanner
+}
+
+// walk scans v, which sits at path under the field name field. It returns
+// the value that must replace v in its parent when masking could not
+// happen in place, together with whether such a replacement is needed.
+func (s *piiScanner) walk(path, field string, v reflect.Value) (reflect.Value, bool) {
+	if !v.IsValid() || s.err != nil {
+		return v, false
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return v, false
+		}
+		return s.walk(path, field, v.Elem())
+
+	case reflect.Pointer:
+		if v.IsNil() || !s.enter(path, v) {
+			return v, false
+		}
+		defer s.leave(v)
+		elem := v.Elem()
+		if replacement, changed := s.walk(path, field, elem); changed {
+			assign(elem, replacement)
+		}
+		return v, false
+
+	case reflect.Map:
+		if v.IsNil() || !s.enter(path, v) {
+			return v, false
+		}
+		defer s.leave(v)
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, key := range keys {
+			name := fmt.Sprint(key.Interface())
+			replacement, changed := s.walk(joinPath(path, name), name, v.MapIndex(key))
+			if changed && replacement.Type().AssignableTo(v.Type().Elem()) {
+				v.SetMapIndex(key, replacement)
+			}
+		}
+		return v, false
+
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 || v.Len() == 0 || !s.enter(path, v) {
+			return v, false
+		}
+		defer s.leave(v)
+		for i := 0; i < v.Len(); i++ {
+			elem := v.Index(i)
+			if replacement, changed := s.walk(fmt.Sprintf("%s[%d]", path, i), field, elem); changed {
+				assign(elem, replacement)
+			}
+		}
+		return v, false
+
+	case reflect.Array, reflect.Struct:
+		if v.Kind() == reflect.Array && v.Type().Elem().Kind() == reflect.Uint8 {
+			return v, false
+		}
+		// Values held by maps and interfaces are not addressable; mask a
+		// copy and hand it back to the parent
+		copied := false
+		if s.mask && !v.CanAddr() {
+			addressable := reflect.New(v.Type()).Elem()
+			addressable.Set(v)
+			v, copied = addressable, true
+		}
+		changedAny := false
+		if v.Kind() == reflect.Array {
+			for i := 0; i < v.Len(); i++ {
+				elem := v.Index(i)
+				if replacement, changed := s.walk(fmt.Sprintf("%s[%d]", path, i), field, elem); changed {
+					changedAny = assign(elem, replacement) || changedAny
+				}
+			}
+		} else {
+			changedAny = s.walkStruct(path, v)
+		}
+		return v, copied && changedAny
+
+	case reflect.String:
+		return s.scanText(path, field, v)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return s.scanWhole(path, field, v)
+	}
+	return v, false
+}
+
+// walkStruct scans the exported fields of the addressable struct v and
+// reports whether any of them was masked
+func (s *piiScanner) walkStruct(path string, v reflect.Value) bool {
+	changedAny := false
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+		name, skip := jsonFieldName(structField)
+		if skip {
+			continue
+		}
+
+		fieldPath := joinPath(path, name)
+		if structField.Anonymous && name == structField.Name {
+			// Embedded structs without a tag are flattened like encoding/json
+			fieldPath = path
+		}
+		fieldValue := v.Field(i)
+		if replacement, changed := s.walk(fieldPath, name, fieldValue); changed {
+			changedAny = assign(fieldValue, replacement) || changedAny
+		}
+	}
+	return changedAny
+}
+
+// scanText searches a string for span matches and falls back to
+// whole-value detection
+func (s *piiScanner) scanText(path, field string, v reflect.Value) (reflect.Value, bool) {
+	s.leaves++
+	text := v.String()
+	spans := s.pm.findSpans(text)
+	if len(spans) == 0 {
+		return s.scanWhole(path, field, v)
+	}
+
+	for _, span := range spans {
+		span.Path = path
+		s.findings = append(s.findings, span)
+	}
+	if !s.mask {
+		return v, false
+	}
+
+	// Replace from the end so earlier offsets stay valid
+	masked := text
+	for i := len(spans) - 1; i >= 0; i-- {
+		span := spans[i]
+		replacement, err := s.pm.anonymizeValue(span.PIIType, masked[span.Start:span.End], span.Context)
+		if err != nil {
+			s.err = fmt.Errorf("failed to mask %s at %s: %w", span.PIIType, path, err)
+			return v, false
+		}
+		masked = masked[:span.Start] + fmt.Sprint(replacement) + masked[span.End:]
+	}
+	return reflect.ValueOf(masked).Convert(v.Type()), true
+}
+
+// scanWhole classifies a scalar as a whole, using its value and field name
+func (s *piiScanner) scanWhole(path, field string, v reflect.Value) (reflect.Value, bool) {
+	value := v.Interface()
+	if v.Kind() == reflect.String {
+		// Detectors expect plain strings, not named string types
+		value = v.String()
+	} else {
+		s.leaves++
+	}
+	classification, detected := s.pm.detectPII(field, value)
+	if !detected {
+		return v, false
+	}
+
+	s.findings = append(s.findings, PIIFinding{
+		Path:        path,
+		PIIType:     classification.PIIType,
+		Sensitivity: classification.Sensitivity,
+		Confidence:  classification.Confidence,
+		Start:       0,
+		End:         len(fmt.Sprint(value)),
+		Context:     classification.Context,
+	})
+	if !s.mask {
+		return v, false
+	}
+
+	replacement, err := s.pm.anonymizeValue(classification.PIIType, value, classification.Context)
+	if err != nil {
+		s.err = fmt.Errorf("failed to mask %s at %s: %w", classification.PIIType, path, err)
+		return v, false
+	}
+	masked := reflect.ValueOf(replacement)
+	if v.Kind() == reflect.String && masked.Kind() == reflect.String {
+		masked = masked.Convert(v.Type())
+	}
+	return masked, true
+}
+
+// findSpans returns the non-overlapping matches of the span detectors in
+// text, ordered by offset. Where matches overlap the most confident, then
+// the longest, wins.
+func (pm *PIIManager) findSpans(text string) []PIIFinding {
+	if text == "" {
+		return nil
+	}
+
+	var candidates []PIIFinding
+	pm.mu.RLock()
+	for piiType, detector := range pm.detectors {
+		spanDetector, ok := detector.(SpanDetector)
+		if !ok {
+			continue
+		}
+		for _, span := range spanDetector.FindSpans(text) {
+			if span.Confidence < pm.config.Confidence || span.Start >= span.End {
+				continue
+			}
+			candidates = append(candidates, PIIFinding{
+				PIIType:     piiType,
+				Sensitivity: detector.GetSensitivity(),
+				Confidence:  span.Confidence,
+				Start:       span.Start,
+				End:         span.End,
+				Context:     span.Context,
+			})
+		}
+	}
+	pm.mu.RUnlock()
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Confidence != b.Confidence {
+			return a.Confidence > b.Confidence
+		}
+		if a.End-a.Start != b.End-b.Start {
+			return a.End-a.Start > b.End-b.Start
+		}
+		if a.Start != b.Start {
+			return a.Start < b.Start
+		}
+		return a.PIIType < b.PIIType
+	})
+
+	var spans []PIIFinding
+	for _, candidate := range candidates {
+		overlaps := false
+		for _, kept := range spans {
+			if candidate.Start < kept.End && kept.Start < candidate.End {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			spans = append(spans, candidate)
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].Start < spans[j].Start })
+	return spans
+}
+
+// FindSpans returns the pattern matches in text that pass the validator
+func (d *RuleDetector) FindSpans(text string) []PIISpan {
+	var spans []PIISpan
+	for _, pattern := range d.patterns {
+		for _, loc := range pattern.FindAllStringIndex(text, -1) {
+			if d.validate != nil && !d.validate(text[loc[0]:loc[1]]) {
+				continue
+			}
+			spans = append(spans, PIISpan{
+				Start:      loc[0],
+				End:        loc[1],
+				Confidence: d.rule.Confidence,
+				Context:    map[string]string{"pattern": "rule", "rule": string(d.rule.Type)},
+			})
+		}
+	}
+	return spans
+}
+
+var (
+	emailSpanRegex = regexp.MustCompile(`[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}`)
+	cpfSpanRegex   = regexp.MustCompile(`\b\d{3}\.?\d{3}\.?\d{3}-?\d{2}\b`)
+)
+
+// FindSpans returns the email addresses in text
+func (d *EmailDetector) FindSpans(text string) []PIISpan {
+	var spans []PIISpan
+	for _, loc := range emailSpanRegex.FindAllStringIndex(text, -1) {
+		spans = append(spans, PIISpan{Start: loc[0], End: loc[1], Confidence: 0.95, Context: map[string]string{"pattern": "email_regex"}})
+	}
+	return spans
+}
+
+// FindSpans returns the valid CPF numbers in text
+func (d *CPFDetector) FindSpans(text string) []PIISpan {
+	var spans []PIISpan
+	for _, loc := range cpfSpanRegex.FindAllStringIndex(text, -1) {
+		if validCPF(text[loc[0]:loc[1]]) {
+			spans = append(spans, PIISpan{Start: loc[0], End: loc[1], Confidence: 0.98, Context: map[string]string{"pattern": "cpf_validation"}})
+		}
+	}
+	return spans
+}
+
+// assign stores replacement in the settable slot and reports whether it
+// could
+func assign(slot, replacement reflect.Value) bool {
+	if !slot.CanSet() || !replacement.Type().AssignableTo(slot.Type()) {
+		return false
+	}
+	slot.Set(replacement)
+	return true
+}
+
+// jsonFieldName returns the name encoding/json gives a struct field and
+// whether the field is skipped
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name, false
+	}
+	return field.Name, false
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
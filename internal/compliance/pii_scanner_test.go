@@ -0,0 +1,155 @@
+package compliance
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+type scanNote struct {
+	Body     string                 `json:"body"`
+	Tags     []string               `json:"tags"`
+	Metadata map[string]interface{} `json:"metadata"`
+	Internal string                 `json:"-"`
+}
+
+func newScanTestManager(t *testing.T) *PIIManager {
+	pm, err := NewPIIManager(PIIDetectionConfig{Enabled: true, Confidence: 0.8, RulesFile: shippedPIIRules}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	t.Cleanup(pm.Close)
+	return pm
+}
+
+func findingAt(findings []PIIFinding, path string) (PIIFinding, bool) {
+	for _, finding := range findings {
+		if finding.Path == path {
+			return finding, true
+		}
+	}
+	return PIIFinding{}, false
+}
+
+func TestPIIManager_ScanNested(t *testing.T) {
+	pm := newScanTestManager(t)
+
+	body := "Ligar para o cliente, CPF 529.982.247-25, e responder ana@example.com"
+	note := &scanNote{
+		Body: body,
+		Tags: []string{"urgente", "ana@example.com"},
+		Metadata: map[string]interface{}{
+			"contact":  map[string]interface{}{"email": "ana@example.com"},
+			"comments": []interface{}{"sem dados", "CNPJ 11.222.333/0001-81"},
+			"count":    3,
+		},
+		Internal: "ana@example.com",
+	}
+
+	findings, err := pm.Scan(note)
+	require.NoError(t, err)
+
+	cpf, ok := findingAt(findings, "body")
+	require.True(t, ok)
+	assert.Equal(t, PIITypeCPF, cpf.PIIType)
+	assert.Equal(t, "529.982.247-25", body[cpf.Start:cpf.End])
+
+	var bodyTypes []PIIType
+	for _, finding := range findings {
+		if finding.Path == "body" {
+			bodyTypes = append(bodyTypes, finding.PIIType)
+		}
+	}
+	assert.Equal(t, []PIIType{PIITypeCPF, PIITypeEmail}, bodyTypes, "spans are reported in text order")
+
+	for _, path := range []string{"tags[1]", "metadata.contact.email", "metadata.comments[1]"} {
+		_, ok := findingAt(findings, path)
+		assert.True(t, ok, path)
+	}
+	for _, path := range []string{"tags[0]", "metadata.comments[0]", "metadata.count", "-", "Internal"} {
+		_, ok := findingAt(findings, path)
+		assert.False(t, ok, path)
+	}
+}
+
+func TestPIIManager_MaskSpans(t *testing.T) {
+	pm := newScanTestManager(t)
+
+	note := &scanNote{
+		Body: "CPF 529.982.247-25 do cliente",
+		Tags: []string{"ana@example.com"},
+		Metadata: map[string]interface{}{
+			"contact": map[string]interface{}{"email": "ana@example.com"},
+			"notes":   []interface{}{"placa ABC1D23 na garagem"},
+		},
+	}
+
+	findings, err := pm.Mask(note)
+	require.NoError(t, err)
+	assert.Len(t, findings, 4)
+
+	// Only the matched span is replaced
+	assert.True(t, strings.HasPrefix(note.Body, "CPF TKN_"), note.Body)
+	assert.True(t, strings.HasSuffix(note.Body, " do cliente"), note.Body)
+	assert.NotContains(t, note.Body, "529.982.247-25")
+
+	assert.NotContains(t, note.Tags[0], "ana@example.com")
+	contact := note.Metadata["contact"].(map[string]interface{})
+	assert.NotEqual(t, "ana@example.com", contact["email"])
+	assert.Equal(t, "placa AB***23 na garagem", note.Metadata["notes"].([]interface{})[0])
+
+	// Masked data has nothing left to find
+	findings, err = pm.Scan(note)
+	require.NoError(t, err)
+	assert.Empty(t, findings)
+}
+
+func TestPIIManager_MaskNeedsPointer(t *testing.T) {
+	pm := newScanTestManager(t)
+
+	_, err := pm.Mask(scanNote{Body: "ana@example.com"})
+	assert.Error(t, err)
+
+	_, err = pm.Mask(scanNote{Body: "nada a mascarar"})
+	assert.NoError(t, err)
+}
+
+func TestPIIManager_ScanCyclic(t *testing.T) {
+	pm := newScanTestManager(t)
+
+	cyclic := map[string]interface{}{"email": "ana@example.com"}
+	cyclic["contact"] = map[string]interface{}{"owner": cyclic}
+	_, err := pm.Scan(cyclic)
+	assert.ErrorIs(t, err, ErrPIICycle)
+	assert.ErrorContains(t, err, "contact.owner")
+	_, err = pm.Mask(cyclic)
+	assert.ErrorIs(t, err, ErrPIICycle)
+
+	// A value reached twice without a cycle is scanned twice
+	shared := map[string]interface{}{"email": "ana@example.com"}
+	findings, err := pm.Scan(map[string]interface{}{"a": shared, "b": shared})
+	require.NoError(t, err)
+	assert.Len(t, findings, 2)
+}
+
+func TestFramework_ScanForPII_Nested(t *testing.T) {
+	framework := createTestFramework(t)
+
+	result, err := framework.ScanForPII(context.Background(), map[string]interface{}{
+		"title": "Revisar contrato",
+		"metadata": map[string]interface{}{
+			"contact": map[string]interface{}{"email": "joao@example.com"},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"metadata.contact.email"}, result.DetectedFields)
+	assert.Equal(t, PIITypeEmail, result.Classifications["metadata.contact.email"].PIIType)
+	assert.Equal(t, 2, result.TotalFields)
+	assert.Equal(t, 1, result.PIIFields)
+	require.Len(t, result.Findings, 1)
+	assert.Equal(t, 0, result.Findings[0].Start)
+	assert.Equal(t, len("joao@example.com"), result.Findings[0].End)
+}
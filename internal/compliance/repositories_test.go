@@ -0,0 +1,308 @@
+package compliance
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// testConsentRepository checks the ConsentRepository contract; the
+// Postgres repository runs it in the integration tests
+func testConsentRepository(t *testing.T, repo ConsentRepository) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	expired := now.Add(-time.Hour)
+
+	consent := ConsentRecord{
+		ID:            "consent-1",
+		SubjectID:     "subject-1",
+		Purpose:       "analytics",
+		Granted:       true,
+		LegalBasis:    string(LegalBasisConsent),
+		ConsentSource: ConsentSourceWeb,
+		Timestamp:     now,
+		ExpiresAt:     &expired,
+		Metadata:      map[string]interface{}{"campaign": "spring"},
+		Version:       1,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	require.NoError(t, repo.StoreConsent(ctx, consent))
+	assert.Error(t, repo.StoreConsent(ctx, consent), "one consent per subject and purpose")
+
+	_, err := repo.GetConsent(ctx, "subject-1", "marketing")
+	assert.ErrorIs(t, err, ErrConsentNotFound)
+
+	expiredConsents, err := repo.GetExpiredConsents(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, expiredConsents, 1)
+	assert.Equal(t, "consent-1", expiredConsents[0].ID)
+
+	// Withdrawing stores version 2 and leaves version 1 in the history
+	withdrawn := consent
+	withdrawnAt := now.Add(time.Minute)
+	withdrawn.WithdrawnAt = &withdrawnAt
+	withdrawn.Version = 2
+	withdrawn.UpdatedAt = withdrawnAt
+	require.NoError(t, repo.UpdateConsent(ctx, withdrawn))
+
+	stale := consent
+	stale.Version = 2
+	assert.ErrorIs(t, repo.UpdateConsent(ctx, stale), ErrConsentVersionConflict)
+
+	current, err := repo.GetConsent(ctx, "subject-1", "analytics")
+	require.NoError(t, err)
+	assert.Equal(t, 2, current.Version)
+	require.NotNil(t, current.WithdrawnAt)
+	assert.True(t, current.WithdrawnAt.Equal(withdrawnAt))
+	assert.Equal(t, "spring", current.Metadata["campaign"])
+
+	history, err := repo.GetConsentHistory(ctx, "subject-1", "analytics")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, 1, history[0].Version)
+	assert.Nil(t, history[0].WithdrawnAt)
+	assert.Equal(t, 2, history[1].Version)
+
+	expiredConsents, err = repo.GetExpiredConsents(ctx, now)
+	require.NoError(t, err)
+	assert.Empty(t, expiredConsents, "withdrawn consents are not reported as expired")
+
+	marketing := consent
+	marketing.ID = "consent-2"
+	marketing.Purpose = "marketing"
+	marketing.ExpiresAt = nil
+	require.NoError(t, repo.StoreConsent(ctx, marketing))
+	all, err := repo.GetAllConsents(ctx, "subject-1")
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	require.NoError(t, repo.DeleteConsent(ctx, "subject-1", "analytics"))
+	_, err = repo.GetConsentHistory(ctx, "subject-1", "analytics")
+	assert.ErrorIs(t, err, ErrConsentNotFound)
+}
+
+// testRetentionRepository checks the RetentionRepository contract
+func testRetentionRepository(t *testing.T, repo RetentionRepository) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	records := []RetentionRecord{
+		{ID: "r-active", RetentionEnd: now.Add(-2 * time.Hour), Status: RetentionStatusActive},
+		{ID: "r-extended", RetentionEnd: now.Add(-time.Hour), Status: RetentionStatusExtended},
+		{ID: "r-future", RetentionEnd: now.Add(time.Hour), Status: RetentionStatusActive},
+		{ID: "r-hold", RetentionEnd: now.Add(-time.Hour), Status: RetentionStatusOnHold, LegalHold: true, LegalHoldReason: "litigation"},
+		{ID: "r-done", RetentionEnd: now.Add(-time.Hour), Status: RetentionStatusCompleted, ActionTaken: true},
+	}
+	for _, record := range records {
+		record.SubjectID = "subject-1"
+		record.DataType = "user_data"
+		record.PolicyID = "user_data_policy"
+		record.Action = RetentionActionDelete
+		record.CreatedAt = now
+		record.RetentionStart = now.Add(-24 * time.Hour)
+		record.UpdatedAt = now
+		require.NoError(t, repo.StoreRetentionRecord(ctx, record))
+	}
+
+	expiredRecords, err := repo.GetExpiredRecords(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, expiredRecords, 2)
+	assert.Equal(t, "r-active", expiredRecords[0].ID, "oldest first")
+	assert.Equal(t, "r-extended", expiredRecords[1].ID)
+
+	extended := expiredRecords[0]
+	extended.RetentionEnd = now.Add(24 * time.Hour)
+	extended.Status = RetentionStatusExtended
+	extended.Extensions = []RetentionExtension{{Reason: "audit", ExtendBy: 26 * time.Hour, ExtendedBy: "dpo", ExtendedAt: now, ExpiresAt: extended.RetentionEnd, Approved: true}}
+	extended.Metadata = map[string]interface{}{"ticket": "LGL-1"}
+	require.NoError(t, repo.UpdateRetentionRecord(ctx, extended))
+
+	subjectRecords, err := repo.GetRetentionRecords(ctx, "subject-1")
+	require.NoError(t, err)
+	require.Len(t, subjectRecords, len(records))
+	for _, record := range subjectRecords {
+		switch record.ID {
+		case "r-active":
+			require.Len(t, record.Extensions, 1)
+			assert.Equal(t, 26*time.Hour, record.Extensions[0].ExtendBy)
+			assert.Equal(t, "LGL-1", record.Metadata["ticket"])
+		case "r-hold":
+			assert.True(t, record.LegalHold)
+			assert.Equal(t, "litigation", record.LegalHoldReason)
+		}
+	}
+
+	expiredRecords, err = repo.GetExpiredRecords(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, expiredRecords, 1)
+	assert.Equal(t, "r-extended", expiredRecords[0].ID)
+
+	require.NoError(t, repo.DeleteRetentionRecord(ctx, "r-done"))
+	assert.ErrorIs(t, repo.DeleteRetentionRecord(ctx, "r-done"), ErrRetentionRecordNotFound)
+	assert.ErrorIs(t, repo.UpdateRetentionRecord(ctx, RetentionRecord{ID: "r-missing", SubjectID: "subject-1"}), ErrRetentionRecordNotFound)
+}
+
+// testDataRightRepository checks the DataRightRepository contract
+func testDataRightRepository(t *testing.T, repo DataRightRepository) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	requests := []DataRightRequest{
+		{ID: "d-access", SubjectID: "subject-1", Type: DataRightAccess, Status: DataRightStatusPending, RequestedAt: now.Add(-2 * time.Hour)},
+		{ID: "d-erasure", SubjectID: "subject-1", Type: DataRightErasure, Status: DataRightStatusPending, RequestedAt: now.Add(-time.Hour)},
+		{ID: "d-other", SubjectID: "subject-2", Type: DataRightAccess, Status: DataRightStatusCompleted, RequestedAt: now},
+	}
+	for _, request := range requests {
+		request.DueAt = request.RequestedAt.Add(720 * time.Hour)
+		request.Updates = []DataRightRequestUpdate{{Timestamp: request.RequestedAt, Status: request.Status, Message: "Request submitted"}}
+		require.NoError(t, repo.StoreRequest(ctx, request))
+	}
+	assert.Error(t, repo.StoreRequest(ctx, requests[0]), "IDs are unique")
+
+	page, total, err := repo.ListRequests(ctx, DataRightFilter{SubjectID: "subject-1"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, page, 2)
+	assert.Equal(t, "d-erasure", page[0].ID, "newest first")
+
+	page, total, err = repo.ListRequests(ctx, DataRightFilter{Type: DataRightAccess, Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	require.Len(t, page, 1)
+	assert.Equal(t, "d-access", page[0].ID)
+
+	page, total, err = repo.ListRequests(ctx, DataRightFilter{Status: DataRightStatusCompleted, Offset: 5})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Empty(t, page)
+
+	request, err := repo.GetRequest(ctx, "d-erasure")
+	require.NoError(t, err)
+	completedAt := now
+	request.Status = DataRightStatusCompleted
+	request.CompletedAt = &completedAt
+	request.Updates = append(request.Updates, DataRightRequestUpdate{Timestamp: now, Status: DataRightStatusCompleted, UpdatedBy: "dpo"})
+	request.Sources = []DataSourceResult{{Source: "tasks", Status: DataSourceStatusCompleted, Records: 2, CompletedAt: now}}
+	request.Export = &ExportBundle{RequestID: request.ID, SubjectID: request.SubjectID, Type: request.Type, GeneratedAt: now, Records: []SubjectRecord{}}
+	require.NoError(t, repo.UpdateRequest(ctx, *request))
+
+	stored, err := repo.GetRequest(ctx, "d-erasure")
+	require.NoError(t, err)
+	assert.Equal(t, DataRightStatusCompleted, stored.Status)
+	require.NotNil(t, stored.CompletedAt)
+	assert.True(t, completedAt.Equal(*stored.CompletedAt))
+	require.Len(t, stored.Updates, 2)
+	assert.Equal(t, "dpo", stored.Updates[1].UpdatedBy)
+	require.Len(t, stored.Sources, 1)
+	assert.Equal(t, 2, stored.Sources[0].Records)
+	require.NotNil(t, stored.Export)
+	assert.Equal(t, "d-erasure", stored.Export.RequestID)
+
+	_, err = repo.GetRequest(ctx, "d-missing")
+	assert.ErrorIs(t, err, ErrDataRightRequestNotFound)
+	assert.ErrorIs(t, repo.UpdateRequest(ctx, DataRightRequest{ID: "d-missing"}), ErrDataRightRequestNotFound)
+
+	_, err = repo.GetVerification(ctx, "subject-1")
+	assert.ErrorIs(t, err, ErrDataRightVerificationNotFound)
+	verification := DataRightVerification{SubjectID: "subject-1", CodeHash: "first", ExpiresAt: now.Add(time.Minute)}
+	require.NoError(t, repo.StoreVerification(ctx, verification))
+	verification.CodeHash, verification.Attempts = "second", 2
+	require.NoError(t, repo.StoreVerification(ctx, verification), "storing replaces the subject's code")
+	storedVerification, err := repo.GetVerification(ctx, "subject-1")
+	require.NoError(t, err)
+	assert.Equal(t, "second", storedVerification.CodeHash)
+	assert.Equal(t, 2, storedVerification.Attempts)
+	assert.True(t, verification.ExpiresAt.Equal(storedVerification.ExpiresAt))
+	require.NoError(t, repo.DeleteVerification(ctx, "subject-1"))
+	_, err = repo.GetVerification(ctx, "subject-1")
+	assert.ErrorIs(t, err, ErrDataRightVerificationNotFound)
+}
+
+func TestInMemoryConsentRepository(t *testing.T) {
+	testConsentRepository(t, NewInMemoryConsentRepository())
+}
+
+func TestInMemoryRetentionRepository(t *testing.T) {
+	testRetentionRepository(t, NewInMemoryRetentionRepository())
+}
+
+func TestInMemoryDataRightRepository(t *testing.T) {
+	testDataRightRepository(t, NewInMemoryDataRightRepository())
+}
+
+func TestConsentManager_VersionsHistory(t *testing.T) {
+	manager, err := NewConsentManager(ConsentConfig{Enabled: true, TTL: time.Hour}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	request := ConsentRequest{SubjectID: "subject-1", Purpose: "analytics", Granted: true, LegalBasis: string(LegalBasisConsent), ConsentSource: ConsentSourceWeb}
+	_, err = manager.GrantConsent(ctx, request)
+	require.NoError(t, err)
+	require.NoError(t, manager.WithdrawConsent(ctx, "subject-1", "analytics"))
+	regranted, err := manager.GrantConsent(ctx, request)
+	require.NoError(t, err)
+	assert.Equal(t, 3, regranted.Version)
+
+	history, err := manager.GetConsentHistory(ctx, "subject-1", "analytics")
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	for i, consent := range history {
+		assert.Equal(t, i+1, consent.Version)
+	}
+	assert.NotNil(t, history[1].WithdrawnAt)
+	assert.Nil(t, history[2].WithdrawnAt)
+
+	expired, err := manager.GetExpiredConsents(ctx, time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, expired, 1)
+}
+
+func TestNewFramework_RepositoryConfig(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+
+	for _, tt := range []struct {
+		name   string
+		config func(*Config, string)
+		kind   string
+	}{
+		{name: "consent", config: func(c *Config, store string) { c.Consent.Store = store }, kind: "consent"},
+		{name: "retention", config: func(c *Config, store string) { c.DataRetention.Store = store }, kind: "retention"},
+		{name: "data rights", config: func(c *Config, store string) { c.DataRights.Store = store }, kind: "data rights"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			config := Config{Enabled: true, AuditLogging: AuditLoggingConfig{Enabled: true, DetailLevel: "minimal"}}
+
+			tt.config(&config, RepositoryPostgres)
+			_, err := NewFramework(config, logger)
+			assert.ErrorContains(t, err, fmt.Sprintf("the postgres %s store requires a database connection", tt.kind))
+
+			tt.config(&config, "dynamo")
+			_, err = NewFramework(config, logger)
+			assert.ErrorContains(t, err, fmt.Sprintf("unknown %s store", tt.kind))
+
+			tt.config(&config, RepositoryMemory)
+			_, err = NewFramework(config, logger)
+			assert.NoError(t, err)
+		})
+	}
+
+	// An injected repository wins over the configured store
+	config := Config{Enabled: true, Consent: ConsentConfig{Enabled: true, Store: RepositoryPostgres}, DataRetention: DataRetentionConfig{Enabled: true, Store: RepositoryPostgres}}
+	consents := NewInMemoryConsentRepository()
+	framework, err := NewFramework(config, logger,
+		WithConsentRepository(consents),
+		WithRetentionRepository(NewInMemoryRetentionRepository()))
+	require.NoError(t, err)
+	require.NoError(t, framework.GetConsentManager().RecordConsent(context.Background(), "subject-1", "analytics", "web"))
+	_, err = consents.GetConsent(context.Background(), "subject-1", "analytics")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,370 @@
+package compliance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RetentionResourceKey is the retention record metadata key naming the one
+// resource a record covers
+const RetentionResourceKey = "resource_id"
+
+// ErrNoRetentionArchive is returned when a record's action is archive and no
+// archive is configured
+var ErrNoRetentionArchive = errors.New("no retention archive configured")
+
+// RetentionTarget applies retention actions to one store of personal data.
+// A record covers the subject's data of the record's DataType, or only the
+// resource named by Resource when set.
+type RetentionTarget interface {
+	// Name identifies the target in results and audit events
+	Name() string
+	// Handles reports whether the target holds data of dataType
+	Handles(dataType string) bool
+	// Retained returns the data the record covers
+	Retained(ctx context.Context, record RetentionRecord) ([]SubjectRecord, error)
+	// DeleteRetained deletes the data the record covers
+	DeleteRetained(ctx context.Context, record RetentionRecord) (int, error)
+	// AnonymizeRetained rewrites the data the record covers through anonymize
+	AnonymizeRetained(ctx context.Context, record RetentionRecord, anonymize AnonymizeFunc) (int, error)
+}
+
+// AnonymizeFunc anonymizes detected PII in data plus the listed fields
+type AnonymizeFunc func(data map[string]interface{}, fields ...string) (map[string]interface{}, error)
+
+// Resource returns the resource the record is narrowed to, or "" when it
+// covers all of the subject's data of its type
+func (r RetentionRecord) Resource() string {
+	resource, _ := r.Metadata[RetentionResourceKey].(string)
+	return resource
+}
+
+// RetentionArchive keeps the data the archive action removes
+type RetentionArchive interface {
+	Archive(ctx context.Context, record RetentionRecord, data []SubjectRecord) error
+}
+
+// FileRetentionArchive appends archived data to a local file, one JSON
+// entry per retention record and target
+type FileRetentionArchive struct {
+	mu   sync.Mutex
+	path string
+}
+
+// archiveEntry is one line of a FileRetentionArchive
+type archiveEntry struct {
+	ArchivedAt time.Time       `json:"archived_at"`
+	Record     RetentionRecord `json:"record"`
+	Data       []SubjectRecord `json:"data"`
+}
+
+// NewFileRetentionArchive creates an archive at path. The file is created
+// on the first archive.
+func NewFileRetentionArchive(path string) (*FileRetentionArchive, error) {
+	if path == "" {
+		return nil, fmt.Errorf("retention archive path is required")
+	}
+	return &FileRetentionArchive{path: path}, nil
+}
+
+// Archive writes the data and syncs the file before returning, so the
+// caller only deletes data that reached the archive
+func (a *FileRetentionArchive) Archive(_ context.Context, record RetentionRecord, data []SubjectRecord) error {
+	line, err := json.Marshal(archiveEntry{ArchivedAt: time.Now().UTC(), Record: record, Data: data})
+	if err != nil {
+		return fmt.Errorf("failed to marshal archive entry: %w", err)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(a.path), 0o750); err != nil {
+		return fmt.Errorf("creating archive directory: %w", err)
+	}
+	file, err := os.OpenFile(a.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening retention archive: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("writing retention archive: %w", err)
+	}
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("syncing retention archive: %w", err)
+	}
+	return nil
+}
+
+// RetentionRunSummary counts what one enforcement run did with the expired
+// records
+type RetentionRunSummary struct {
+	Expired   int `json:"expired"`
+	Completed int `json:"completed"`
+	// Held records belong to a subject under legal hold
+	Held int `json:"held"`
+	// Deferred records are still in their grace period
+	Deferred int `json:"deferred"`
+	// Failed records stay expired and are retried by the next run
+	Failed int `json:"failed"`
+}
+
+// RegisterTarget adds a store that retention actions apply to. Targets run
+// in registration order.
+func (rm *RetentionManager) RegisterTarget(target RetentionTarget) error {
+	rm.runMu.Lock()
+	defer rm.runMu.Unlock()
+
+	for _, existing := range rm.targets {
+		if existing.Name() == target.Name() {
+			return fmt.Errorf("retention target %q already registered", target.Name())
+		}
+	}
+	rm.targets = append(rm.targets, target)
+	return nil
+}
+
+// EnforceRetention applies the action of every expired retention record to
+// the registered targets. Records of subjects under legal hold are put on
+// hold instead, records in their grace period wait, and records whose
+// action failed stay expired for the next run. Each action and hold is
+// audited. Concurrent calls run one after the other.
+func (rm *RetentionManager) EnforceRetention(ctx context.Context) (*RetentionRunSummary, error) {
+	summary := &RetentionRunSummary{}
+	if !rm.config.Enabled {
+		return summary, nil
+	}
+
+	rm.runMu.Lock()
+	defer rm.runMu.Unlock()
+
+	now := time.Now()
+	expiredRecords, err := rm.repository.GetExpiredRecords(ctx, now)
+	if err != nil {
+		return summary, fmt.Errorf("failed to get expired records: %w", err)
+	}
+
+	rm.logger.Info("Processing expired retentions", zap.Int("count", len(expiredRecords)))
+
+	holds := make(map[string]string)
+	for _, record := range expiredRecords {
+		if err := ctx.Err(); err != nil {
+			return summary, err
+		}
+		summary.Expired++
+
+		reason, held, err := rm.legalHold(ctx, record, holds)
+		if err != nil {
+			// Fail closed: without the hold status nothing is removed
+			rm.logger.Error("Failed to check legal hold",
+				zap.String("record_id", record.ID),
+				zap.String("subject_id", record.SubjectID),
+				zap.Error(err))
+			summary.Failed++
+			continue
+		}
+		if held {
+			rm.holdRecord(ctx, record, reason)
+			summary.Held++
+			continue
+		}
+
+		// Check grace period
+		if record.GraceEnd != nil && now.Before(*record.GraceEnd) {
+			summary.Deferred++
+			continue
+		}
+
+		results, err := rm.executeRetentionAction(ctx, record)
+		rm.auditRetention(ctx, record, results, err, "")
+		if err != nil {
+			rm.logger.Error("Failed to execute retention action",
+				zap.String("record_id", record.ID),
+				zap.String("action", string(record.Action)),
+				zap.Error(err))
+			summary.Failed++
+			continue
+		}
+
+		// Update record status
+		record.Status = RetentionStatusCompleted
+		record.ActionTaken = true
+		takenAt := time.Now()
+		record.ActionTakenAt = &takenAt
+		record.UpdatedAt = takenAt
+
+		if err := rm.repository.UpdateRetentionRecord(ctx, record); err != nil {
+			rm.logger.Error("Failed to update retention record",
+				zap.String("record_id", record.ID),
+				zap.Error(err))
+		}
+		summary.Completed++
+	}
+
+	rm.logger.Info("Expired retentions processed",
+		zap.Int("completed", summary.Completed),
+		zap.Int("held", summary.Held),
+		zap.Int("deferred", summary.Deferred),
+		zap.Int("failed", summary.Failed))
+
+	return summary, nil
+}
+
+// legalHold reports whether the record or any other record of its subject
+// is under legal hold. holds caches subjects already checked in this run.
+func (rm *RetentionManager) legalHold(ctx context.Context, record RetentionRecord, holds map[string]string) (string, bool, error) {
+	if record.LegalHold {
+		return record.LegalHoldReason, true, nil
+	}
+	if reason, checked := holds[record.SubjectID]; checked {
+		return reason, reason != "", nil
+	}
+
+	records, err := rm.repository.GetRetentionRecords(ctx, record.SubjectID)
+	if err != nil {
+		return "", false, err
+	}
+	holds[record.SubjectID] = ""
+	for _, other := range records {
+		if other.LegalHold {
+			reason := other.LegalHoldReason
+			if reason == "" {
+				reason = "legal hold"
+			}
+			holds[record.SubjectID] = reason
+			return reason, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// holdRecord puts a record created after its subject's legal hold on hold
+// too, so RemoveLegalHold releases it with the others
+func (rm *RetentionManager) holdRecord(ctx context.Context, record RetentionRecord, reason string) {
+	rm.logger.Info("Skipping record on legal hold",
+		zap.String("record_id", record.ID),
+		zap.String("subject_id", record.SubjectID))
+
+	record.LegalHold = true
+	record.LegalHoldReason = reason
+	record.Status = RetentionStatusOnHold
+	record.UpdatedAt = time.Now()
+	if err := rm.repository.UpdateRetentionRecord(ctx, record); err != nil {
+		rm.logger.Error("Failed to place legal hold",
+			zap.String("record_id", record.ID),
+			zap.Error(err))
+	}
+	rm.auditRetention(ctx, record, nil, nil, reason)
+}
+
+func (rm *RetentionManager) executeRetentionAction(ctx context.Context, record RetentionRecord) ([]DataSourceResult, error) {
+	var apply func(RetentionTarget) (int, error)
+	switch record.Action {
+	case RetentionActionDelete, RetentionActionPurge:
+		apply = func(target RetentionTarget) (int, error) {
+			return target.DeleteRetained(ctx, record)
+		}
+	case RetentionActionAnonymize:
+		if rm.anonymize == nil {
+			return nil, fmt.Errorf("no anonymizer configured")
+		}
+		apply = func(target RetentionTarget) (int, error) {
+			return target.AnonymizeRetained(ctx, record, rm.anonymize)
+		}
+	case RetentionActionArchive:
+		if rm.archive == nil {
+			return nil, ErrNoRetentionArchive
+		}
+		apply = func(target RetentionTarget) (int, error) {
+			return rm.archiveRetained(ctx, target, record)
+		}
+	case RetentionActionNotify, RetentionActionReview:
+		// These actions involve people, not stored data
+		rm.logger.Info("Retention period ended",
+			zap.String("subject_id", record.SubjectID),
+			zap.String("data_type", record.DataType),
+			zap.String("action", string(record.Action)))
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown retention action: %s", record.Action)
+	}
+
+	var results []DataSourceResult
+	var failed []string
+	for _, target := range rm.targets {
+		if !target.Handles(record.DataType) {
+			continue
+		}
+		count, err := apply(target)
+		result := DataSourceResult{Source: target.Name(), Status: DataSourceStatusCompleted, Records: count, CompletedAt: time.Now()}
+		if err != nil {
+			result.Status = DataSourceStatusFailed
+			result.Error = err.Error()
+			failed = append(failed, target.Name())
+		}
+		results = append(results, result)
+	}
+
+	if len(results) == 0 {
+		rm.logger.Warn("No retention target holds this data type",
+			zap.String("record_id", record.ID),
+			zap.String("data_type", record.DataType))
+	}
+	if len(failed) > 0 {
+		return results, fmt.Errorf("retention targets failed: %s", strings.Join(failed, ", "))
+	}
+	return results, nil
+}
+
+// archiveRetained archives the target's data before deleting it
+func (rm *RetentionManager) archiveRetained(ctx context.Context, target RetentionTarget, record RetentionRecord) (int, error) {
+	data, err := target.Retained(ctx, record)
+	if err != nil {
+		return 0, err
+	}
+	if len(data) > 0 {
+		if err := rm.archive.Archive(ctx, record, data); err != nil {
+			return 0, err
+		}
+	}
+	return target.DeleteRetained(ctx, record)
+}
+
+// auditRetention audits the outcome of a record: held when holdReason is
+// set, otherwise the action's results and error
+func (rm *RetentionManager) auditRetention(ctx context.Context, record RetentionRecord, results []DataSourceResult, actionErr error, holdReason string) {
+	if rm.auditLogger == nil {
+		return
+	}
+
+	result := AuditResultSuccess
+	reason := holdReason
+	switch {
+	case holdReason != "":
+		result = AuditResultBlocked
+	case actionErr != nil:
+		result = AuditResultFailure
+		reason = actionErr.Error()
+		for _, target := range results {
+			if target.Status == DataSourceStatusCompleted {
+				result = AuditResultPartialSuccess
+				break
+			}
+		}
+	}
+
+	if err := rm.auditLogger.LogRetentionEnforcement(ctx, record, results, result, reason); err != nil {
+		rm.logger.Warn("Failed to audit retention enforcement",
+			zap.String("record_id", record.ID),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,276 @@
+package compliance
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/internal/lifecycle"
+)
+
+// fakeRetentionTarget holds one map of fields per subject
+type fakeRetentionTarget struct {
+	mu   sync.Mutex
+	name string
+	data map[string]map[string]interface{}
+	fail error
+}
+
+func newFakeRetentionTarget(name string) *fakeRetentionTarget {
+	return &fakeRetentionTarget{name: name, data: make(map[string]map[string]interface{})}
+}
+
+func (f *fakeRetentionTarget) Name() string { return f.name }
+
+func (f *fakeRetentionTarget) Handles(dataType string) bool { return dataType == "task_data" }
+
+func (f *fakeRetentionTarget) Retained(_ context.Context, record RetentionRecord) ([]SubjectRecord, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data, ok := f.data[record.SubjectID]
+	if !ok {
+		return nil, nil
+	}
+	return []SubjectRecord{{Source: f.name, Type: "task", ID: record.SubjectID, Data: data}}, nil
+}
+
+func (f *fakeRetentionTarget) DeleteRetained(_ context.Context, record RetentionRecord) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.fail != nil {
+		return 0, f.fail
+	}
+	if _, ok := f.data[record.SubjectID]; !ok {
+		return 0, nil
+	}
+	delete(f.data, record.SubjectID)
+	return 1, nil
+}
+
+func (f *fakeRetentionTarget) AnonymizeRetained(_ context.Context, record RetentionRecord, anonymize AnonymizeFunc) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.fail != nil {
+		return 0, f.fail
+	}
+	data, ok := f.data[record.SubjectID]
+	if !ok {
+		return 0, nil
+	}
+	anonymized, err := anonymize(data, "title")
+	if err != nil {
+		return 0, err
+	}
+	f.data[record.SubjectID] = anonymized
+	return 1, nil
+}
+
+func (f *fakeRetentionTarget) get(subjectID string) (map[string]interface{}, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data, ok := f.data[subjectID]
+	return data, ok
+}
+
+// newRetentionTestFramework returns a framework with a file audit store, an
+// archive under a temp dir and one fake target
+func newRetentionTestFramework(t *testing.T) (*Framework, *fakeRetentionTarget, string) {
+	t.Helper()
+	framework := createTestFramework(t)
+
+	dir := t.TempDir()
+	config := framework.config
+	config.AuditLogging.EncryptionEnabled = false
+	config.AuditLogging.Store = AuditStoreFile
+	config.AuditLogging.FilePath = filepath.Join(dir, "audit.log")
+	config.DataRetention.ArchivePath = filepath.Join(dir, "archive", "retention.log")
+
+	withTargets, err := NewFramework(config, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = withTargets.Close() })
+
+	target := newFakeRetentionTarget("tasks")
+	require.NoError(t, withTargets.RegisterRetentionTarget(target))
+	assert.Error(t, withTargets.RegisterRetentionTarget(target), "target names are unique")
+
+	return withTargets, target, config.DataRetention.ArchivePath
+}
+
+// storeExpiredRecord stores a task_data record whose retention ended an
+// hour ago
+func storeExpiredRecord(t *testing.T, framework *Framework, subjectID string, action RetentionAction) RetentionRecord {
+	t.Helper()
+	now := time.Now()
+	record := RetentionRecord{
+		ID:             "retention-" + subjectID,
+		SubjectID:      subjectID,
+		DataType:       "task_data",
+		PolicyID:       "task_policy",
+		CreatedAt:      now.Add(-48 * time.Hour),
+		RetentionStart: now.Add(-48 * time.Hour),
+		RetentionEnd:   now.Add(-time.Hour),
+		Status:         RetentionStatusActive,
+		Action:         action,
+		UpdatedAt:      now,
+	}
+	require.NoError(t, framework.retentionMgr.repository.StoreRetentionRecord(context.Background(), record))
+	return record
+}
+
+func retentionRecord(t *testing.T, framework *Framework, subjectID string) RetentionRecord {
+	t.Helper()
+	records, err := framework.retentionMgr.GetRetentionStatus(context.Background(), subjectID)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	return records[0]
+}
+
+func TestEnforceRetention_Actions(t *testing.T) {
+	framework, target, archivePath := newRetentionTestFramework(t)
+	ctx := context.Background()
+
+	for _, subject := range []string{"deleted", "anonymized", "archived"} {
+		target.data[subject] = map[string]interface{}{"title": "call " + subject, "email": subject + "@example.com", "status": "done"}
+	}
+	storeExpiredRecord(t, framework, "deleted", RetentionActionDelete)
+	storeExpiredRecord(t, framework, "anonymized", RetentionActionAnonymize)
+	storeExpiredRecord(t, framework, "archived", RetentionActionArchive)
+
+	summary, err := framework.EnforceRetention(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, RetentionRunSummary{Expired: 3, Completed: 3}, *summary)
+
+	_, exists := target.get("deleted")
+	assert.False(t, exists)
+	_, exists = target.get("archived")
+	assert.False(t, exists)
+
+	anonymized, exists := target.get("anonymized")
+	require.True(t, exists)
+	assert.NotEqual(t, "call anonymized", anonymized["title"], "listed fields are anonymized")
+	assert.NotEqual(t, "anonymized@example.com", anonymized["email"], "detected PII is anonymized")
+	assert.Equal(t, "done", anonymized["status"])
+
+	// The archive holds the data before it was deleted
+	file, err := os.Open(archivePath)
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+	scanner := bufio.NewScanner(file)
+	require.True(t, scanner.Scan())
+	var entry archiveEntry
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+	assert.Equal(t, "archived", entry.Record.SubjectID)
+	require.Len(t, entry.Data, 1)
+	assert.Equal(t, "call archived", entry.Data[0].Data["title"])
+	assert.False(t, scanner.Scan())
+
+	for _, subject := range []string{"deleted", "anonymized", "archived"} {
+		record := retentionRecord(t, framework, subject)
+		assert.Equal(t, RetentionStatusCompleted, record.Status)
+		assert.True(t, record.ActionTaken)
+		assert.NotNil(t, record.ActionTakenAt)
+
+		events, err := framework.GetAuditLogs(ctx, AuditFilter{SubjectID: subject, EventType: string(AuditEventRetentionPolicy)})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, AuditResultSuccess, events[0].Result)
+		assert.Equal(t, string(record.Action), events[0].ProcessingType)
+	}
+
+	// Completed records are not enforced again
+	summary, err = framework.EnforceRetention(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, summary.Expired)
+}
+
+func TestEnforceRetention_LegalHold(t *testing.T) {
+	framework, target, _ := newRetentionTestFramework(t)
+	ctx := context.Background()
+
+	target.data["subject-1"] = map[string]interface{}{"title": "disputed"}
+	record := storeExpiredRecord(t, framework, "subject-1", RetentionActionDelete)
+	require.NoError(t, framework.retentionMgr.PlaceLegalHold(ctx, "subject-1", "litigation"))
+
+	// A record created after the hold is held too
+	late := record
+	late.ID = "retention-late"
+	require.NoError(t, framework.retentionMgr.repository.StoreRetentionRecord(ctx, late))
+
+	summary, err := framework.EnforceRetention(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, RetentionRunSummary{Expired: 1, Held: 1}, *summary)
+
+	_, exists := target.get("subject-1")
+	assert.True(t, exists, "held data is kept")
+
+	records, err := framework.retentionMgr.GetRetentionStatus(ctx, "subject-1")
+	require.NoError(t, err)
+	for _, record := range records {
+		assert.True(t, record.LegalHold)
+		assert.Equal(t, RetentionStatusOnHold, record.Status)
+		assert.False(t, record.ActionTaken)
+	}
+
+	events, err := framework.GetAuditLogs(ctx, AuditFilter{SubjectID: "subject-1", EventType: string(AuditEventRetentionPolicy)})
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+	assert.Equal(t, AuditResultBlocked, events[0].Result)
+	assert.Equal(t, "litigation", events[0].Details["reason"])
+}
+
+func TestEnforceRetention_GracePeriod(t *testing.T) {
+	framework, target, _ := newRetentionTestFramework(t)
+	ctx := context.Background()
+
+	target.data["subject-1"] = map[string]interface{}{"title": "recent"}
+	record := storeExpiredRecord(t, framework, "subject-1", RetentionActionDelete)
+	graceEnd := time.Now().Add(time.Hour)
+	record.GraceEnd = &graceEnd
+	require.NoError(t, framework.retentionMgr.repository.UpdateRetentionRecord(ctx, record))
+
+	summary, err := framework.EnforceRetention(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, RetentionRunSummary{Expired: 1, Deferred: 1}, *summary)
+	_, exists := target.get("subject-1")
+	assert.True(t, exists)
+}
+
+func TestEnforceRetention_FailureIsRetried(t *testing.T) {
+	framework, target, _ := newRetentionTestFramework(t)
+	ctx := context.Background()
+
+	target.data["subject-1"] = map[string]interface{}{"title": "stuck"}
+	storeExpiredRecord(t, framework, "subject-1", RetentionActionDelete)
+	target.fail = errors.New("store unavailable")
+
+	summary, err := framework.EnforceRetention(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, RetentionRunSummary{Expired: 1, Failed: 1}, *summary)
+	assert.Equal(t, RetentionStatusActive, retentionRecord(t, framework, "subject-1").Status)
+
+	events, err := framework.GetAuditLogs(ctx, AuditFilter{SubjectID: "subject-1", EventType: string(AuditEventRetentionPolicy)})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, AuditResultFailure, events[0].Result)
+
+	// The lifecycle operation reports the failure
+	operation := &lifecycle.Operation{Type: lifecycle.OperationRetention}
+	assert.Error(t, NewRetentionOperation(framework).Execute(ctx, operation))
+	assert.NotNil(t, operation.Result["retention"])
+
+	target.fail = nil
+	require.NoError(t, NewRetentionOperation(framework).Execute(ctx, operation))
+	assert.Equal(t, RetentionStatusCompleted, retentionRecord(t, framework, "subject-1").Status)
+	_, exists := target.get("subject-1")
+	assert.False(t, exists)
+}
@@ -2,10 +2,15 @@ package compliance
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/pkg/types"
 )
 
 // RetentionManager handles data retention policies and lifecycle management
@@ -13,14 +18,25 @@ type RetentionManager struct {
 	config     DataRetentionConfig
 	logger     *zap.Logger
 	policies   map[string]RetentionPolicy
-	scheduler  *RetentionScheduler
 	repository RetentionRepository
+
+	// Enforcement, wired by the Framework
+	targets     []RetentionTarget
+	anonymize   AnonymizeFunc
+	archive     RetentionArchive
+	auditLogger *AuditLogger
+	runMu       sync.Mutex
 }
 
+// ErrRetentionRecordNotFound is returned when a retention record does not exist
+var ErrRetentionRecordNotFound = errors.New("retention record not found")
+
 // RetentionRepository interface for persistence
 type RetentionRepository interface {
 	StoreRetentionRecord(ctx context.Context, record RetentionRecord) error
 	GetRetentionRecords(ctx context.Context, subjectID string) ([]RetentionRecord, error)
+	// GetExpiredRecords returns active or extended records whose retention
+	// ended before beforeTime, oldest first
 	GetExpiredRecords(ctx context.Context, beforeTime time.Time) ([]RetentionRecord, error)
 	UpdateRetentionRecord(ctx context.Context, record RetentionRecord) error
 	DeleteRetentionRecord(ctx context.Context, recordID string) error
@@ -120,22 +136,24 @@ type RetentionExtension struct {
 	Approved   bool          `json:"approved"`
 }
 
-// RetentionScheduler handles scheduling of retention actions
-type RetentionScheduler struct {
-	manager *RetentionManager
-	ticker  *time.Ticker
-	stop    chan bool
+// NewRetentionManager creates a new retention manager that keeps records
+// in memory
+func NewRetentionManager(config DataRetentionConfig, logger *zap.Logger) (*RetentionManager, error) {
+	return NewRetentionManagerWithRepository(config, logger, NewInMemoryRetentionRepository())
 }
 
-// NewRetentionManager creates a new retention manager
-func NewRetentionManager(config DataRetentionConfig, logger *zap.Logger) (*RetentionManager, error) {
+// NewRetentionManagerWithRepository creates a retention manager over
+// repository
+func NewRetentionManagerWithRepository(config DataRetentionConfig, logger *zap.Logger, repository RetentionRepository) (*RetentionManager, error) {
+	if repository == nil {
+		return nil, fmt.Errorf("retention repository is required")
+	}
+
 	rm := &RetentionManager{
-		config:   config,
-		logger:   logger,
-		policies: make(map[string]RetentionPolicy),
-		repository: &InMemoryRetentionRepository{
-			records: make(map[string][]RetentionRecord),
-		},
+		config:     config,
+		logger:     logger,
+		policies:   make(map[string]RetentionPolicy),
+		repository: repository,
 	}
 
 	if !config.Enabled {
@@ -145,16 +163,6 @@ func NewRetentionManager(config DataRetentionConfig, logger *zap.Logger) (*Reten
 	// Initialize default retention policies
 	rm.initializeDefaultPolicies()
 
-	// Create and start scheduler if auto-delete is enabled
-	if config.AutoDelete {
-		rm.scheduler = &RetentionScheduler{
-			manager: rm,
-			ticker:  time.NewTicker(24 * time.Hour), // Run daily
-			stop:    make(chan bool),
-		}
-		go rm.scheduler.run()
-	}
-
 	return rm, nil
 }
 
@@ -179,6 +187,7 @@ func (rm *RetentionManager) ApplyRetentionPolicy(ctx context.Context, subjectID
 			RetentionEnd:   time.Now().Add(policy.RetentionPeriod),
 			Status:         RetentionStatusActive,
 			Action:         policy.Action,
+			Metadata:       rm.recordMetadata(data),
 			UpdatedAt:      time.Now(),
 		}
 
@@ -207,57 +216,11 @@ func (rm *RetentionManager) ApplyRetentionPolicy(ctx context.Context, subjectID
 	return nil
 }
 
-// ProcessExpiredRetentions processes expired retention records
+// ProcessExpiredRetentions processes expired retention records. See
+// EnforceRetention for the outcome of each record.
 func (rm *RetentionManager) ProcessExpiredRetentions(ctx context.Context) error {
-	if !rm.config.Enabled {
-		return nil
-	}
-
-	// Get expired records
-	expiredRecords, err := rm.repository.GetExpiredRecords(ctx, time.Now())
-	if err != nil {
-		return fmt.Errorf("failed to get expired records: %w", err)
-	}
-
-	rm.logger.Info("Processing expired retentions", zap.Int("count", len(expiredRecords)))
-
-	for _, record := range expiredRecords {
-		if record.LegalHold {
-			rm.logger.Info("Skipping record on legal hold",
-				zap.String("record_id", record.ID),
-				zap.String("subject_id", record.SubjectID))
-			continue
-		}
-
-		// Check grace period
-		if record.GraceEnd != nil && time.Now().Before(*record.GraceEnd) {
-			continue
-		}
-
-		// Execute retention action
-		if err := rm.executeRetentionAction(ctx, record); err != nil {
-			rm.logger.Error("Failed to execute retention action",
-				zap.String("record_id", record.ID),
-				zap.String("action", string(record.Action)),
-				zap.Error(err))
-			continue
-		}
-
-		// Update record status
-		record.Status = RetentionStatusCompleted
-		record.ActionTaken = true
-		now := time.Now()
-		record.ActionTakenAt = &now
-		record.UpdatedAt = now
-
-		if err := rm.repository.UpdateRetentionRecord(ctx, record); err != nil {
-			rm.logger.Error("Failed to update retention record",
-				zap.String("record_id", record.ID),
-				zap.Error(err))
-		}
-	}
-
-	return nil
+	_, err := rm.EnforceRetention(ctx)
+	return err
 }
 
 // ExtendRetention extends the retention period for specific data
@@ -374,6 +337,16 @@ func (rm *RetentionManager) GetRetentionStatus(ctx context.Context, subjectID st
 	return rm.repository.GetRetentionRecords(ctx, subjectID)
 }
 
+// GetExpiredRecords returns the records whose retention ended before
+// beforeTime and that are still waiting for their action
+func (rm *RetentionManager) GetExpiredRecords(ctx context.Context, beforeTime time.Time) ([]RetentionRecord, error) {
+	if !rm.config.Enabled {
+		return nil, fmt.Errorf("retention management is disabled")
+	}
+
+	return rm.repository.GetExpiredRecords(ctx, beforeTime)
+}
+
 // GetPolicies returns all retention policies
 func (rm *RetentionManager) GetPolicies() map[string]RetentionPolicy {
 	return rm.policies
@@ -431,73 +404,6 @@ func (rm *RetentionManager) ShouldDeleteData(ctx context.Context, subjectID, dat
 
 // Helper methods
 
-func (rm *RetentionManager) executeRetentionAction(ctx context.Context, record RetentionRecord) error {
-	switch record.Action {
-	case RetentionActionDelete:
-		return rm.deleteData(ctx, record)
-	case RetentionActionArchive:
-		return rm.archiveData(ctx, record)
-	case RetentionActionAnonymize:
-		return rm.anonymizeData(ctx, record)
-	case RetentionActionPurge:
-		return rm.purgeData(ctx, record)
-	case RetentionActionNotify:
-		return rm.notifyRetention(ctx, record)
-	case RetentionActionReview:
-		return rm.scheduleReview(ctx, record)
-	default:
-		return fmt.Errorf("unknown retention action: %s", record.Action)
-	}
-}
-
-func (rm *RetentionManager) deleteData(ctx context.Context, record RetentionRecord) error {
-	rm.logger.Info("Deleting data for retention policy",
-		zap.String("subject_id", record.SubjectID),
-		zap.String("data_type", record.DataType))
-	// Implementation would delete actual data
-	return nil
-}
-
-func (rm *RetentionManager) archiveData(ctx context.Context, record RetentionRecord) error {
-	rm.logger.Info("Archiving data for retention policy",
-		zap.String("subject_id", record.SubjectID),
-		zap.String("data_type", record.DataType))
-	// Implementation would archive data
-	return nil
-}
-
-func (rm *RetentionManager) anonymizeData(ctx context.Context, record RetentionRecord) error {
-	rm.logger.Info("Anonymizing data for retention policy",
-		zap.String("subject_id", record.SubjectID),
-		zap.String("data_type", record.DataType))
-	// Implementation would anonymize data
-	return nil
-}
-
-func (rm *RetentionManager) purgeData(ctx context.Context, record RetentionRecord) error {
-	rm.logger.Info("Purging data for retention policy",
-		zap.String("subject_id", record.SubjectID),
-		zap.String("data_type", record.DataType))
-	// Implementation would permanently delete all traces
-	return nil
-}
-
-func (rm *RetentionManager) notifyRetention(ctx context.Context, record RetentionRecord) error {
-	rm.logger.Info("Sending retention notification",
-		zap.String("subject_id", record.SubjectID),
-		zap.String("data_type", record.DataType))
-	// Implementation would send notifications
-	return nil
-}
-
-func (rm *RetentionManager) scheduleReview(ctx context.Context, record RetentionRecord) error {
-	rm.logger.Info("Scheduling retention review",
-		zap.String("subject_id", record.SubjectID),
-		zap.String("data_type", record.DataType))
-	// Implementation would schedule human review
-	return nil
-}
-
 func (rm *RetentionManager) getApplicablePolicies(data map[string]interface{}) []RetentionPolicy {
 	var applicable []RetentionPolicy
 
@@ -516,6 +422,10 @@ func (rm *RetentionManager) policyApplies(policy RetentionPolicy, data map[strin
 }
 
 func (rm *RetentionManager) inferDataType(data map[string]interface{}) string {
+	// An explicit category from RecordDataCreation wins
+	if category, ok := data["_category"].(string); ok && category != "" {
+		return category
+	}
 	// Infer data type based on fields present
 	if _, exists := data["email"]; exists {
 		return "user_data"
@@ -526,8 +436,20 @@ func (rm *RetentionManager) inferDataType(data map[string]interface{}) string {
 	return "general_data"
 }
 
+// recordMetadata narrows a record to one resource when data identifies it.
+// Retention targets act on that resource only; records without it cover
+// all of the subject's data of the record's type.
+func (rm *RetentionManager) recordMetadata(data map[string]interface{}) map[string]interface{} {
+	for _, field := range []string{"task_id", "id"} {
+		if id, ok := data[field].(string); ok && id != "" {
+			return map[string]interface{}{RetentionResourceKey: id}
+		}
+	}
+	return nil
+}
+
 func (rm *RetentionManager) generateRecordID() string {
-	return fmt.Sprintf("retention_%d", time.Now().UnixNano())
+	return "retention_" + types.New().String()
 }
 
 func (rm *RetentionManager) initializeDefaultPolicies() {
@@ -566,70 +488,70 @@ func (rm *RetentionManager) initializeDefaultPolicies() {
 	rm.logger.Info("Default retention policies initialized", zap.Int("policies", len(defaultPolicies)))
 }
 
-// RetentionScheduler methods
-
-func (rs *RetentionScheduler) run() {
-	for {
-		select {
-		case <-rs.ticker.C:
-			ctx := context.Background()
-			if err := rs.manager.ProcessExpiredRetentions(ctx); err != nil {
-				rs.manager.logger.Error("Error processing expired retentions", zap.Error(err))
-			}
-		case <-rs.stop:
-			rs.ticker.Stop()
-			return
-		}
-	}
-}
-
-func (rs *RetentionScheduler) Stop() {
-	rs.stop <- true
-}
-
 // InMemoryRetentionRepository implementation
 
 type InMemoryRetentionRepository struct {
+	mu      sync.RWMutex
 	records map[string][]RetentionRecord
 }
 
+// NewInMemoryRetentionRepository creates an empty in-memory retention repository
+func NewInMemoryRetentionRepository() *InMemoryRetentionRepository {
+	return &InMemoryRetentionRepository{records: make(map[string][]RetentionRecord)}
+}
+
 func (r *InMemoryRetentionRepository) StoreRetentionRecord(ctx context.Context, record RetentionRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	r.records[record.SubjectID] = append(r.records[record.SubjectID], record)
 	return nil
 }
 
 func (r *InMemoryRetentionRepository) GetRetentionRecords(ctx context.Context, subjectID string) ([]RetentionRecord, error) {
-	records, exists := r.records[subjectID]
-	if !exists {
-		return []RetentionRecord{}, nil
-	}
-	return records, nil
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]RetentionRecord{}, r.records[subjectID]...), nil
 }
 
 func (r *InMemoryRetentionRepository) GetExpiredRecords(ctx context.Context, beforeTime time.Time) ([]RetentionRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
 	var expired []RetentionRecord
 	for _, recordList := range r.records {
 		for _, record := range recordList {
-			if record.Status == RetentionStatusActive && record.RetentionEnd.Before(beforeTime) {
+			waiting := record.Status == RetentionStatusActive || record.Status == RetentionStatusExtended
+			if waiting && record.RetentionEnd.Before(beforeTime) {
 				expired = append(expired, record)
 			}
 		}
 	}
+	sort.Slice(expired, func(i, j int) bool {
+		return expired[i].RetentionEnd.Before(expired[j].RetentionEnd)
+	})
 	return expired, nil
 }
 
 func (r *InMemoryRetentionRepository) UpdateRetentionRecord(ctx context.Context, record RetentionRecord) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	records := r.records[record.SubjectID]
 	for i, existing := range records {
 		if existing.ID == record.ID {
 			records[i] = record
-			break
+			return nil
 		}
 	}
-	return nil
+	return fmt.Errorf("%w: %s", ErrRetentionRecordNotFound, record.ID)
 }
 
 func (r *InMemoryRetentionRepository) DeleteRetentionRecord(ctx context.Context, recordID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
 	for subjectID, records := range r.records {
 		for i, record := range records {
 			if record.ID == recordID {
@@ -638,5 +560,5 @@ func (r *InMemoryRetentionRepository) DeleteRetentionRecord(ctx context.Context,
 			}
 		}
 	}
-	return fmt.Errorf("record not found: %s", recordID)
+	return fmt.Errorf("%w: %s", ErrRetentionRecordNotFound, recordID)
 }
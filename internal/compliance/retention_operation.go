@@ -0,0 +1,47 @@
+package compliance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vertikon/mcp-ultra/internal/lifecycle"
+)
+
+// RetentionOperation runs retention enforcement as a lifecycle operation,
+// so the operations manager schedules, retries and records it
+type RetentionOperation struct {
+	framework *Framework
+}
+
+// NewRetentionOperation creates the executor for lifecycle.OperationRetention
+func NewRetentionOperation(framework *Framework) *RetentionOperation {
+	return &RetentionOperation{framework: framework}
+}
+
+// Execute enforces expired retention records and stores the run summary in
+// the operation result. Records that failed make the operation fail; the
+// next run retries them.
+func (o *RetentionOperation) Execute(ctx context.Context, operation *lifecycle.Operation) error {
+	summary, err := o.framework.EnforceRetention(ctx)
+	if operation.Result == nil {
+		operation.Result = make(map[string]interface{})
+	}
+	operation.Result["retention"] = summary
+	if err != nil {
+		return err
+	}
+	if summary.Failed > 0 {
+		return fmt.Errorf("%d of %d expired retention records failed", summary.Failed, summary.Expired)
+	}
+	return nil
+}
+
+// Rollback is not supported: deleted and anonymized data cannot be restored
+func (o *RetentionOperation) Rollback(_ context.Context, _ *lifecycle.Operation) error {
+	return fmt.Errorf("retention enforcement cannot be rolled back")
+}
+
+// Validate accepts any operation; enforcement takes no parameters
+func (o *RetentionOperation) Validate(_ *lifecycle.Operation) error {
+	return nil
+}
@@ -0,0 +1,156 @@
+package compliance
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const retentionColumns = `id, subject_id, data_type, policy_id, created_at, retention_start, retention_end,
+	grace_end, status, action, action_taken, action_taken_at, legal_hold, legal_hold_reason,
+	extensions, metadata, updated_at`
+
+// PostgresRetentionRepository keeps retention records in the
+// compliance_retention_records table
+// (migration 005_compliance_consent_retention)
+type PostgresRetentionRepository struct {
+	db *sql.DB
+}
+
+// NewPostgresRetentionRepository creates a retention repository over db
+func NewPostgresRetentionRepository(db *sql.DB) *PostgresRetentionRepository {
+	return &PostgresRetentionRepository{db: db}
+}
+
+// StoreRetentionRecord inserts a new record
+func (r *PostgresRetentionRepository) StoreRetentionRecord(ctx context.Context, record RetentionRecord) error {
+	args, err := retentionArgs(record)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, `
+		INSERT INTO compliance_retention_records (`+retentionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+	`, args...)
+	if err != nil {
+		return fmt.Errorf("inserting retention record: %w", err)
+	}
+	return nil
+}
+
+// GetRetentionRecords returns the records of a subject, oldest first
+func (r *PostgresRetentionRepository) GetRetentionRecords(ctx context.Context, subjectID string) ([]RetentionRecord, error) {
+	return r.query(ctx, `
+		SELECT `+retentionColumns+` FROM compliance_retention_records
+		WHERE subject_id = $1 ORDER BY created_at, id
+	`, subjectID)
+}
+
+// GetExpiredRecords returns active or extended records whose retention
+// ended before beforeTime, oldest first
+func (r *PostgresRetentionRepository) GetExpiredRecords(ctx context.Context, beforeTime time.Time) ([]RetentionRecord, error) {
+	return r.query(ctx, `
+		SELECT `+retentionColumns+` FROM compliance_retention_records
+		WHERE status IN ($1, $2) AND retention_end < $3
+		ORDER BY retention_end, id
+	`, string(RetentionStatusActive), string(RetentionStatusExtended), beforeTime)
+}
+
+// UpdateRetentionRecord replaces every mutable field of the record
+func (r *PostgresRetentionRepository) UpdateRetentionRecord(ctx context.Context, record RetentionRecord) error {
+	args, err := retentionArgs(record)
+	if err != nil {
+		return err
+	}
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE compliance_retention_records SET
+			subject_id = $2, data_type = $3, policy_id = $4, created_at = $5,
+			retention_start = $6, retention_end = $7, grace_end = $8, status = $9,
+			action = $10, action_taken = $11, action_taken_at = $12, legal_hold = $13,
+			legal_hold_reason = $14, extensions = $15, metadata = $16, updated_at = $17
+		WHERE id = $1
+	`, args...)
+	if err != nil {
+		return fmt.Errorf("updating retention record: %w", err)
+	}
+	return requireRetentionRow(result, record.ID)
+}
+
+// DeleteRetentionRecord deletes a record
+func (r *PostgresRetentionRepository) DeleteRetentionRecord(ctx context.Context, recordID string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM compliance_retention_records WHERE id = $1`, recordID)
+	if err != nil {
+		return fmt.Errorf("deleting retention record: %w", err)
+	}
+	return requireRetentionRow(result, recordID)
+}
+
+func (r *PostgresRetentionRepository) query(ctx context.Context, query string, args ...interface{}) ([]RetentionRecord, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying retention records: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	records := make([]RetentionRecord, 0)
+	for rows.Next() {
+		var record RetentionRecord
+		var status, action string
+		var graceEnd, actionTakenAt sql.NullTime
+		var extensions, metadata []byte
+		if err := rows.Scan(
+			&record.ID, &record.SubjectID, &record.DataType, &record.PolicyID, &record.CreatedAt,
+			&record.RetentionStart, &record.RetentionEnd, &graceEnd, &status, &action,
+			&record.ActionTaken, &actionTakenAt, &record.LegalHold, &record.LegalHoldReason,
+			&extensions, &metadata, &record.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning retention record: %w", err)
+		}
+		record.Status = RetentionStatus(status)
+		record.Action = RetentionAction(action)
+		record.GraceEnd = nullTimePtr(graceEnd)
+		record.ActionTakenAt = nullTimePtr(actionTakenAt)
+		if err := unmarshalJSONColumn(extensions, &record.Extensions); err != nil {
+			return nil, fmt.Errorf("decoding retention record %s extensions: %w", record.ID, err)
+		}
+		if err := unmarshalJSONColumn(metadata, &record.Metadata); err != nil {
+			return nil, fmt.Errorf("decoding retention record %s metadata: %w", record.ID, err)
+		}
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating retention records: %w", err)
+	}
+	return records, nil
+}
+
+// retentionArgs returns the record in retentionColumns order
+func retentionArgs(record RetentionRecord) ([]interface{}, error) {
+	extensions, err := json.Marshal(record.Extensions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal retention extensions: %w", err)
+	}
+	metadata, err := json.Marshal(record.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal retention metadata: %w", err)
+	}
+	return []interface{}{
+		record.ID, record.SubjectID, record.DataType, record.PolicyID, record.CreatedAt,
+		record.RetentionStart, record.RetentionEnd, record.GraceEnd, string(record.Status),
+		string(record.Action), record.ActionTaken, record.ActionTakenAt, record.LegalHold,
+		record.LegalHoldReason, string(extensions), string(metadata), record.UpdatedAt,
+	}, nil
+}
+
+func requireRetentionRow(result sql.Result, recordID string) error {
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking retention record %s: %w", recordID, err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: %s", ErrRetentionRecordNotFound, recordID)
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ type Config struct {
 	GRPC        GRPCConfig       `yaml:"grpc"`
 	Database    DatabaseConfig   `yaml:"database"`
 	NATS        NATSConfig       `yaml:"nats"`
+	Outbox      OutboxConfig     `yaml:"outbox"`
 	Telemetry   TelemetryConfig  `yaml:"telemetry"`
 	Features    FeaturesConfig   `yaml:"features"`
 	Security    SecurityConfig   `yaml:"security"`
@@ -48,33 +49,49 @@ type PIIDetectionConfig struct {
 	ClassificationAPI string   `yaml:"classification_api"`
 	Confidence        float64  `yaml:"confidence" default:"0.8"`
 	AutoMask          bool     `yaml:"auto_mask" default:"true"`
+	// RulesFile adds YAML detector rules to the built-in detectors
+	RulesFile string `yaml:"rules_file"`
+	// ReloadInterval is how often RulesFile is checked for changes; zero
+	// disables reloading
+	ReloadInterval time.Duration `yaml:"reload_interval" default:"30s"`
+	// MaskOnSave masks PII spans in tasks before they are stored
+	MaskOnSave bool `yaml:"mask_on_save"`
 }
 
 // ConsentConfig configures consent management
 type ConsentConfig struct {
 	Enabled         bool          `yaml:"enabled" default:"true"`
 	DefaultPurposes []string      `yaml:"default_purposes"`
-	TTL             time.Duration `yaml:"ttl" default:"2y"`
+	TTL             time.Duration `yaml:"ttl" default:"17520h"`
 	GranularLevel   string        `yaml:"granular_level" default:"purpose"` // purpose, field, operation
+	Store           string        `yaml:"store" default:"memory"`           // memory, postgres
 }
 
 // DataRetentionConfig configures data retention policies
 type DataRetentionConfig struct {
 	Enabled         bool                     `yaml:"enabled" default:"true"`
-	DefaultPeriod   time.Duration            `yaml:"default_period" default:"2y"`
+	DefaultPeriod   time.Duration            `yaml:"default_period" default:"17520h"`
 	CategoryPeriods map[string]time.Duration `yaml:"category_periods"`
 	AutoDelete      bool                     `yaml:"auto_delete" default:"true"`
-	BackupRetention time.Duration            `yaml:"backup_retention" default:"7y"`
+	BackupRetention time.Duration            `yaml:"backup_retention" default:"61320h"`
+	Store           string                   `yaml:"store" default:"memory"` // memory, postgres
+	// EnforcementInterval is how often expired records are enforced when
+	// AutoDelete is on
+	EnforcementInterval time.Duration `yaml:"enforcement_interval" default:"24h"`
+	// ArchivePath is where the archive action keeps data; empty disables it
+	ArchivePath string `yaml:"archive_path" default:"data/retention/archive.log"`
 }
 
 // AuditLoggingConfig configures compliance audit logging
 type AuditLoggingConfig struct {
 	Enabled           bool          `yaml:"enabled" default:"true"`
 	DetailLevel       string        `yaml:"detail_level" default:"full"` // minimal, standard, full
-	RetentionPeriod   time.Duration `yaml:"retention_period" default:"7y"`
+	RetentionPeriod   time.Duration `yaml:"retention_period" default:"61320h"`
 	EncryptionEnabled bool          `yaml:"encryption_enabled" default:"true"`
 	ExternalLogging   bool          `yaml:"external_logging" default:"false"`
 	ExternalEndpoint  string        `yaml:"external_endpoint"`
+	Store             string        `yaml:"store" default:"none"` // none, file, postgres
+	FilePath          string        `yaml:"file_path" default:"data/audit/compliance-audit.log"`
 }
 
 // LGPDConfig specific configuration for Brazilian LGPD compliance
@@ -112,25 +129,38 @@ type DataRightsConfig struct {
 	ResponseTime         time.Duration `yaml:"response_time" default:"720h"` // 30 days
 	AutoFulfillment      bool          `yaml:"auto_fulfillment" default:"false"`
 	VerificationRequired bool          `yaml:"verification_required" default:"true"`
+	VerificationTTL      time.Duration `yaml:"verification_ttl" default:"15m"`
 	NotificationChannels []string      `yaml:"notification_channels"`
+	Store                string        `yaml:"store" default:"memory"` // memory, postgres
 }
 
 // ServerConfig holds HTTP server configuration
 type ServerConfig struct {
-	Port         int           `yaml:"port" envconfig:"HTTP_PORT" default:"9655"`
-	ReadTimeout  time.Duration `yaml:"read_timeout" default:"30s"`
-	WriteTimeout time.Duration `yaml:"write_timeout" default:"30s"`
-	IdleTimeout  time.Duration `yaml:"idle_timeout" default:"120s"`
+	Port         int             `yaml:"port" envconfig:"HTTP_PORT" default:"9655"`
+	ReadTimeout  time.Duration   `yaml:"read_timeout" default:"30s"`
+	WriteTimeout time.Duration   `yaml:"write_timeout" default:"30s"`
+	IdleTimeout  time.Duration   `yaml:"idle_timeout" default:"120s"`
+	RateLimit    RateLimitConfig `yaml:"rate_limit"`
+}
+
+// RateLimitConfig limits the API requests each tenant may make
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained rate per tenant; 0 disables limiting
+	RequestsPerSecond float64 `yaml:"requests_per_second" envconfig:"RATE_LIMIT_RPS" default:"0"`
+	Burst             int     `yaml:"burst" envconfig:"RATE_LIMIT_BURST" default:"50"`
 }
 
 // GRPCConfig holds gRPC server configuration
 type GRPCConfig struct {
-	Port               int             `yaml:"port" envconfig:"GRPC_PORT" default:"9656"`
-	MaxRecvMessageSize int             `yaml:"max_recv_message_size" default:"4194304"` // 4MB
-	MaxSendMessageSize int             `yaml:"max_send_message_size" default:"4194304"` // 4MB
-	ConnectionTimeout  time.Duration   `yaml:"connection_timeout" default:"30s"`
-	ShutdownTimeout    time.Duration   `yaml:"shutdown_timeout" default:"30s"`
-	Keepalive          KeepaliveConfig `yaml:"keepalive"`
+	Port               int           `yaml:"port" envconfig:"GRPC_PORT" default:"9656"`
+	MaxRecvMessageSize int           `yaml:"max_recv_message_size" default:"4194304"` // 4MB
+	MaxSendMessageSize int           `yaml:"max_send_message_size" default:"4194304"` // 4MB
+	ConnectionTimeout  time.Duration `yaml:"connection_timeout" default:"30s"`
+	ShutdownTimeout    time.Duration `yaml:"shutdown_timeout" default:"30s"`
+	// Reflection lets clients such as grpcurl discover the services; it
+	// exposes the whole API surface, so it is off unless asked for
+	Reflection bool            `yaml:"reflection" envconfig:"GRPC_REFLECTION"`
+	Keepalive  KeepaliveConfig `yaml:"keepalive"`
 }
 
 // KeepaliveConfig holds gRPC keepalive configuration
@@ -152,6 +182,9 @@ type DatabaseConfig struct {
 
 // PostgreSQLConfig holds PostgreSQL configuration
 type PostgreSQLConfig struct {
+	// Enabled stores tasks, users, events and flags in PostgreSQL; they
+	// are kept in memory otherwise
+	Enabled         bool          `yaml:"enabled" envconfig:"POSTGRES_ENABLED"`
 	Host            string        `yaml:"host" envconfig:"POSTGRES_HOST" default:"localhost"`
 	Port            int           `yaml:"port" envconfig:"POSTGRES_PORT" default:"5432"`
 	Database        string        `yaml:"database" envconfig:"POSTGRES_DB" default:"mcp_ultra"`
@@ -165,19 +198,34 @@ type PostgreSQLConfig struct {
 
 // RedisConfig holds Redis configuration
 type RedisConfig struct {
+	// Enabled caches in Redis; the cache is in memory otherwise
+	Enabled  bool   `yaml:"enabled" envconfig:"REDIS_ENABLED"`
 	Addr     string `yaml:"addr" envconfig:"REDIS_ADDR" default:"localhost:6379"`
 	Password string `yaml:"password" envconfig:"REDIS_PASSWORD" default:""`
 	DB       int    `yaml:"db" envconfig:"REDIS_DB" default:"0"`
 	PoolSize int    `yaml:"pool_size" default:"10"`
+	// ClusterAddrs, when set, keep cached AI answers in this Redis cluster
+	// through cache.DistributedCache instead of the server at Addr
+	ClusterAddrs []string `yaml:"cluster_addrs" envconfig:"REDIS_CLUSTER_ADDRS"`
 }
 
 // NATSConfig holds NATS configuration
 type NATSConfig struct {
+	// Enabled publishes events to NATS when it is reachable; they stay
+	// in-process otherwise
+	Enabled   bool   `yaml:"enabled" envconfig:"NATS_ENABLED" default:"true"`
 	URL       string `yaml:"url" envconfig:"NATS_URL" default:"nats://localhost:4222"`
 	ClusterID string `yaml:"cluster_id" default:"mcp-cluster"`
 	ClientID  string `yaml:"client_id" default:"mcp-ultra"`
 }
 
+// OutboxConfig tunes the relay of the transactional event outbox
+type OutboxConfig struct {
+	// Retention, when set, clears the retry bookkeeping of events published
+	// longer ago; the events stay in the event store
+	Retention time.Duration `yaml:"retention" envconfig:"OUTBOX_RETENTION"`
+}
+
 // TelemetryConfig holds comprehensive telemetry configuration
 type TelemetryConfig struct {
 	Enabled        bool   `yaml:"enabled" envconfig:"TELEMETRY_ENABLED" default:"true"`
@@ -185,6 +233,7 @@ type TelemetryConfig struct {
 	ServiceVersion string `yaml:"service_version" envconfig:"SERVICE_VERSION" default:"1.0.0"`
 	Environment    string `yaml:"environment" envconfig:"ENVIRONMENT" default:"development"`
 	Debug          bool   `yaml:"debug" envconfig:"TELEMETRY_DEBUG" default:"false"`
+	LogLevel       string `yaml:"log_level" envconfig:"LOG_LEVEL" default:"info"`
 
 	// Tracing configuration
 	Tracing TracingConfig `yaml:"tracing"`
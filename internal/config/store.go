@@ -0,0 +1,439 @@
+package config
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/yaml.v3"
+)
+
+// maskedValue replaces sensitive values when a config section is exported
+const maskedValue = "********"
+
+// sensitiveKeys are key names, or "_name" suffixes, whose values are masked
+// on export
+var sensitiveKeys = []string{"password", "secret", "token", "api_key", "private_key", "credentials"}
+
+// ErrConcurrentUpdate is returned when the config changed while an update
+// was being prepared
+var ErrConcurrentUpdate = errors.New("config changed concurrently, retry the update")
+
+// ErrRestartRequired is returned when a change touches fields that no
+// registered handler applies at runtime
+var ErrRestartRequired = errors.New("config change requires a restart")
+
+// RestartRequiredError lists the changed fields that need a restart
+type RestartRequiredError struct {
+	Fields []string
+}
+
+func (e *RestartRequiredError) Error() string {
+	return fmt.Sprintf("%v: %s", ErrRestartRequired, strings.Join(e.Fields, ", "))
+}
+
+func (e *RestartRequiredError) Unwrap() error { return ErrRestartRequired }
+
+// ValidationError describes one invalid configuration field
+type ValidationError struct {
+	Field   string
+	Message string
+	Code    string
+}
+
+// ValidationErrors is returned when a configuration fails validation
+type ValidationErrors []ValidationError
+
+func (v ValidationErrors) Error() string {
+	msgs := make([]string, len(v))
+	for i, e := range v {
+		msgs[i] = fmt.Sprintf("%s: %s", e.Field, e.Message)
+	}
+	return "invalid configuration: " + strings.Join(msgs, "; ")
+}
+
+// Validate checks the values that would break the service at runtime
+func (c *Config) Validate() error {
+	var errs ValidationErrors
+	checkPort := func(field string, port int) {
+		if port < 1 || port > 65535 {
+			errs = append(errs, ValidationError{Field: field, Message: "must be between 1 and 65535", Code: "out_of_range"})
+		}
+	}
+	checkNonNegative := func(field string, d time.Duration) {
+		if d < 0 {
+			errs = append(errs, ValidationError{Field: field, Message: "must not be negative", Code: "out_of_range"})
+		}
+	}
+
+	checkPort("server.port", c.Server.Port)
+	checkPort("grpc.port", c.GRPC.Port)
+	checkPort("database.postgresql.port", c.Database.PostgreSQL.Port)
+	checkNonNegative("server.read_timeout", c.Server.ReadTimeout)
+	checkNonNegative("server.write_timeout", c.Server.WriteTimeout)
+	checkNonNegative("server.idle_timeout", c.Server.IdleTimeout)
+	checkNonNegative("grpc.connection_timeout", c.GRPC.ConnectionTimeout)
+	checkNonNegative("grpc.shutdown_timeout", c.GRPC.ShutdownTimeout)
+
+	if c.GRPC.Port == c.Server.Port {
+		errs = append(errs, ValidationError{Field: "grpc.port", Message: "must differ from server.port", Code: "conflict"})
+	}
+	if rate := c.Telemetry.Tracing.SampleRate; rate < 0 || rate > 1 {
+		errs = append(errs, ValidationError{Field: "telemetry.tracing.sample_rate", Message: "must be between 0 and 1", Code: "out_of_range"})
+	}
+	if c.Telemetry.LogLevel != "" {
+		if _, err := zapcore.ParseLevel(c.Telemetry.LogLevel); err != nil {
+			errs = append(errs, ValidationError{Field: "telemetry.log_level", Message: "must be debug, info, warn or error", Code: "invalid"})
+		}
+	}
+	if rl := c.Server.RateLimit; rl.RequestsPerSecond < 0 || rl.Burst < 0 {
+		errs = append(errs, ValidationError{Field: "server.rate_limit", Message: "must not be negative", Code: "out_of_range"})
+	}
+	if pg := c.Database.PostgreSQL; pg.MaxIdleConns > pg.MaxOpenConns && pg.MaxOpenConns > 0 {
+		errs = append(errs, ValidationError{Field: "database.postgresql.max_idle_conns", Message: "must not exceed max_open_conns", Code: "conflict"})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// ChangeHandler is called after the active configuration changes
+type ChangeHandler func(previous, current *Config)
+
+// Store holds the active configuration and lets it be reloaded or patched
+// at runtime. Configs handed out by Get must be treated as read-only.
+type Store struct {
+	mu        sync.RWMutex
+	current   *Config
+	checksum  string
+	updatedAt time.Time
+	load      func() (*Config, error)
+	handlers  []ChangeHandler
+	hotPaths  []string
+}
+
+// NewStore creates a store around cfg. load is used by Reload; nil means Load.
+func NewStore(cfg *Config, load func() (*Config, error)) (*Store, error) {
+	if load == nil {
+		load = Load
+	}
+	checksum, err := configChecksum(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{current: cfg, checksum: checksum, updatedAt: time.Now(), load: load}, nil
+}
+
+// Get returns the active configuration
+func (s *Store) Get() *Config {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.current
+}
+
+// Checksum returns a SHA-256 of the active configuration and when it was set
+func (s *Store) Checksum() (string, time.Time) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.checksum, s.updatedAt
+}
+
+// OnChange registers a handler called after every reload or update. paths
+// are the dotted YAML paths, such as "telemetry.log_level", the handler
+// applies at runtime; changes to any other field are refused with a
+// RestartRequiredError.
+func (s *Store) OnChange(handler ChangeHandler, paths ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers = append(s.handlers, handler)
+	s.hotPaths = append(s.hotPaths, paths...)
+}
+
+// Reload loads the configuration again from file and environment
+func (s *Store) Reload() error {
+	cfg, err := s.load()
+	if err != nil {
+		return fmt.Errorf("reloading config: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	current := s.Get()
+	before, err := toTree(current)
+	if err != nil {
+		return err
+	}
+	after, err := toTree(cfg)
+	if err != nil {
+		return err
+	}
+	diff := diffFlat(flatten("", before), flatten("", after))
+	if len(diff) == 0 {
+		return nil
+	}
+	if err := s.checkHot(diff); err != nil {
+		return err
+	}
+	return s.set(cfg, current)
+}
+
+// Section returns a section of the configuration as a generic map. section
+// is a dotted YAML path such as "database.redis"; empty means everything.
+func (s *Store) Section(section string, maskSensitive bool) (map[string]interface{}, error) {
+	tree, err := toTree(s.Get())
+	if err != nil {
+		return nil, err
+	}
+	node, err := lookupSection(tree, section)
+	if err != nil {
+		return nil, err
+	}
+	if maskSensitive {
+		maskTree(node)
+	}
+	return node, nil
+}
+
+// Update merges values into section and validates the result. It returns
+// the changed fields as {"path": {"old": ..., "new": ...}}. With dryRun the
+// active configuration is left untouched.
+func (s *Store) Update(section string, values map[string]interface{}, dryRun bool) (map[string]interface{}, error) {
+	current := s.Get()
+
+	tree, err := toTree(current)
+	if err != nil {
+		return nil, err
+	}
+	before, err := lookupSection(tree, section)
+	if err != nil {
+		return nil, err
+	}
+	oldFlat := flatten(section, before)
+
+	mergeTree(before, values)
+
+	next, err := fromTree(tree)
+	if err != nil {
+		return nil, ValidationErrors{{Field: section, Message: err.Error(), Code: "invalid"}}
+	}
+	if err := next.Validate(); err != nil {
+		return nil, err
+	}
+
+	nextTree, err := toTree(next)
+	if err != nil {
+		return nil, err
+	}
+	after, err := lookupSection(nextTree, section)
+	if err != nil {
+		return nil, err
+	}
+	diff := diffFlat(oldFlat, flatten(section, after))
+	if err := s.checkHot(diff); err != nil {
+		return nil, err
+	}
+
+	if dryRun || len(diff) == 0 {
+		return diff, nil
+	}
+
+	if err := s.set(next, current); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// checkHot refuses diff if it changes fields no handler applies at runtime
+func (s *Store) checkHot(diff map[string]interface{}) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var cold []string
+	for field := range diff {
+		if !matchesPath(field, s.hotPaths) {
+			cold = append(cold, field)
+		}
+	}
+	if len(cold) == 0 {
+		return nil
+	}
+	sort.Strings(cold)
+	return &RestartRequiredError{Fields: cold}
+}
+
+// matchesPath reports whether field is one of paths or nested below one
+func matchesPath(field string, paths []string) bool {
+	for _, p := range paths {
+		if field == p || strings.HasPrefix(field, p+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// set activates cfg. If expected is non-nil the swap only happens while it
+// is still the active config.
+func (s *Store) set(cfg, expected *Config) error {
+	checksum, err := configChecksum(cfg)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if expected != nil && s.current != expected {
+		s.mu.Unlock()
+		return ErrConcurrentUpdate
+	}
+	previous := s.current
+	s.current = cfg
+	s.checksum = checksum
+	s.updatedAt = time.Now()
+	handlers := make([]ChangeHandler, len(s.handlers))
+	copy(handlers, s.handlers)
+	s.mu.Unlock()
+
+	for _, h := range handlers {
+		h(previous, cfg)
+	}
+	return nil
+}
+
+func configChecksum(cfg *Config) (string, error) {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("encoding config: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// toTree converts the config into nested maps keyed by YAML names
+func toTree(cfg *Config) (map[string]interface{}, error) {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("encoding config: %w", err)
+	}
+	tree := make(map[string]interface{})
+	if err := yaml.Unmarshal(data, &tree); err != nil {
+		return nil, fmt.Errorf("decoding config: %w", err)
+	}
+	return tree, nil
+}
+
+// fromTree decodes nested maps back into a Config, rejecting unknown keys
+func fromTree(tree map[string]interface{}) (*Config, error) {
+	data, err := yaml.Marshal(tree)
+	if err != nil {
+		return nil, err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	cfg := &Config{}
+	if err := dec.Decode(cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// lookupSection walks a dotted path; the returned map aliases tree
+func lookupSection(tree map[string]interface{}, section string) (map[string]interface{}, error) {
+	if section == "" {
+		return tree, nil
+	}
+	node := tree
+	for _, key := range strings.Split(section, ".") {
+		child, ok := node[key].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unknown config section %q", section)
+		}
+		node = child
+	}
+	return node, nil
+}
+
+func mergeTree(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if srcMap, ok := v.(map[string]interface{}); ok {
+			if dstMap, ok := dst[k].(map[string]interface{}); ok {
+				mergeTree(dstMap, srcMap)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+func maskTree(node map[string]interface{}) {
+	for k, v := range node {
+		if child, ok := v.(map[string]interface{}); ok {
+			maskTree(child)
+			continue
+		}
+		if isSensitiveKey(k) && v != nil && v != "" {
+			node[k] = maskedValue
+		}
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if key == s || strings.HasSuffix(key, "_"+s) {
+			return true
+		}
+	}
+	return false
+}
+
+func flatten(prefix string, node map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{})
+	for k, v := range node {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		if child, ok := v.(map[string]interface{}); ok {
+			for ck, cv := range flatten(path, child) {
+				out[ck] = cv
+			}
+			continue
+		}
+		out[path] = v
+	}
+	return out
+}
+
+func diffFlat(before, after map[string]interface{}) map[string]interface{} {
+	keys := make(map[string]struct{}, len(before)+len(after))
+	for k := range before {
+		keys[k] = struct{}{}
+	}
+	for k := range after {
+		keys[k] = struct{}{}
+	}
+
+	diff := make(map[string]interface{})
+	for k := range keys {
+		if reflect.DeepEqual(before[k], after[k]) {
+			continue
+		}
+		oldValue, newValue := before[k], after[k]
+		if isSensitiveKey(k[strings.LastIndex(k, ".")+1:]) {
+			oldValue, newValue = maskedValue, maskedValue
+		}
+		diff[k] = map[string]interface{}{"old": oldValue, "new": newValue}
+	}
+	return diff
+}
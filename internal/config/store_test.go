@@ -0,0 +1,126 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() *Config {
+	cfg := &Config{Environment: "test"}
+	cfg.Telemetry.LogLevel = "info"
+	cfg.Server.Port = 9655
+	cfg.Server.ReadTimeout = 30 * time.Second
+	cfg.GRPC.Port = 9656
+	cfg.Database.PostgreSQL.Port = 5432
+	cfg.Database.PostgreSQL.Password = "hunter2"
+	cfg.Database.PostgreSQL.MaxOpenConns = 25
+	cfg.Database.PostgreSQL.MaxIdleConns = 5
+	return cfg
+}
+
+func TestStore_SectionMasksSecrets(t *testing.T) {
+	store, err := NewStore(testConfig(), nil)
+	require.NoError(t, err)
+
+	section, err := store.Section("database.postgresql", true)
+	require.NoError(t, err)
+	assert.Equal(t, maskedValue, section["password"])
+	assert.Equal(t, 5432, section["port"])
+
+	section, err = store.Section("database.postgresql", false)
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", section["password"])
+
+	_, err = store.Section("nope", false)
+	assert.Error(t, err)
+}
+
+func TestStore_Update(t *testing.T) {
+	store, err := NewStore(testConfig(), nil)
+	require.NoError(t, err)
+	checksum, _ := store.Checksum()
+
+	var changed *Config
+	store.OnChange(func(_, current *Config) { changed = current }, "telemetry.log_level")
+
+	diff, err := store.Update("telemetry", map[string]interface{}{"log_level": "debug"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"old": "info", "new": "debug"}, diff["telemetry.log_level"])
+	assert.Equal(t, "info", store.Get().Telemetry.LogLevel, "dry run leaves config untouched")
+	assert.Nil(t, changed)
+
+	_, err = store.Update("telemetry", map[string]interface{}{"log_level": "debug"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "debug", store.Get().Telemetry.LogLevel)
+	assert.Same(t, store.Get(), changed)
+
+	newChecksum, _ := store.Checksum()
+	assert.NotEqual(t, checksum, newChecksum)
+}
+
+func TestStore_UpdateRequiresRestart(t *testing.T) {
+	store, err := NewStore(testConfig(), nil)
+	require.NoError(t, err)
+	store.OnChange(func(_, _ *Config) {}, "server.rate_limit")
+
+	_, err = store.Update("server", map[string]interface{}{"rate_limit": map[string]interface{}{"burst": 10}}, false)
+	require.NoError(t, err)
+	assert.Equal(t, 10, store.Get().Server.RateLimit.Burst)
+
+	for _, dryRun := range []bool{true, false} {
+		_, err = store.Update("server", map[string]interface{}{"read_timeout": "45s", "rate_limit": map[string]interface{}{"burst": 20}}, dryRun)
+		require.ErrorIs(t, err, ErrRestartRequired)
+		var restart *RestartRequiredError
+		require.True(t, errors.As(err, &restart))
+		assert.Equal(t, []string{"server.read_timeout"}, restart.Fields)
+	}
+	assert.Equal(t, 30*time.Second, store.Get().Server.ReadTimeout)
+	assert.Equal(t, 10, store.Get().Server.RateLimit.Burst, "nothing of a refused update is applied")
+}
+
+func TestStore_UpdateRejectsInvalidValues(t *testing.T) {
+	store, err := NewStore(testConfig(), nil)
+	require.NoError(t, err)
+
+	_, err = store.Update("grpc", map[string]interface{}{"port": 9655}, false)
+	var verrs ValidationErrors
+	require.True(t, errors.As(err, &verrs))
+	assert.Equal(t, "grpc.port", verrs[0].Field)
+
+	_, err = store.Update("server", map[string]interface{}{"prot": 1}, false)
+	require.True(t, errors.As(err, &verrs))
+	assert.Equal(t, "invalid", verrs[0].Code)
+
+	assert.Equal(t, 9656, store.Get().GRPC.Port)
+}
+
+func TestStore_Reload(t *testing.T) {
+	next := testConfig()
+	next.Telemetry.LogLevel = "warn"
+	store, err := NewStore(testConfig(), func() (*Config, error) { return next, nil })
+	require.NoError(t, err)
+
+	var applied []string
+	store.OnChange(func(_, current *Config) { applied = append(applied, current.Telemetry.LogLevel) }, "telemetry.log_level")
+
+	require.NoError(t, store.Reload())
+	assert.Equal(t, "warn", store.Get().Telemetry.LogLevel)
+	assert.Equal(t, []string{"warn"}, applied)
+
+	require.NoError(t, store.Reload())
+	assert.Len(t, applied, 1, "an unchanged reload notifies nobody")
+
+	next = testConfig()
+	next.Server.Port = 0
+	assert.Error(t, store.Reload())
+
+	next = testConfig()
+	next.Environment = "reloaded"
+	assert.ErrorIs(t, store.Reload(), ErrRestartRequired)
+	assert.Equal(t, "test", store.Get().Environment)
+	assert.Equal(t, "warn", store.Get().Telemetry.LogLevel)
+}
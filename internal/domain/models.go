@@ -9,6 +9,7 @@ import (
 // Task represents a task in the system
 type Task struct {
 	ID          types.UUID             `json:"id" db:"id"`
+	TenantID    string                 `json:"tenant_id" db:"tenant_id"`
 	Title       string                 `json:"title" db:"title"`
 	Description string                 `json:"description" db:"description"`
 	Status      TaskStatus             `json:"status" db:"status"`
@@ -65,6 +66,7 @@ const (
 // Event represents a domain event
 type Event struct {
 	ID          types.UUID             `json:"id"`
+	TenantID    string                 `json:"tenant_id"`
 	Type        string                 `json:"type"`
 	AggregateID types.UUID             `json:"aggregate_id"`
 	Data        map[string]interface{} `json:"data"`
@@ -91,10 +93,21 @@ type TaskFilter struct {
 	AssigneeID *types.UUID
 	CreatedBy  *types.UUID
 	Tags       []string
-	FromDate   *time.Time
-	ToDate     *time.Time
-	Limit      int
-	Offset     int
+	// TagMatch selects whether tasks need all Tags or any of them
+	TagMatch TagMatch
+	// Query matches the title and description by full-text search
+	Query    string
+	FromDate *time.Time
+	ToDate   *time.Time
+	// Sort orders the results, newest first by default
+	Sort []TaskSort
+	// After is a cursor from NextCursor; the results start after its task
+	// and Offset is ignored
+	After  string
+	Limit  int
+	Offset int
+	// Count selects how the matching tasks are counted
+	Count TaskCount
 }
 
 // NewTask creates a new task with default values
@@ -43,6 +43,13 @@ type FeatureFlagRepository interface {
 	Delete(ctx context.Context, key string) error
 }
 
+// Transactor runs fn in a single transaction, committed when fn returns nil
+// and rolled back otherwise. Repositories called with the context fn
+// receives take part in the transaction.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 // CacheRepository defines the interface for cache operations
 type CacheRepository interface {
 	Set(ctx context.Context, key string, value interface{}, ttl int) error
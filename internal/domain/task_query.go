@@ -0,0 +1,270 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// ErrInvalidCursor means a TaskFilter.After cursor is malformed or was made
+// for another sort order
+var ErrInvalidCursor = errors.New("invalid task cursor")
+
+// TaskSortField is a field tasks can be sorted by
+type TaskSortField string
+
+const (
+	TaskSortCreatedAt TaskSortField = "created_at"
+	TaskSortUpdatedAt TaskSortField = "updated_at"
+	// TaskSortDueDate sorts tasks without a due date after every other
+	TaskSortDueDate TaskSortField = "due_date"
+	// TaskSortPriority sorts by rank, from low to urgent
+	TaskSortPriority TaskSortField = "priority"
+	TaskSortStatus   TaskSortField = "status"
+	TaskSortTitle    TaskSortField = "title"
+)
+
+// Valid reports whether tasks can be sorted by f
+func (f TaskSortField) Valid() bool {
+	switch f {
+	case TaskSortCreatedAt, TaskSortUpdatedAt, TaskSortDueDate, TaskSortPriority, TaskSortStatus, TaskSortTitle:
+		return true
+	}
+	return false
+}
+
+// TaskSort orders tasks by one field
+type TaskSort struct {
+	Field      TaskSortField
+	Descending bool
+}
+
+// TagMatch selects how TaskFilter.Tags match a task
+type TagMatch int
+
+const (
+	// TagMatchAll matches tasks carrying every tag
+	TagMatchAll TagMatch = iota
+	// TagMatchAny matches tasks carrying at least one tag
+	TagMatchAny
+)
+
+// TaskCount selects how TaskRepository.List counts the matching tasks
+type TaskCount int
+
+const (
+	// TaskCountExact counts every matching task
+	TaskCountExact TaskCount = iota
+	// TaskCountEstimated uses the database's estimate, which is cheap on
+	// large tenants but approximate
+	TaskCountEstimated
+	// TaskCountNone skips counting; List returns -1 as the total
+	TaskCountNone
+)
+
+// defaultTaskSort is the order of a filter without Sort
+var defaultTaskSort = []TaskSort{{Field: TaskSortCreatedAt, Descending: true}}
+
+// Rank orders priorities from low (1) to urgent (4); unknown priorities
+// rank 0
+func (p Priority) Rank() int {
+	switch p {
+	case PriorityLow:
+		return 1
+	case PriorityMedium:
+		return 2
+	case PriorityHigh:
+		return 3
+	case PriorityUrgent:
+		return 4
+	}
+	return 0
+}
+
+// SortOrder returns the order of the filter's results: Sort, or newest
+// first without one. Ties are broken by task ID, in the direction of the
+// last field.
+func (f TaskFilter) SortOrder() []TaskSort {
+	if len(f.Sort) == 0 {
+		return defaultTaskSort
+	}
+	return f.Sort
+}
+
+// Validate checks the sort fields and the cursor of the filter
+func (f TaskFilter) Validate() error {
+	seen := make(map[TaskSortField]bool, len(f.Sort))
+	for _, sort := range f.Sort {
+		if !sort.Field.Valid() {
+			return fmt.Errorf("invalid sort field %q", sort.Field)
+		}
+		if seen[sort.Field] {
+			return fmt.Errorf("sort field %q repeated", sort.Field)
+		}
+		seen[sort.Field] = true
+	}
+	_, err := f.Position()
+	return err
+}
+
+// taskCursor is the encoded form of a cursor: the sort order it was made
+// for, the sort values of the task and its ID
+type taskCursor struct {
+	Order  string   `json:"o"`
+	Values []string `json:"v"`
+	ID     string   `json:"id"`
+}
+
+// NextCursor returns the cursor resuming the filter's listing after last,
+// the final task of a page
+func (f TaskFilter) NextCursor(last *Task) string {
+	order := f.SortOrder()
+	cursor := taskCursor{Order: sortKey(order), Values: make([]string, len(order)), ID: last.ID.String()}
+	for i, sort := range order {
+		cursor.Values[i] = sortValue(last, sort.Field)
+	}
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Position decodes the After cursor into a task holding the sort values and
+// ID it resumes after, or nil without a cursor. The cursor must have been
+// made for the filter's sort order.
+func (f TaskFilter) Position() (*Task, error) {
+	if f.After == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(f.After)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor taskCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	order := f.SortOrder()
+	if cursor.Order != sortKey(order) || len(cursor.Values) != len(order) {
+		return nil, ErrInvalidCursor
+	}
+
+	position := &Task{}
+	if position.ID, err = types.Parse(cursor.ID); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	for i, sort := range order {
+		if err := setSortValue(position, sort.Field, cursor.Values[i]); err != nil {
+			return nil, ErrInvalidCursor
+		}
+	}
+	return position, nil
+}
+
+// CompareTasks orders a and b by sort, then by ID in the direction of the
+// last field. It returns a negative number when a comes first, a positive
+// one when b does and 0 only for the same ID.
+func CompareTasks(a, b *Task, sort []TaskSort) int {
+	descending := false
+	for _, s := range sort {
+		descending = s.Descending
+		if c := compareField(a, b, s.Field); c != 0 {
+			if s.Descending {
+				return -c
+			}
+			return c
+		}
+	}
+	c := strings.Compare(a.ID.String(), b.ID.String())
+	if descending {
+		return -c
+	}
+	return c
+}
+
+func sortKey(order []TaskSort) string {
+	parts := make([]string, len(order))
+	for i, sort := range order {
+		parts[i] = string(sort.Field)
+		if sort.Descending {
+			parts[i] = "-" + parts[i]
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+func compareField(a, b *Task, field TaskSortField) int {
+	switch field {
+	case TaskSortCreatedAt:
+		return a.CreatedAt.Compare(b.CreatedAt)
+	case TaskSortUpdatedAt:
+		return a.UpdatedAt.Compare(b.UpdatedAt)
+	case TaskSortDueDate:
+		switch {
+		case a.DueDate == nil && b.DueDate == nil:
+			return 0
+		case a.DueDate == nil:
+			return 1
+		case b.DueDate == nil:
+			return -1
+		}
+		return a.DueDate.Compare(*b.DueDate)
+	case TaskSortPriority:
+		return a.Priority.Rank() - b.Priority.Rank()
+	case TaskSortStatus:
+		return strings.Compare(string(a.Status), string(b.Status))
+	case TaskSortTitle:
+		return strings.Compare(a.Title, b.Title)
+	}
+	return 0
+}
+
+// noDueDate is the cursor value of a task without a due date
+const noDueDate = "infinity"
+
+func sortValue(task *Task, field TaskSortField) string {
+	switch field {
+	case TaskSortCreatedAt:
+		return task.CreatedAt.UTC().Format(time.RFC3339Nano)
+	case TaskSortUpdatedAt:
+		return task.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case TaskSortDueDate:
+		if task.DueDate == nil {
+			return noDueDate
+		}
+		return task.DueDate.UTC().Format(time.RFC3339Nano)
+	case TaskSortPriority:
+		return string(task.Priority)
+	case TaskSortStatus:
+		return string(task.Status)
+	case TaskSortTitle:
+		return task.Title
+	}
+	return ""
+}
+
+func setSortValue(task *Task, field TaskSortField, value string) error {
+	var err error
+	switch field {
+	case TaskSortCreatedAt:
+		task.CreatedAt, err = time.Parse(time.RFC3339Nano, value)
+	case TaskSortUpdatedAt:
+		task.UpdatedAt, err = time.Parse(time.RFC3339Nano, value)
+	case TaskSortDueDate:
+		if value != noDueDate {
+			var due time.Time
+			due, err = time.Parse(time.RFC3339Nano, value)
+			task.DueDate = &due
+		}
+	case TaskSortPriority:
+		task.Priority = Priority(value)
+	case TaskSortStatus:
+		task.Status = TaskStatus(value)
+	case TaskSortTitle:
+		task.Title = value
+	}
+	return err
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+func TestTaskFilter_CursorRoundTrip(t *testing.T) {
+	due := time.Date(2026, 3, 1, 12, 0, 0, 123456000, time.UTC)
+	task := NewTask("Ship it", "", types.New())
+	task.DueDate = &due
+	task.Priority = PriorityHigh
+
+	filter := TaskFilter{Sort: []TaskSort{
+		{Field: TaskSortDueDate},
+		{Field: TaskSortPriority, Descending: true},
+		{Field: TaskSortTitle},
+	}}
+	filter.After = filter.NextCursor(task)
+
+	position, err := filter.Position()
+	require.NoError(t, err)
+	assert.Equal(t, task.ID, position.ID)
+	require.NotNil(t, position.DueDate)
+	assert.True(t, due.Equal(*position.DueDate))
+	assert.Equal(t, 0, CompareTasks(task, position, filter.Sort))
+
+	task.DueDate = nil
+	filter.After = filter.NextCursor(task)
+	position, err = filter.Position()
+	require.NoError(t, err)
+	assert.Nil(t, position.DueDate)
+
+	filter.Sort = filter.Sort[:1]
+	_, err = filter.Position()
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	_, err = TaskFilter{After: "not a cursor"}.Position()
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestTaskFilter_Validate(t *testing.T) {
+	assert.NoError(t, TaskFilter{}.Validate())
+	assert.Error(t, TaskFilter{Sort: []TaskSort{{Field: "assignee"}}}.Validate())
+	assert.Error(t, TaskFilter{Sort: []TaskSort{{Field: TaskSortTitle}, {Field: TaskSortTitle, Descending: true}}}.Validate())
+}
+
+func TestCompareTasks_DueDateLast(t *testing.T) {
+	due := time.Now()
+	dated := &Task{ID: types.New(), DueDate: &due}
+	undated := &Task{ID: types.New()}
+	order := []TaskSort{{Field: TaskSortDueDate}}
+
+	assert.Negative(t, CompareTasks(dated, undated, order))
+	order[0].Descending = true
+	assert.Positive(t, CompareTasks(dated, undated, order))
+}
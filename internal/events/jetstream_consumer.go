@@ -0,0 +1,354 @@
+package events
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// Headers set on events and on the dead letters a JetStreamConsumer publishes
+const (
+	HeaderEventID            = "Event-ID"
+	HeaderTenantID           = "Tenant-ID"
+	HeaderDeadLetterReason   = "Dead-Letter-Reason"
+	HeaderDeadLetterSubject  = "Dead-Letter-Subject"
+	HeaderDeadLetterConsumer = "Dead-Letter-Consumer"
+	HeaderDeadLetterAttempts = "Dead-Letter-Deliveries"
+)
+
+// Defaults for the zero values of ConsumerConfig
+const (
+	DefaultMaxDeliver  = 5
+	DefaultAckWait     = 30 * time.Second
+	DefaultDedupWindow = 24 * time.Hour
+)
+
+// DefaultBackoff is how long a failed event waits before each redelivery;
+// the last delay repeats
+var DefaultBackoff = []time.Duration{time.Second, 5 * time.Second, 30 * time.Second}
+
+// kvKey matches the event IDs usable as KV keys as they are
+var kvKey = regexp.MustCompile(`^[-/_=.a-zA-Z0-9]+$`)
+
+// ConsumerConfig describes a durable JetStream consumer
+type ConsumerConfig struct {
+	// Stream holds the events; it is created with Subjects when missing
+	Stream   string
+	Subjects []string
+	// Durable names the consumer; replicas sharing it split the events
+	Durable       string
+	FilterSubject string
+	MaxDeliver    int
+	AckWait       time.Duration
+	Backoff       []time.Duration
+	// DeadLetterStream receives the events the handler gave up on, on
+	// "dlq.<Stream>.<Durable>"; it defaults to "<Stream>_DLQ"
+	DeadLetterStream string
+	// DedupWindow is how long handled Event-IDs are remembered, in the
+	// "dedup_<Durable>" KV bucket
+	DedupWindow time.Duration
+}
+
+// PermanentError marks a handler error that retrying cannot fix; the event
+// goes straight to the dead-letter stream
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string { return e.Err.Error() }
+
+func (e *PermanentError) Unwrap() error { return e.Err }
+
+// Permanent wraps err so the event is dead-lettered without retries
+func Permanent(err error) error {
+	return &PermanentError{Err: err}
+}
+
+// JetStreamConsumer runs an EventHandler on a durable JetStream consumer.
+// Handled events are acked; failed ones are redelivered after a backoff
+// until MaxDeliver, then published to the dead-letter stream. Events are
+// handled at most once per Event-ID within DedupWindow.
+type JetStreamConsumer struct {
+	js      jetstream.JetStream
+	config  ConsumerConfig
+	handler EventHandler
+	logger  *zap.Logger
+
+	mu      sync.Mutex
+	consume jetstream.ConsumeContext
+	dedup   jetstream.KeyValue
+	ctx     context.Context
+}
+
+// NewJetStreamConsumer creates a consumer on conn; call Start to begin
+// handling events
+func NewJetStreamConsumer(conn *nats.Conn, config ConsumerConfig, handler EventHandler, logger *zap.Logger) (*JetStreamConsumer, error) {
+	if config.Stream == "" || config.Durable == "" {
+		return nil, fmt.Errorf("consumer needs a stream and a durable name")
+	}
+	if config.MaxDeliver <= 0 {
+		config.MaxDeliver = DefaultMaxDeliver
+	}
+	if config.AckWait <= 0 {
+		config.AckWait = DefaultAckWait
+	}
+	if len(config.Backoff) == 0 {
+		config.Backoff = DefaultBackoff
+	}
+	if config.DeadLetterStream == "" {
+		config.DeadLetterStream = config.Stream + "_DLQ"
+	}
+	if config.DedupWindow <= 0 {
+		config.DedupWindow = DefaultDedupWindow
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return nil, fmt.Errorf("creating JetStream context: %w", err)
+	}
+	return &JetStreamConsumer{js: js, config: config, handler: handler, logger: logger}, nil
+}
+
+// DeadLetterSubject is where events this consumer gave up on are published
+func (c *JetStreamConsumer) DeadLetterSubject() string {
+	return fmt.Sprintf("dlq.%s.%s", c.config.Stream, c.config.Durable)
+}
+
+// Start creates what is missing of the streams, the durable consumer and
+// the dedup bucket, then handles events until Drain. Handlers get a
+// context derived from ctx that is not cancelled by a drain.
+func (c *JetStreamConsumer) Start(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.consume != nil {
+		return fmt.Errorf("consumer %s already started", c.config.Durable)
+	}
+
+	consumer, err := c.setup(ctx)
+	if err != nil {
+		return err
+	}
+	c.ctx = context.WithoutCancel(ctx)
+	consume, err := consumer.Consume(c.handle, jetstream.ConsumeErrHandler(func(_ jetstream.ConsumeContext, err error) {
+		c.logger.Warn("JetStream consume error", zap.String("durable", c.config.Durable), zap.Error(err))
+	}))
+	if err != nil {
+		return fmt.Errorf("consuming %s: %w", c.config.Durable, err)
+	}
+	c.consume = consume
+
+	c.logger.Info("JetStream consumer started",
+		zap.String("stream", c.config.Stream),
+		zap.String("durable", c.config.Durable))
+	return nil
+}
+
+// Drain stops fetching events and waits for the ones already fetched to
+// be handled, or for ctx to end. It is safe on a consumer never started.
+func (c *JetStreamConsumer) Drain(ctx context.Context) error {
+	c.mu.Lock()
+	consume := c.consume
+	c.consume = nil
+	c.mu.Unlock()
+	if consume == nil {
+		return nil
+	}
+
+	consume.Drain()
+	select {
+	case <-consume.Closed():
+		c.logger.Info("JetStream consumer drained", zap.String("durable", c.config.Durable))
+		return nil
+	case <-ctx.Done():
+		consume.Stop()
+		return fmt.Errorf("draining %s: %w", c.config.Durable, ctx.Err())
+	}
+}
+
+func (c *JetStreamConsumer) setup(ctx context.Context) (jetstream.Consumer, error) {
+	if err := ensureStream(ctx, c.js, c.config.Stream, c.config.Subjects); err != nil {
+		return nil, err
+	}
+	dlqSubjects := []string{fmt.Sprintf("dlq.%s.>", c.config.Stream)}
+	if err := ensureStream(ctx, c.js, c.config.DeadLetterStream, dlqSubjects); err != nil {
+		return nil, err
+	}
+
+	dedup, err := c.js.CreateOrUpdateKeyValue(ctx, jetstream.KeyValueConfig{
+		Bucket: "dedup_" + c.config.Durable,
+		TTL:    c.config.DedupWindow,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating dedup bucket: %w", err)
+	}
+	c.dedup = dedup
+
+	consumer, err := c.js.CreateOrUpdateConsumer(ctx, c.config.Stream, jetstream.ConsumerConfig{
+		Durable:       c.config.Durable,
+		FilterSubject: c.config.FilterSubject,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       c.config.AckWait,
+		MaxDeliver:    c.config.MaxDeliver,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating consumer %s: %w", c.config.Durable, err)
+	}
+	return consumer, nil
+}
+
+// ensureStream creates a missing stream and leaves an existing one as its
+// owner configured it
+func ensureStream(ctx context.Context, js jetstream.JetStream, name string, subjects []string) error {
+	_, err := js.Stream(ctx, name)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, jetstream.ErrStreamNotFound) {
+		return fmt.Errorf("looking up stream %s: %w", name, err)
+	}
+	if len(subjects) == 0 {
+		return fmt.Errorf("stream %s does not exist and has no subjects to create it with", name)
+	}
+	if _, err := js.CreateStream(ctx, jetstream.StreamConfig{Name: name, Subjects: subjects}); err != nil {
+		return fmt.Errorf("creating stream %s: %w", name, err)
+	}
+	return nil
+}
+
+// handle runs the handler on one delivery and settles it
+func (c *JetStreamConsumer) handle(msg jetstream.Msg) {
+	deliveries := uint64(1)
+	if meta, err := msg.Metadata(); err == nil {
+		deliveries = meta.NumDelivered
+	}
+
+	var event domain.Event
+	if err := json.Unmarshal(msg.Data(), &event); err != nil {
+		c.giveUp(msg, deliveries, fmt.Errorf("decoding event: %w", err))
+		return
+	}
+	id := msg.Headers().Get(HeaderEventID)
+	if id == "" && event.ID != types.Nil {
+		id = event.ID.String()
+	}
+	if c.handled(id) {
+		c.logger.Debug("Skipping duplicate event", zap.String("event_id", id))
+		c.settle(msg.Ack(), id)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(tenant.WithID(c.ctx, event.TenantID), c.config.AckWait)
+	err := c.handler.Handle(ctx, &event)
+	cancel()
+	if err == nil {
+		c.remember(id)
+		c.settle(msg.Ack(), id)
+		return
+	}
+
+	var permanent *PermanentError
+	if errors.As(err, &permanent) || deliveries >= uint64(c.config.MaxDeliver) {
+		c.giveUp(msg, deliveries, err)
+		return
+	}
+	delay := c.backoff(deliveries)
+	c.logger.Warn("Event handler failed, redelivering",
+		zap.String("event_id", id),
+		zap.Uint64("delivery", deliveries),
+		zap.Duration("delay", delay),
+		zap.Error(err))
+	c.settle(msg.NakWithDelay(delay), id)
+}
+
+// giveUp moves msg to the dead-letter stream. If that fails the event is
+// redelivered, so it is never lost.
+func (c *JetStreamConsumer) giveUp(msg jetstream.Msg, deliveries uint64, cause error) {
+	id := msg.Headers().Get(HeaderEventID)
+	dead := &nats.Msg{Subject: c.DeadLetterSubject(), Data: msg.Data(), Header: nats.Header{}}
+	for key, values := range msg.Headers() {
+		dead.Header[key] = values
+	}
+	dead.Header.Set(HeaderDeadLetterReason, cause.Error())
+	dead.Header.Set(HeaderDeadLetterSubject, msg.Subject())
+	dead.Header.Set(HeaderDeadLetterConsumer, c.config.Durable)
+	dead.Header.Set(HeaderDeadLetterAttempts, strconv.FormatUint(deliveries, 10))
+
+	ctx, cancel := context.WithTimeout(c.ctx, c.config.AckWait)
+	defer cancel()
+	if _, err := c.js.PublishMsg(ctx, dead); err != nil {
+		c.logger.Error("Failed to dead-letter event", zap.String("event_id", id), zap.Error(err))
+		c.settle(msg.NakWithDelay(c.backoff(deliveries)), id)
+		return
+	}
+	c.logger.Error("Event dead-lettered",
+		zap.String("event_id", id),
+		zap.String("subject", dead.Subject),
+		zap.Uint64("deliveries", deliveries),
+		zap.Error(cause))
+	c.settle(msg.TermWithReason("dead-lettered"), id)
+}
+
+// handled reports whether id was handled within the dedup window. Lookup
+// failures count as not handled: a duplicate beats a lost event.
+func (c *JetStreamConsumer) handled(id string) bool {
+	if id == "" {
+		return false
+	}
+	_, err := c.dedup.Get(c.ctx, dedupKey(id))
+	if err != nil && !errors.Is(err, jetstream.ErrKeyNotFound) {
+		c.logger.Warn("Failed to check event dedup", zap.String("event_id", id), zap.Error(err))
+	}
+	return err == nil
+}
+
+func (c *JetStreamConsumer) remember(id string) {
+	if id == "" {
+		return
+	}
+	if _, err := c.dedup.Put(c.ctx, dedupKey(id), []byte(time.Now().UTC().Format(time.RFC3339))); err != nil {
+		c.logger.Warn("Failed to record handled event", zap.String("event_id", id), zap.Error(err))
+	}
+}
+
+// backoff is the delay before the redelivery following delivery n
+func (c *JetStreamConsumer) backoff(n uint64) time.Duration {
+	i := int(n) - 1
+	if i >= len(c.config.Backoff) {
+		i = len(c.config.Backoff) - 1
+	}
+	if i < 0 {
+		i = 0
+	}
+	return c.config.Backoff[i]
+}
+
+func (c *JetStreamConsumer) settle(err error, id string) {
+	if err != nil {
+		c.logger.Warn("Failed to settle event", zap.String("event_id", id), zap.Error(err))
+	}
+}
+
+// dedupKey keeps IDs that are valid KV keys readable and hashes the rest
+func dedupKey(id string) string {
+	if kvKey.MatchString(id) && !strings.HasPrefix(id, ".") && !strings.HasSuffix(id, ".") {
+		return id
+	}
+	sum := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,173 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// runJetStream starts an embedded nats-server with JetStream and returns
+// its URL
+func runJetStream(t *testing.T) string {
+	t.Helper()
+	srv, err := server.NewServer(&server.Options{Host: "127.0.0.1", Port: -1, JetStream: true, StoreDir: t.TempDir(), NoLog: true, NoSigs: true})
+	require.NoError(t, err)
+	go srv.Start()
+	require.True(t, srv.ReadyForConnections(5*time.Second), "nats-server not ready")
+	t.Cleanup(srv.Shutdown)
+	return srv.ClientURL()
+}
+
+// startConsumer runs handler on a fresh server and returns the bus that
+// publishes to it
+func startConsumer(t *testing.T, config ConsumerConfig, handler EventHandlerFunc) (*NATSEventBus, *JetStreamConsumer) {
+	t.Helper()
+	url := runJetStream(t)
+	logger := zaptest.NewLogger(t)
+
+	bus, err := NewNATSEventBus(url, logger)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = bus.Close() })
+
+	config.Stream, config.Subjects, config.Durable = "EVENTS", []string{"events.>"}, "tasks"
+	config.Backoff = []time.Duration{10 * time.Millisecond}
+	consumer, err := NewJetStreamConsumer(bus.conn, config, handler, logger)
+	require.NoError(t, err)
+	require.NoError(t, consumer.Start(context.Background()))
+	t.Cleanup(func() { _ = consumer.Drain(context.Background()) })
+	return bus, consumer
+}
+
+func newEvent() *domain.Event {
+	return &domain.Event{ID: types.New(), Type: "task.created", AggregateID: types.New(), OccurredAt: time.Now(), Version: 1}
+}
+
+// deadLetters reads the messages in the dead-letter stream
+func deadLetters(t *testing.T, bus *NATSEventBus, want int) []*jetstream.RawStreamMsg {
+	t.Helper()
+	js, err := jetstream.New(bus.conn)
+	require.NoError(t, err)
+	stream, err := js.Stream(context.Background(), "EVENTS_DLQ")
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		info, err := stream.Info(context.Background())
+		return err == nil && info.State.Msgs == uint64(want)
+	}, 5*time.Second, 10*time.Millisecond)
+	msgs := make([]*jetstream.RawStreamMsg, want)
+	for i := range msgs {
+		msgs[i], err = stream.GetMsg(context.Background(), uint64(i+1))
+		require.NoError(t, err)
+	}
+	return msgs
+}
+
+func TestJetStreamConsumer_Deduplicates(t *testing.T) {
+	var calls atomic.Int32
+	bus, _ := startConsumer(t, ConsumerConfig{}, func(_ context.Context, _ *domain.Event) error {
+		calls.Add(1)
+		return nil
+	})
+
+	event := newEvent()
+	require.NoError(t, bus.Publish(context.Background(), event))
+	require.NoError(t, bus.Publish(context.Background(), event))
+	require.NoError(t, bus.Publish(context.Background(), newEvent()))
+
+	require.Eventually(t, func() bool { return calls.Load() == 2 }, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load(), "the repeated Event-ID must be handled once")
+}
+
+func TestJetStreamConsumer_RetriesWithBackoff(t *testing.T) {
+	var calls atomic.Int32
+	bus, _ := startConsumer(t, ConsumerConfig{MaxDeliver: 5}, func(_ context.Context, _ *domain.Event) error {
+		if calls.Add(1) < 3 {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+
+	require.NoError(t, bus.Publish(context.Background(), newEvent()))
+	require.Eventually(t, func() bool { return calls.Load() == 3 }, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, int32(3), calls.Load(), "a handled event must not be redelivered")
+}
+
+func TestJetStreamConsumer_DeadLetters(t *testing.T) {
+	var calls atomic.Int32
+	bus, consumer := startConsumer(t, ConsumerConfig{MaxDeliver: 3}, func(_ context.Context, event *domain.Event) error {
+		calls.Add(1)
+		if event.Version == 2 {
+			return Permanent(errors.New("unsupported version"))
+		}
+		return errors.New("always failing")
+	})
+
+	exhausted := newEvent()
+	require.NoError(t, bus.Publish(context.Background(), exhausted))
+	letters := deadLetters(t, bus, 1)
+	assert.Equal(t, int32(3), calls.Load(), "the event must be delivered MaxDeliver times")
+	assert.Equal(t, consumer.DeadLetterSubject(), letters[0].Subject)
+	assert.Equal(t, exhausted.ID.String(), letters[0].Header.Get(HeaderEventID))
+	assert.Equal(t, "events.default.task.created", letters[0].Header.Get(HeaderDeadLetterSubject))
+	assert.Equal(t, "3", letters[0].Header.Get(HeaderDeadLetterAttempts))
+	assert.Equal(t, "always failing", letters[0].Header.Get(HeaderDeadLetterReason))
+
+	permanent := newEvent()
+	permanent.Version = 2
+	require.NoError(t, bus.Publish(context.Background(), permanent))
+	letters = deadLetters(t, bus, 2)
+	assert.Equal(t, int32(4), calls.Load(), "a permanent error must not be retried")
+	assert.Equal(t, "1", letters[1].Header.Get(HeaderDeadLetterAttempts))
+
+	require.NoError(t, bus.conn.PublishMsg(&nats.Msg{Subject: "events.default.task.created", Data: []byte("{")}))
+	letters = deadLetters(t, bus, 3)
+	assert.Contains(t, letters[2].Header.Get(HeaderDeadLetterReason), "decoding event")
+}
+
+func TestJetStreamConsumer_Drain(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	var handled atomic.Bool
+	bus, consumer := startConsumer(t, ConsumerConfig{}, func(ctx context.Context, _ *domain.Event) error {
+		close(started)
+		<-release
+		handled.Store(ctx.Err() == nil)
+		return nil
+	})
+	require.NoError(t, bus.Publish(context.Background(), newEvent()))
+	<-started
+
+	var wg sync.WaitGroup
+	var drainErr error
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		drainErr = consumer.Drain(context.Background())
+	}()
+	time.Sleep(50 * time.Millisecond)
+	assert.False(t, handled.Load(), "drain must wait for the event in flight")
+
+	close(release)
+	wg.Wait()
+	require.NoError(t, drainErr)
+	assert.True(t, handled.Load(), "the handler context must outlive the drain")
+}
+
+func TestNewJetStreamConsumer_RequiresNames(t *testing.T) {
+	_, err := NewJetStreamConsumer(nil, ConsumerConfig{Stream: "EVENTS"}, nil, zaptest.NewLogger(t))
+	assert.Error(t, err)
+}
@@ -0,0 +1,63 @@
+package events
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+)
+
+// DefaultEventStream is the stream EnsureStream creates when none captures
+// "events.>"
+const DefaultEventStream = "EVENTS"
+
+// JetStreamPublisher publishes events to the JetStream stream capturing
+// "events.>" and waits for it to store them, unlike NATSEventBus. The event
+// ID is the message ID, so a republished event is dropped by the stream
+// within its duplicate window.
+type JetStreamPublisher struct {
+	js     jetstream.JetStream
+	logger *zap.Logger
+}
+
+var _ Publisher = (*JetStreamPublisher)(nil)
+
+// NewJetStreamPublisher creates a publisher on conn
+func NewJetStreamPublisher(conn *nats.Conn, logger *zap.Logger) (*JetStreamPublisher, error) {
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return nil, fmt.Errorf("creating JetStream context: %w", err)
+	}
+	return &JetStreamPublisher{js: js, logger: logger}, nil
+}
+
+// EnsureStream creates the stream named name on "events.>" unless it
+// exists. Publishing fails while no stream captures the event subjects.
+func (p *JetStreamPublisher) EnsureStream(ctx context.Context, name string) error {
+	return ensureStream(ctx, p.js, name, []string{"events.>"})
+}
+
+// Publish publishes event and returns once the stream acknowledged it
+func (p *JetStreamPublisher) Publish(ctx context.Context, event *domain.Event) error {
+	msg, err := eventMsg(event)
+	if err != nil {
+		return err
+	}
+
+	ack, err := p.js.PublishMsg(ctx, msg, jetstream.WithMsgID(event.ID.String()))
+	if err != nil {
+		return fmt.Errorf("publishing event to JetStream: %w", err)
+	}
+
+	p.logger.Debug("Event published",
+		zap.String("event_id", event.ID.String()),
+		zap.String("event_type", event.Type),
+		zap.String("stream", ack.Stream),
+		zap.Bool("duplicate", ack.Duplicate))
+
+	return nil
+}
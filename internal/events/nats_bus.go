@@ -3,6 +3,7 @@ package events
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,16 +11,40 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
 )
 
 // NATSEventBus implements EventBus using NATS
 type NATSEventBus struct {
-	conn   *nats.Conn
-	logger *zap.Logger
+	conn    *nats.Conn
+	logger  *zap.Logger
+	breaker Breaker
+}
+
+// Breaker fails calls fast while a dependency keeps failing;
+// cache.CircuitBreaker implements it
+type Breaker interface {
+	Allow() bool
+	RecordSuccess()
+	RecordFailure()
+}
+
+// errCircuitOpen is returned for publishes refused by an open breaker
+var errCircuitOpen = errors.New("circuit breaker is open")
+
+// NATSOption configures a NATSEventBus
+type NATSOption func(*NATSEventBus)
+
+// WithBreaker refuses to publish while breaker is open and records the
+// outcome of every publish
+func WithBreaker(breaker Breaker) NATSOption {
+	return func(bus *NATSEventBus) {
+		bus.breaker = breaker
+	}
 }
 
 // NewNATSEventBus creates a new NATS event bus
-func NewNATSEventBus(natsURL string, logger *zap.Logger) (*NATSEventBus, error) {
+func NewNATSEventBus(natsURL string, logger *zap.Logger, opts ...NATSOption) (*NATSEventBus, error) {
 	conn, err := nats.Connect(natsURL,
 		nats.ReconnectWait(2*time.Second),
 		nats.MaxReconnects(-1),
@@ -40,50 +65,55 @@ func NewNATSEventBus(natsURL string, logger *zap.Logger) (*NATSEventBus, error)
 		return nil, fmt.Errorf("connecting to NATS: %w", err)
 	}
 
-	return &NATSEventBus{
+	bus := &NATSEventBus{
 		conn:   conn,
 		logger: logger,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(bus)
+	}
+	return bus, nil
 }
 
 // Publish publishes an event to NATS
 func (bus *NATSEventBus) Publish(_ context.Context, event *domain.Event) error {
-	subject := fmt.Sprintf("events.%s", event.Type)
-
-	data, err := json.Marshal(event)
+	msg, err := eventMsg(event)
 	if err != nil {
-		return fmt.Errorf("marshaling event: %w", err)
+		return err
 	}
 
-	// Create NATS message with headers
-	msg := &nats.Msg{
-		Subject: subject,
-		Data:    data,
-		Header: nats.Header{
-			"Event-ID":      []string{event.ID.String()},
-			"Event-Type":    []string{event.Type},
-			"Aggregate-ID":  []string{event.AggregateID.String()},
-			"Event-Version": []string{fmt.Sprintf("%d", event.Version)},
-			"Content-Type":  []string{"application/json"},
-			"Timestamp":     []string{event.OccurredAt.Format(time.RFC3339)},
-		},
-	}
-
-	if err := bus.conn.PublishMsg(msg); err != nil {
+	if err := bus.publish(msg); err != nil {
 		return fmt.Errorf("publishing event to NATS: %w", err)
 	}
 
 	bus.logger.Debug("Event published",
 		zap.String("event_id", event.ID.String()),
 		zap.String("event_type", event.Type),
-		zap.String("subject", subject))
+		zap.String("subject", msg.Subject))
+
+	return nil
+}
 
+// publish sends msg through the breaker, when there is one
+func (bus *NATSEventBus) publish(msg *nats.Msg) error {
+	if bus.breaker == nil {
+		return bus.conn.PublishMsg(msg)
+	}
+	if !bus.breaker.Allow() {
+		return errCircuitOpen
+	}
+	if err := bus.conn.PublishMsg(msg); err != nil {
+		bus.breaker.RecordFailure()
+		return err
+	}
+	bus.breaker.RecordSuccess()
 	return nil
 }
 
-// Subscribe subscribes to events of a specific type
+// Subscribe subscribes to events of a specific type from every tenant.
+// Handlers run in the context of the event's tenant.
 func (bus *NATSEventBus) Subscribe(eventType string, handler EventHandler) (*nats.Subscription, error) {
-	subject := fmt.Sprintf("events.%s", eventType)
+	subject := eventSubject("*", eventType)
 
 	sub, err := bus.conn.Subscribe(subject, func(msg *nats.Msg) {
 		var event domain.Event
@@ -94,7 +124,7 @@ func (bus *NATSEventBus) Subscribe(eventType string, handler EventHandler) (*nat
 			return
 		}
 
-		ctx := context.Background()
+		ctx := tenant.WithID(context.Background(), event.TenantID)
 		if err := handler.Handle(ctx, &event); err != nil {
 			bus.logger.Error("Failed to handle event",
 				zap.Error(err),
@@ -111,9 +141,9 @@ func (bus *NATSEventBus) Subscribe(eventType string, handler EventHandler) (*nat
 	return sub, nil
 }
 
-// SubscribeQueue subscribes to events with queue group
+// SubscribeQueue subscribes to events with queue group, like Subscribe
 func (bus *NATSEventBus) SubscribeQueue(eventType, queue string, handler EventHandler) (*nats.Subscription, error) {
-	subject := fmt.Sprintf("events.%s", eventType)
+	subject := eventSubject("*", eventType)
 
 	sub, err := bus.conn.QueueSubscribe(subject, queue, func(msg *nats.Msg) {
 		var event domain.Event
@@ -124,7 +154,7 @@ func (bus *NATSEventBus) SubscribeQueue(eventType, queue string, handler EventHa
 			return
 		}
 
-		ctx := context.Background()
+		ctx := tenant.WithID(context.Background(), event.TenantID)
 		if err := handler.Handle(ctx, &event); err != nil {
 			bus.logger.Error("Failed to handle event",
 				zap.Error(err),
@@ -143,6 +173,17 @@ func (bus *NATSEventBus) SubscribeQueue(eventType, queue string, handler EventHa
 	return sub, nil
 }
 
+// Conn returns the underlying NATS connection, for JetStream clients that
+// share it
+func (bus *NATSEventBus) Conn() *nats.Conn {
+	return bus.conn
+}
+
+// IsConnected reports whether the NATS connection is currently up
+func (bus *NATSEventBus) IsConnected() bool {
+	return bus.conn != nil && bus.conn.IsConnected()
+}
+
 // Close closes the NATS connection
 func (bus *NATSEventBus) Close() error {
 	if bus.conn != nil && !bus.conn.IsClosed() {
@@ -226,3 +267,37 @@ func (h *TaskEventHandler) handleTaskDeleted(_ context.Context, event *domain.Ev
 	// Implement business logic here
 	return nil
 }
+
+// eventSubject returns the subject of the events of type eventType from
+// tenantID: "events.<tenant>.<type>"
+func eventSubject(tenantID, eventType string) string {
+	return fmt.Sprintf("events.%s.%s", tenantID, eventType)
+}
+
+// eventMsg encodes event as a message on its tenant's subject with its
+// metadata in headers. Events without a tenant belong to the default one.
+func eventMsg(event *domain.Event) (*nats.Msg, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling event: %w", err)
+	}
+
+	tenantID := event.TenantID
+	if tenantID == "" {
+		tenantID = tenant.Default
+	}
+
+	return &nats.Msg{
+		Subject: eventSubject(tenantID, event.Type),
+		Data:    data,
+		Header: nats.Header{
+			HeaderEventID:   []string{event.ID.String()},
+			HeaderTenantID:  []string{tenantID},
+			"Event-Type":    []string{event.Type},
+			"Aggregate-ID":  []string{event.AggregateID.String()},
+			"Event-Version": []string{fmt.Sprintf("%d", event.Version)},
+			"Content-Type":  []string{"application/json"},
+			"Timestamp":     []string{event.OccurredAt.Format(time.RFC3339)},
+		},
+	}, nil
+}
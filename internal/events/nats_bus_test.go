@@ -0,0 +1,92 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+)
+
+func TestNATSEventBus_ScopesSubjectsByTenant(t *testing.T) {
+	bus, err := NewNATSEventBus(runJetStream(t), zaptest.NewLogger(t))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = bus.Close() })
+
+	var mu sync.Mutex
+	handled := make(map[string]string)
+	_, err = bus.Subscribe("task.*", EventHandlerFunc(func(ctx context.Context, event *domain.Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		handled[event.ID.String()] = tenant.ID(ctx)
+		return nil
+	}))
+	require.NoError(t, err)
+
+	acmeOnly := make(chan *nats.Msg, 4)
+	_, err = bus.conn.ChanSubscribe("events.acme.>", acmeOnly)
+	require.NoError(t, err)
+	require.NoError(t, bus.conn.Flush())
+
+	acme, globex := newEvent(), newEvent()
+	acme.TenantID, globex.TenantID = "acme", "globex"
+	require.NoError(t, bus.Publish(context.Background(), acme))
+	require.NoError(t, bus.Publish(context.Background(), globex))
+
+	select {
+	case msg := <-acmeOnly:
+		assert.Equal(t, "events.acme.task.created", msg.Subject)
+		assert.Equal(t, "acme", msg.Header.Get(HeaderTenantID))
+	case <-time.After(5 * time.Second):
+		t.Fatal("acme event not received")
+	}
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(handled) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	mu.Lock()
+	assert.Equal(t, "acme", handled[acme.ID.String()], "handlers run in the event's tenant")
+	assert.Equal(t, "globex", handled[globex.ID.String()])
+	mu.Unlock()
+
+	require.NoError(t, bus.conn.Flush())
+	assert.Empty(t, acmeOnly, "a tenant's subscribers never see another tenant's events")
+}
+
+// countingBreaker records outcomes and is open when told to
+type countingBreaker struct {
+	open                bool
+	successes, failures int
+}
+
+func (b *countingBreaker) Allow() bool    { return !b.open }
+func (b *countingBreaker) RecordSuccess() { b.successes++ }
+func (b *countingBreaker) RecordFailure() { b.failures++ }
+
+func TestNATSEventBus_Breaker(t *testing.T) {
+	breaker := &countingBreaker{}
+	bus, err := NewNATSEventBus(runJetStream(t), zaptest.NewLogger(t), WithBreaker(breaker))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = bus.Close() })
+	ctx := context.Background()
+
+	require.NoError(t, bus.Publish(ctx, newEvent()))
+	assert.Equal(t, 1, breaker.successes)
+
+	bus.conn.Close()
+	assert.Error(t, bus.Publish(ctx, newEvent()))
+	assert.Equal(t, 1, breaker.failures)
+
+	breaker.open = true
+	assert.ErrorIs(t, bus.Publish(ctx, newEvent()), errCircuitOpen)
+	assert.Equal(t, 1, breaker.failures, "refused publishes are not attempted")
+}
@@ -0,0 +1,256 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// Defaults for the zero values of OutboxRelayConfig
+const (
+	DefaultRelayInterval   = time.Second
+	DefaultRelayBatchSize  = 100
+	DefaultCleanupInterval = time.Hour
+)
+
+// Publisher publishes a domain event; NATSEventBus and JetStreamPublisher
+// implement it
+type Publisher interface {
+	Publish(ctx context.Context, event *domain.Event) error
+}
+
+// OutboxEvent is an event waiting in the outbox
+type OutboxEvent struct {
+	Event *domain.Event
+	// Attempts counts the failed publications so far
+	Attempts int
+}
+
+// OutboxBatch holds the events one relay claimed. Its marks take effect on
+// Commit; Rollback releases the events for the next claim.
+type OutboxBatch interface {
+	Events() []OutboxEvent
+	MarkPublished(ctx context.Context, id types.UUID) error
+	MarkFailed(ctx context.Context, id types.UUID, retryAt time.Time, cause error) error
+	Commit() error
+	Rollback() error
+}
+
+// OutboxStore keeps the events written in the same transaction as the
+// aggregates they describe, until an OutboxRelay publishes them
+type OutboxStore interface {
+	// Claim returns up to limit unpublished events that are due, oldest
+	// first. It returns at most the oldest unpublished event of each
+	// aggregate, so events are published in the order their aggregate
+	// emitted them, and skips events another relay has claimed.
+	Claim(ctx context.Context, limit int) (OutboxBatch, error)
+	// ClearPublished resets the delivery bookkeeping of the events published
+	// before the given time. The events themselves are kept: the outbox is
+	// also the domain event store.
+	ClearPublished(ctx context.Context, before time.Time) (int64, error)
+}
+
+// OutboxRelayConfig tunes an OutboxRelay
+type OutboxRelayConfig struct {
+	// Interval is how often the outbox is polled
+	Interval  time.Duration
+	BatchSize int
+	// Backoff is how long a failed event waits before each retry; the last
+	// delay repeats
+	Backoff []time.Duration
+	// Retention, when positive, clears the delivery bookkeeping of events
+	// published longer ago, checked every CleanupInterval; zero disables
+	// cleanup
+	Retention       time.Duration
+	CleanupInterval time.Duration
+}
+
+// OutboxRelay publishes the events in an OutboxStore. Delivery is at least
+// once: an event published right before a crash is published again, so
+// consumers deduplicate by Event-ID as JetStreamConsumer does.
+type OutboxRelay struct {
+	store     OutboxStore
+	publisher Publisher
+	config    OutboxRelayConfig
+	logger    *zap.Logger
+
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewOutboxRelay creates a relay from store to publisher; call Start to
+// begin relaying
+func NewOutboxRelay(store OutboxStore, publisher Publisher, config OutboxRelayConfig, logger *zap.Logger) *OutboxRelay {
+	if config.Interval <= 0 {
+		config.Interval = DefaultRelayInterval
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultRelayBatchSize
+	}
+	if len(config.Backoff) == 0 {
+		config.Backoff = DefaultBackoff
+	}
+	if config.CleanupInterval <= 0 {
+		config.CleanupInterval = DefaultCleanupInterval
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &OutboxRelay{store: store, publisher: publisher, config: config, logger: logger}
+}
+
+// Start relays until ctx is done or Stop is called
+func (r *OutboxRelay) Start(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stop != nil {
+		return
+	}
+	r.stop, r.done = make(chan struct{}), make(chan struct{})
+	go r.run(ctx, r.stop, r.done)
+}
+
+// Stop ends relaying and waits for the batch in progress. It is safe on a
+// nil or stopped relay.
+func (r *OutboxRelay) Stop() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	stop, done := r.stop, r.done
+	r.stop, r.done = nil, nil
+	r.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+}
+
+func (r *OutboxRelay) run(ctx context.Context, stop, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+	var cleaned time.Time
+
+	for {
+		r.drain(ctx, stop)
+		if r.config.Retention > 0 && time.Since(cleaned) >= r.config.CleanupInterval {
+			if _, err := r.Cleanup(ctx); err != nil {
+				r.logger.Error("Failed to clean up outbox", zap.Error(err))
+			}
+			cleaned = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain relays batches until one publishes nothing
+func (r *OutboxRelay) drain(ctx context.Context, stop chan struct{}) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-stop:
+			return
+		default:
+		}
+		published, err := r.RelayOnce(ctx)
+		if err != nil {
+			r.logger.Error("Failed to relay outbox events", zap.Error(err))
+			return
+		}
+		if published == 0 {
+			return
+		}
+	}
+}
+
+// RelayOnce publishes one batch and returns how many of its events were
+// published. Events that fail to publish are retried after the backoff.
+func (r *OutboxRelay) RelayOnce(ctx context.Context) (int, error) {
+	batch, err := r.store.Claim(ctx, r.config.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("claiming outbox events: %w", err)
+	}
+
+	published := 0
+	for _, pending := range batch.Events() {
+		ok, err := r.relay(ctx, batch, pending)
+		if err != nil {
+			if rbErr := batch.Rollback(); rbErr != nil {
+				err = errors.Join(err, fmt.Errorf("rolling back outbox batch: %w", rbErr))
+			}
+			return 0, err
+		}
+		if ok {
+			published++
+		}
+	}
+	if err := batch.Commit(); err != nil {
+		return 0, fmt.Errorf("committing outbox batch: %w", err)
+	}
+	return published, nil
+}
+
+// relay publishes one event and marks it, reporting whether it was
+// published; only a failure to mark it is returned
+func (r *OutboxRelay) relay(ctx context.Context, batch OutboxBatch, pending OutboxEvent) (bool, error) {
+	event := pending.Event
+	if err := r.publisher.Publish(ctx, event); err != nil {
+		delay := r.backoff(pending.Attempts)
+		r.logger.Warn("Failed to publish outbox event, will retry",
+			zap.String("event_id", event.ID.String()),
+			zap.String("event_type", event.Type),
+			zap.Int("attempts", pending.Attempts+1),
+			zap.Duration("retry_in", delay),
+			zap.Error(err))
+		if err := batch.MarkFailed(ctx, event.ID, time.Now().Add(delay), err); err != nil {
+			return false, fmt.Errorf("marking outbox event %s failed: %w", event.ID, err)
+		}
+		return false, nil
+	}
+	if err := batch.MarkPublished(ctx, event.ID); err != nil {
+		return false, fmt.Errorf("marking outbox event %s published: %w", event.ID, err)
+	}
+	return true, nil
+}
+
+// Cleanup clears the delivery bookkeeping of the events published more
+// than Retention ago; it does nothing when Retention is not positive
+func (r *OutboxRelay) Cleanup(ctx context.Context) (int64, error) {
+	if r.config.Retention <= 0 {
+		return 0, nil
+	}
+	cleared, err := r.store.ClearPublished(ctx, time.Now().Add(-r.config.Retention))
+	if err != nil {
+		return 0, fmt.Errorf("clearing published outbox events: %w", err)
+	}
+	if cleared > 0 {
+		r.logger.Debug("Outbox cleaned up", zap.Int64("cleared", cleared))
+	}
+	return cleared, nil
+}
+
+// backoff is the delay before retrying an event that failed attempts times
+// before
+func (r *OutboxRelay) backoff(attempts int) time.Duration {
+	if attempts >= len(r.config.Backoff) {
+		return r.config.Backoff[len(r.config.Backoff)-1]
+	}
+	return r.config.Backoff[attempts]
+}
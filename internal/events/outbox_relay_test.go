@@ -0,0 +1,248 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+type outboxRow struct {
+	event       *domain.Event
+	attempts    int
+	nextAttempt time.Time
+	publishedAt time.Time
+	lastError   string
+}
+
+// memoryOutbox claims like the PostgreSQL outbox: the due head of each
+// aggregate, with marks applied on commit
+type memoryOutbox struct {
+	mu      sync.Mutex
+	rows    []*outboxRow
+	markErr error
+}
+
+func (o *memoryOutbox) add(event *domain.Event) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.rows = append(o.rows, &outboxRow{event: event})
+}
+
+func (o *memoryOutbox) row(id types.UUID) *outboxRow {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, row := range o.rows {
+		if row.event.ID == id {
+			return row
+		}
+	}
+	return nil
+}
+
+func (o *memoryOutbox) Claim(_ context.Context, limit int) (OutboxBatch, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	batch := &memoryBatch{outbox: o, rows: make(map[types.UUID]*outboxRow)}
+	blocked := make(map[types.UUID]bool)
+	for _, row := range o.rows {
+		if !row.publishedAt.IsZero() || blocked[row.event.AggregateID] {
+			continue
+		}
+		blocked[row.event.AggregateID] = true
+		if row.nextAttempt.After(time.Now()) || len(batch.events) == limit {
+			continue
+		}
+		batch.events = append(batch.events, OutboxEvent{Event: row.event, Attempts: row.attempts})
+		batch.rows[row.event.ID] = row
+	}
+	return batch, nil
+}
+
+func (o *memoryOutbox) ClearPublished(_ context.Context, before time.Time) (int64, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var cleared int64
+	for _, row := range o.rows {
+		if row.publishedAt.IsZero() || !row.publishedAt.Before(before) || (row.attempts == 0 && row.lastError == "") {
+			continue
+		}
+		row.attempts, row.lastError = 0, ""
+		cleared++
+	}
+	return cleared, nil
+}
+
+type memoryBatch struct {
+	outbox *memoryOutbox
+	events []OutboxEvent
+	rows   map[types.UUID]*outboxRow
+	marks  []func()
+}
+
+func (b *memoryBatch) Events() []OutboxEvent { return b.events }
+
+func (b *memoryBatch) MarkPublished(_ context.Context, id types.UUID) error {
+	if b.outbox.markErr != nil {
+		return b.outbox.markErr
+	}
+	b.marks = append(b.marks, func() { b.rows[id].publishedAt = time.Now() })
+	return nil
+}
+
+func (b *memoryBatch) MarkFailed(_ context.Context, id types.UUID, retryAt time.Time, cause error) error {
+	b.marks = append(b.marks, func() {
+		row := b.rows[id]
+		row.attempts++
+		row.nextAttempt, row.lastError = retryAt, cause.Error()
+	})
+	return nil
+}
+
+func (b *memoryBatch) Commit() error {
+	b.outbox.mu.Lock()
+	defer b.outbox.mu.Unlock()
+	for _, mark := range b.marks {
+		mark()
+	}
+	return nil
+}
+
+func (b *memoryBatch) Rollback() error { return nil }
+
+// recordingPublisher records what it publishes and fails the IDs in fail
+// once
+type recordingPublisher struct {
+	mu        sync.Mutex
+	published []types.UUID
+	fail      map[types.UUID]bool
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, event *domain.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.fail[event.ID] {
+		delete(p.fail, event.ID)
+		return errors.New("nats: timeout")
+	}
+	p.published = append(p.published, event.ID)
+	return nil
+}
+
+func (p *recordingPublisher) ids() []types.UUID {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]types.UUID(nil), p.published...)
+}
+
+func eventOf(aggregate types.UUID) *domain.Event {
+	event := newEvent()
+	event.AggregateID = aggregate
+	return event
+}
+
+func TestOutboxRelay_PublishesInOrderPerAggregate(t *testing.T) {
+	first, second := types.New(), types.New()
+	a1, a2, a3, b1 := eventOf(first), eventOf(first), eventOf(first), eventOf(second)
+	outbox := &memoryOutbox{}
+	for _, event := range []*domain.Event{a1, a2, b1, a3} {
+		outbox.add(event)
+	}
+	publisher := &recordingPublisher{fail: map[types.UUID]bool{a1.ID: true}}
+
+	relay := NewOutboxRelay(outbox, publisher, OutboxRelayConfig{
+		Interval: 5 * time.Millisecond,
+		Backoff:  []time.Duration{20 * time.Millisecond},
+	}, zaptest.NewLogger(t))
+	relay.Start(context.Background())
+	defer relay.Stop()
+
+	require.Eventually(t, func() bool { return len(publisher.ids()) == 4 }, 5*time.Second, 5*time.Millisecond)
+	relay.Stop()
+
+	published := publisher.ids()
+	assert.Equal(t, b1.ID, published[0], "a failing aggregate must not hold back the others")
+	assert.Equal(t, []types.UUID{a1.ID, a2.ID, a3.ID}, published[1:], "events of an aggregate must keep their order")
+	assert.Equal(t, 1, outbox.row(a1.ID).attempts)
+	assert.Equal(t, "nats: timeout", outbox.row(a1.ID).lastError)
+}
+
+func TestOutboxRelay_RollsBackWhenMarkingFails(t *testing.T) {
+	outbox := &memoryOutbox{markErr: errors.New("connection reset")}
+	event := newEvent()
+	outbox.add(event)
+	relay := NewOutboxRelay(outbox, &recordingPublisher{}, OutboxRelayConfig{}, zaptest.NewLogger(t))
+
+	_, err := relay.RelayOnce(context.Background())
+	assert.ErrorContains(t, err, "connection reset")
+	assert.True(t, outbox.row(event.ID).publishedAt.IsZero(), "the event must be claimed again")
+}
+
+func TestOutboxRelay_Cleanup(t *testing.T) {
+	outbox := &memoryOutbox{}
+	old, recent, pending := newEvent(), newEvent(), newEvent()
+	for _, event := range []*domain.Event{old, recent, pending} {
+		outbox.add(event)
+		outbox.row(event.ID).attempts = 2
+		outbox.row(event.ID).lastError = "nats: timeout"
+	}
+	outbox.row(old.ID).publishedAt = time.Now().Add(-48 * time.Hour)
+	outbox.row(recent.ID).publishedAt = time.Now()
+	outbox.row(pending.ID).nextAttempt = time.Now().Add(time.Hour)
+
+	disabled := NewOutboxRelay(outbox, &recordingPublisher{}, OutboxRelayConfig{}, zaptest.NewLogger(t))
+	cleared, err := disabled.Cleanup(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, cleared, "cleanup is off without a retention")
+	assert.Equal(t, 2, outbox.row(old.ID).attempts)
+
+	relay := NewOutboxRelay(outbox, &recordingPublisher{}, OutboxRelayConfig{Retention: 24 * time.Hour}, zaptest.NewLogger(t))
+	cleared, err = relay.Cleanup(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), cleared)
+	require.NotNil(t, outbox.row(old.ID), "published events stay in the event store")
+	assert.Zero(t, outbox.row(old.ID).attempts)
+	assert.Empty(t, outbox.row(old.ID).lastError)
+	assert.False(t, outbox.row(old.ID).publishedAt.IsZero(), "cleared events are not published again")
+	assert.Equal(t, 2, outbox.row(recent.ID).attempts)
+	assert.Equal(t, 2, outbox.row(pending.ID).attempts)
+}
+
+func TestJetStreamPublisher(t *testing.T) {
+	conn, err := nats.Connect(runJetStream(t))
+	require.NoError(t, err)
+	t.Cleanup(conn.Close)
+	publisher, err := NewJetStreamPublisher(conn, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	event := newEvent()
+	assert.Error(t, publisher.Publish(ctx, event), "publishing without a stream must fail")
+
+	require.NoError(t, publisher.EnsureStream(ctx, DefaultEventStream))
+	require.NoError(t, publisher.EnsureStream(ctx, DefaultEventStream), "an existing stream is left alone")
+	js, err := jetstream.New(conn)
+	require.NoError(t, err)
+	stream, err := js.Stream(ctx, DefaultEventStream)
+	require.NoError(t, err)
+
+	require.NoError(t, publisher.Publish(ctx, event))
+	require.NoError(t, publisher.Publish(ctx, event))
+	info, err := stream.Info(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), info.State.Msgs, "a republished event must be dropped as a duplicate")
+
+	msg, err := stream.GetMsg(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, event.ID.String(), msg.Header.Get(HeaderEventID))
+}
@@ -0,0 +1,335 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+const (
+	// DefaultTaskFeedCapacity is how many recent events are kept for resume
+	DefaultTaskFeedCapacity = 1024
+
+	// taskSubscriberBuffer bounds how far a subscriber may lag before it is dropped
+	taskSubscriberBuffer = 256
+
+	taskEventPrefix = "task."
+)
+
+var (
+	// ErrVersionExpired means the requested version is no longer buffered;
+	// the client has to reload its state and subscribe from the live edge.
+	ErrVersionExpired = errors.New("task feed: version expired")
+
+	// ErrSlowConsumer means the subscriber fell too far behind and was dropped;
+	// it can resubscribe from the last version it processed.
+	ErrSlowConsumer = errors.New("task feed: subscriber too slow")
+
+	// ErrFeedClosed is returned once the feed has been shut down
+	ErrFeedClosed = errors.New("task feed: closed")
+)
+
+// TaskFeedEvent is a task domain event tagged with its position in the feed
+type TaskFeedEvent struct {
+	Version uint64        `json:"version"`
+	Event   *domain.Event `json:"event"`
+	// Task is the task snapshot carried by the event; nil for deletions
+	Task *domain.Task `json:"task,omitempty"`
+}
+
+// TaskFeedFilter selects the feed events a subscriber receives; empty fields
+// match everything. Field filters apply to the task snapshot. Deletions carry
+// no snapshot and always pass them, so clients can drop tasks they hold.
+type TaskFeedFilter struct {
+	EventTypes  []string
+	Status      []domain.TaskStatus
+	Priority    []domain.Priority
+	AssigneeIDs []types.UUID
+	Tags        []string
+	Query       string
+}
+
+// Matches reports whether the event passes the filter
+func (f TaskFeedFilter) Matches(e TaskFeedEvent) bool {
+	if len(f.EventTypes) > 0 && !containsString(f.EventTypes, e.Event.Type) {
+		return false
+	}
+
+	task := e.Task
+	if task == nil {
+		return true
+	}
+
+	if len(f.Status) > 0 && !containsValue(f.Status, task.Status) {
+		return false
+	}
+	if len(f.Priority) > 0 && !containsValue(f.Priority, task.Priority) {
+		return false
+	}
+	if len(f.AssigneeIDs) > 0 && (task.AssigneeID == nil || !containsValue(f.AssigneeIDs, *task.AssigneeID)) {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !containsString(task.Tags, tag) {
+			return false
+		}
+	}
+	if f.Query != "" {
+		q := strings.ToLower(f.Query)
+		if !strings.Contains(strings.ToLower(task.Title), q) && !strings.Contains(strings.ToLower(task.Description), q) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsString(list []string, v string) bool {
+	return containsValue(list, v)
+}
+
+func containsValue[T comparable](list []T, v T) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+// TaskFeed fans task events out to live subscribers and keeps a bounded
+// history so clients can resume from the last version they saw. Subscribers
+// only receive the events of their tenant.
+//
+// Versions are local to the process. They start from the wall clock in
+// microseconds, so they keep increasing across restarts and a version from a
+// previous run simply reports ErrVersionExpired.
+type TaskFeed struct {
+	mu          sync.Mutex
+	logger      *zap.Logger
+	buffer      []TaskFeedEvent
+	capacity    int
+	head        int // index of the oldest event in buffer
+	size        int
+	version     uint64
+	subscribers map[*TaskSubscription]struct{}
+	closed      bool
+}
+
+// NewTaskFeed creates a feed keeping up to capacity events for resume
+func NewTaskFeed(capacity int, logger *zap.Logger) *TaskFeed {
+	if capacity <= 0 {
+		capacity = DefaultTaskFeedCapacity
+	}
+	return &TaskFeed{
+		logger:      logger,
+		buffer:      make([]TaskFeedEvent, capacity),
+		capacity:    capacity,
+		version:     uint64(time.Now().UnixMicro()),
+		subscribers: make(map[*TaskSubscription]struct{}),
+	}
+}
+
+// Handle implements EventHandler so the feed can subscribe to the event bus.
+// Non-task events are ignored.
+func (f *TaskFeed) Handle(_ context.Context, event *domain.Event) error {
+	if event == nil || !strings.HasPrefix(event.Type, taskEventPrefix) {
+		return nil
+	}
+
+	entry := TaskFeedEvent{Event: event, Task: taskSnapshot(event)}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return ErrFeedClosed
+	}
+
+	f.version++
+	entry.Version = f.version
+	f.append(entry)
+
+	for sub := range f.subscribers {
+		if !sub.allows(entry) {
+			continue
+		}
+		select {
+		case sub.ch <- entry:
+		default:
+			f.logger.Warn("Dropping slow task feed subscriber",
+				zap.Uint64("last_version", sub.lastQueued))
+			f.drop(sub, ErrSlowConsumer)
+			continue
+		}
+		sub.lastQueued = entry.Version
+	}
+
+	return nil
+}
+
+// Subscribe returns a subscription receiving every event after since of the
+// tenant ctx acts for. since == 0 starts at the live edge without replay.
+func (f *TaskFeed) Subscribe(ctx context.Context, since uint64) (*TaskSubscription, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return nil, ErrFeedClosed
+	}
+
+	var backlog []TaskFeedEvent
+	if since != 0 && since < f.version {
+		oldest := f.version - uint64(f.size) + 1
+		if f.size == 0 || since+1 < oldest {
+			return nil, ErrVersionExpired
+		}
+		backlog = f.since(since)
+	}
+
+	sub := &TaskSubscription{
+		feed:       f,
+		ch:         make(chan TaskFeedEvent, len(backlog)+taskSubscriberBuffer),
+		lastQueued: since,
+		tenantID:   tenant.ID(ctx),
+		allTenants: tenant.IsAll(ctx),
+	}
+	for _, e := range backlog {
+		if !sub.allows(e) {
+			continue
+		}
+		sub.ch <- e
+		sub.lastQueued = e.Version
+	}
+	f.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+// Version returns the version of the newest event
+func (f *TaskFeed) Version() uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.version
+}
+
+// Close ends every subscription
+func (f *TaskFeed) Close() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return
+	}
+	f.closed = true
+	for sub := range f.subscribers {
+		f.drop(sub, ErrFeedClosed)
+	}
+}
+
+// append stores an event, overwriting the oldest when full. Caller holds mu.
+func (f *TaskFeed) append(e TaskFeedEvent) {
+	idx := (f.head + f.size) % f.capacity
+	f.buffer[idx] = e
+	if f.size < f.capacity {
+		f.size++
+		return
+	}
+	f.head = (f.head + 1) % f.capacity
+}
+
+// since returns buffered events newer than version, oldest first. Caller holds mu.
+func (f *TaskFeed) since(version uint64) []TaskFeedEvent {
+	out := make([]TaskFeedEvent, 0)
+	for i := 0; i < f.size; i++ {
+		e := f.buffer[(f.head+i)%f.capacity]
+		if e.Version > version {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// drop removes a subscriber and closes its channel. Caller holds mu.
+func (f *TaskFeed) drop(sub *TaskSubscription, err error) {
+	if _, ok := f.subscribers[sub]; !ok {
+		return
+	}
+	delete(f.subscribers, sub)
+	sub.err = err
+	close(sub.ch)
+}
+
+// TaskSubscription is a live view of the task feed
+type TaskSubscription struct {
+	feed       *TaskFeed
+	ch         chan TaskFeedEvent
+	lastQueued uint64
+	err        error
+	tenantID   string
+	allTenants bool
+}
+
+// allows reports whether the event belongs to the subscriber's tenant.
+// Events without a tenant belong to the default one.
+func (s *TaskSubscription) allows(e TaskFeedEvent) bool {
+	if s.allTenants {
+		return true
+	}
+	eventTenant := e.Event.TenantID
+	if eventTenant == "" {
+		eventTenant = tenant.Default
+	}
+	return eventTenant == s.tenantID
+}
+
+// Events delivers feed events in version order. The channel is closed when
+// the subscription ends; Err then reports why.
+func (s *TaskSubscription) Events() <-chan TaskFeedEvent {
+	return s.ch
+}
+
+// Err returns the reason the subscription ended, nil if closed by the caller
+func (s *TaskSubscription) Err() error {
+	s.feed.mu.Lock()
+	defer s.feed.mu.Unlock()
+	return s.err
+}
+
+// Close ends the subscription
+func (s *TaskSubscription) Close() {
+	s.feed.mu.Lock()
+	defer s.feed.mu.Unlock()
+	s.feed.drop(s, nil)
+}
+
+// taskSnapshot extracts the task carried under the "task" key of the event
+// data. Events arriving over NATS hold generic JSON, in-process events hold
+// the domain value, so both are normalised through JSON.
+func taskSnapshot(event *domain.Event) *domain.Task {
+	raw, ok := event.Data["task"]
+	if !ok || raw == nil {
+		return nil
+	}
+	if task, ok := raw.(*domain.Task); ok {
+		c := *task
+		return &c
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil
+	}
+	var task domain.Task
+	if err := json.Unmarshal(b, &task); err != nil {
+		return nil
+	}
+	return &task
+}
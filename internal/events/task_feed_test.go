@@ -0,0 +1,193 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+func taskEvent(eventType string, task *domain.Task) *domain.Event {
+	data := map[string]interface{}{"task_id": task.ID}
+	if eventType != "task.deleted" {
+		data["task"] = task
+	}
+	return &domain.Event{ID: types.New(), Type: eventType, AggregateID: task.ID, Data: data, OccurredAt: time.Now(), Version: 1}
+}
+
+func receive(t *testing.T, sub *TaskSubscription) TaskFeedEvent {
+	t.Helper()
+	select {
+	case e, ok := <-sub.Events():
+		require.True(t, ok, "subscription closed")
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return TaskFeedEvent{}
+	}
+}
+
+func TestTaskFeed_FanOutAndResume(t *testing.T) {
+	ctx := context.Background()
+	feed := NewTaskFeed(8, zaptest.NewLogger(t))
+	defer feed.Close()
+
+	live, err := feed.Subscribe(ctx, 0)
+	require.NoError(t, err)
+	defer live.Close()
+
+	task := domain.NewTask("Write report", "", types.New())
+	require.NoError(t, feed.Handle(ctx, taskEvent("task.created", task)))
+	require.NoError(t, feed.Handle(ctx, &domain.Event{Type: "user.created"}))
+	require.NoError(t, feed.Handle(ctx, taskEvent("task.updated", task)))
+
+	first := receive(t, live)
+	second := receive(t, live)
+	assert.Equal(t, "task.created", first.Event.Type)
+	assert.Equal(t, first.Version+1, second.Version, "non-task events are not versioned")
+	require.NotNil(t, first.Task)
+	assert.Equal(t, "Write report", first.Task.Title)
+
+	resumed, err := feed.Subscribe(ctx, first.Version)
+	require.NoError(t, err)
+	defer resumed.Close()
+	assert.Equal(t, second.Version, receive(t, resumed).Version)
+
+	require.NoError(t, feed.Handle(ctx, taskEvent("task.deleted", task)))
+	assert.Equal(t, feed.Version(), receive(t, resumed).Version)
+}
+
+func TestTaskFeed_ExpiredVersion(t *testing.T) {
+	ctx := context.Background()
+	feed := NewTaskFeed(2, zaptest.NewLogger(t))
+	defer feed.Close()
+
+	task := domain.NewTask("t", "", types.New())
+	require.NoError(t, feed.Handle(ctx, taskEvent("task.created", task)))
+	oldest := feed.Version()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, feed.Handle(ctx, taskEvent("task.updated", task)))
+	}
+
+	_, err := feed.Subscribe(ctx, oldest)
+	assert.ErrorIs(t, err, ErrVersionExpired)
+
+	sub, err := feed.Subscribe(ctx, feed.Version()-1)
+	require.NoError(t, err)
+	defer sub.Close()
+	assert.Equal(t, feed.Version(), receive(t, sub).Version)
+}
+
+func TestTaskFeed_DropsSlowConsumer(t *testing.T) {
+	ctx := context.Background()
+	feed := NewTaskFeed(4, zaptest.NewLogger(t))
+	defer feed.Close()
+
+	sub, err := feed.Subscribe(ctx, 0)
+	require.NoError(t, err)
+
+	task := domain.NewTask("t", "", types.New())
+	for i := 0; i <= taskSubscriberBuffer; i++ {
+		require.NoError(t, feed.Handle(ctx, taskEvent("task.updated", task)))
+	}
+
+	for range sub.Events() {
+	}
+	assert.ErrorIs(t, sub.Err(), ErrSlowConsumer)
+}
+
+func TestTaskFeed_Close(t *testing.T) {
+	feed := NewTaskFeed(4, zaptest.NewLogger(t))
+	sub, err := feed.Subscribe(context.Background(), 0)
+	require.NoError(t, err)
+
+	feed.Close()
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+	assert.ErrorIs(t, sub.Err(), ErrFeedClosed)
+
+	_, err = feed.Subscribe(context.Background(), 0)
+	assert.ErrorIs(t, err, ErrFeedClosed)
+	assert.ErrorIs(t, feed.Handle(context.Background(), taskEvent("task.created", domain.NewTask("t", "", types.New()))), ErrFeedClosed)
+}
+
+func TestTaskFeed_IsolatesTenants(t *testing.T) {
+	ctx := context.Background()
+	feed := NewTaskFeed(8, zaptest.NewLogger(t))
+	defer feed.Close()
+
+	acmeTask, globexTask := domain.NewTask("acme", "", types.New()), domain.NewTask("globex", "", types.New())
+	acmeEvent, globexEvent := taskEvent("task.created", acmeTask), taskEvent("task.created", globexTask)
+	acmeEvent.TenantID, globexEvent.TenantID = "acme", "globex"
+
+	require.NoError(t, feed.Handle(ctx, globexEvent))
+	since := feed.Version() - 1
+	require.NoError(t, feed.Handle(ctx, acmeEvent))
+
+	acme, err := feed.Subscribe(tenant.WithID(ctx, "acme"), since)
+	require.NoError(t, err)
+	defer acme.Close()
+	all, err := feed.Subscribe(tenant.WithAll(ctx), since)
+	require.NoError(t, err)
+	defer all.Close()
+
+	assert.Equal(t, "acme", receive(t, acme).Task.Title, "the replay skips other tenants")
+	assert.Equal(t, "globex", receive(t, all).Task.Title)
+	assert.Equal(t, "acme", receive(t, all).Task.Title)
+
+	require.NoError(t, feed.Handle(ctx, taskEvent("task.updated", globexTask)))
+	assert.Equal(t, "globex", receive(t, all).Task.Title)
+	assert.Empty(t, acme.Events(), "live events of other tenants are not delivered")
+}
+
+func TestTaskFeedFilter_Matches(t *testing.T) {
+	assignee := types.New()
+	task := domain.NewTask("Quarterly Report", "numbers", types.New())
+	task.Priority = domain.PriorityHigh
+	task.AssigneeID = &assignee
+	task.Tags = []string{"finance", "q3"}
+
+	updated := TaskFeedEvent{Event: &domain.Event{Type: "task.updated"}, Task: task}
+	deleted := TaskFeedEvent{Event: &domain.Event{Type: "task.deleted"}}
+
+	tests := []struct {
+		name   string
+		filter TaskFeedFilter
+		event  TaskFeedEvent
+		want   bool
+	}{
+		{"empty filter", TaskFeedFilter{}, updated, true},
+		{"event type", TaskFeedFilter{EventTypes: []string{"task.created"}}, updated, false},
+		{"status", TaskFeedFilter{Status: []domain.TaskStatus{domain.TaskStatusPending}}, updated, true},
+		{"priority", TaskFeedFilter{Priority: []domain.Priority{domain.PriorityLow}}, updated, false},
+		{"assignee", TaskFeedFilter{AssigneeIDs: []types.UUID{assignee}}, updated, true},
+		{"other assignee", TaskFeedFilter{AssigneeIDs: []types.UUID{types.New()}}, updated, false},
+		{"all tags", TaskFeedFilter{Tags: []string{"finance", "q4"}}, updated, false},
+		{"query", TaskFeedFilter{Query: "report"}, updated, true},
+		{"deletion passes field filters", TaskFeedFilter{Priority: []domain.Priority{domain.PriorityLow}}, deleted, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.Matches(tt.event))
+		})
+	}
+}
+
+func TestTaskSnapshot_FromJSONData(t *testing.T) {
+	task := domain.NewTask("From NATS", "", types.New())
+	event := &domain.Event{Type: "task.created", Data: map[string]interface{}{
+		"task": map[string]interface{}{"id": task.ID.String(), "title": task.Title, "status": "pending"},
+	}}
+
+	snapshot := taskSnapshot(event)
+	require.NotNil(t, snapshot)
+	assert.Equal(t, task.ID, snapshot.ID)
+	assert.Equal(t, domain.TaskStatusPending, snapshot.Status)
+}
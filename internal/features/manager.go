@@ -10,11 +10,13 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
 )
 
-// FlagManager manages feature flags with persistence
+// FlagManager manages feature flags with persistence. Flags belong to the
+// tenant of the context they are read or written with.
 type FlagManager struct {
-	flags     map[string]*domain.FeatureFlag
+	flags     map[string]map[string]*domain.FeatureFlag // tenant -> key -> flag
 	mu        sync.RWMutex
 	repo      domain.FeatureFlagRepository
 	cache     domain.CacheRepository
@@ -26,7 +28,7 @@ type FlagManager struct {
 // NewFlagManager creates a new feature flag manager
 func NewFlagManager(repo domain.FeatureFlagRepository, cache domain.CacheRepository, logger *zap.Logger) *FlagManager {
 	manager := &FlagManager{
-		flags:  make(map[string]*domain.FeatureFlag),
+		flags:  make(map[string]map[string]*domain.FeatureFlag),
 		repo:   repo,
 		cache:  cache,
 		logger: logger,
@@ -34,7 +36,8 @@ func NewFlagManager(repo domain.FeatureFlagRepository, cache domain.CacheReposit
 	}
 
 	// Start background refresh
-	go manager.startRefresh(30 * time.Second)
+	manager.refresher = time.NewTicker(30 * time.Second)
+	go manager.startRefresh()
 
 	return manager
 }
@@ -64,7 +67,7 @@ func (m *FlagManager) IsEnabledWithDefault(ctx context.Context, key string, defa
 func (m *FlagManager) GetFlag(ctx context.Context, key string) (*domain.FeatureFlag, error) {
 	// Try memory cache first
 	m.mu.RLock()
-	if flag, exists := m.flags[key]; exists {
+	if flag, exists := m.flags[tenant.ID(ctx)][key]; exists {
 		m.mu.RUnlock()
 		return flag, nil
 	}
@@ -76,9 +79,7 @@ func (m *FlagManager) GetFlag(ctx context.Context, key string) (*domain.FeatureF
 		var flag domain.FeatureFlag
 		if json.Unmarshal([]byte(cachedData), &flag) == nil {
 			// Update memory cache
-			m.mu.Lock()
-			m.flags[key] = &flag
-			m.mu.Unlock()
+			m.remember(ctx, &flag)
 			return &flag, nil
 		}
 	}
@@ -95,9 +96,7 @@ func (m *FlagManager) GetFlag(ctx context.Context, key string) (*domain.FeatureF
 	}
 
 	// Update memory cache
-	m.mu.Lock()
-	m.flags[key] = flag
-	m.mu.Unlock()
+	m.remember(ctx, flag)
 
 	return flag, nil
 }
@@ -127,9 +126,7 @@ func (m *FlagManager) SetFlag(ctx context.Context, flag *domain.FeatureFlag) err
 	}
 
 	// Update caches
-	m.mu.Lock()
-	m.flags[flag.Key] = flag
-	m.mu.Unlock()
+	m.remember(ctx, flag)
 
 	cacheKey := fmt.Sprintf("flag:%s", flag.Key)
 	if err := m.cache.Set(ctx, cacheKey, flag, 300); err != nil {
@@ -156,7 +153,7 @@ func (m *FlagManager) DeleteFlag(ctx context.Context, key string) error {
 
 	// Remove from caches
 	m.mu.Lock()
-	delete(m.flags, key)
+	delete(m.flags[tenant.ID(ctx)], key)
 	m.mu.Unlock()
 
 	cacheKey := fmt.Sprintf("flag:%s", key)
@@ -169,7 +166,8 @@ func (m *FlagManager) DeleteFlag(ctx context.Context, key string) error {
 	return nil
 }
 
-// RefreshFlags reloads all flags from the repository
+// RefreshFlags reloads all flags of the tenant ctx acts for from the
+// repository
 func (m *FlagManager) RefreshFlags(ctx context.Context) error {
 	flags, err := m.repo.List(ctx)
 	if err != nil {
@@ -179,12 +177,12 @@ func (m *FlagManager) RefreshFlags(ctx context.Context) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	// Clear existing flags
-	m.flags = make(map[string]*domain.FeatureFlag)
+	// Replace the tenant's flags
+	loaded := make(map[string]*domain.FeatureFlag, len(flags))
+	m.flags[tenant.ID(ctx)] = loaded
 
-	// Load new flags
 	for _, flag := range flags {
-		m.flags[flag.Key] = flag
+		loaded[flag.Key] = flag
 
 		// Update Redis cache
 		cacheKey := fmt.Sprintf("flag:%s", flag.Key)
@@ -193,22 +191,35 @@ func (m *FlagManager) RefreshFlags(ctx context.Context) error {
 		}
 	}
 
-	m.logger.Info("Feature flags refreshed", zap.Int("count", len(flags)))
+	m.logger.Info("Feature flags refreshed",
+		zap.String("tenant_id", tenant.ID(ctx)),
+		zap.Int("count", len(flags)))
 
 	return nil
 }
 
-// startRefresh starts background refresh of feature flags
-func (m *FlagManager) startRefresh(interval time.Duration) {
-	m.refresher = time.NewTicker(interval)
+// SetRefreshInterval changes how often flags are reloaded from the
+// repository; non-positive intervals are ignored
+func (m *FlagManager) SetRefreshInterval(interval time.Duration) {
+	if interval > 0 {
+		m.refresher.Reset(interval)
+	}
+}
+
+// startRefresh refreshes feature flags on every tick of the refresher
+func (m *FlagManager) startRefresh() {
 	defer m.refresher.Stop()
 
 	for {
 		select {
 		case <-m.refresher.C:
-			ctx := context.Background()
-			if err := m.RefreshFlags(ctx); err != nil {
-				m.logger.Error("Failed to refresh feature flags", zap.Error(err))
+			for _, tenantID := range m.tenants() {
+				ctx := tenant.WithID(context.Background(), tenantID)
+				if err := m.RefreshFlags(ctx); err != nil {
+					m.logger.Error("Failed to refresh feature flags",
+						zap.String("tenant_id", tenantID),
+						zap.Error(err))
+				}
 			}
 		case <-m.stopCh:
 			return
@@ -216,6 +227,33 @@ func (m *FlagManager) startRefresh(interval time.Duration) {
 	}
 }
 
+// tenants returns the tenants whose flags are held in memory, always
+// including the default one
+func (m *FlagManager) tenants() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ids := []string{tenant.Default}
+	for id := range m.flags {
+		if id != tenant.Default {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// remember keeps flag in memory for the tenant ctx acts for
+func (m *FlagManager) remember(ctx context.Context, flag *domain.FeatureFlag) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := tenant.ID(ctx)
+	if m.flags[id] == nil {
+		m.flags[id] = make(map[string]*domain.FeatureFlag)
+	}
+	m.flags[id][flag.Key] = flag
+}
+
 // Stop stops the background refresh
 func (m *FlagManager) Stop() {
 	if m.refresher != nil {
@@ -6,9 +6,12 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/repository/memory"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
 )
 
 // Mock repositories
@@ -81,7 +84,7 @@ func TestFlagManager_IsEnabled(t *testing.T) {
 	logger := zap.NewNop()
 
 	manager := &FlagManager{
-		flags:  make(map[string]*domain.FeatureFlag),
+		flags:  make(map[string]map[string]*domain.FeatureFlag),
 		repo:   flagRepo,
 		cache:  cacheRepo,
 		logger: logger,
@@ -126,7 +129,7 @@ func TestFlagManager_IsEnabledWithDefault(t *testing.T) {
 	logger := zap.NewNop()
 
 	manager := &FlagManager{
-		flags:  make(map[string]*domain.FeatureFlag),
+		flags:  make(map[string]map[string]*domain.FeatureFlag),
 		repo:   flagRepo,
 		cache:  cacheRepo,
 		logger: logger,
@@ -156,7 +159,7 @@ func TestFlagManager_EvaluatePercentage(t *testing.T) {
 	logger := zap.NewNop()
 
 	manager := &FlagManager{
-		flags:  make(map[string]*domain.FeatureFlag),
+		flags:  make(map[string]map[string]*domain.FeatureFlag),
 		repo:   flagRepo,
 		cache:  cacheRepo,
 		logger: logger,
@@ -188,7 +191,7 @@ func TestFlagManager_EvaluateUserList(t *testing.T) {
 	logger := zap.NewNop()
 
 	manager := &FlagManager{
-		flags:  make(map[string]*domain.FeatureFlag),
+		flags:  make(map[string]map[string]*domain.FeatureFlag),
 		repo:   flagRepo,
 		cache:  cacheRepo,
 		logger: logger,
@@ -219,7 +222,7 @@ func TestFlagManager_EvaluateAttribute(t *testing.T) {
 	logger := zap.NewNop()
 
 	manager := &FlagManager{
-		flags:  make(map[string]*domain.FeatureFlag),
+		flags:  make(map[string]map[string]*domain.FeatureFlag),
 		repo:   flagRepo,
 		cache:  cacheRepo,
 		logger: logger,
@@ -262,3 +265,25 @@ func TestFlagManager_EvaluateAttribute(t *testing.T) {
 	result = manager.evaluateAttribute(flag, attributes)
 	assert.False(t, result)
 }
+
+func TestFlagManager_IsolatesTenants(t *testing.T) {
+	manager := NewFlagManager(memory.NewFeatureFlagRepository(), memory.NewCacheRepository(), zap.NewNop())
+	defer manager.Stop()
+
+	acme := tenant.WithID(context.Background(), "acme")
+	globex := tenant.WithID(context.Background(), "globex")
+
+	require.NoError(t, manager.SetFlag(acme, &domain.FeatureFlag{Key: "beta", Enabled: true, Strategy: "simple"}))
+	require.NoError(t, manager.SetFlag(globex, &domain.FeatureFlag{Key: "beta", Enabled: false, Strategy: "simple"}))
+
+	assert.True(t, manager.IsEnabled(acme, "beta"))
+	assert.False(t, manager.IsEnabled(globex, "beta"), "a tenant's flag does not leak into another tenant")
+	assert.True(t, manager.IsEnabledWithDefault(context.Background(), "beta", true), "the default tenant has no such flag")
+
+	require.NoError(t, manager.RefreshFlags(globex))
+	assert.True(t, manager.IsEnabled(acme, "beta"), "refreshing one tenant keeps the others")
+
+	require.NoError(t, manager.DeleteFlag(acme, "beta"))
+	assert.False(t, manager.IsEnabledWithDefault(globex, "beta", true))
+	assert.True(t, manager.IsEnabledWithDefault(acme, "beta", true))
+}
@@ -0,0 +1,81 @@
+// Package client provides typed gRPC clients for the service API.
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+
+	taskv1 "github.com/vertikon/mcp-ultra/api/grpc/gen/task/v1"
+)
+
+// Config holds client connection settings
+type Config struct {
+	Target             string
+	KeepaliveTime      time.Duration
+	KeepaliveTimeout   time.Duration
+	MaxRecvMessageSize int
+	MaxSendMessageSize int
+}
+
+// Clients bundles the typed service clients sharing one connection
+type Clients struct {
+	conn  *grpc.ClientConn
+	Tasks taskv1.TaskServiceClient
+}
+
+// New opens a connection to target. Without extra dial options the
+// connection is plaintext, which suits in-cluster traffic behind a mesh.
+func New(cfg Config, opts ...grpc.DialOption) (*Clients, error) {
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+	if cfg.KeepaliveTime > 0 {
+		dialOpts = append(dialOpts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    cfg.KeepaliveTime,
+			Timeout: cfg.KeepaliveTimeout,
+		}))
+	}
+	var callOpts []grpc.CallOption
+	if cfg.MaxRecvMessageSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(cfg.MaxRecvMessageSize))
+	}
+	if cfg.MaxSendMessageSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallSendMsgSize(cfg.MaxSendMessageSize))
+	}
+	if len(callOpts) > 0 {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(callOpts...))
+	}
+	dialOpts = append(dialOpts, opts...)
+
+	conn, err := grpc.NewClient(cfg.Target, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("creating gRPC client for %s: %w", cfg.Target, err)
+	}
+
+	return &Clients{
+		conn:  conn,
+		Tasks: taskv1.NewTaskServiceClient(conn),
+	}, nil
+}
+
+// Conn exposes the underlying connection
+func (c *Clients) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
+// Close closes the underlying connection
+func (c *Clients) Close() error {
+	return c.conn.Close()
+}
+
+// WithToken attaches the caller's bearer token to outgoing requests; the
+// server takes the calling user and tenant from it
+func WithToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
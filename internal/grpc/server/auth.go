@@ -0,0 +1,171 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	systemv1 "github.com/vertikon/mcp-ultra/api/grpc/gen/system/v1"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/security"
+)
+
+// Authenticator verifies the bearer token of a call and returns its context
+// carrying the caller's claims and tenant; security.AuthService implements
+// it
+type Authenticator interface {
+	Authenticate(ctx context.Context, token, method, path string) (context.Context, error)
+}
+
+// publicMethods are served without a token: health checks for probes and
+// load balancers, and reflection when the server registers it
+var publicMethods = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.",
+}
+
+// adminServices make up the admin plane, open only to callers with the
+// admin role
+var adminServices = []string{
+	"/" + systemv1.SystemService_ServiceDesc.ServiceName + "/",
+}
+
+// AuthInterceptors returns the server options authenticating every call
+// but health checks and reflection. Handlers see the caller's claims via
+// security.GetUserFromContext and its tenant via tenant.ID.
+func AuthInterceptors(auth Authenticator) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			ctx, err := authenticate(ctx, auth, info.FullMethod)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			ctx, err := authenticate(ss.Context(), auth, info.FullMethod)
+			if err != nil {
+				return err
+			}
+			return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+		}),
+	}
+}
+
+// AdminInterceptors returns the server options refusing calls to the admin
+// plane unless the caller has the admin role. They must follow
+// AuthInterceptors; without them no call carries claims and the admin plane
+// is closed.
+func AdminInterceptors() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if err := authorizeAdmin(ctx, info.FullMethod); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := authorizeAdmin(ss.Context(), info.FullMethod); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	}
+}
+
+// authorizeAdmin refuses a call to an admin method unless the caller is an
+// admin
+func authorizeAdmin(ctx context.Context, method string) error {
+	for _, prefix := range adminServices {
+		if strings.HasPrefix(method, prefix) && !isAdmin(ctx) {
+			return status.Error(codes.PermissionDenied, "admin role required")
+		}
+	}
+	return nil
+}
+
+// isAdmin reports whether the authenticated caller has the admin role
+func isAdmin(ctx context.Context) bool {
+	claims, err := security.GetUserFromContext(ctx)
+	return err == nil && claims.Role == string(domain.RoleAdmin)
+}
+
+// callerID returns the user ID of the authenticated caller
+func callerID(ctx context.Context) (string, error) {
+	claims, err := security.GetUserFromContext(ctx)
+	if err != nil || claims.UserID == "" {
+		return "", status.Error(codes.Unauthenticated, "authentication required")
+	}
+	return claims.UserID, nil
+}
+
+// requireAdmin refuses the call unless the caller is an admin
+func requireAdmin(ctx context.Context) error {
+	if _, err := callerID(ctx); err != nil {
+		return err
+	}
+	if !isAdmin(ctx) {
+		return status.Error(codes.PermissionDenied, "admin role required")
+	}
+	return nil
+}
+
+// authorizeSubject refuses access to a data subject's records unless the
+// caller is that subject or an admin
+func authorizeSubject(ctx context.Context, subjectID string) error {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+	if subjectID != caller && !isAdmin(ctx) {
+		return status.Error(codes.PermissionDenied, "callers may only act on their own data")
+	}
+	return nil
+}
+
+// authenticate verifies the "authorization: Bearer <token>" metadata of a
+// call to method
+func authenticate(ctx context.Context, auth Authenticator, method string) (context.Context, error) {
+	for _, prefix := range publicMethods {
+		if strings.HasPrefix(method, prefix) {
+			return ctx, nil
+		}
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization metadata")
+	}
+	token := strings.TrimPrefix(values[0], "Bearer ")
+	if token == values[0] {
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization metadata format")
+	}
+
+	// gRPC calls are authorized like POST requests to their method path
+	ctx, err := auth.Authenticate(ctx, token, "POST", method)
+	switch {
+	case errors.Is(err, security.ErrForbidden):
+		return nil, status.Error(codes.PermissionDenied, security.ErrForbidden.Error())
+	case errors.Is(err, security.ErrInvalidTenant):
+		return nil, status.Error(codes.Unauthenticated, security.ErrInvalidTenant.Error())
+	case err != nil:
+		return nil, status.Error(codes.Unauthenticated, security.ErrInvalidToken.Error())
+	}
+	return ctx, nil
+}
+
+// contextStream is a server stream with a replaced context
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	taskv1 "github.com/vertikon/mcp-ultra/api/grpc/gen/task/v1"
+	"github.com/vertikon/mcp-ultra/internal/config"
+	"github.com/vertikon/mcp-ultra/internal/security"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+)
+
+// asCaller authenticates every call as claims, standing in for
+// AuthInterceptors
+func asCaller(claims *security.Claims) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			return handler(security.WithClaims(ctx, claims), req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, &contextStream{ServerStream: ss, ctx: security.WithClaims(ss.Context(), claims)})
+		}),
+	}
+}
+
+// tokenAuthenticator accepts the tokens it maps to claims
+type tokenAuthenticator map[string]*security.Claims
+
+func (a tokenAuthenticator) Authenticate(ctx context.Context, token, _, _ string) (context.Context, error) {
+	claims, ok := a[token]
+	switch {
+	case !ok:
+		return nil, security.ErrInvalidToken
+	case claims == nil:
+		return nil, security.ErrForbidden
+	}
+	return security.WithClaims(ctx, claims), nil
+}
+
+// tenantTaskServer answers GetTask with the tenant of the call
+type tenantTaskServer struct {
+	taskv1.UnimplementedTaskServiceServer
+}
+
+func (tenantTaskServer) GetTask(ctx context.Context, _ *taskv1.GetTaskRequest) (*taskv1.GetTaskResponse, error) {
+	return &taskv1.GetTaskResponse{Task: &taskv1.Task{Title: tenant.ID(ctx)}}, nil
+}
+
+func TestAuthInterceptors(t *testing.T) {
+	auth := tokenAuthenticator{
+		"acme":   {UserID: "u1", TenantID: "acme"},
+		"banned": nil,
+	}
+	srv := NewServer(config.GRPCConfig{ShutdownTimeout: time.Second}, zaptest.NewLogger(t), AuthInterceptors(auth)...)
+	taskv1.RegisterTaskServiceServer(srv, tenantTaskServer{})
+	conn := serveBufconn(t, srv)
+	client := taskv1.NewTaskServiceClient(conn)
+
+	call := func(authorization string) (*taskv1.GetTaskResponse, error) {
+		ctx := context.Background()
+		if authorization != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+		}
+		return client.GetTask(ctx, &taskv1.GetTaskRequest{Id: "1"})
+	}
+
+	resp, err := call("Bearer acme")
+	require.NoError(t, err)
+	assert.Equal(t, "acme", resp.Task.Title, "the token's tenant is in the context")
+
+	_, err = call("")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = call("acme")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = call("Bearer forged")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = call("Bearer banned")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Health checks stay open for probes
+	_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,423 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	compliancev1 "github.com/vertikon/mcp-ultra/api/grpc/gen/compliance/v1"
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+)
+
+var consentSourceToProto = map[compliance.ConsentSource]compliancev1.ConsentSource{
+	compliance.ConsentSourceWeb:    compliancev1.ConsentSource_CONSENT_SOURCE_WEB,
+	compliance.ConsentSourceMobile: compliancev1.ConsentSource_CONSENT_SOURCE_MOBILE,
+	compliance.ConsentSourceAPI:    compliancev1.ConsentSource_CONSENT_SOURCE_API,
+	compliance.ConsentSourcePhone:  compliancev1.ConsentSource_CONSENT_SOURCE_PHONE,
+	compliance.ConsentSourceEmail:  compliancev1.ConsentSource_CONSENT_SOURCE_EMAIL,
+	compliance.ConsentSourcePaper:  compliancev1.ConsentSource_CONSENT_SOURCE_PAPER,
+	compliance.ConsentSourceImport: compliancev1.ConsentSource_CONSENT_SOURCE_IMPORT,
+}
+
+var dataRightTypeToProto = map[compliance.DataRightType]compliancev1.DataRightType{
+	compliance.DataRightAccess:          compliancev1.DataRightType_DATA_RIGHT_TYPE_ACCESS,
+	compliance.DataRightRectification:   compliancev1.DataRightType_DATA_RIGHT_TYPE_RECTIFICATION,
+	compliance.DataRightErasure:         compliancev1.DataRightType_DATA_RIGHT_TYPE_ERASURE,
+	compliance.DataRightPortability:     compliancev1.DataRightType_DATA_RIGHT_TYPE_PORTABILITY,
+	compliance.DataRightRestriction:     compliancev1.DataRightType_DATA_RIGHT_TYPE_RESTRICTION,
+	compliance.DataRightObjection:       compliancev1.DataRightType_DATA_RIGHT_TYPE_OBJECTION,
+	compliance.DataRightWithdrawConsent: compliancev1.DataRightType_DATA_RIGHT_TYPE_WITHDRAW_CONSENT,
+}
+
+var dataRightStatusToProto = map[compliance.DataRightStatus]compliancev1.DataRightStatus{
+	compliance.DataRightStatusPending:    compliancev1.DataRightStatus_DATA_RIGHT_STATUS_PENDING,
+	compliance.DataRightStatusInProgress: compliancev1.DataRightStatus_DATA_RIGHT_STATUS_IN_PROGRESS,
+	compliance.DataRightStatusCompleted:  compliancev1.DataRightStatus_DATA_RIGHT_STATUS_COMPLETED,
+	compliance.DataRightStatusRejected:   compliancev1.DataRightStatus_DATA_RIGHT_STATUS_REJECTED,
+	compliance.DataRightStatusPartial:    compliancev1.DataRightStatus_DATA_RIGHT_STATUS_PARTIAL,
+}
+
+var dataSourceStatusToProto = map[compliance.DataSourceStatus]compliancev1.DataSourceStatus{
+	compliance.DataSourceStatusCompleted: compliancev1.DataSourceStatus_DATA_SOURCE_STATUS_COMPLETED,
+	compliance.DataSourceStatusFailed:    compliancev1.DataSourceStatus_DATA_SOURCE_STATUS_FAILED,
+}
+
+var exportFormatToProto = map[compliance.ExportFormat]compliancev1.ExportFormat{
+	compliance.ExportFormatJSON: compliancev1.ExportFormat_EXPORT_FORMAT_JSON,
+	compliance.ExportFormatCSV:  compliancev1.ExportFormat_EXPORT_FORMAT_CSV,
+}
+
+var piiTypeToProto = map[compliance.PIIType]compliancev1.PIIType{
+	compliance.PIITypeEmail:       compliancev1.PIIType_PII_TYPE_EMAIL,
+	compliance.PIITypeCPF:         compliancev1.PIIType_PII_TYPE_CPF,
+	compliance.PIITypeCNPJ:        compliancev1.PIIType_PII_TYPE_CNPJ,
+	compliance.PIITypePhone:       compliancev1.PIIType_PII_TYPE_PHONE,
+	compliance.PIITypeCreditCard:  compliancev1.PIIType_PII_TYPE_CREDIT_CARD,
+	compliance.PIITypeIPAddress:   compliancev1.PIIType_PII_TYPE_IP_ADDRESS,
+	compliance.PIITypeSSN:         compliancev1.PIIType_PII_TYPE_SSN,
+	compliance.PIITypePassport:    compliancev1.PIIType_PII_TYPE_PASSPORT,
+	compliance.PIITypeDateOfBirth: compliancev1.PIIType_PII_TYPE_DATE_OF_BIRTH,
+	compliance.PIITypeAddress:     compliancev1.PIIType_PII_TYPE_ADDRESS,
+	compliance.PIITypeName:        compliancev1.PIIType_PII_TYPE_NAME,
+	compliance.PIITypeUsername:    compliancev1.PIIType_PII_TYPE_USERNAME,
+	compliance.PIITypeCustom:      compliancev1.PIIType_PII_TYPE_CUSTOM,
+}
+
+var piiSensitivityToProto = map[compliance.PIISensitivity]compliancev1.PIISensitivity{
+	compliance.PIISensitivityPublic:       compliancev1.PIISensitivity_PII_SENSITIVITY_PUBLIC,
+	compliance.PIISensitivityInternal:     compliancev1.PIISensitivity_PII_SENSITIVITY_INTERNAL,
+	compliance.PIISensitivityConfidential: compliancev1.PIISensitivity_PII_SENSITIVITY_CONFIDENTIAL,
+	compliance.PIISensitivityRestricted:   compliancev1.PIISensitivity_PII_SENSITIVITY_RESTRICTED,
+}
+
+var anonymizationToProto = map[compliance.AnonymizationMethod]compliancev1.AnonymizationMethod{
+	compliance.AnonymizationHash:       compliancev1.AnonymizationMethod_ANONYMIZATION_METHOD_HASH,
+	compliance.AnonymizationEncrypt:    compliancev1.AnonymizationMethod_ANONYMIZATION_METHOD_ENCRYPT,
+	compliance.AnonymizationTokenize:   compliancev1.AnonymizationMethod_ANONYMIZATION_METHOD_TOKENIZE,
+	compliance.AnonymizationRedact:     compliancev1.AnonymizationMethod_ANONYMIZATION_METHOD_REDACT,
+	compliance.AnonymizationGeneralize: compliancev1.AnonymizationMethod_ANONYMIZATION_METHOD_GENERALIZE,
+	compliance.AnonymizationShuffle:    compliancev1.AnonymizationMethod_ANONYMIZATION_METHOD_SHUFFLE,
+	compliance.AnonymizationNoise:      compliancev1.AnonymizationMethod_ANONYMIZATION_METHOD_NOISE,
+}
+
+var auditEventTypeToProto = map[compliance.AuditEventType]compliancev1.AuditEventType{
+	compliance.AuditEventDataProcessing:   compliancev1.AuditEventType_AUDIT_EVENT_TYPE_DATA_PROCESSING,
+	compliance.AuditEventConsentGrant:     compliancev1.AuditEventType_AUDIT_EVENT_TYPE_CONSENT_GRANT,
+	compliance.AuditEventConsentWithdraw:  compliancev1.AuditEventType_AUDIT_EVENT_TYPE_CONSENT_WITHDRAW,
+	compliance.AuditEventDataAccess:       compliancev1.AuditEventType_AUDIT_EVENT_TYPE_DATA_ACCESS,
+	compliance.AuditEventDataExport:       compliancev1.AuditEventType_AUDIT_EVENT_TYPE_DATA_EXPORT,
+	compliance.AuditEventDataDelete:       compliancev1.AuditEventType_AUDIT_EVENT_TYPE_DATA_DELETE,
+	compliance.AuditEventDataRectify:      compliancev1.AuditEventType_AUDIT_EVENT_TYPE_DATA_RECTIFY,
+	compliance.AuditEventRightsRequest:    compliancev1.AuditEventType_AUDIT_EVENT_TYPE_RIGHTS_REQUEST,
+	compliance.AuditEventPIIDetection:     compliancev1.AuditEventType_AUDIT_EVENT_TYPE_PII_DETECTION,
+	compliance.AuditEventAnonymization:    compliancev1.AuditEventType_AUDIT_EVENT_TYPE_ANONYMIZATION,
+	compliance.AuditEventRetentionPolicy:  compliancev1.AuditEventType_AUDIT_EVENT_TYPE_RETENTION_POLICY,
+	compliance.AuditEventSecurityIncident: compliancev1.AuditEventType_AUDIT_EVENT_TYPE_SECURITY_INCIDENT,
+	compliance.AuditEventComplianceCheck:  compliancev1.AuditEventType_AUDIT_EVENT_TYPE_COMPLIANCE_CHECK,
+}
+
+var auditResultToProto = map[compliance.AuditResult]compliancev1.AuditResult{
+	compliance.AuditResultSuccess:        compliancev1.AuditResult_AUDIT_RESULT_SUCCESS,
+	compliance.AuditResultFailure:        compliancev1.AuditResult_AUDIT_RESULT_FAILURE,
+	compliance.AuditResultPartialSuccess: compliancev1.AuditResult_AUDIT_RESULT_PARTIAL_SUCCESS,
+	compliance.AuditResultBlocked:        compliancev1.AuditResult_AUDIT_RESULT_BLOCKED,
+	compliance.AuditResultSkipped:        compliancev1.AuditResult_AUDIT_RESULT_SKIPPED,
+}
+
+var retentionStatusToProto = map[compliance.RetentionStatus]compliancev1.RetentionStatus{
+	compliance.RetentionStatusActive:     compliancev1.RetentionStatus_RETENTION_STATUS_ACTIVE,
+	compliance.RetentionStatusExpired:    compliancev1.RetentionStatus_RETENTION_STATUS_EXPIRED,
+	compliance.RetentionStatusProcessing: compliancev1.RetentionStatus_RETENTION_STATUS_PROCESSING,
+	compliance.RetentionStatusCompleted:  compliancev1.RetentionStatus_RETENTION_STATUS_COMPLETED,
+	compliance.RetentionStatusOnHold:     compliancev1.RetentionStatus_RETENTION_STATUS_ON_HOLD,
+	compliance.RetentionStatusExtended:   compliancev1.RetentionStatus_RETENTION_STATUS_EXTENDED,
+}
+
+var retentionActionToProto = map[compliance.RetentionAction]compliancev1.RetentionAction{
+	compliance.RetentionActionDelete:    compliancev1.RetentionAction_RETENTION_ACTION_DELETE,
+	compliance.RetentionActionArchive:   compliancev1.RetentionAction_RETENTION_ACTION_ARCHIVE,
+	compliance.RetentionActionAnonymize: compliancev1.RetentionAction_RETENTION_ACTION_ANONYMIZE,
+	compliance.RetentionActionNotify:    compliancev1.RetentionAction_RETENTION_ACTION_NOTIFY,
+	compliance.RetentionActionReview:    compliancev1.RetentionAction_RETENTION_ACTION_REVIEW,
+	compliance.RetentionActionPurge:     compliancev1.RetentionAction_RETENTION_ACTION_PURGE,
+}
+
+// enumFromProto reverses one of the *ToProto tables
+func enumFromProto[D comparable, P comparable](table map[D]P, value P) (D, bool) {
+	for d, p := range table {
+		if p == value {
+			return d, true
+		}
+	}
+	var zero D
+	return zero, false
+}
+
+// The checks below mirror the (validate.rules) annotations of
+// compliance.proto so the contract holds without generated validators.
+
+func requireString(field, value string) error {
+	if value == "" {
+		return status.Errorf(codes.InvalidArgument, "%s is required", field)
+	}
+	return nil
+}
+
+func requireMessage(field string, present bool) error {
+	if !present {
+		return status.Errorf(codes.InvalidArgument, "%s is required", field)
+	}
+	return nil
+}
+
+func requireRange(field string, value, min, max int32) error {
+	if value < min || value > max {
+		return status.Errorf(codes.InvalidArgument, "%s must be between %d and %d, got %d", field, min, max, value)
+	}
+	return nil
+}
+
+// structToMap converts a wire struct into plain Go values; nil stays nil
+func structToMap(s *structpb.Struct) map[string]interface{} {
+	if s == nil {
+		return nil
+	}
+	return s.AsMap()
+}
+
+// mapToStruct converts arbitrary JSON-encodable values into a wire struct.
+// Values are passed through encoding/json first because structpb only
+// accepts a few concrete Go types.
+func mapToStruct(m map[string]interface{}) (*structpb.Struct, error) {
+	if m == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("encoding struct: %w", err)
+	}
+	var plain map[string]interface{}
+	if err := json.Unmarshal(raw, &plain); err != nil {
+		return nil, fmt.Errorf("decoding struct: %w", err)
+	}
+	return structpb.NewStruct(plain)
+}
+
+func stringMapToInterface(m map[string]string) map[string]interface{} {
+	if len(m) == 0 {
+		return nil
+	}
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
+func interfaceMapToString(m map[string]interface{}) map[string]string {
+	if len(m) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		out[k] = metadataValue(v)
+	}
+	return out
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func consentToProto(c *compliance.ConsentRecord) *compliancev1.ConsentRecord {
+	if c == nil {
+		return nil
+	}
+	return &compliancev1.ConsentRecord{
+		Id:            c.ID,
+		SubjectId:     c.SubjectID,
+		Purpose:       c.Purpose,
+		Granted:       c.Granted,
+		LegalBasis:    c.LegalBasis,
+		ConsentSource: consentSourceToProto[c.ConsentSource],
+		Timestamp:     timestampOrNil(c.Timestamp),
+		ExpiresAt:     optionalTimestamp(c.ExpiresAt),
+		WithdrawnAt:   optionalTimestamp(c.WithdrawnAt),
+		IpAddress:     c.IPAddress,
+		UserAgent:     c.UserAgent,
+		ConsentString: c.ConsentString,
+		Metadata:      interfaceMapToString(c.Metadata),
+		Version:       int32(c.Version),
+		CreatedAt:     timestampOrNil(c.CreatedAt),
+		UpdatedAt:     timestampOrNil(c.UpdatedAt),
+	}
+}
+
+// consentRequestFromProto builds a consent grant; an unspecified source is
+// recorded as api since the grant arrived over the API
+func consentRequestFromProto(req *compliancev1.GrantConsentRequest) (compliance.ConsentRequest, error) {
+	source := compliance.ConsentSourceAPI
+	if req.GetConsentSource() != compliancev1.ConsentSource_CONSENT_SOURCE_UNSPECIFIED {
+		var ok bool
+		source, ok = enumFromProto(consentSourceToProto, req.GetConsentSource())
+		if !ok {
+			return compliance.ConsentRequest{}, status.Errorf(codes.InvalidArgument, "unsupported consent_source %s", req.GetConsentSource())
+		}
+	}
+	if req.GetExpirationDays() < 0 {
+		return compliance.ConsentRequest{}, status.Error(codes.InvalidArgument, "expiration_days must not be negative")
+	}
+
+	consent := compliance.ConsentRequest{
+		SubjectID:     req.GetSubjectId(),
+		Purpose:       req.GetPurpose(),
+		Granted:       req.GetGranted(),
+		LegalBasis:    req.GetLegalBasis(),
+		ConsentSource: source,
+		IPAddress:     req.GetIpAddress(),
+		UserAgent:     req.GetUserAgent(),
+		ConsentString: req.GetConsentString(),
+		Metadata:      stringMapToInterface(req.GetMetadata()),
+	}
+	if days := int(req.GetExpirationDays()); days > 0 {
+		consent.ExpirationDays = &days
+	}
+	return consent, nil
+}
+
+func dataRightRequestToProto(r *compliance.DataRightRequest) (*compliancev1.DataRightRequest, error) {
+	if r == nil {
+		return nil, nil
+	}
+	data, err := mapToStruct(r.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	pb := &compliancev1.DataRightRequest{
+		Id:          r.ID,
+		Type:        dataRightTypeToProto[r.Type],
+		Status:      dataRightStatusToProto[r.Status],
+		SubjectId:   r.SubjectID,
+		RequestedAt: timestampOrNil(r.RequestedAt),
+		CompletedAt: optionalTimestamp(r.CompletedAt),
+		Data:        data,
+		Reason:      r.Reason,
+		Updates:     make([]*compliancev1.DataRightRequestUpdate, 0, len(r.Updates)),
+		DueAt:       timestampOrNil(r.DueAt),
+		Sources:     make([]*compliancev1.DataSourceResult, 0, len(r.Sources)),
+	}
+	for _, u := range r.Updates {
+		pb.Updates = append(pb.Updates, &compliancev1.DataRightRequestUpdate{
+			Timestamp: timestampOrNil(u.Timestamp),
+			Status:    dataRightStatusToProto[u.Status],
+			Message:   u.Message,
+			UpdatedBy: u.UpdatedBy,
+		})
+	}
+	for _, source := range r.Sources {
+		pb.Sources = append(pb.Sources, &compliancev1.DataSourceResult{
+			Source:      source.Source,
+			Status:      dataSourceStatusToProto[source.Status],
+			Records:     int32(source.Records),
+			Error:       source.Error,
+			CompletedAt: timestampOrNil(source.CompletedAt),
+		})
+	}
+	return pb, nil
+}
+
+func classificationToProto(c compliance.PIIClassification, includeContext bool) (*compliancev1.PIIClassification, error) {
+	pb := &compliancev1.PIIClassification{
+		FieldName:   c.FieldName,
+		PiiType:     piiTypeToProto[c.PIIType],
+		Sensitivity: piiSensitivityToProto[c.Sensitivity],
+		Confidence:  c.Confidence,
+		Method:      anonymizationToProto[c.Method],
+		Timestamp:   timestampOrNil(c.Timestamp),
+	}
+	if includeContext {
+		pb.Context = c.Context
+	}
+	// Only the processed value leaves the service; OriginalValue never does
+	if c.ProcessedValue != nil {
+		value, err := mapToStruct(map[string]interface{}{"value": c.ProcessedValue})
+		if err != nil {
+			return nil, err
+		}
+		pb.ProcessedValue = value
+	}
+	return pb, nil
+}
+
+// classificationsToProto converts scan results ordered by field name
+func classificationsToProto(classifications map[string]compliance.PIIClassification, includeContext bool) ([]*compliancev1.PIIClassification, error) {
+	fields := make([]string, 0, len(classifications))
+	for field := range classifications {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	out := make([]*compliancev1.PIIClassification, 0, len(fields))
+	for _, field := range fields {
+		pb, err := classificationToProto(classifications[field], includeContext)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, pb)
+	}
+	return out, nil
+}
+
+func auditEventToProto(e compliance.AuditEvent) *compliancev1.AuditEvent {
+	return &compliancev1.AuditEvent{
+		Id:              e.ID,
+		Timestamp:       timestampOrNil(e.Timestamp),
+		EventType:       auditEventTypeToProto[e.EventType],
+		SubjectId:       e.SubjectID,
+		UserId:          e.UserID,
+		SessionId:       e.SessionID,
+		IpAddress:       e.IPAddress,
+		UserAgent:       e.UserAgent,
+		Purpose:         e.Purpose,
+		LegalBasis:      e.LegalBasis,
+		DataCategories:  e.DataCategories,
+		ProcessingType:  e.ProcessingType,
+		Result:          auditResultToProto[e.Result],
+		Details:         interfaceMapToString(e.Details),
+		DataHash:        e.DataHash,
+		ComplianceFlags: e.ComplianceFlags,
+		Encrypted:       e.Encrypted,
+		Version:         e.Version,
+		Service:         e.Service,
+	}
+}
+
+func retentionRecordToProto(r compliance.RetentionRecord) *compliancev1.RetentionRecord {
+	pb := &compliancev1.RetentionRecord{
+		Id:              r.ID,
+		SubjectId:       r.SubjectID,
+		DataType:        r.DataType,
+		PolicyId:        r.PolicyID,
+		CreatedAt:       timestampOrNil(r.CreatedAt),
+		RetentionStart:  timestampOrNil(r.RetentionStart),
+		RetentionEnd:    timestampOrNil(r.RetentionEnd),
+		GraceEnd:        optionalTimestamp(r.GraceEnd),
+		Status:          retentionStatusToProto[r.Status],
+		Action:          retentionActionToProto[r.Action],
+		ActionTaken:     r.ActionTaken,
+		ActionTakenAt:   optionalTimestamp(r.ActionTakenAt),
+		LegalHold:       r.LegalHold,
+		LegalHoldReason: r.LegalHoldReason,
+		Extensions:      make([]*compliancev1.RetentionExtension, 0, len(r.Extensions)),
+		Metadata:        interfaceMapToString(r.Metadata),
+		UpdatedAt:       timestampOrNil(r.UpdatedAt),
+	}
+	for _, ext := range r.Extensions {
+		pb.Extensions = append(pb.Extensions, &compliancev1.RetentionExtension{
+			Reason:          ext.Reason,
+			ExtendBySeconds: int64(ext.ExtendBy / time.Second),
+			ExtendedBy:      ext.ExtendedBy,
+			ExtendedAt:      timestampOrNil(ext.ExtendedAt),
+			ExpiresAt:       timestampOrNil(ext.ExpiresAt),
+			Approved:        ext.Approved,
+		})
+	}
+	return pb
+}
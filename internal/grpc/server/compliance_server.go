@@ -1,7 +1,849 @@
-//go:build ultra_advanced
-// +build ultra_advanced
-
 package server
 
-// This file is disabled by default. To enable advanced features, build with:
-// go build -tags ultra_advanced ./...
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	compliancev1 "github.com/vertikon/mcp-ultra/api/grpc/gen/compliance/v1"
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+)
+
+const (
+	maxDataRightPageSize = 100
+	maxAuditLogPageSize  = 1000
+)
+
+// sensitivityRank orders PII sensitivities for data classification
+var sensitivityRank = map[compliance.PIISensitivity]int{
+	compliance.PIISensitivityPublic:       0,
+	compliance.PIISensitivityInternal:     1,
+	compliance.PIISensitivityConfidential: 2,
+	compliance.PIISensitivityRestricted:   3,
+}
+
+// ComplianceServer implements compliancev1.ComplianceServiceServer on top of
+// the compliance framework. Subjects act on their own data, taken from the
+// authenticated caller; admins may act on any subject and alone update or
+// process data rights requests and change retention. GenerateComplianceReport
+// is not served and answers Unimplemented.
+type ComplianceServer struct {
+	compliancev1.UnimplementedComplianceServiceServer
+
+	framework *compliance.Framework
+	logger    *zap.Logger
+}
+
+// NewComplianceServer creates a new gRPC compliance server. With a nil
+// framework every call fails with Unavailable.
+func NewComplianceServer(framework *compliance.Framework, logger *zap.Logger) *ComplianceServer {
+	return &ComplianceServer{
+		framework: framework,
+		logger:    logger,
+	}
+}
+
+// GrantConsent records or updates a subject's consent for a purpose
+func (s *ComplianceServer) GrantConsent(ctx context.Context, req *compliancev1.GrantConsentRequest) (*compliancev1.GrantConsentResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := firstError(
+		requireString("subject_id", req.GetSubjectId()),
+		requireString("purpose", req.GetPurpose()),
+		requireString("legal_basis", req.GetLegalBasis()),
+	); err != nil {
+		return nil, err
+	}
+	if err := authorizeSubject(ctx, req.GetSubjectId()); err != nil {
+		return nil, err
+	}
+	request, err := consentRequestFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	consent, err := s.framework.GetConsentManager().GrantConsent(ctx, request)
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+	if err := s.framework.GetAuditLogger().LogConsentAction(ctx, consent.SubjectID, *consent, "grant"); err != nil {
+		s.logger.Warn("Failed to audit consent grant", zap.Error(err))
+	}
+	return &compliancev1.GrantConsentResponse{Consent: consentToProto(consent)}, nil
+}
+
+// GetConsent returns the current consent record for a subject and purpose
+func (s *ComplianceServer) GetConsent(ctx context.Context, req *compliancev1.GetConsentRequest) (*compliancev1.GetConsentResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := firstError(
+		requireString("subject_id", req.GetSubjectId()),
+		requireString("purpose", req.GetPurpose()),
+	); err != nil {
+		return nil, err
+	}
+	if err := authorizeSubject(ctx, req.GetSubjectId()); err != nil {
+		return nil, err
+	}
+
+	history, err := s.framework.GetConsentManager().GetConsentHistory(ctx, req.GetSubjectId(), req.GetPurpose())
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+	if len(history) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no consent for purpose %q", req.GetPurpose())
+	}
+	return &compliancev1.GetConsentResponse{Consent: consentToProto(&history[len(history)-1])}, nil
+}
+
+// ListConsents returns the current consent for every purpose of a subject
+func (s *ComplianceServer) ListConsents(ctx context.Context, req *compliancev1.ListConsentsRequest) (*compliancev1.ListConsentsResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := requireString("subject_id", req.GetSubjectId()); err != nil {
+		return nil, err
+	}
+	if err := authorizeSubject(ctx, req.GetSubjectId()); err != nil {
+		return nil, err
+	}
+
+	consents, err := s.framework.GetConsentManager().GetAllConsents(ctx, req.GetSubjectId())
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+	sort.Slice(consents, func(i, j int) bool { return consents[i].Purpose < consents[j].Purpose })
+
+	resp := &compliancev1.ListConsentsResponse{Consents: make([]*compliancev1.ConsentRecord, 0, len(consents))}
+	for i := range consents {
+		resp.Consents = append(resp.Consents, consentToProto(&consents[i]))
+	}
+	return resp, nil
+}
+
+// WithdrawConsent withdraws a subject's consent for a purpose
+func (s *ComplianceServer) WithdrawConsent(ctx context.Context, req *compliancev1.WithdrawConsentRequest) (*emptypb.Empty, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := firstError(
+		requireString("subject_id", req.GetSubjectId()),
+		requireString("purpose", req.GetPurpose()),
+	); err != nil {
+		return nil, err
+	}
+	if err := authorizeSubject(ctx, req.GetSubjectId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.framework.GetConsentManager().WithdrawConsent(ctx, req.GetSubjectId(), req.GetPurpose()); err != nil {
+		return nil, complianceStatus(err)
+	}
+	withdrawn := compliance.ConsentRecord{SubjectID: req.GetSubjectId(), Purpose: req.GetPurpose()}
+	if err := s.framework.GetAuditLogger().LogConsentAction(ctx, req.GetSubjectId(), withdrawn, "withdraw"); err != nil {
+		s.logger.Warn("Failed to audit consent withdrawal", zap.Error(err))
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CreateDataRightRequest opens a data subject rights request
+func (s *ComplianceServer) CreateDataRightRequest(ctx context.Context, req *compliancev1.CreateDataRightRequestRequest) (*compliancev1.CreateDataRightRequestResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := requireString("subject_id", req.GetSubjectId()); err != nil {
+		return nil, err
+	}
+	if err := authorizeSubject(ctx, req.GetSubjectId()); err != nil {
+		return nil, err
+	}
+	rightType, ok := enumFromProto(dataRightTypeToProto, req.GetType())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported type %s", req.GetType())
+	}
+
+	created, err := s.framework.SubmitDataRightRequest(ctx, compliance.DataRightRequest{
+		SubjectID:        req.GetSubjectId(),
+		Type:             rightType,
+		Reason:           req.GetReason(),
+		Data:             structToMap(req.GetData()),
+		VerificationCode: req.GetVerificationCode(),
+	})
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+
+	pb, err := dataRightRequestToProto(created)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &compliancev1.CreateDataRightRequestResponse{Request: pb}, nil
+}
+
+// GetDataRightRequest returns a data subject rights request by ID
+func (s *ComplianceServer) GetDataRightRequest(ctx context.Context, req *compliancev1.GetDataRightRequestRequest) (*compliancev1.GetDataRightRequestResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := requireString("request_id", req.GetRequestId()); err != nil {
+		return nil, err
+	}
+
+	request, err := s.dataRightRequest(ctx, req.GetRequestId())
+	if err != nil {
+		return nil, err
+	}
+	pb, err := dataRightRequestToProto(request)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &compliancev1.GetDataRightRequestResponse{Request: pb}, nil
+}
+
+// ListDataRightRequests lists data subject rights requests, newest first
+func (s *ComplianceServer) ListDataRightRequests(ctx context.Context, req *compliancev1.ListDataRightRequestsRequest) (*compliancev1.ListDataRightRequestsResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := requireRange("page_size", req.GetPageSize(), 1, maxDataRightPageSize); err != nil {
+		return nil, err
+	}
+	offset, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	subjectID, err := listedSubject(ctx, req.GetSubjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := compliance.DataRightFilter{
+		SubjectID: subjectID,
+		Limit:     int(req.GetPageSize()),
+		Offset:    offset,
+	}
+	if req.GetType() != compliancev1.DataRightType_DATA_RIGHT_TYPE_UNSPECIFIED {
+		t, ok := enumFromProto(dataRightTypeToProto, req.GetType())
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported type %s", req.GetType())
+		}
+		filter.Type = t
+	}
+	if req.GetStatus() != compliancev1.DataRightStatus_DATA_RIGHT_STATUS_UNSPECIFIED {
+		st, ok := enumFromProto(dataRightStatusToProto, req.GetStatus())
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported status %s", req.GetStatus())
+		}
+		filter.Status = st
+	}
+
+	requests, total, err := s.framework.ListDataRightRequests(ctx, filter)
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+
+	resp := &compliancev1.ListDataRightRequestsResponse{Requests: make([]*compliancev1.DataRightRequest, 0, len(requests))}
+	for i := range requests {
+		pb, err := dataRightRequestToProto(&requests[i])
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Requests = append(resp.Requests, pb)
+	}
+	if next := offset + len(requests); len(requests) > 0 && next < total {
+		resp.NextPageToken = encodePageToken(next)
+	}
+	return resp, nil
+}
+
+// UpdateDataRightRequest moves a request to a new status. Only admins may
+// do so; the message is taken from the last entry of request.updates and
+// the caller is recorded as its author.
+func (s *ComplianceServer) UpdateDataRightRequest(ctx context.Context, req *compliancev1.UpdateDataRightRequestRequest) (*compliancev1.UpdateDataRightRequestResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	update := req.GetRequest()
+	if err := requireMessage("request", update != nil); err != nil {
+		return nil, err
+	}
+	if err := firstError(
+		requireString("request.id", update.GetId()),
+		requireString("request.subject_id", update.GetSubjectId()),
+	); err != nil {
+		return nil, err
+	}
+	newStatus, ok := enumFromProto(dataRightStatusToProto, update.GetStatus())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported request.status %s", update.GetStatus())
+	}
+
+	existing, err := s.framework.GetDataRightRequest(ctx, update.GetId())
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+	if existing.SubjectID != update.GetSubjectId() {
+		return nil, status.Error(codes.InvalidArgument, "request.subject_id does not match the stored request")
+	}
+
+	updatedBy, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var message string
+	if updates := update.GetUpdates(); len(updates) > 0 {
+		message = updates[len(updates)-1].GetMessage()
+	}
+
+	updated, err := s.framework.UpdateDataRightRequest(ctx, update.GetId(), newStatus, message, updatedBy)
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+	pb, err := dataRightRequestToProto(updated)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &compliancev1.UpdateDataRightRequestResponse{Request: pb}, nil
+}
+
+// ProcessDataRightRequest fulfils a request across the registered data
+// sources and returns it with the per-source results. Only admins may
+// process requests, and the caller is recorded as the processor.
+func (s *ComplianceServer) ProcessDataRightRequest(ctx context.Context, req *compliancev1.ProcessDataRightRequestRequest) (*compliancev1.ProcessDataRightRequestResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := requireString("request_id", req.GetRequestId()); err != nil {
+		return nil, err
+	}
+
+	processedBy, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	processed, err := s.framework.ProcessDataRightRequest(ctx, req.GetRequestId(), processedBy)
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+	pb, err := dataRightRequestToProto(processed)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &compliancev1.ProcessDataRightRequestResponse{Request: pb}, nil
+}
+
+// ExportDataRightRequest returns the export bundle of a processed access or
+// portability request
+func (s *ComplianceServer) ExportDataRightRequest(ctx context.Context, req *compliancev1.ExportDataRightRequestRequest) (*compliancev1.ExportDataRightRequestResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := requireString("request_id", req.GetRequestId()); err != nil {
+		return nil, err
+	}
+
+	format := compliance.ExportFormatJSON
+	if req.GetFormat() != compliancev1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
+		var ok bool
+		if format, ok = enumFromProto(exportFormatToProto, req.GetFormat()); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported format %s", req.GetFormat())
+		}
+	}
+
+	if _, err := s.dataRightRequest(ctx, req.GetRequestId()); err != nil {
+		return nil, err
+	}
+	content, err := s.framework.GetDataRightExport(ctx, req.GetRequestId(), format)
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+	return &compliancev1.ExportDataRightRequestResponse{
+		ContentType: format.ContentType(),
+		Filename:    fmt.Sprintf("data-right-%s.%s", req.GetRequestId(), format),
+		Content:     content,
+	}, nil
+}
+
+// ProcessData runs data through the compliance pipeline. Consent checks,
+// auditing and masking follow the server's compliance configuration; the
+// options only select what is reported back.
+func (s *ComplianceServer) ProcessData(ctx context.Context, req *compliancev1.ProcessDataRequest) (*compliancev1.ProcessDataResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := firstError(
+		requireString("subject_id", req.GetSubjectId()),
+		requireMessage("data", req.GetData() != nil),
+		requireString("purpose", req.GetPurpose()),
+	); err != nil {
+		return nil, err
+	}
+	if err := authorizeSubject(ctx, req.GetSubjectId()); err != nil {
+		return nil, err
+	}
+
+	data := structToMap(req.GetData())
+	processed, err := s.framework.ProcessData(ctx, req.GetSubjectId(), data, req.GetPurpose())
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+	processedData, err := mapToStruct(processed)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &compliancev1.ProcessDataResponse{
+		ProcessedData: processedData,
+		Result:        &compliancev1.ProcessingResult{Success: true, Message: "processed"},
+	}
+	if req.GetOptions().GetDetectPii() {
+		scan, err := s.framework.ScanForPII(ctx, data)
+		if err != nil {
+			return nil, complianceStatus(err)
+		}
+		for field, c := range scan.Classifications {
+			c.ProcessedValue = processed[field]
+			scan.Classifications[field] = c
+		}
+		if resp.PiiClassifications, err = classificationsToProto(scan.Classifications, true); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Result.Metadata = map[string]string{"pii_fields": strconv.Itoa(scan.PIIFields)}
+	}
+	return resp, nil
+}
+
+// ValidateProcessing reports whether a subject's data may be processed for a purpose
+func (s *ComplianceServer) ValidateProcessing(ctx context.Context, req *compliancev1.ValidateProcessingRequest) (*compliancev1.ValidateProcessingResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := firstError(
+		requireString("subject_id", req.GetSubjectId()),
+		requireString("purpose", req.GetPurpose()),
+	); err != nil {
+		return nil, err
+	}
+	if err := authorizeSubject(ctx, req.GetSubjectId()); err != nil {
+		return nil, err
+	}
+
+	result := s.framework.GetConsentManager().ValidateConsent(ctx, req.GetSubjectId(), req.GetPurpose())
+	consentResult := &compliancev1.ConsentValidationResult{
+		Valid:           result.Valid,
+		Consent:         consentToProto(result.Consent),
+		Reason:          result.Reason,
+		RequiredActions: result.RequiredActions,
+	}
+	if result.ExpiresIn != nil {
+		consentResult.ExpiresInSeconds = int64(*result.ExpiresIn / time.Second)
+	}
+	return &compliancev1.ValidateProcessingResponse{
+		Valid:           result.Valid,
+		Reason:          result.Reason,
+		RequiredActions: result.RequiredActions,
+		ConsentResult:   consentResult,
+	}, nil
+}
+
+// DetectPII scans the top-level fields of data for PII
+func (s *ComplianceServer) DetectPII(ctx context.Context, req *compliancev1.DetectPIIRequest) (*compliancev1.DetectPIIResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := requireMessage("data", req.GetData() != nil); err != nil {
+		return nil, err
+	}
+	opts := req.GetOptions()
+
+	data := structToMap(req.GetData())
+	if fields := opts.GetScanFields(); len(fields) > 0 {
+		selected := make(map[string]interface{}, len(fields))
+		for _, f := range fields {
+			if v, ok := data[f]; ok {
+				selected[f] = v
+			}
+		}
+		data = selected
+	}
+
+	scan, err := s.framework.ScanForPII(ctx, data)
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+	for field, c := range scan.Classifications {
+		if c.Confidence < opts.GetConfidenceThreshold() {
+			delete(scan.Classifications, field)
+		}
+	}
+
+	classifications, err := classificationsToProto(scan.Classifications, opts.GetIncludeContext())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &compliancev1.DetectPIIResponse{Classifications: classifications}, nil
+}
+
+// ClassifyData derives a classification level, the PII categories present
+// and the regulations that apply to data
+func (s *ComplianceServer) ClassifyData(ctx context.Context, req *compliancev1.ClassifyDataRequest) (*compliancev1.ClassifyDataResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := requireMessage("data", req.GetData() != nil); err != nil {
+		return nil, err
+	}
+	opts := req.GetOptions()
+
+	scan, err := s.framework.ScanForPII(ctx, structToMap(req.GetData()))
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+
+	level := compliance.PIISensitivityPublic
+	categories := make(map[string]interface{})
+	for _, c := range scan.Classifications {
+		if sensitivityRank[c.Sensitivity] > sensitivityRank[level] {
+			level = c.Sensitivity
+		}
+		categories[string(c.PIIType)] = true
+	}
+
+	classification := &compliancev1.DataClassification{
+		ClassificationLevel: string(level),
+		DataCategories:      sortedKeys(categories),
+		Metadata:            map[string]string{"pii_fields": strconv.Itoa(scan.PIIFields)},
+	}
+	if opts.GetApplyRegulations() && scan.PIIFields > 0 {
+		regulations, err := s.applicableRegulations(ctx, opts.GetTargetRegulations())
+		if err != nil {
+			return nil, err
+		}
+		classification.ApplicableRegulations = regulations
+	}
+
+	resp := &compliancev1.ClassifyDataResponse{Classification: classification}
+	if opts.GetDetectPii() {
+		if resp.PiiClassifications, err = classificationsToProto(scan.Classifications, false); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return resp, nil
+}
+
+// GetAuditLog returns compliance audit events page by page
+func (s *ComplianceServer) GetAuditLog(ctx context.Context, req *compliancev1.GetAuditLogRequest) (*compliancev1.GetAuditLogResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := requireRange("page_size", req.GetPageSize(), 1, maxAuditLogPageSize); err != nil {
+		return nil, err
+	}
+	offset, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	subjectID, err := listedSubject(ctx, req.GetSubjectId())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := compliance.AuditFilter{
+		SubjectID: subjectID,
+		Limit:     int(req.GetPageSize()),
+		Offset:    offset,
+	}
+	if req.GetEventType() != compliancev1.AuditEventType_AUDIT_EVENT_TYPE_UNSPECIFIED {
+		eventType, ok := enumFromProto(auditEventTypeToProto, req.GetEventType())
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported event_type %s", req.GetEventType())
+		}
+		filter.EventType = string(eventType)
+	}
+	if r := req.GetTimeRange(); r != nil {
+		if r.GetStart() != nil {
+			filter.StartTime = r.GetStart().AsTime()
+		}
+		if r.GetEnd() != nil {
+			filter.EndTime = r.GetEnd().AsTime()
+		}
+		if !filter.StartTime.IsZero() && !filter.EndTime.IsZero() && filter.EndTime.Before(filter.StartTime) {
+			return nil, status.Error(codes.InvalidArgument, "time_range.end must not be before time_range.start")
+		}
+	}
+
+	events, err := s.framework.GetAuditLogs(ctx, filter)
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+
+	resp := &compliancev1.GetAuditLogResponse{Events: make([]*compliancev1.AuditEvent, 0, len(events))}
+	for _, e := range events {
+		resp.Events = append(resp.Events, auditEventToProto(e))
+	}
+	if len(events) == filter.Limit {
+		resp.NextPageToken = encodePageToken(offset + len(events))
+	}
+	return resp, nil
+}
+
+// GetComplianceStatus reports which compliance components are enabled and
+// their health. It also answers while the framework is disabled.
+func (s *ComplianceServer) GetComplianceStatus(ctx context.Context, _ *compliancev1.GetComplianceStatusRequest) (*compliancev1.GetComplianceStatusResponse, error) {
+	if s.framework == nil {
+		return nil, status.Error(codes.Unavailable, "compliance framework is not configured")
+	}
+
+	state, err := s.framework.GetComplianceStatus(ctx)
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+
+	resp := &compliancev1.GetComplianceStatusResponse{
+		Enabled:      state["enabled"] == true,
+		LgpdEnabled:  state["lgpd_enabled"] == true,
+		GdprEnabled:  state["gdpr_enabled"] == true,
+		HealthStatus: make(map[string]*structpb.Struct),
+	}
+	resp.DefaultRegion, _ = state["default_region"].(string)
+	if components, ok := state["components"].(map[string]interface{}); ok {
+		resp.Components = &compliancev1.ComplianceComponents{
+			PiiDetection:  components["pii_detection"] == true,
+			ConsentMgmt:   components["consent_mgmt"] == true,
+			AuditLogging:  components["audit_logging"] == true,
+			DataRetention: components["data_retention"] == true,
+			Anonymization: components["anonymization"] == true,
+		}
+	}
+	for _, component := range []string{"pii_manager", "consent_manager"} {
+		health, ok := state[component].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		pb, err := mapToStruct(health)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.HealthStatus[component] = pb
+	}
+	return resp, nil
+}
+
+// GetRetentionStatus returns the retention records of a subject
+func (s *ComplianceServer) GetRetentionStatus(ctx context.Context, req *compliancev1.GetRetentionStatusRequest) (*compliancev1.GetRetentionStatusResponse, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := requireString("subject_id", req.GetSubjectId()); err != nil {
+		return nil, err
+	}
+	if err := authorizeSubject(ctx, req.GetSubjectId()); err != nil {
+		return nil, err
+	}
+
+	records, err := s.framework.GetRetentionManager().GetRetentionStatus(ctx, req.GetSubjectId())
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+	resp := &compliancev1.GetRetentionStatusResponse{Records: make([]*compliancev1.RetentionRecord, 0, len(records))}
+	for _, r := range records {
+		resp.Records = append(resp.Records, retentionRecordToProto(r))
+	}
+	return resp, nil
+}
+
+// ExtendRetention pushes back the retention end of a subject's active
+// records. Only admins may do so, and the caller is recorded as approver.
+func (s *ComplianceServer) ExtendRetention(ctx context.Context, req *compliancev1.ExtendRetentionRequest) (*emptypb.Empty, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := firstError(
+		requireString("subject_id", req.GetSubjectId()),
+		requireString("reason", req.GetReason()),
+	); err != nil {
+		return nil, err
+	}
+	approvedBy, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetExtendBySeconds() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "extend_by_seconds must be greater than 0, got %d", req.GetExtendBySeconds())
+	}
+	if err := s.requireRetentionRecords(ctx, req.GetSubjectId()); err != nil {
+		return nil, err
+	}
+
+	extendBy := time.Duration(req.GetExtendBySeconds()) * time.Second
+	if err := s.framework.GetRetentionManager().ExtendRetention(ctx, req.GetSubjectId(), req.GetReason(), extendBy, approvedBy); err != nil {
+		return nil, complianceStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// PlaceLegalHold stops retention actions on all of a subject's records.
+// Only admins may place holds.
+func (s *ComplianceServer) PlaceLegalHold(ctx context.Context, req *compliancev1.PlaceLegalHoldRequest) (*emptypb.Empty, error) {
+	if err := s.checkEnabled(); err != nil {
+		return nil, err
+	}
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := firstError(
+		requireString("subject_id", req.GetSubjectId()),
+		requireString("reason", req.GetReason()),
+	); err != nil {
+		return nil, err
+	}
+	if err := s.requireRetentionRecords(ctx, req.GetSubjectId()); err != nil {
+		return nil, err
+	}
+
+	if err := s.framework.GetRetentionManager().PlaceLegalHold(ctx, req.GetSubjectId(), req.GetReason()); err != nil {
+		return nil, complianceStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// dataRightRequest loads a data rights request the caller may see: its
+// own, or any for admins
+func (s *ComplianceServer) dataRightRequest(ctx context.Context, id string) (*compliance.DataRightRequest, error) {
+	request, err := s.framework.GetDataRightRequest(ctx, id)
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+	if err := authorizeSubject(ctx, request.SubjectID); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// listedSubject returns the subject a listing is restricted to. Admins may
+// list any subject, or all with an empty one; others only their own.
+func listedSubject(ctx context.Context, requested string) (string, error) {
+	caller, err := callerID(ctx)
+	if err != nil {
+		return "", err
+	}
+	if isAdmin(ctx) {
+		return requested, nil
+	}
+	if requested != "" && requested != caller {
+		return "", status.Error(codes.PermissionDenied, "callers may only act on their own data")
+	}
+	return caller, nil
+}
+
+func (s *ComplianceServer) checkEnabled() error {
+	if s.framework == nil {
+		return status.Error(codes.Unavailable, "compliance framework is not configured")
+	}
+	if !s.framework.IsEnabled() {
+		return status.Error(codes.FailedPrecondition, "compliance framework is disabled")
+	}
+	return nil
+}
+
+// requireRetentionRecords fails with NotFound when nothing is retained for
+// the subject, since retention changes would otherwise silently do nothing
+func (s *ComplianceServer) requireRetentionRecords(ctx context.Context, subjectID string) error {
+	records, err := s.framework.GetRetentionManager().GetRetentionStatus(ctx, subjectID)
+	if err != nil {
+		return complianceStatus(err)
+	}
+	if len(records) == 0 {
+		return status.Errorf(codes.NotFound, "no retention records for subject %q", subjectID)
+	}
+	return nil
+}
+
+// applicableRegulations returns the enabled regulations among targets; no
+// targets means every enabled regulation
+func (s *ComplianceServer) applicableRegulations(ctx context.Context, targets []string) ([]string, error) {
+	state, err := s.framework.GetComplianceStatus(ctx)
+	if err != nil {
+		return nil, complianceStatus(err)
+	}
+	enabled := map[string]bool{
+		"lgpd": state["lgpd_enabled"] == true,
+		"gdpr": state["gdpr_enabled"] == true,
+	}
+	if len(targets) == 0 {
+		targets = []string{"gdpr", "lgpd"}
+	}
+
+	var regulations []string
+	for _, t := range targets {
+		t = strings.ToLower(t)
+		if enabled[t] {
+			regulations = append(regulations, t)
+		}
+	}
+	return regulations, nil
+}
+
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// complianceStatus maps compliance errors onto gRPC codes. Like the task
+// service, most compliance errors are plain strings, so the mapping falls
+// back to their wording.
+func complianceStatus(err error) error {
+	switch {
+	case errors.Is(err, compliance.ErrDataRightRequestNotFound),
+		errors.Is(err, compliance.ErrConsentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, compliance.ErrConsentVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, compliance.ErrDataRightRequestClosed),
+		errors.Is(err, compliance.ErrNoExport):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, compliance.ErrVerificationRequired):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, compliance.ErrInvalidVerificationCode):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if err != nil {
+		msg := err.Error()
+		switch {
+		case strings.HasPrefix(msg, "invalid"):
+			return status.Error(codes.InvalidArgument, msg)
+		case strings.Contains(msg, "disabled"), strings.HasPrefix(msg, "no valid consent"):
+			return status.Error(codes.FailedPrecondition, msg)
+		}
+	}
+	return toStatus(err)
+}
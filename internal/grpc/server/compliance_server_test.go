@@ -0,0 +1,423 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	compliancev1 "github.com/vertikon/mcp-ultra/api/grpc/gen/compliance/v1"
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+	"github.com/vertikon/mcp-ultra/internal/config"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/security"
+)
+
+func newComplianceFramework(t *testing.T, enabled bool) *compliance.Framework {
+	t.Helper()
+
+	framework, err := compliance.NewFramework(compliance.Config{
+		Enabled:       enabled,
+		DefaultRegion: "BR",
+		PIIDetection:  compliance.PIIDetectionConfig{Enabled: true, Confidence: 0.8, AutoMask: true},
+		Consent:       compliance.ConsentConfig{Enabled: true, TTL: time.Hour},
+		DataRetention: compliance.DataRetentionConfig{Enabled: true, DefaultPeriod: 24 * time.Hour},
+		AuditLogging:  compliance.AuditLoggingConfig{Enabled: true, DetailLevel: "minimal"},
+		LGPD:          compliance.LGPDConfig{Enabled: true},
+		DataRights:    compliance.DataRightsConfig{Enabled: true, VerificationRequired: true},
+	}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	return framework
+}
+
+// newComplianceClient calls the compliance API as the admin "dpo"
+func newComplianceClient(t *testing.T, framework *compliance.Framework) compliancev1.ComplianceServiceClient {
+	t.Helper()
+	return newComplianceClientAs(t, framework, &security.Claims{UserID: "dpo", Role: string(domain.RoleAdmin)})
+}
+
+// newComplianceClientAs calls the compliance API as claims; nil calls it
+// unauthenticated
+func newComplianceClientAs(t *testing.T, framework *compliance.Framework, claims *security.Claims) compliancev1.ComplianceServiceClient {
+	t.Helper()
+
+	log := zaptest.NewLogger(t)
+	var opts []grpc.ServerOption
+	if claims != nil {
+		opts = asCaller(claims)
+	}
+	srv := NewServer(config.GRPCConfig{ShutdownTimeout: time.Second}, log, opts...)
+	compliancev1.RegisterComplianceServiceServer(srv, NewComplianceServer(framework, log))
+	return compliancev1.NewComplianceServiceClient(serveBufconn(t, srv))
+}
+
+func TestComplianceServer_Consent(t *testing.T) {
+	client := newComplianceClient(t, newComplianceFramework(t, true))
+	ctx := context.Background()
+
+	granted, err := client.GrantConsent(ctx, &compliancev1.GrantConsentRequest{
+		SubjectId:  "subject-1",
+		Purpose:    "marketing",
+		Granted:    true,
+		LegalBasis: "consent",
+		Metadata:   map[string]string{"campaign": "spring"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, compliancev1.ConsentSource_CONSENT_SOURCE_API, granted.Consent.ConsentSource)
+	assert.Equal(t, "spring", granted.Consent.Metadata["campaign"])
+	assert.NotNil(t, granted.Consent.ExpiresAt)
+
+	valid, err := client.ValidateProcessing(ctx, &compliancev1.ValidateProcessingRequest{SubjectId: "subject-1", Purpose: "marketing"})
+	require.NoError(t, err)
+	assert.True(t, valid.Valid)
+	assert.Positive(t, valid.ConsentResult.ExpiresInSeconds)
+
+	_, err = client.WithdrawConsent(ctx, &compliancev1.WithdrawConsentRequest{SubjectId: "subject-1", Purpose: "marketing"})
+	require.NoError(t, err)
+
+	got, err := client.GetConsent(ctx, &compliancev1.GetConsentRequest{SubjectId: "subject-1", Purpose: "marketing"})
+	require.NoError(t, err)
+	assert.NotNil(t, got.Consent.WithdrawnAt)
+
+	list, err := client.ListConsents(ctx, &compliancev1.ListConsentsRequest{SubjectId: "subject-1"})
+	require.NoError(t, err)
+	assert.Len(t, list.Consents, 1)
+
+	_, err = client.GetConsent(ctx, &compliancev1.GetConsentRequest{SubjectId: "subject-1", Purpose: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.WithdrawConsent(ctx, &compliancev1.WithdrawConsentRequest{SubjectId: "subject-2", Purpose: "marketing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// issueVerificationCode issues the code a subject would receive out of band
+func issueVerificationCode(t *testing.T, framework *compliance.Framework, subjectID string) string {
+	t.Helper()
+	code, err := framework.IssueVerificationCode(context.Background(), subjectID)
+	require.NoError(t, err)
+	return code
+}
+
+func TestComplianceServer_EnforcesContract(t *testing.T) {
+	client := newComplianceClient(t, newComplianceFramework(t, true))
+	ctx := context.Background()
+
+	calls := map[string]func() error{
+		"grant without legal_basis": func() error {
+			_, err := client.GrantConsent(ctx, &compliancev1.GrantConsentRequest{SubjectId: "s", Purpose: "p"})
+			return err
+		},
+		"data right with unspecified type": func() error {
+			_, err := client.CreateDataRightRequest(ctx, &compliancev1.CreateDataRightRequestRequest{SubjectId: "s", VerificationCode: "1234"})
+			return err
+		},
+		"list with page_size 0": func() error {
+			_, err := client.ListDataRightRequests(ctx, &compliancev1.ListDataRightRequestsRequest{})
+			return err
+		},
+		"audit log with page_size over 1000": func() error {
+			_, err := client.GetAuditLog(ctx, &compliancev1.GetAuditLogRequest{PageSize: 1001})
+			return err
+		},
+		"update without request": func() error {
+			_, err := client.UpdateDataRightRequest(ctx, &compliancev1.UpdateDataRightRequestRequest{})
+			return err
+		},
+		"detect without data": func() error {
+			_, err := client.DetectPII(ctx, &compliancev1.DetectPIIRequest{})
+			return err
+		},
+		"extend by zero": func() error {
+			_, err := client.ExtendRetention(ctx, &compliancev1.ExtendRetentionRequest{SubjectId: "s", Reason: "audit", ApprovedBy: "dpo"})
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, codes.InvalidArgument, status.Code(call()))
+		})
+	}
+}
+
+func TestComplianceServer_DataRightRequests(t *testing.T) {
+	framework := newComplianceFramework(t, true)
+	client := newComplianceClient(t, framework)
+	ctx := context.Background()
+
+	_, err := client.CreateDataRightRequest(ctx, &compliancev1.CreateDataRightRequestRequest{
+		Type:      compliancev1.DataRightType_DATA_RIGHT_TYPE_ACCESS,
+		SubjectId: "subject-1",
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "verification code is required")
+	_, err = client.CreateDataRightRequest(ctx, &compliancev1.CreateDataRightRequestRequest{
+		Type:             compliancev1.DataRightType_DATA_RIGHT_TYPE_ACCESS,
+		SubjectId:        "subject-1",
+		VerificationCode: "1234",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "codes must have been issued")
+
+	var ids []string
+	for _, rightType := range []compliancev1.DataRightType{
+		compliancev1.DataRightType_DATA_RIGHT_TYPE_ACCESS,
+		compliancev1.DataRightType_DATA_RIGHT_TYPE_ERASURE,
+	} {
+		created, err := client.CreateDataRightRequest(ctx, &compliancev1.CreateDataRightRequestRequest{
+			Type:             rightType,
+			SubjectId:        "subject-1",
+			Reason:           "titular request",
+			VerificationCode: issueVerificationCode(t, framework, "subject-1"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, compliancev1.DataRightStatus_DATA_RIGHT_STATUS_PENDING, created.Request.Status)
+		assert.Empty(t, created.Request.VerificationCode, "codes are never returned")
+		ids = append(ids, created.Request.Id)
+	}
+
+	page, err := client.ListDataRightRequests(ctx, &compliancev1.ListDataRightRequestsRequest{SubjectId: "subject-1", PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, page.Requests, 1)
+	require.NotEmpty(t, page.NextPageToken)
+	page, err = client.ListDataRightRequests(ctx, &compliancev1.ListDataRightRequestsRequest{SubjectId: "subject-1", PageSize: 1, PageToken: page.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, page.Requests, 1)
+	assert.Empty(t, page.NextPageToken)
+
+	erasures, err := client.ListDataRightRequests(ctx, &compliancev1.ListDataRightRequestsRequest{
+		Type:     compliancev1.DataRightType_DATA_RIGHT_TYPE_ERASURE,
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, erasures.Requests, 1)
+	assert.Equal(t, ids[1], erasures.Requests[0].Id)
+
+	updated, err := client.UpdateDataRightRequest(ctx, &compliancev1.UpdateDataRightRequestRequest{Request: &compliancev1.DataRightRequest{
+		Id:        ids[0],
+		SubjectId: "subject-1",
+		Status:    compliancev1.DataRightStatus_DATA_RIGHT_STATUS_COMPLETED,
+		Updates:   []*compliancev1.DataRightRequestUpdate{{Message: "export sent", UpdatedBy: "forged"}},
+	}})
+	require.NoError(t, err)
+	assert.NotNil(t, updated.Request.CompletedAt)
+	require.Len(t, updated.Request.Updates, 2)
+	assert.Equal(t, "export sent", updated.Request.Updates[1].Message)
+	assert.Equal(t, "dpo", updated.Request.Updates[1].UpdatedBy, "the caller is the author")
+
+	_, err = client.UpdateDataRightRequest(ctx, &compliancev1.UpdateDataRightRequestRequest{Request: &compliancev1.DataRightRequest{
+		Id:        ids[0],
+		SubjectId: "subject-1",
+		Status:    compliancev1.DataRightStatus_DATA_RIGHT_STATUS_IN_PROGRESS,
+	}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = client.GetDataRightRequest(ctx, &compliancev1.GetDataRightRequestRequest{RequestId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestComplianceServer_SubjectsActOnTheirOwnData(t *testing.T) {
+	framework := newComplianceFramework(t, true)
+	admin := newComplianceClient(t, framework)
+	subject := newComplianceClientAs(t, framework, &security.Claims{UserID: "subject-1", Role: string(domain.RoleUser)})
+	ctx := context.Background()
+
+	_, err := newComplianceClientAs(t, framework, nil).ListConsents(ctx, &compliancev1.ListConsentsRequest{SubjectId: "subject-1"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	grant := func(subjectID string) error {
+		_, err := subject.GrantConsent(ctx, &compliancev1.GrantConsentRequest{SubjectId: subjectID, Purpose: "marketing", Granted: true, LegalBasis: "consent"})
+		return err
+	}
+	assert.NoError(t, grant("subject-1"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(grant("subject-2")))
+
+	create := func(client compliancev1.ComplianceServiceClient, subjectID string) (*compliancev1.CreateDataRightRequestResponse, error) {
+		return client.CreateDataRightRequest(ctx, &compliancev1.CreateDataRightRequestRequest{
+			Type:             compliancev1.DataRightType_DATA_RIGHT_TYPE_ACCESS,
+			SubjectId:        subjectID,
+			VerificationCode: issueVerificationCode(t, framework, subjectID),
+		})
+	}
+	own, err := create(subject, "subject-1")
+	require.NoError(t, err)
+	_, err = create(subject, "subject-2")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	other, err := create(admin, "subject-2")
+	require.NoError(t, err, "admins act for any subject")
+
+	_, err = subject.GetDataRightRequest(ctx, &compliancev1.GetDataRightRequestRequest{RequestId: own.Request.Id})
+	assert.NoError(t, err)
+	_, err = subject.GetDataRightRequest(ctx, &compliancev1.GetDataRightRequestRequest{RequestId: other.Request.Id})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = subject.ExportDataRightRequest(ctx, &compliancev1.ExportDataRightRequestRequest{RequestId: other.Request.Id})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	listed, err := subject.ListDataRightRequests(ctx, &compliancev1.ListDataRightRequestsRequest{PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, listed.Requests, 1, "subjects only list their own requests")
+	assert.Equal(t, own.Request.Id, listed.Requests[0].Id)
+	_, err = subject.ListDataRightRequests(ctx, &compliancev1.ListDataRightRequestsRequest{SubjectId: "subject-2", PageSize: 10})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = subject.ProcessDataRightRequest(ctx, &compliancev1.ProcessDataRightRequestRequest{RequestId: own.Request.Id})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "only admins process requests")
+	_, err = subject.PlaceLegalHold(ctx, &compliancev1.PlaceLegalHoldRequest{SubjectId: "subject-1", Reason: "litigation"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+// profileSource holds one profile record for subject-1
+type profileSource struct{}
+
+func (profileSource) Name() string { return "profiles" }
+
+func (profileSource) Find(_ context.Context, subjectID string) ([]compliance.SubjectRecord, error) {
+	if subjectID != "subject-1" {
+		return nil, nil
+	}
+	return []compliance.SubjectRecord{{Type: "profile", ID: subjectID, Data: map[string]interface{}{"email": "ana@example.com"}}}, nil
+}
+
+func (s profileSource) Export(ctx context.Context, subjectID string) ([]compliance.SubjectRecord, error) {
+	return s.Find(ctx, subjectID)
+}
+
+func (profileSource) Rectify(context.Context, string, map[string]interface{}) (int, error) {
+	return 0, nil
+}
+
+func (profileSource) Erase(context.Context, string) (int, error) {
+	return 0, nil
+}
+
+func TestComplianceServer_ProcessAndExport(t *testing.T) {
+	framework := newComplianceFramework(t, true)
+	require.NoError(t, framework.RegisterDataSource(profileSource{}))
+	client := newComplianceClient(t, framework)
+	ctx := context.Background()
+
+	created, err := client.CreateDataRightRequest(ctx, &compliancev1.CreateDataRightRequestRequest{
+		Type:             compliancev1.DataRightType_DATA_RIGHT_TYPE_PORTABILITY,
+		SubjectId:        "subject-1",
+		VerificationCode: issueVerificationCode(t, framework, "subject-1"),
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, created.Request.DueAt)
+
+	_, err = client.ExportDataRightRequest(ctx, &compliancev1.ExportDataRightRequestRequest{RequestId: created.Request.Id})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "nothing to export before processing")
+
+	processed, err := client.ProcessDataRightRequest(ctx, &compliancev1.ProcessDataRightRequestRequest{RequestId: created.Request.Id, ProcessedBy: "dpo"})
+	require.NoError(t, err)
+	assert.Equal(t, compliancev1.DataRightStatus_DATA_RIGHT_STATUS_COMPLETED, processed.Request.Status)
+	require.Len(t, processed.Request.Sources, 1)
+	assert.Equal(t, "profiles", processed.Request.Sources[0].Source)
+	assert.Equal(t, compliancev1.DataSourceStatus_DATA_SOURCE_STATUS_COMPLETED, processed.Request.Sources[0].Status)
+	assert.EqualValues(t, 1, processed.Request.Sources[0].Records)
+
+	export, err := client.ExportDataRightRequest(ctx, &compliancev1.ExportDataRightRequestRequest{
+		RequestId: created.Request.Id,
+		Format:    compliancev1.ExportFormat_EXPORT_FORMAT_CSV,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "text/csv", export.ContentType)
+	assert.Equal(t, "data-right-"+created.Request.Id+".csv", export.Filename)
+	assert.Contains(t, string(export.Content), "profiles,profile,subject-1,email,ana@example.com")
+
+	_, err = client.ProcessDataRightRequest(ctx, &compliancev1.ProcessDataRightRequestRequest{RequestId: created.Request.Id})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "completed requests are closed")
+	_, err = client.ProcessDataRightRequest(ctx, &compliancev1.ProcessDataRightRequestRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestComplianceServer_PII(t *testing.T) {
+	client := newComplianceClient(t, newComplianceFramework(t, true))
+	ctx := context.Background()
+
+	data, err := structpb.NewStruct(map[string]interface{}{
+		"email": "ana@example.com",
+		"note":  "hello",
+	})
+	require.NoError(t, err)
+
+	detected, err := client.DetectPII(ctx, &compliancev1.DetectPIIRequest{Data: data})
+	require.NoError(t, err)
+	require.Len(t, detected.Classifications, 1)
+	assert.Equal(t, compliancev1.PIIType_PII_TYPE_EMAIL, detected.Classifications[0].PiiType)
+	assert.Nil(t, detected.Classifications[0].ProcessedValue, "detection never returns the original value")
+
+	classified, err := client.ClassifyData(ctx, &compliancev1.ClassifyDataRequest{
+		Data:    data,
+		Options: &compliancev1.ClassificationOptions{ApplyRegulations: true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "confidential", classified.Classification.ClassificationLevel)
+	assert.Equal(t, []string{"email"}, classified.Classification.DataCategories)
+	assert.Equal(t, []string{"lgpd"}, classified.Classification.ApplicableRegulations)
+
+	_, err = client.ProcessData(ctx, &compliancev1.ProcessDataRequest{SubjectId: "subject-1", Purpose: "analytics", Data: data})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "processing requires consent")
+
+	_, err = client.GrantConsent(ctx, &compliancev1.GrantConsentRequest{SubjectId: "subject-1", Purpose: "analytics", Granted: true, LegalBasis: "consent"})
+	require.NoError(t, err)
+	processed, err := client.ProcessData(ctx, &compliancev1.ProcessDataRequest{
+		SubjectId: "subject-1",
+		Purpose:   "analytics",
+		Data:      data,
+		Options:   &compliancev1.ProcessingOptions{DetectPii: true},
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, "ana@example.com", processed.ProcessedData.Fields["email"].GetStringValue())
+	assert.Equal(t, "hello", processed.ProcessedData.Fields["note"].GetStringValue())
+	require.Len(t, processed.PiiClassifications, 1)
+	assert.Equal(t, "1", processed.Result.Metadata["pii_fields"])
+}
+
+func TestComplianceServer_Retention(t *testing.T) {
+	framework := newComplianceFramework(t, true)
+	client := newComplianceClient(t, framework)
+	ctx := context.Background()
+
+	_, err := client.PlaceLegalHold(ctx, &compliancev1.PlaceLegalHoldRequest{SubjectId: "subject-1", Reason: "litigation"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	require.NoError(t, framework.GetRetentionManager().RecordDataCreation(ctx, "subject-1", "personal", map[string]interface{}{"name": "Ana"}))
+
+	_, err = client.ExtendRetention(ctx, &compliancev1.ExtendRetentionRequest{
+		SubjectId:       "subject-1",
+		Reason:          "audit",
+		ExtendBySeconds: 3600,
+		ApprovedBy:      "dpo",
+	})
+	require.NoError(t, err)
+
+	_, err = client.PlaceLegalHold(ctx, &compliancev1.PlaceLegalHoldRequest{SubjectId: "subject-1", Reason: "litigation"})
+	require.NoError(t, err)
+
+	retention, err := client.GetRetentionStatus(ctx, &compliancev1.GetRetentionStatusRequest{SubjectId: "subject-1"})
+	require.NoError(t, err)
+	require.NotEmpty(t, retention.Records)
+	record := retention.Records[0]
+	assert.True(t, record.LegalHold)
+	assert.Equal(t, compliancev1.RetentionStatus_RETENTION_STATUS_ON_HOLD, record.Status)
+	require.Len(t, record.Extensions, 1)
+	assert.Equal(t, int64(3600), record.Extensions[0].ExtendBySeconds)
+}
+
+func TestComplianceServer_Unavailable(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := newComplianceClient(t, nil).ListConsents(ctx, &compliancev1.ListConsentsRequest{SubjectId: "s"})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	disabled := newComplianceClient(t, newComplianceFramework(t, false))
+	_, err = disabled.ListConsents(ctx, &compliancev1.ListConsentsRequest{SubjectId: "s"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	state, err := disabled.GetComplianceStatus(ctx, &compliancev1.GetComplianceStatusRequest{})
+	require.NoError(t, err)
+	assert.False(t, state.Enabled)
+}
@@ -1,7 +1,128 @@
-//go:build ultra_advanced
-// +build ultra_advanced
-
+// Package server hosts the gRPC API of the service.
 package server
 
-// This file is disabled by default. To enable advanced features, build with:
-// go build -tags ultra_advanced ./...
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/vertikon/mcp-ultra/internal/config"
+)
+
+// Server wraps a grpc.Server configured from config.GRPCConfig
+type Server struct {
+	config config.GRPCConfig
+	logger *zap.Logger
+	server *grpc.Server
+	health *health.Server
+}
+
+// NewServer creates a gRPC server with keepalive, message size limits and
+// the default logging/recovery interceptors. Extra options are appended.
+// Reflection is registered only when cfg.Reflection is set.
+func NewServer(cfg config.GRPCConfig, logger *zap.Logger, opts ...grpc.ServerOption) *Server {
+	serverOpts := []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle:     cfg.Keepalive.MaxConnectionIdle,
+			MaxConnectionAge:      cfg.Keepalive.MaxConnectionAge,
+			MaxConnectionAgeGrace: cfg.Keepalive.MaxConnectionAgeGrace,
+			Time:                  cfg.Keepalive.Time,
+			Timeout:               cfg.Keepalive.Timeout,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             cfg.Keepalive.MinTime,
+			PermitWithoutStream: cfg.Keepalive.PermitWithoutStream,
+		}),
+		grpc.ChainUnaryInterceptor(
+			UnaryRecoveryInterceptor(logger),
+			UnaryLoggingInterceptor(logger),
+		),
+		grpc.ChainStreamInterceptor(
+			StreamRecoveryInterceptor(logger),
+			StreamLoggingInterceptor(logger),
+		),
+	}
+	if cfg.MaxRecvMessageSize > 0 {
+		serverOpts = append(serverOpts, grpc.MaxRecvMsgSize(cfg.MaxRecvMessageSize))
+	}
+	if cfg.MaxSendMessageSize > 0 {
+		serverOpts = append(serverOpts, grpc.MaxSendMsgSize(cfg.MaxSendMessageSize))
+	}
+	if cfg.ConnectionTimeout > 0 {
+		serverOpts = append(serverOpts, grpc.ConnectionTimeout(cfg.ConnectionTimeout))
+	}
+	serverOpts = append(serverOpts, opts...)
+
+	s := &Server{
+		config: cfg,
+		logger: logger,
+		server: grpc.NewServer(serverOpts...),
+		health: health.NewServer(),
+	}
+
+	healthpb.RegisterHealthServer(s.server, s.health)
+	if cfg.Reflection {
+		reflection.Register(s.server)
+	}
+
+	return s
+}
+
+// RegisterService implements grpc.ServiceRegistrar and marks the service as serving
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	s.server.RegisterService(desc, impl)
+	s.health.SetServingStatus(desc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+}
+
+// Serve accepts connections on the listener until Shutdown is called
+func (s *Server) Serve(lis net.Listener) error {
+	s.logger.Info("Starting gRPC server", zap.String("address", lis.Addr().String()))
+
+	if err := s.server.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		return fmt.Errorf("serving gRPC: %w", err)
+	}
+	return nil
+}
+
+// ListenAndServe listens on the configured port and serves
+func (s *Server) ListenAndServe() error {
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.config.Port))
+	if err != nil {
+		return fmt.Errorf("listening on gRPC port %d: %w", s.config.Port, err)
+	}
+	return s.Serve(lis)
+}
+
+// Shutdown stops accepting new RPCs and waits for in-flight ones. If ctx or
+// the configured ShutdownTimeout expires first, remaining RPCs are cancelled.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.health.Shutdown()
+
+	if s.config.ShutdownTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.ShutdownTimeout)
+		defer cancel()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		return fmt.Errorf("gRPC graceful shutdown: %w", ctx.Err())
+	}
+}
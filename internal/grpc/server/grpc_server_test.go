@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/vertikon/mcp-ultra/internal/config"
+)
+
+// serveBufconn serves srv over an in-memory listener and returns a client
+// connection to it; both are torn down when the test ends
+func serveBufconn(t *testing.T, srv *Server) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(func() { _ = srv.Shutdown(context.Background()) })
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestNewServer_ReflectionIsOptIn(t *testing.T) {
+	const service = "grpc.reflection.v1.ServerReflection"
+
+	srv := NewServer(config.GRPCConfig{}, zaptest.NewLogger(t))
+	assert.NotContains(t, srv.server.GetServiceInfo(), service)
+
+	srv = NewServer(config.GRPCConfig{Reflection: true}, zaptest.NewLogger(t))
+	assert.Contains(t, srv.server.GetServiceInfo(), service)
+}
@@ -1,7 +1,641 @@
-//go:build ultra_advanced
-// +build ultra_advanced
-
 package server
 
-// This file is disabled by default. To enable advanced features, build with:
-// go build -tags ultra_advanced ./...
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	systemv1 "github.com/vertikon/mcp-ultra/api/grpc/gen/system/v1"
+	"github.com/vertikon/mcp-ultra/internal/cache"
+	"github.com/vertikon/mcp-ultra/internal/config"
+	"github.com/vertikon/mcp-ultra/internal/lifecycle"
+	"github.com/vertikon/mcp-ultra/pkg/logger"
+)
+
+const (
+	// defaultWatchInterval is how often Watch re-reads the health report
+	defaultWatchInterval = 5 * time.Second
+
+	defaultLogLimit = 100
+	maxLogLimit     = 10000
+)
+
+// BuildInfo identifies the running binary
+type BuildInfo struct {
+	ServiceName string
+	Version     string
+	BuildDate   string
+	GitCommit   string
+}
+
+var healthToServing = map[lifecycle.HealthStatus]systemv1.ServingStatus{
+	lifecycle.HealthStatusHealthy:   systemv1.ServingStatus_SERVING_STATUS_SERVING,
+	lifecycle.HealthStatusDegraded:  systemv1.ServingStatus_SERVING_STATUS_DEGRADED,
+	lifecycle.HealthStatusUnhealthy: systemv1.ServingStatus_SERVING_STATUS_NOT_SERVING,
+	lifecycle.HealthStatusUnknown:   systemv1.ServingStatus_SERVING_STATUS_SERVICE_UNKNOWN,
+}
+
+var healthToComponent = map[lifecycle.HealthStatus]systemv1.ComponentStatus{
+	lifecycle.HealthStatusHealthy:   systemv1.ComponentStatus_COMPONENT_STATUS_HEALTHY,
+	lifecycle.HealthStatusDegraded:  systemv1.ComponentStatus_COMPONENT_STATUS_DEGRADED,
+	lifecycle.HealthStatusUnhealthy: systemv1.ComponentStatus_COMPONENT_STATUS_UNHEALTHY,
+	lifecycle.HealthStatusUnknown:   systemv1.ComponentStatus_COMPONENT_STATUS_UNKNOWN,
+}
+
+var breakerStateToProto = map[cache.CircuitBreakerState]systemv1.CircuitBreakerState{
+	cache.CircuitBreakerClosed:   systemv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED,
+	cache.CircuitBreakerOpen:     systemv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN,
+	cache.CircuitBreakerHalfOpen: systemv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN,
+}
+
+// SystemServer implements systemv1.SystemServiceServer, the admin plane for
+// inspecting and operating a running instance. Any dependency may be nil;
+// the RPCs backed by it then return Unavailable.
+type SystemServer struct {
+	systemv1.UnimplementedSystemServiceServer
+
+	health    *lifecycle.HealthMonitor
+	configs   *config.Store
+	breakers  *cache.CircuitBreakerRegistry
+	logs      *logger.Broadcaster
+	build     BuildInfo
+	logger    *zap.Logger
+	startedAt time.Time
+
+	watchInterval time.Duration
+}
+
+// NewSystemServer creates a new gRPC system server
+func NewSystemServer(
+	health *lifecycle.HealthMonitor,
+	configs *config.Store,
+	breakers *cache.CircuitBreakerRegistry,
+	logs *logger.Broadcaster,
+	build BuildInfo,
+	logger *zap.Logger,
+) *SystemServer {
+	return &SystemServer{
+		health:        health,
+		configs:       configs,
+		breakers:      breakers,
+		logs:          logs,
+		build:         build,
+		logger:        logger,
+		startedAt:     time.Now(),
+		watchInterval: defaultWatchInterval,
+	}
+}
+
+// Check reports the health of the instance, or of one component when
+// service is set. deep_check runs the checks instead of using the last report.
+func (s *SystemServer) Check(ctx context.Context, req *systemv1.HealthCheckRequest) (*systemv1.HealthCheckResponse, error) {
+	if s.health == nil {
+		return nil, status.Error(codes.Unavailable, "health monitor is not configured")
+	}
+
+	start := time.Now()
+	report := s.healthReport(ctx, req.GetDeepCheck())
+	resp, err := s.healthResponse(report, req.GetService())
+	if err != nil {
+		return nil, err
+	}
+	resp.ResponseTime = durationpb.New(time.Since(start))
+	return resp, nil
+}
+
+// Watch sends the current health and then every change to it
+func (s *SystemServer) Watch(req *systemv1.HealthCheckRequest, stream grpc.ServerStreamingServer[systemv1.HealthCheckResponse]) error {
+	if s.health == nil {
+		return status.Error(codes.Unavailable, "health monitor is not configured")
+	}
+
+	ctx := stream.Context()
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+
+	last := ""
+	deep := req.GetDeepCheck()
+	for {
+		resp, err := s.healthResponse(s.healthReport(ctx, deep), req.GetService())
+		if err != nil {
+			return err
+		}
+		if fp := healthFingerprint(resp); fp != last {
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+			last = fp
+		}
+		// Later rounds follow the background checks rather than forcing new ones
+		deep = false
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetSystemInfo describes the binary and the Go runtime
+func (s *SystemServer) GetSystemInfo(_ context.Context, req *systemv1.GetSystemInfoRequest) (*systemv1.GetSystemInfoResponse, error) {
+	info := &systemv1.SystemInfo{
+		ServiceName: s.build.ServiceName,
+		Version:     s.build.Version,
+		BuildDate:   s.build.BuildDate,
+		GitCommit:   s.build.GitCommit,
+	}
+	if s.configs != nil {
+		cfg := s.configs.Get()
+		info.Environment = map[string]string{
+			"environment": cfg.Environment,
+			"region":      cfg.Region,
+			"datacenter":  cfg.Datacenter,
+		}
+	}
+
+	if req.GetIncludeRuntime() {
+		var mem runtime.MemStats
+		runtime.ReadMemStats(&mem)
+		info.Runtime = &systemv1.RuntimeInfo{
+			GoVersion:    runtime.Version(),
+			Os:           runtime.GOOS,
+			Arch:         runtime.GOARCH,
+			NumCpu:       int32(runtime.NumCPU()),
+			NumGoroutine: int32(runtime.NumGoroutine()),
+			Memory: &systemv1.MemoryStats{
+				Alloc:        mem.Alloc,
+				TotalAlloc:   mem.TotalAlloc,
+				Sys:          mem.Sys,
+				HeapAlloc:    mem.HeapAlloc,
+				HeapSys:      mem.HeapSys,
+				HeapInuse:    mem.HeapInuse,
+				StackInuse:   mem.StackInuse,
+				StackSys:     mem.StackSys,
+				NumGc:        mem.NumGC,
+				GcPauseTotal: durationpb.New(time.Duration(mem.PauseTotalNs)),
+			},
+			Uptime:    durationpb.New(time.Since(s.startedAt)),
+			StartTime: timestamppb.New(s.startedAt),
+		}
+	}
+
+	if bi, ok := debug.ReadBuildInfo(); ok && (req.GetIncludeBuild() || req.GetIncludeDependencies()) {
+		if req.GetIncludeBuild() {
+			build := &systemv1.BuildInfo{Compiler: runtime.Compiler}
+			for _, setting := range bi.Settings {
+				if setting.Key == "-tags" {
+					build.BuildTags = strings.Split(setting.Value, ",")
+				}
+				build.Settings = append(build.Settings, &systemv1.BuildSetting{Key: setting.Key, Value: setting.Value})
+			}
+			info.Build = build
+		}
+		if req.GetIncludeDependencies() {
+			for _, dep := range bi.Deps {
+				d := &systemv1.Dependency{Name: dep.Path, Version: dep.Version, Path: dep.Path}
+				if dep.Replace != nil {
+					d.Replace = true
+					d.Path = dep.Replace.Path
+				}
+				info.Dependencies = append(info.Dependencies, d)
+			}
+		}
+	}
+
+	return &systemv1.GetSystemInfoResponse{System: info}, nil
+}
+
+// GetConfig returns the active configuration, or one dotted section of it.
+// Secrets are masked unless an admin asks for them unmasked.
+func (s *SystemServer) GetConfig(ctx context.Context, req *systemv1.GetConfigRequest) (*systemv1.GetConfigResponse, error) {
+	if s.configs == nil {
+		return nil, status.Error(codes.Unavailable, "config store is not configured")
+	}
+
+	mask := req.GetMaskSensitive() || !isAdmin(ctx)
+	section, err := s.configs.Section(req.GetSection(), mask)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pb, err := structpb.NewStruct(section)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encoding config: %v", err)
+	}
+
+	checksum, updatedAt := s.configs.Checksum()
+	return &systemv1.GetConfigResponse{
+		Config:      pb,
+		Checksum:    checksum,
+		LastUpdated: timestamppb.New(updatedAt),
+	}, nil
+}
+
+// UpdateConfig merges the given values into a section. Validation failures
+// are reported in the response rather than as an RPC error; changes to
+// fields that only take effect on restart fail with FailedPrecondition.
+func (s *SystemServer) UpdateConfig(_ context.Context, req *systemv1.UpdateConfigRequest) (*systemv1.UpdateConfigResponse, error) {
+	if s.configs == nil {
+		return nil, status.Error(codes.Unavailable, "config store is not configured")
+	}
+	if req.GetConfig() == nil {
+		return nil, status.Error(codes.InvalidArgument, "config is required")
+	}
+
+	diff, err := s.configs.Update(req.GetSection(), req.GetConfig().AsMap(), req.GetDryRun())
+	if err != nil {
+		var verrs config.ValidationErrors
+		switch {
+		case errors.As(err, &verrs):
+			resp := &systemv1.UpdateConfigResponse{Success: false}
+			for _, v := range verrs {
+				resp.Errors = append(resp.Errors, &systemv1.ConfigValidationError{Field: v.Field, Message: v.Message, Code: v.Code})
+			}
+			return resp, nil
+		case errors.Is(err, config.ErrRestartRequired):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, config.ErrConcurrentUpdate):
+			return nil, status.Error(codes.Aborted, err.Error())
+		default:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	pb, err := structpb.NewStruct(diff)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encoding diff: %v", err)
+	}
+
+	if !req.GetDryRun() && len(diff) > 0 {
+		s.logger.Warn("Configuration updated over admin API",
+			zap.String("section", req.GetSection()),
+			zap.Strings("fields", sortedKeys(diff)))
+	}
+	return &systemv1.UpdateConfigResponse{Success: true, Diff: pb}, nil
+}
+
+// ReloadConfig re-reads the configuration from file and environment. It
+// fails with FailedPrecondition, keeping the active config, when fields
+// that only take effect on restart changed.
+func (s *SystemServer) ReloadConfig(_ context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if s.configs == nil {
+		return nil, status.Error(codes.Unavailable, "config store is not configured")
+	}
+
+	if err := s.configs.Reload(); err != nil {
+		var verrs config.ValidationErrors
+		switch {
+		case errors.As(err, &verrs), errors.Is(err, config.ErrRestartRequired):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, config.ErrConcurrentUpdate):
+			return nil, status.Error(codes.Aborted, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	checksum, _ := s.configs.Checksum()
+	s.logger.Info("Configuration reloaded", zap.String("checksum", checksum))
+	return &emptypb.Empty{}, nil
+}
+
+// GetLogs returns recent log entries held in memory, newest last
+func (s *SystemServer) GetLogs(_ context.Context, req *systemv1.GetLogsRequest) (*systemv1.GetLogsResponse, error) {
+	if s.logs == nil {
+		return nil, status.Error(codes.Unavailable, "log capture is not configured")
+	}
+
+	filter, err := newLogFilter(req.GetServiceName(), req.GetLevel(), req.GetQuery(), req.GetLabels())
+	if err != nil {
+		return nil, err
+	}
+	filter.from = optionalTime(req.GetStartTime())
+	filter.to = optionalTime(req.GetEndTime())
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultLogLimit
+	}
+	if limit > maxLogLimit {
+		limit = maxLogLimit
+	}
+
+	var matched []*systemv1.LogEntry
+	for _, e := range s.logs.Recent(0) {
+		if filter.matches(e) {
+			matched = append(matched, s.logEntryToProto(e))
+		}
+	}
+	if len(matched) > limit {
+		matched = matched[len(matched)-limit:]
+	}
+	return &systemv1.GetLogsResponse{Logs: matched}, nil
+}
+
+// StreamLogs sends recent matching log entries and, with follow, keeps
+// sending new ones until the client goes away
+func (s *SystemServer) StreamLogs(req *systemv1.StreamLogsRequest, stream grpc.ServerStreamingServer[systemv1.StreamLogsResponse]) error {
+	if s.logs == nil {
+		return status.Error(codes.Unavailable, "log capture is not configured")
+	}
+
+	filter, err := newLogFilter(req.GetServiceName(), req.GetLevel(), req.GetQuery(), req.GetLabels())
+	if err != nil {
+		return err
+	}
+
+	recent, live, cancel := s.logs.Subscribe(defaultLogLimit)
+	defer cancel()
+
+	for _, e := range recent {
+		if !filter.matches(e) {
+			continue
+		}
+		if err := stream.Send(&systemv1.StreamLogsResponse{Log: s.logEntryToProto(e)}); err != nil {
+			return err
+		}
+	}
+	if !req.GetFollow() {
+		return nil
+	}
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case e := <-live:
+			if !filter.matches(e) {
+				continue
+			}
+			if err := stream.Send(&systemv1.StreamLogsResponse{Log: s.logEntryToProto(e)}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetCircuitBreakers lists the registered circuit breakers
+func (s *SystemServer) GetCircuitBreakers(_ context.Context, req *systemv1.GetCircuitBreakersRequest) (*systemv1.GetCircuitBreakersResponse, error) {
+	if s.breakers == nil {
+		return nil, status.Error(codes.Unavailable, "circuit breaker registry is not configured")
+	}
+
+	resp := &systemv1.GetCircuitBreakersResponse{}
+	for _, nb := range s.breakers.List() {
+		if req.GetServiceName() != "" && nb.Service != req.GetServiceName() {
+			continue
+		}
+		resp.CircuitBreakers = append(resp.CircuitBreakers, breakerToProto(nb))
+	}
+	return resp, nil
+}
+
+// TriggerCircuitBreaker forces a circuit breaker open
+func (s *SystemServer) TriggerCircuitBreaker(_ context.Context, req *systemv1.TriggerCircuitBreakerRequest) (*emptypb.Empty, error) {
+	breaker, err := s.breaker(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	breaker.ForceOpen()
+	s.logger.Warn("Circuit breaker forced open",
+		zap.String("name", req.GetName()),
+		zap.String("reason", req.GetReason()))
+	return &emptypb.Empty{}, nil
+}
+
+// ResetCircuitBreaker closes a circuit breaker and clears its counters
+func (s *SystemServer) ResetCircuitBreaker(_ context.Context, req *systemv1.ResetCircuitBreakerRequest) (*emptypb.Empty, error) {
+	breaker, err := s.breaker(req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	breaker.Reset()
+	s.logger.Info("Circuit breaker reset", zap.String("name", req.GetName()))
+	return &emptypb.Empty{}, nil
+}
+
+func (s *SystemServer) breaker(name string) (*cache.CircuitBreaker, error) {
+	if s.breakers == nil {
+		return nil, status.Error(codes.Unavailable, "circuit breaker registry is not configured")
+	}
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	breaker, ok := s.breakers.Get(name)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "circuit breaker %q not found", name)
+	}
+	return breaker, nil
+}
+
+func (s *SystemServer) healthReport(ctx context.Context, deep bool) *lifecycle.HealthReport {
+	if !deep {
+		if report := s.health.GetLastReport(); report != nil {
+			return report
+		}
+	}
+	return s.health.GetHealth(ctx)
+}
+
+func (s *SystemServer) healthResponse(report *lifecycle.HealthReport, service string) (*systemv1.HealthCheckResponse, error) {
+	resp := &systemv1.HealthCheckResponse{
+		Status:    healthToServing[report.Status],
+		Timestamp: timestamppb.New(report.Timestamp),
+		Version:   report.Version,
+		Metadata: map[string]string{
+			"uptime":    report.Uptime.Round(time.Second).String(),
+			"healthy":   fmt.Sprint(report.Summary.Healthy),
+			"degraded":  fmt.Sprint(report.Summary.Degraded),
+			"unhealthy": fmt.Sprint(report.Summary.Unhealthy),
+		},
+	}
+
+	for _, check := range report.Checks {
+		resp.Components = append(resp.Components, &systemv1.ComponentHealth{
+			Name:         check.Name,
+			Status:       healthToComponent[check.Status],
+			Message:      check.Message,
+			ResponseTime: durationpb.New(check.Duration),
+			Metadata:     stringifyDetails(check.Details),
+			Checks: []*systemv1.HealthCheck{{
+				Name:         check.Name,
+				Passed:       check.Status == lifecycle.HealthStatusHealthy,
+				Message:      firstNonEmpty(check.Error, check.Message),
+				ResponseTime: durationpb.New(check.Duration),
+			}},
+		})
+	}
+	for _, dep := range report.Dependencies {
+		metadata := stringifyDetails(dep.Details)
+		metadata["type"] = dep.Type
+		resp.Components = append(resp.Components, &systemv1.ComponentHealth{
+			Name:         dep.Name,
+			Status:       healthToComponent[dep.Status],
+			ResponseTime: durationpb.New(dep.ResponseTime),
+			Metadata:     metadata,
+		})
+	}
+	sort.Slice(resp.Components, func(i, j int) bool { return resp.Components[i].Name < resp.Components[j].Name })
+
+	if service == "" {
+		return resp, nil
+	}
+	for _, c := range resp.Components {
+		if c.Name == service {
+			resp.Components = []*systemv1.ComponentHealth{c}
+			resp.Status = componentToServing(c.Status)
+			return resp, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "unknown service %q", service)
+}
+
+func (s *SystemServer) logEntryToProto(e logger.Entry) *systemv1.LogEntry {
+	service := e.Logger
+	if service == "" {
+		service = s.build.ServiceName
+	}
+	return &systemv1.LogEntry{
+		Timestamp: timestamppb.New(e.Time),
+		Level:     e.Level.String(),
+		Message:   e.Message,
+		Service:   service,
+		TraceId:   e.Fields["trace_id"],
+		SpanId:    e.Fields["span_id"],
+		Fields:    e.Fields,
+	}
+}
+
+func componentToServing(c systemv1.ComponentStatus) systemv1.ServingStatus {
+	switch c {
+	case systemv1.ComponentStatus_COMPONENT_STATUS_HEALTHY:
+		return systemv1.ServingStatus_SERVING_STATUS_SERVING
+	case systemv1.ComponentStatus_COMPONENT_STATUS_DEGRADED:
+		return systemv1.ServingStatus_SERVING_STATUS_DEGRADED
+	case systemv1.ComponentStatus_COMPONENT_STATUS_UNHEALTHY:
+		return systemv1.ServingStatus_SERVING_STATUS_NOT_SERVING
+	default:
+		return systemv1.ServingStatus_SERVING_STATUS_SERVICE_UNKNOWN
+	}
+}
+
+// healthFingerprint changes whenever the overall or a component status does
+func healthFingerprint(resp *systemv1.HealthCheckResponse) string {
+	var b strings.Builder
+	b.WriteString(resp.Status.String())
+	for _, c := range resp.Components {
+		fmt.Fprintf(&b, "|%s=%s", c.Name, c.Status)
+	}
+	return b.String()
+}
+
+func breakerToProto(nb cache.NamedCircuitBreaker) *systemv1.CircuitBreakerInfo {
+	stats := nb.Breaker.Stats()
+	info := &systemv1.CircuitBreakerInfo{
+		Name:         nb.Name,
+		Service:      nb.Service,
+		State:        breakerStateToProto[stats.State],
+		FailureCount: int32(stats.FailureCount),
+		SuccessCount: int32(stats.SuccessCount),
+		RequestCount: int32(stats.HalfOpenRequests),
+		Config: &systemv1.CircuitBreakerConfig{
+			MaxRequests:      int32(stats.HalfOpenMaxRequests),
+			Timeout:          durationpb.New(stats.RecoveryTimeout),
+			FailureThreshold: int32(stats.FailureThreshold),
+		},
+	}
+	if !stats.LastFailureTime.IsZero() {
+		info.LastFailureAt = timestamppb.New(stats.LastFailureTime)
+		if stats.State == cache.CircuitBreakerOpen {
+			info.NextAttemptAt = timestamppb.New(stats.LastFailureTime.Add(stats.RecoveryTimeout))
+		}
+	}
+	return info
+}
+
+// logFilter selects log entries for GetLogs and StreamLogs
+type logFilter struct {
+	service  string
+	minLevel zapcore.Level
+	query    string
+	labels   map[string]string
+	from, to *time.Time
+}
+
+func newLogFilter(service, level, query string, labels map[string]string) (*logFilter, error) {
+	f := &logFilter{service: service, minLevel: zapcore.DebugLevel, query: strings.ToLower(query), labels: labels}
+	if level != "" {
+		if err := f.minLevel.UnmarshalText([]byte(level)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid level %q", level)
+		}
+	}
+	return f, nil
+}
+
+func (f *logFilter) matches(e logger.Entry) bool {
+	if e.Level < f.minLevel {
+		return false
+	}
+	if f.service != "" && !strings.HasPrefix(e.Logger, f.service) {
+		return false
+	}
+	if f.query != "" && !strings.Contains(strings.ToLower(e.Message), f.query) {
+		return false
+	}
+	for k, v := range f.labels {
+		if e.Fields[k] != v {
+			return false
+		}
+	}
+	if f.from != nil && e.Time.Before(*f.from) {
+		return false
+	}
+	if f.to != nil && e.Time.After(*f.to) {
+		return false
+	}
+	return true
+}
+
+func stringifyDetails(details map[string]interface{}) map[string]string {
+	out := make(map[string]string, len(details))
+	for k, v := range details {
+		out[k] = fmt.Sprint(v)
+	}
+	return out
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,253 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	systemv1 "github.com/vertikon/mcp-ultra/api/grpc/gen/system/v1"
+	"github.com/vertikon/mcp-ultra/internal/cache"
+	"github.com/vertikon/mcp-ultra/internal/config"
+	"github.com/vertikon/mcp-ultra/internal/lifecycle"
+	"github.com/vertikon/mcp-ultra/internal/security"
+	"github.com/vertikon/mcp-ultra/pkg/logger"
+)
+
+type systemFixture struct {
+	client   systemv1.SystemServiceClient
+	failing  *atomic.Bool
+	breakers *cache.CircuitBreakerRegistry
+	configs  *config.Store
+	logs     *logger.Broadcaster
+	system   *SystemServer
+}
+
+func newSystemFixture(t *testing.T) *systemFixture {
+	t.Helper()
+	return newSystemFixtureAs(t, &security.Claims{UserID: "ops", Role: "admin"})
+}
+
+// newSystemFixtureAs serves the system service to callers authenticated as
+// claims
+func newSystemFixtureAs(t *testing.T, claims *security.Claims) *systemFixture {
+	t.Helper()
+
+	logs := logger.NewBroadcaster(50, zapcore.DebugLevel)
+	log := logs.Tee(zaptest.NewLogger(t))
+
+	failing := &atomic.Bool{}
+	healthConfig := lifecycle.DefaultHealthConfig()
+	healthConfig.EnableHTTPEndpoint = false
+	healthConfig.EnablePersistence = false
+	health := lifecycle.NewHealthMonitor(healthConfig, "test", logger.FromZap(log))
+	health.RegisterChecker(lifecycle.NewFuncHealthChecker("database", true, time.Second, func(context.Context) error {
+		if failing.Load() {
+			return errors.New("connection refused")
+		}
+		return nil
+	}))
+
+	cfg := &config.Config{Environment: "test"}
+	cfg.Server.Port = 9655
+	cfg.GRPC.Port = 9656
+	cfg.Database.PostgreSQL.Port = 5432
+	cfg.Database.PostgreSQL.Password = "hunter2"
+	configs, err := config.NewStore(cfg, func() (*config.Config, error) {
+		reloaded := *cfg
+		reloaded.Server.RateLimit.RequestsPerSecond = 100
+		return &reloaded, nil
+	})
+	require.NoError(t, err)
+	configs.OnChange(func(_, _ *config.Config) {}, "server.rate_limit")
+
+	breakers := cache.NewCircuitBreakerRegistry()
+	require.NoError(t, breakers.Register("redis", "cache", cache.NewCircuitBreaker(3, time.Minute, 1)))
+
+	system := NewSystemServer(health, configs, breakers, logs, BuildInfo{ServiceName: "mcp-ultra", Version: "test"}, log)
+	system.watchInterval = 10 * time.Millisecond
+
+	srv := NewServer(config.GRPCConfig{ShutdownTimeout: time.Second}, log, append(asCaller(claims), AdminInterceptors()...)...)
+	systemv1.RegisterSystemServiceServer(srv, system)
+
+	return &systemFixture{
+		client:   systemv1.NewSystemServiceClient(serveBufconn(t, srv)),
+		failing:  failing,
+		breakers: breakers,
+		configs:  configs,
+		logs:     logs,
+		system:   system,
+	}
+}
+
+func TestSystemServer_Check(t *testing.T) {
+	f := newSystemFixture(t)
+	ctx := context.Background()
+
+	resp, err := f.client.Check(ctx, &systemv1.HealthCheckRequest{DeepCheck: true})
+	require.NoError(t, err)
+	assert.Equal(t, systemv1.ServingStatus_SERVING_STATUS_SERVING, resp.Status)
+	require.Len(t, resp.Components, 1)
+	assert.Equal(t, "database", resp.Components[0].Name)
+
+	f.failing.Store(true)
+	resp, err = f.client.Check(ctx, &systemv1.HealthCheckRequest{DeepCheck: true, Service: "database"})
+	require.NoError(t, err)
+	assert.Equal(t, systemv1.ServingStatus_SERVING_STATUS_NOT_SERVING, resp.Status)
+	assert.Equal(t, "connection refused", resp.Components[0].Checks[0].Message)
+
+	_, err = f.client.Check(ctx, &systemv1.HealthCheckRequest{Service: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSystemServer_Watch(t *testing.T) {
+	f := newSystemFixture(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := f.client.Watch(ctx, &systemv1.HealthCheckRequest{DeepCheck: true})
+	require.NoError(t, err)
+
+	first, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, systemv1.ServingStatus_SERVING_STATUS_SERVING, first.Status)
+
+	// Watch follows the last report, which a deep check refreshes
+	f.failing.Store(true)
+	_, err = f.client.Check(ctx, &systemv1.HealthCheckRequest{DeepCheck: true})
+	require.NoError(t, err)
+
+	second, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, systemv1.ServingStatus_SERVING_STATUS_NOT_SERVING, second.Status)
+}
+
+func TestSystemServer_Config(t *testing.T) {
+	f := newSystemFixture(t)
+	ctx := context.Background()
+
+	got, err := f.client.GetConfig(ctx, &systemv1.GetConfigRequest{Section: "database.postgresql", MaskSensitive: true})
+	require.NoError(t, err)
+	assert.Equal(t, "********", got.Config.Fields["password"].GetStringValue())
+	assert.NotEmpty(t, got.Checksum)
+
+	got, err = f.client.GetConfig(ctx, &systemv1.GetConfigRequest{Section: "database.postgresql"})
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", got.Config.Fields["password"].GetStringValue(), "admins may unmask")
+
+	// Only admins get secrets unmasked
+	direct, err := f.system.GetConfig(ctx, &systemv1.GetConfigRequest{Section: "database.postgresql"})
+	require.NoError(t, err)
+	assert.Equal(t, "********", direct.Config.Fields["password"].GetStringValue())
+
+	_, err = f.client.GetConfig(ctx, &systemv1.GetConfigRequest{Section: "nope"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	values, err := structpb.NewStruct(map[string]interface{}{"port": 9656})
+	require.NoError(t, err)
+	updated, err := f.client.UpdateConfig(ctx, &systemv1.UpdateConfigRequest{Section: "server", Config: values})
+	require.NoError(t, err)
+	assert.False(t, updated.Success)
+	require.Len(t, updated.Errors, 1)
+	assert.Equal(t, "grpc.port", updated.Errors[0].Field)
+
+	// The port only changes on restart
+	values, err = structpb.NewStruct(map[string]interface{}{"port": 8080})
+	require.NoError(t, err)
+	_, err = f.client.UpdateConfig(ctx, &systemv1.UpdateConfigRequest{Section: "server", Config: values})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, 9655, f.configs.Get().Server.Port)
+
+	values, err = structpb.NewStruct(map[string]interface{}{"rate_limit": map[string]interface{}{"burst": 10}})
+	require.NoError(t, err)
+	updated, err = f.client.UpdateConfig(ctx, &systemv1.UpdateConfigRequest{Section: "server", Config: values, DryRun: true})
+	require.NoError(t, err)
+	assert.True(t, updated.Success)
+	assert.Contains(t, updated.Diff.Fields, "server.rate_limit.burst")
+	assert.Equal(t, 0, f.configs.Get().Server.RateLimit.Burst)
+
+	_, err = f.client.UpdateConfig(ctx, &systemv1.UpdateConfigRequest{Section: "server", Config: values})
+	require.NoError(t, err)
+	assert.Equal(t, 10, f.configs.Get().Server.RateLimit.Burst)
+
+	_, err = f.client.ReloadConfig(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, float64(100), f.configs.Get().Server.RateLimit.RequestsPerSecond)
+}
+
+func TestSystemServer_CircuitBreakers(t *testing.T) {
+	f := newSystemFixture(t)
+	ctx := context.Background()
+
+	_, err := f.client.TriggerCircuitBreaker(ctx, &systemv1.TriggerCircuitBreakerRequest{Name: "redis", Reason: "maintenance"})
+	require.NoError(t, err)
+
+	list, err := f.client.GetCircuitBreakers(ctx, &systemv1.GetCircuitBreakersRequest{ServiceName: "cache"})
+	require.NoError(t, err)
+	require.Len(t, list.CircuitBreakers, 1)
+	assert.Equal(t, systemv1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN, list.CircuitBreakers[0].State)
+	assert.NotNil(t, list.CircuitBreakers[0].NextAttemptAt)
+
+	_, err = f.client.ResetCircuitBreaker(ctx, &systemv1.ResetCircuitBreakerRequest{Name: "redis"})
+	require.NoError(t, err)
+	breaker, _ := f.breakers.Get("redis")
+	assert.Equal(t, cache.CircuitBreakerClosed, breaker.State())
+
+	_, err = f.client.ResetCircuitBreaker(ctx, &systemv1.ResetCircuitBreakerRequest{Name: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSystemServer_Logs(t *testing.T) {
+	f := newSystemFixture(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	f.system.logger.Info("cache warmed")
+	f.system.logger.Debug("noise")
+
+	logs, err := f.client.GetLogs(ctx, &systemv1.GetLogsRequest{Level: "info", Query: "warmed"})
+	require.NoError(t, err)
+	require.Len(t, logs.Logs, 1)
+	assert.Equal(t, "info", logs.Logs[0].Level)
+
+	_, err = f.client.GetLogs(ctx, &systemv1.GetLogsRequest{Level: "loud"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	stream, err := f.client.StreamLogs(ctx, &systemv1.StreamLogsRequest{Query: "warmed", Follow: true})
+	require.NoError(t, err)
+	backlog, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "cache warmed", backlog.Log.Message)
+
+	// The stream is subscribed once the backlog has arrived
+	f.system.logger.Info("cache warmed again")
+	live, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "cache warmed again", live.Log.Message)
+}
+
+func TestSystemServer_RequiresAdmin(t *testing.T) {
+	f := newSystemFixtureAs(t, &security.Claims{UserID: "dev", Role: "user"})
+	ctx := context.Background()
+
+	_, err := f.client.GetConfig(ctx, &systemv1.GetConfigRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = f.client.ReloadConfig(ctx, &emptypb.Empty{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	stream, err := f.client.StreamLogs(ctx, &systemv1.StreamLogsRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
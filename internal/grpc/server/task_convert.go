@@ -0,0 +1,305 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	taskv1 "github.com/vertikon/mcp-ultra/api/grpc/gen/task/v1"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/services"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+var statusToProto = map[domain.TaskStatus]taskv1.TaskStatus{
+	domain.TaskStatusPending:    taskv1.TaskStatus_TASK_STATUS_PENDING,
+	domain.TaskStatusInProgress: taskv1.TaskStatus_TASK_STATUS_IN_PROGRESS,
+	domain.TaskStatusCompleted:  taskv1.TaskStatus_TASK_STATUS_COMPLETED,
+	domain.TaskStatusCancelled:  taskv1.TaskStatus_TASK_STATUS_CANCELLED,
+}
+
+var priorityToProto = map[domain.Priority]taskv1.TaskPriority{
+	domain.PriorityLow:    taskv1.TaskPriority_TASK_PRIORITY_LOW,
+	domain.PriorityMedium: taskv1.TaskPriority_TASK_PRIORITY_MEDIUM,
+	domain.PriorityHigh:   taskv1.TaskPriority_TASK_PRIORITY_HIGH,
+	domain.PriorityUrgent: taskv1.TaskPriority_TASK_PRIORITY_URGENT,
+}
+
+// taskToProto converts a domain task into its wire representation
+func taskToProto(t *domain.Task) *taskv1.Task {
+	if t == nil {
+		return nil
+	}
+
+	pb := &taskv1.Task{
+		Id:          t.ID.String(),
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      statusToProto[t.Status],
+		Priority:    priorityToProto[t.Priority],
+		Tags:        t.Tags,
+		CreatedAt:   timestamppb.New(t.CreatedAt),
+		UpdatedAt:   timestamppb.New(t.UpdatedAt),
+	}
+	if t.AssigneeID != nil {
+		pb.Assignee = &taskv1.TaskAssignee{UserId: t.AssigneeID.String()}
+	}
+	if t.DueDate != nil {
+		pb.DueDate = timestamppb.New(*t.DueDate)
+	}
+	if t.CompletedAt != nil {
+		pb.CompletedAt = timestamppb.New(*t.CompletedAt)
+	}
+	if len(t.Metadata) > 0 {
+		pb.Metadata = make(map[string]string, len(t.Metadata))
+		for k, v := range t.Metadata {
+			pb.Metadata[k] = metadataValue(v)
+		}
+	}
+	return pb
+}
+
+func metadataValue(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+func statusFromProto(s taskv1.TaskStatus) (domain.TaskStatus, error) {
+	for d, p := range statusToProto {
+		if p == s {
+			return d, nil
+		}
+	}
+	return "", status.Errorf(codes.InvalidArgument, "unsupported task status %s", s)
+}
+
+// priorityFromProto maps a priority; unspecified falls back to medium
+func priorityFromProto(p taskv1.TaskPriority) (domain.Priority, error) {
+	if p == taskv1.TaskPriority_TASK_PRIORITY_UNSPECIFIED {
+		return domain.PriorityMedium, nil
+	}
+	for d, v := range priorityToProto {
+		if v == p {
+			return d, nil
+		}
+	}
+	return "", status.Errorf(codes.InvalidArgument, "unsupported task priority %s", p)
+}
+
+func parseUUID(field, value string) (types.UUID, error) {
+	id, err := types.Parse(value)
+	if err != nil {
+		return types.Nil, status.Errorf(codes.InvalidArgument, "invalid %s %q", field, value)
+	}
+	return id, nil
+}
+
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// createRequestFromProto builds the service request for a new task
+func createRequestFromProto(t *taskv1.Task, createdBy types.UUID) (services.CreateTaskRequest, error) {
+	if t == nil {
+		return services.CreateTaskRequest{}, status.Error(codes.InvalidArgument, "task is required")
+	}
+
+	priority, err := priorityFromProto(t.GetPriority())
+	if err != nil {
+		return services.CreateTaskRequest{}, err
+	}
+
+	req := services.CreateTaskRequest{
+		Title:       t.GetTitle(),
+		Description: t.GetDescription(),
+		Priority:    priority,
+		CreatedBy:   createdBy,
+		DueDate:     optionalTime(t.GetDueDate()),
+		Tags:        t.GetTags(),
+	}
+	if uid := t.GetAssignee().GetUserId(); uid != "" {
+		id, err := parseUUID("assignee.user_id", uid)
+		if err != nil {
+			return services.CreateTaskRequest{}, err
+		}
+		req.AssigneeID = &id
+	}
+	return req, nil
+}
+
+// updatableFields lists the task paths accepted in an update mask
+var updatableFields = []string{"title", "description", "priority", "assignee", "due_date", "tags", "status"}
+
+// updateRequestFromProto builds the service update from a task and field mask.
+// An empty mask updates every field carrying a non-zero value. The returned
+// flag reports whether the caller asked for the task to be completed.
+func updateRequestFromProto(t *taskv1.Task, mask *fieldmaskpb.FieldMask) (services.UpdateTaskRequest, bool, error) {
+	var req services.UpdateTaskRequest
+
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		paths = nonZeroPaths(t)
+	}
+
+	complete := false
+	for _, path := range paths {
+		switch path {
+		case "title":
+			title := t.GetTitle()
+			req.Title = &title
+		case "description":
+			description := t.GetDescription()
+			req.Description = &description
+		case "priority":
+			priority, err := priorityFromProto(t.GetPriority())
+			if err != nil {
+				return req, false, err
+			}
+			req.Priority = &priority
+		case "assignee", "assignee.user_id":
+			id, err := parseUUID("assignee.user_id", t.GetAssignee().GetUserId())
+			if err != nil {
+				return req, false, err
+			}
+			req.AssigneeID = &id
+		case "due_date":
+			req.DueDate = optionalTime(t.GetDueDate())
+		case "tags":
+			req.Tags = append([]string{}, t.GetTags()...)
+		case "status":
+			s, err := statusFromProto(t.GetStatus())
+			if err != nil {
+				return req, false, err
+			}
+			if s != domain.TaskStatusCompleted {
+				return req, false, status.Errorf(codes.InvalidArgument, "status can only be changed to %s", taskv1.TaskStatus_TASK_STATUS_COMPLETED)
+			}
+			complete = true
+		default:
+			return req, false, status.Errorf(codes.InvalidArgument, "field %q cannot be updated; allowed: %s", path, strings.Join(updatableFields, ", "))
+		}
+	}
+	return req, complete, nil
+}
+
+func nonZeroPaths(t *taskv1.Task) []string {
+	var paths []string
+	if t.GetTitle() != "" {
+		paths = append(paths, "title")
+	}
+	if t.GetDescription() != "" {
+		paths = append(paths, "description")
+	}
+	if t.GetPriority() != taskv1.TaskPriority_TASK_PRIORITY_UNSPECIFIED {
+		paths = append(paths, "priority")
+	}
+	if t.GetAssignee().GetUserId() != "" {
+		paths = append(paths, "assignee")
+	}
+	if t.GetDueDate() != nil {
+		paths = append(paths, "due_date")
+	}
+	if len(t.GetTags()) > 0 {
+		paths = append(paths, "tags")
+	}
+	if t.GetStatus() != taskv1.TaskStatus_TASK_STATUS_UNSPECIFIED {
+		paths = append(paths, "status")
+	}
+	return paths
+}
+
+// filterFromProto maps the wire filter onto the repository filter
+func filterFromProto(f *taskv1.TaskFilter) (domain.TaskFilter, error) {
+	var filter domain.TaskFilter
+	if f == nil {
+		return filter, nil
+	}
+
+	for _, s := range f.GetStatus() {
+		ds, err := statusFromProto(s)
+		if err != nil {
+			return filter, err
+		}
+		filter.Status = append(filter.Status, ds)
+	}
+	for _, p := range f.GetPriority() {
+		if p == taskv1.TaskPriority_TASK_PRIORITY_UNSPECIFIED {
+			continue
+		}
+		dp, err := priorityFromProto(p)
+		if err != nil {
+			return filter, err
+		}
+		filter.Priority = append(filter.Priority, dp)
+	}
+	switch len(f.GetAssigneeIds()) {
+	case 0:
+	case 1:
+		id, err := parseUUID("assignee_ids", f.GetAssigneeIds()[0])
+		if err != nil {
+			return filter, err
+		}
+		filter.AssigneeID = &id
+	default:
+		return filter, status.Error(codes.InvalidArgument, "filtering by more than one assignee is not supported")
+	}
+	filter.Tags = f.GetTags()
+	filter.Query = f.GetSearchQuery()
+	if r := f.GetCreatedAtRange(); r != nil {
+		filter.FromDate = optionalTime(r.GetStart())
+		filter.ToDate = optionalTime(r.GetEnd())
+	}
+	return filter, nil
+}
+
+// sortFromProto maps sort_by onto the repository's sort fields
+func sortFromProto(fields []*taskv1.TaskSortField) ([]domain.TaskSort, error) {
+	sort := make([]domain.TaskSort, 0, len(fields))
+	for _, f := range fields {
+		field := domain.TaskSortField(f.GetField())
+		if !field.Valid() {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot sort by %q", f.GetField())
+		}
+		sort = append(sort, domain.TaskSort{Field: field, Descending: f.GetOrder() == taskv1.SortOrder_SORT_ORDER_DESC})
+	}
+	return sort, nil
+}
+
+// Offset page tokens, opaque to clients, page the compliance listings; task
+// listings page by the repository's cursors.
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	offset, err := strconv.Atoi(string(raw))
+	if err != nil || offset < 0 {
+		return 0, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	return offset, nil
+}
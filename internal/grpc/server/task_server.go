@@ -1,7 +1,474 @@
-//go:build ultra_advanced
-// +build ultra_advanced
-
 package server
 
-// This file is disabled by default. To enable advanced features, build with:
-// go build -tags ultra_advanced ./...
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	taskv1 "github.com/vertikon/mcp-ultra/api/grpc/gen/task/v1"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/events"
+	"github.com/vertikon/mcp-ultra/internal/services"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	maxBatchSize    = 100
+
+	// analyticsScanSize is the page size used when walking tasks for analytics
+	analyticsScanSize = 500
+)
+
+// TaskService is the business API the gRPC adapter relies on.
+// It is satisfied by *services.TaskService.
+type TaskService interface {
+	CreateTask(ctx context.Context, req services.CreateTaskRequest) (*domain.Task, error)
+	GetTask(ctx context.Context, id types.UUID) (*domain.Task, error)
+	UpdateTask(ctx context.Context, id types.UUID, req services.UpdateTaskRequest) (*domain.Task, error)
+	CompleteTask(ctx context.Context, id types.UUID) (*domain.Task, error)
+	DeleteTask(ctx context.Context, id types.UUID) error
+	ListTasks(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, int, error)
+}
+
+// TaskServer implements taskv1.TaskServiceServer on top of the task service
+type TaskServer struct {
+	taskv1.UnimplementedTaskServiceServer
+
+	service TaskService
+	feed    *events.TaskFeed
+	logger  *zap.Logger
+	now     func() time.Time
+}
+
+// NewTaskServer creates a new gRPC task server. A nil feed disables StreamTasks.
+func NewTaskServer(service TaskService, feed *events.TaskFeed, logger *zap.Logger) *TaskServer {
+	return &TaskServer{
+		service: service,
+		feed:    feed,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// CreateTask creates a task owned by the calling user
+func (s *TaskServer) CreateTask(ctx context.Context, req *taskv1.CreateTaskRequest) (*taskv1.CreateTaskResponse, error) {
+	task, err := s.createTask(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &taskv1.CreateTaskResponse{Task: taskToProto(task)}, nil
+}
+
+// GetTask retrieves a task by ID
+func (s *TaskServer) GetTask(ctx context.Context, req *taskv1.GetTaskRequest) (*taskv1.GetTaskResponse, error) {
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.service.GetTask(ctx, id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &taskv1.GetTaskResponse{Task: taskToProto(task)}, nil
+}
+
+// UpdateTask applies the fields selected by update_mask
+func (s *TaskServer) UpdateTask(ctx context.Context, req *taskv1.UpdateTaskRequest) (*taskv1.UpdateTaskResponse, error) {
+	task, err := s.updateTask(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &taskv1.UpdateTaskResponse{Task: taskToProto(task)}, nil
+}
+
+// DeleteTask removes a task
+func (s *TaskServer) DeleteTask(ctx context.Context, req *taskv1.DeleteTaskRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID("id", req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.service.DeleteTask(ctx, id); err != nil {
+		return nil, toStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListTasks lists tasks page by page
+func (s *TaskServer) ListTasks(ctx context.Context, req *taskv1.ListTasksRequest) (*taskv1.ListTasksResponse, error) {
+	filter, err := filterFromProto(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.GetPageSize())
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+	if filter.Sort, err = sortFromProto(req.GetSortBy()); err != nil {
+		return nil, err
+	}
+	// Page tokens are the repository's keyset cursors; one task more than
+	// the page tells whether another page follows
+	filter.After = req.GetPageToken()
+	filter.Limit = pageSize + 1
+	if err := filter.Validate(); err != nil {
+		if errors.Is(err, domain.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tasks, total, err := s.service.ListTasks(ctx, filter)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &taskv1.ListTasksResponse{TotalCount: int32(total)}
+	if len(tasks) > pageSize {
+		tasks = tasks[:pageSize]
+		resp.NextPageToken = filter.NextCursor(tasks[pageSize-1])
+	}
+	resp.Tasks = make([]*taskv1.Task, 0, len(tasks))
+	for _, t := range tasks {
+		resp.Tasks = append(resp.Tasks, taskToProto(t))
+	}
+	return resp, nil
+}
+
+// BatchCreateTasks creates each task independently; failures are reported per index
+func (s *TaskServer) BatchCreateTasks(ctx context.Context, req *taskv1.BatchCreateTasksRequest) (*taskv1.BatchCreateTasksResponse, error) {
+	if err := checkBatchSize(len(req.GetRequests())); err != nil {
+		return nil, err
+	}
+
+	resp := &taskv1.BatchCreateTasksResponse{}
+	for i, r := range req.GetRequests() {
+		task, err := s.createTask(ctx, r)
+		if err != nil {
+			resp.Errors = append(resp.Errors, batchError(i, err))
+			continue
+		}
+		resp.Responses = append(resp.Responses, &taskv1.CreateTaskResponse{Task: taskToProto(task)})
+	}
+	return resp, nil
+}
+
+// BatchUpdateTasks updates each task independently; failures are reported per index
+func (s *TaskServer) BatchUpdateTasks(ctx context.Context, req *taskv1.BatchUpdateTasksRequest) (*taskv1.BatchUpdateTasksResponse, error) {
+	if err := checkBatchSize(len(req.GetRequests())); err != nil {
+		return nil, err
+	}
+
+	resp := &taskv1.BatchUpdateTasksResponse{}
+	for i, r := range req.GetRequests() {
+		task, err := s.updateTask(ctx, r)
+		if err != nil {
+			resp.Errors = append(resp.Errors, batchError(i, err))
+			continue
+		}
+		resp.Responses = append(resp.Responses, &taskv1.UpdateTaskResponse{Task: taskToProto(task)})
+	}
+	return resp, nil
+}
+
+// BatchDeleteTasks validates every ID up front, then deletes in order and
+// stops at the first failure.
+func (s *TaskServer) BatchDeleteTasks(ctx context.Context, req *taskv1.BatchDeleteTasksRequest) (*emptypb.Empty, error) {
+	if err := checkBatchSize(len(req.GetIds())); err != nil {
+		return nil, err
+	}
+
+	ids := make([]types.UUID, 0, len(req.GetIds()))
+	for _, raw := range req.GetIds() {
+		id, err := parseUUID("id", raw)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	for _, id := range ids {
+		if err := s.service.DeleteTask(ctx, id); err != nil {
+			st := status.Convert(toStatus(err))
+			return nil, status.Errorf(st.Code(), "deleting task %s: %s", id, st.Message())
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetTaskAnalytics computes the requested metrics over the matching tasks.
+// With no metrics selected every metric is returned.
+func (s *TaskServer) GetTaskAnalytics(ctx context.Context, req *taskv1.GetTaskAnalyticsRequest) (*taskv1.GetTaskAnalyticsResponse, error) {
+	filter, err := filterFromProto(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	if r := req.GetDateRange(); r != nil {
+		filter.FromDate = optionalTime(r.GetStart())
+		filter.ToDate = optionalTime(r.GetEnd())
+	}
+
+	tasks, err := s.scanTasks(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := req.GetMetrics()
+	if len(metrics) == 0 {
+		for v := range taskv1.AnalyticsMetric_name {
+			if m := taskv1.AnalyticsMetric(v); m != taskv1.AnalyticsMetric_ANALYTICS_METRIC_UNSPECIFIED {
+				metrics = append(metrics, m)
+			}
+		}
+		sort.Slice(metrics, func(i, j int) bool { return metrics[i] < metrics[j] })
+	}
+
+	resp := &taskv1.GetTaskAnalyticsResponse{}
+	for _, m := range metrics {
+		result, err := computeMetric(m, tasks, s.now())
+		if err != nil {
+			return nil, err
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp, nil
+}
+
+func (s *TaskServer) createTask(ctx context.Context, req *taskv1.CreateTaskRequest) (*domain.Task, error) {
+	createdBy, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createReq, err := createRequestFromProto(req.GetTask(), createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.service.CreateTask(ctx, createReq)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return task, nil
+}
+
+func (s *TaskServer) updateTask(ctx context.Context, req *taskv1.UpdateTaskRequest) (*domain.Task, error) {
+	if req.GetTask() == nil {
+		return nil, status.Error(codes.InvalidArgument, "task is required")
+	}
+	id, err := parseUUID("task.id", req.GetTask().GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	update, complete, err := updateRequestFromProto(req.GetTask(), req.GetUpdateMask())
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.service.UpdateTask(ctx, id, update)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if complete && task.Status != domain.TaskStatusCompleted {
+		task, err = s.service.CompleteTask(ctx, id)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+	}
+	return task, nil
+}
+
+// scanTasks walks every page of tasks matching the filter
+func (s *TaskServer) scanTasks(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, error) {
+	filter.Limit = analyticsScanSize
+	filter.Offset = 0
+	filter.Count = domain.TaskCountNone
+
+	var all []*domain.Task
+	for {
+		page, _, err := s.service.ListTasks(ctx, filter)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		all = append(all, page...)
+		if len(page) < filter.Limit {
+			return all, nil
+		}
+		filter.After = filter.NextCursor(page[len(page)-1])
+	}
+}
+
+// userIDFromContext returns the authenticated caller, who becomes
+// created_by on new tasks
+func userIDFromContext(ctx context.Context) (types.UUID, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return types.Nil, err
+	}
+	return parseUUID("user_id", userID)
+}
+
+func checkBatchSize(n int) error {
+	if n == 0 || n > maxBatchSize {
+		return status.Errorf(codes.InvalidArgument, "batch must contain between 1 and %d items, got %d", maxBatchSize, n)
+	}
+	return nil
+}
+
+func batchError(index int, err error) *taskv1.BatchError {
+	st := status.Convert(err)
+	return &taskv1.BatchError{
+		Index:   int32(index),
+		Code:    st.Code().String(),
+		Message: st.Message(),
+	}
+}
+
+// toStatus maps service errors onto gRPC codes. The service layer reports
+// failures as wrapped strings, so the mapping keys off their wording.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	msg := err.Error()
+	switch {
+	case strings.HasPrefix(msg, "invalid request"):
+		return status.Error(codes.InvalidArgument, msg)
+	case strings.Contains(msg, "not found"):
+		return status.Error(codes.NotFound, msg)
+	case strings.HasPrefix(msg, "cannot "):
+		return status.Error(codes.FailedPrecondition, msg)
+	default:
+		return status.Error(codes.Internal, msg)
+	}
+}
+
+func computeMetric(metric taskv1.AnalyticsMetric, tasks []*domain.Task, now time.Time) (*taskv1.AnalyticsResult, error) {
+	result := &taskv1.AnalyticsResult{Metric: metric, Values: map[string]float64{}}
+
+	switch metric {
+	case taskv1.AnalyticsMetric_ANALYTICS_METRIC_TASK_COUNT:
+		result.Values["total"] = float64(len(tasks))
+		result.DataPoints = dailyCounts(tasks)
+
+	case taskv1.AnalyticsMetric_ANALYTICS_METRIC_COMPLETION_RATE:
+		completed := 0
+		for _, t := range tasks {
+			if t.Status == domain.TaskStatusCompleted {
+				completed++
+			}
+		}
+		result.Values["completed"] = float64(completed)
+		result.Values["total"] = float64(len(tasks))
+		result.Values["rate"] = ratio(completed, len(tasks))
+
+	case taskv1.AnalyticsMetric_ANALYTICS_METRIC_AVERAGE_COMPLETION_TIME:
+		var sum time.Duration
+		n := 0
+		for _, t := range tasks {
+			if t.CompletedAt != nil {
+				sum += t.CompletedAt.Sub(t.CreatedAt)
+				n++
+			}
+		}
+		result.Values["completed"] = float64(n)
+		result.Values["average_seconds"] = 0
+		if n > 0 {
+			result.Values["average_seconds"] = (sum / time.Duration(n)).Seconds()
+		}
+
+	case taskv1.AnalyticsMetric_ANALYTICS_METRIC_OVERDUE_TASKS:
+		overdue := 0
+		for _, t := range tasks {
+			if isOpen(t) && t.DueDate != nil && t.DueDate.Before(now) {
+				overdue++
+			}
+		}
+		result.Values["overdue"] = float64(overdue)
+
+	case taskv1.AnalyticsMetric_ANALYTICS_METRIC_TASKS_BY_STATUS:
+		for _, t := range tasks {
+			result.Values[string(t.Status)]++
+		}
+
+	case taskv1.AnalyticsMetric_ANALYTICS_METRIC_TASKS_BY_PRIORITY:
+		for _, t := range tasks {
+			result.Values[string(t.Priority)]++
+		}
+
+	case taskv1.AnalyticsMetric_ANALYTICS_METRIC_WORKLOAD_BY_ASSIGNEE:
+		for _, t := range tasks {
+			if !isOpen(t) {
+				continue
+			}
+			key := "unassigned"
+			if t.AssigneeID != nil {
+				key = t.AssigneeID.String()
+			}
+			result.Values[key]++
+		}
+
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported analytics metric %s", metric)
+	}
+
+	return result, nil
+}
+
+func isOpen(t *domain.Task) bool {
+	return t.Status == domain.TaskStatusPending || t.Status == domain.TaskStatusInProgress
+}
+
+func ratio(part, whole int) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return float64(part) / float64(whole)
+}
+
+// dailyCounts buckets task creation per UTC day
+func dailyCounts(tasks []*domain.Task) []*taskv1.AnalyticsDataPoint {
+	buckets := make(map[time.Time]int)
+	for _, t := range tasks {
+		buckets[t.CreatedAt.UTC().Truncate(24*time.Hour)]++
+	}
+
+	days := make([]time.Time, 0, len(buckets))
+	for d := range buckets {
+		days = append(days, d)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+	points := make([]*taskv1.AnalyticsDataPoint, 0, len(days))
+	for _, d := range days {
+		points = append(points, &taskv1.AnalyticsDataPoint{
+			Timestamp: timestamppb.New(d),
+			Value:     float64(buckets[d]),
+		})
+	}
+	return points
+}
@@ -0,0 +1,259 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	taskv1 "github.com/vertikon/mcp-ultra/api/grpc/gen/task/v1"
+	"github.com/vertikon/mcp-ultra/internal/config"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/events"
+	"github.com/vertikon/mcp-ultra/internal/repository/memory"
+	"github.com/vertikon/mcp-ultra/internal/services"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+type taskFixture struct {
+	client taskv1.TaskServiceClient
+	user   *domain.User
+	feed   *events.TaskFeed
+}
+
+func newTaskFixture(t *testing.T) *taskFixture {
+	t.Helper()
+	logger := zaptest.NewLogger(t)
+
+	users := memory.NewUserRepository()
+	user := &domain.User{ID: types.New(), Email: "owner@example.com", Name: "Owner", Role: domain.RoleUser, Active: true}
+	require.NoError(t, users.Create(context.Background(), user))
+
+	feed := events.NewTaskFeed(16, logger)
+	t.Cleanup(feed.Close)
+	bus := memory.NewEventBus()
+	bus.Subscribe(func(ctx context.Context, e *domain.Event) { _ = feed.Handle(ctx, e) })
+
+	svc := services.NewTaskService(
+		memory.NewTaskRepository(),
+		users,
+		memory.NewEventRepository(),
+		memory.NewCacheRepository(),
+		logger,
+		bus,
+	)
+
+	auth := tokenAuthenticator{"owner-token": {UserID: user.ID.String(), Role: string(domain.RoleUser)}}
+	srv := NewServer(config.GRPCConfig{ShutdownTimeout: time.Second}, logger, AuthInterceptors(auth)...)
+	taskv1.RegisterTaskServiceServer(srv, NewTaskServer(svc, feed, logger))
+
+	conn := serveBufconn(t, srv)
+	return &taskFixture{client: taskv1.NewTaskServiceClient(conn), user: user, feed: feed}
+}
+
+func (f *taskFixture) ctx() context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer owner-token")
+}
+
+func TestTaskServer_CreateAndGet(t *testing.T) {
+	f := newTaskFixture(t)
+
+	created, err := f.client.CreateTask(f.ctx(), &taskv1.CreateTaskRequest{
+		Task: &taskv1.Task{
+			Title:    "Write report",
+			Priority: taskv1.TaskPriority_TASK_PRIORITY_HIGH,
+			Tags:     []string{"docs"},
+		},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.Task.Id)
+	assert.Equal(t, taskv1.TaskStatus_TASK_STATUS_PENDING, created.Task.Status)
+	assert.Equal(t, taskv1.TaskPriority_TASK_PRIORITY_HIGH, created.Task.Priority)
+
+	got, err := f.client.GetTask(f.ctx(), &taskv1.GetTaskRequest{Id: created.Task.Id})
+	require.NoError(t, err)
+	assert.Equal(t, "Write report", got.Task.Title)
+	assert.Equal(t, []string{"docs"}, got.Task.Tags)
+}
+
+func TestTaskServer_CreateTask_Errors(t *testing.T) {
+	f := newTaskFixture(t)
+
+	_, err := f.client.CreateTask(context.Background(), &taskv1.CreateTaskRequest{Task: &taskv1.Task{Title: "x"}})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = f.client.CreateTask(f.ctx(), &taskv1.CreateTaskRequest{Task: &taskv1.Task{}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = f.client.CreateTask(f.ctx(), &taskv1.CreateTaskRequest{
+		Task: &taskv1.Task{Title: "x", Priority: taskv1.TaskPriority_TASK_PRIORITY_CRITICAL},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = f.client.GetTask(f.ctx(), &taskv1.GetTaskRequest{Id: types.New().String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestTaskServer_UpdateTask_WithMask(t *testing.T) {
+	f := newTaskFixture(t)
+
+	created, err := f.client.CreateTask(f.ctx(), &taskv1.CreateTaskRequest{
+		Task: &taskv1.Task{Title: "Original", Description: "keep me"},
+	})
+	require.NoError(t, err)
+
+	updated, err := f.client.UpdateTask(f.ctx(), &taskv1.UpdateTaskRequest{
+		Task:       &taskv1.Task{Id: created.Task.Id, Title: "Renamed", Description: "ignored"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", updated.Task.Title)
+	assert.Equal(t, "keep me", updated.Task.Description)
+
+	_, err = f.client.UpdateTask(f.ctx(), &taskv1.UpdateTaskRequest{
+		Task:       &taskv1.Task{Id: created.Task.Id},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"created_at"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTaskServer_ListTasks_Paginates(t *testing.T) {
+	f := newTaskFixture(t)
+
+	for i := 0; i < 5; i++ {
+		_, err := f.client.CreateTask(f.ctx(), &taskv1.CreateTaskRequest{Task: &taskv1.Task{Title: "task"}})
+		require.NoError(t, err)
+	}
+
+	seen := map[string]bool{}
+	token := ""
+	pages := 0
+	for {
+		resp, err := f.client.ListTasks(f.ctx(), &taskv1.ListTasksRequest{PageSize: 2, PageToken: token})
+		require.NoError(t, err)
+		assert.EqualValues(t, 5, resp.TotalCount)
+		for _, task := range resp.Tasks {
+			seen[task.Id] = true
+		}
+		pages++
+		if resp.NextPageToken == "" {
+			break
+		}
+		token = resp.NextPageToken
+	}
+	assert.Equal(t, 3, pages)
+	assert.Len(t, seen, 5)
+
+	_, err := f.client.ListTasks(f.ctx(), &taskv1.ListTasksRequest{PageToken: "%%%"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTaskServer_ListTasks_SortsAndSearches(t *testing.T) {
+	f := newTaskFixture(t)
+
+	for _, title := range []string{"beta release", "alpha release", "gamma notes"} {
+		_, err := f.client.CreateTask(f.ctx(), &taskv1.CreateTaskRequest{Task: &taskv1.Task{Title: title}})
+		require.NoError(t, err)
+	}
+
+	resp, err := f.client.ListTasks(f.ctx(), &taskv1.ListTasksRequest{
+		Filter: &taskv1.TaskFilter{SearchQuery: "release"},
+		SortBy: []*taskv1.TaskSortField{{Field: "title", Order: taskv1.SortOrder_SORT_ORDER_ASC}},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Tasks, 2)
+	assert.Equal(t, "alpha release", resp.Tasks[0].Title)
+	assert.Equal(t, "beta release", resp.Tasks[1].Title)
+
+	first, err := f.client.ListTasks(f.ctx(), &taskv1.ListTasksRequest{PageSize: 1})
+	require.NoError(t, err)
+	_, err = f.client.ListTasks(f.ctx(), &taskv1.ListTasksRequest{
+		PageToken: first.NextPageToken,
+		SortBy:    []*taskv1.TaskSortField{{Field: "title"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "a token only resumes its own sort order")
+
+	_, err = f.client.ListTasks(f.ctx(), &taskv1.ListTasksRequest{SortBy: []*taskv1.TaskSortField{{Field: "assignee"}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTaskServer_BatchOperations(t *testing.T) {
+	f := newTaskFixture(t)
+
+	created, err := f.client.BatchCreateTasks(f.ctx(), &taskv1.BatchCreateTasksRequest{
+		Requests: []*taskv1.CreateTaskRequest{
+			{Task: &taskv1.Task{Title: "one"}},
+			{Task: &taskv1.Task{}},
+			{Task: &taskv1.Task{Title: "three"}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, created.Responses, 2)
+	require.Len(t, created.Errors, 1)
+	assert.EqualValues(t, 1, created.Errors[0].Index)
+	assert.Equal(t, codes.InvalidArgument.String(), created.Errors[0].Code)
+
+	updated, err := f.client.BatchUpdateTasks(f.ctx(), &taskv1.BatchUpdateTasksRequest{
+		Requests: []*taskv1.UpdateTaskRequest{
+			{Task: &taskv1.Task{Id: created.Responses[0].Task.Id, Title: "uno"}},
+			{Task: &taskv1.Task{Id: types.New().String(), Title: "missing"}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, updated.Responses, 1)
+	assert.Equal(t, "uno", updated.Responses[0].Task.Title)
+	require.Len(t, updated.Errors, 1)
+	assert.Equal(t, codes.NotFound.String(), updated.Errors[0].Code)
+
+	_, err = f.client.BatchDeleteTasks(f.ctx(), &taskv1.BatchDeleteTasksRequest{
+		Ids: []string{created.Responses[0].Task.Id, created.Responses[1].Task.Id},
+	})
+	require.NoError(t, err)
+
+	list, err := f.client.ListTasks(f.ctx(), &taskv1.ListTasksRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, list.Tasks)
+
+	_, err = f.client.BatchDeleteTasks(f.ctx(), &taskv1.BatchDeleteTasksRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTaskServer_GetTaskAnalytics(t *testing.T) {
+	f := newTaskFixture(t)
+
+	for _, p := range []taskv1.TaskPriority{
+		taskv1.TaskPriority_TASK_PRIORITY_LOW,
+		taskv1.TaskPriority_TASK_PRIORITY_HIGH,
+		taskv1.TaskPriority_TASK_PRIORITY_HIGH,
+	} {
+		_, err := f.client.CreateTask(f.ctx(), &taskv1.CreateTaskRequest{Task: &taskv1.Task{Title: "t", Priority: p}})
+		require.NoError(t, err)
+	}
+
+	resp, err := f.client.GetTaskAnalytics(f.ctx(), &taskv1.GetTaskAnalyticsRequest{
+		Metrics: []taskv1.AnalyticsMetric{
+			taskv1.AnalyticsMetric_ANALYTICS_METRIC_TASK_COUNT,
+			taskv1.AnalyticsMetric_ANALYTICS_METRIC_TASKS_BY_PRIORITY,
+			taskv1.AnalyticsMetric_ANALYTICS_METRIC_COMPLETION_RATE,
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+
+	assert.Equal(t, 3.0, resp.Results[0].Values["total"])
+	assert.Len(t, resp.Results[0].DataPoints, 1)
+	assert.Equal(t, 2.0, resp.Results[1].Values["high"])
+	assert.Equal(t, 1.0, resp.Results[1].Values["low"])
+	assert.Equal(t, 0.0, resp.Results[2].Values["rate"])
+
+	all, err := f.client.GetTaskAnalytics(f.ctx(), &taskv1.GetTaskAnalyticsRequest{})
+	require.NoError(t, err)
+	assert.Len(t, all.Results, len(taskv1.AnalyticsMetric_name)-1)
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	taskv1 "github.com/vertikon/mcp-ultra/api/grpc/gen/task/v1"
+	"github.com/vertikon/mcp-ultra/internal/events"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// eventTypeToProto maps domain event types onto the stream event enum
+var eventTypeToProto = map[string]taskv1.TaskEventType{
+	"task.created":   taskv1.TaskEventType_TASK_EVENT_TYPE_CREATED,
+	"task.updated":   taskv1.TaskEventType_TASK_EVENT_TYPE_UPDATED,
+	"task.deleted":   taskv1.TaskEventType_TASK_EVENT_TYPE_DELETED,
+	"task.completed": taskv1.TaskEventType_TASK_EVENT_TYPE_STATUS_CHANGED,
+}
+
+// StreamTasks sends matching task events as they happen. With since_version
+// set, buffered events after that version are replayed first.
+func (s *TaskServer) StreamTasks(req *taskv1.StreamTasksRequest, stream grpc.ServerStreamingServer[taskv1.StreamTasksResponse]) error {
+	if s.feed == nil {
+		return status.Error(codes.Unavailable, "task stream is not enabled")
+	}
+
+	filter, err := feedFilterFromProto(req)
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	sub, err := s.feed.Subscribe(ctx, req.GetSinceVersion())
+	if err != nil {
+		return feedStatus(err, req.GetSinceVersion())
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+
+		case e, ok := <-sub.Events():
+			if !ok {
+				if err := sub.Err(); err != nil {
+					return feedStatus(err, req.GetSinceVersion())
+				}
+				return nil
+			}
+			if !filter.Matches(e) {
+				continue
+			}
+			if err := stream.Send(&taskv1.StreamTasksResponse{Event: feedEventToProto(e)}); err != nil {
+				s.logger.Debug("Task stream send failed", zap.Error(err))
+				return err
+			}
+		}
+	}
+}
+
+func feedFilterFromProto(req *taskv1.StreamTasksRequest) (events.TaskFeedFilter, error) {
+	var filter events.TaskFeedFilter
+
+	for _, t := range req.GetEventTypes() {
+		matched := false
+		for name, pt := range eventTypeToProto {
+			if pt == t {
+				filter.EventTypes = append(filter.EventTypes, name)
+				matched = true
+			}
+		}
+		if !matched {
+			return filter, status.Errorf(codes.InvalidArgument, "unsupported event type %s", t)
+		}
+	}
+
+	f := req.GetFilter()
+	if f == nil {
+		return filter, nil
+	}
+	for _, st := range f.GetStatus() {
+		ds, err := statusFromProto(st)
+		if err != nil {
+			return filter, err
+		}
+		filter.Status = append(filter.Status, ds)
+	}
+	for _, p := range f.GetPriority() {
+		if p == taskv1.TaskPriority_TASK_PRIORITY_UNSPECIFIED {
+			continue
+		}
+		dp, err := priorityFromProto(p)
+		if err != nil {
+			return filter, err
+		}
+		filter.Priority = append(filter.Priority, dp)
+	}
+	for _, raw := range f.GetAssigneeIds() {
+		id, err := parseUUID("assignee_ids", raw)
+		if err != nil {
+			return filter, err
+		}
+		filter.AssigneeIDs = append(filter.AssigneeIDs, id)
+	}
+	filter.Tags = f.GetTags()
+	filter.Query = f.GetSearchQuery()
+	return filter, nil
+}
+
+func feedEventToProto(e events.TaskFeedEvent) *taskv1.TaskEvent {
+	pb := &taskv1.TaskEvent{
+		Type:      eventTypeToProto[e.Event.Type],
+		Timestamp: timestamppb.New(e.Event.OccurredAt),
+		Version:   e.Version,
+		EventId:   e.Event.ID.String(),
+	}
+	if e.Task != nil {
+		pb.Task = taskToProto(e.Task)
+	} else if e.Event.AggregateID != types.Nil {
+		pb.Task = &taskv1.Task{Id: e.Event.AggregateID.String()}
+	}
+	return pb
+}
+
+func feedStatus(err error, since uint64) error {
+	switch {
+	case errors.Is(err, events.ErrVersionExpired):
+		return status.Errorf(codes.OutOfRange, "since_version %d is no longer available; reload tasks and stream from 0", since)
+	case errors.Is(err, events.ErrSlowConsumer):
+		return status.Error(codes.ResourceExhausted, "stream fell behind; resume from the last received version")
+	case errors.Is(err, events.ErrFeedClosed):
+		return status.Error(codes.Unavailable, "task stream is shutting down")
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
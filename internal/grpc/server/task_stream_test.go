@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	taskv1 "github.com/vertikon/mcp-ultra/api/grpc/gen/task/v1"
+)
+
+func TestTaskServer_StreamTasks(t *testing.T) {
+	f := newTaskFixture(t)
+	ctx, cancel := context.WithTimeout(f.ctx(), 5*time.Second)
+	defer cancel()
+
+	seed, err := f.client.CreateTask(f.ctx(), &taskv1.CreateTaskRequest{Task: &taskv1.Task{Title: "seed"}})
+	require.NoError(t, err)
+	// Resuming from a version avoids racing the server-side subscription
+	since := f.feed.Version()
+
+	stream, err := f.client.StreamTasks(ctx, &taskv1.StreamTasksRequest{
+		SinceVersion: since,
+		Filter:       &taskv1.TaskFilter{Priority: []taskv1.TaskPriority{taskv1.TaskPriority_TASK_PRIORITY_HIGH}},
+	})
+	require.NoError(t, err)
+
+	_, err = f.client.CreateTask(f.ctx(), &taskv1.CreateTaskRequest{Task: &taskv1.Task{Title: "low", Priority: taskv1.TaskPriority_TASK_PRIORITY_LOW}})
+	require.NoError(t, err)
+	high, err := f.client.CreateTask(f.ctx(), &taskv1.CreateTaskRequest{Task: &taskv1.Task{Title: "high", Priority: taskv1.TaskPriority_TASK_PRIORITY_HIGH}})
+	require.NoError(t, err)
+
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, taskv1.TaskEventType_TASK_EVENT_TYPE_CREATED, resp.Event.Type)
+	assert.Equal(t, high.Task.Id, resp.Event.Task.Id)
+	assert.Equal(t, since+2, resp.Event.Version)
+	assert.NotEmpty(t, resp.Event.EventId)
+
+	// Resuming again replays everything buffered since, deletions included
+	_, err = f.client.DeleteTask(f.ctx(), &taskv1.DeleteTaskRequest{Id: seed.Task.Id})
+	require.NoError(t, err)
+
+	resumed, err := f.client.StreamTasks(ctx, &taskv1.StreamTasksRequest{SinceVersion: since})
+	require.NoError(t, err)
+	var got []taskv1.TaskEventType
+	for i := 0; i < 3; i++ {
+		resp, err := resumed.Recv()
+		require.NoError(t, err)
+		got = append(got, resp.Event.Type)
+	}
+	assert.Equal(t, taskv1.TaskEventType_TASK_EVENT_TYPE_DELETED, got[2])
+}
+
+func TestTaskServer_StreamTasks_ExpiredVersion(t *testing.T) {
+	f := newTaskFixture(t)
+
+	stream, err := f.client.StreamTasks(f.ctx(), &taskv1.StreamTasksRequest{SinceVersion: 1})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+}
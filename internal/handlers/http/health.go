@@ -9,12 +9,13 @@ import (
 	"sync"
 	"time"
 
-	"github.com/go-chi/chi/v5"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/pkg/httpx"
 )
 
 type HealthStatus string
@@ -94,7 +95,9 @@ func (h *HealthService) RegisterChecker(name string, checker HealthChecker) {
 	h.checkers[name] = checker
 }
 
-func (h *HealthService) RegisterRoutes(r chi.Router) {
+var _ HealthServiceInterface = (*HealthService)(nil)
+
+func (h *HealthService) RegisterRoutes(r httpx.Router) {
 	r.Get("/health", h.HealthHandler)
 	r.Get("/healthz", h.HealthzHandler)
 	r.Get("/ready", h.ReadinessHandler)
@@ -8,16 +8,17 @@ import (
 	"testing"
 	"time"
 
-	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/pkg/httpx"
 )
 
 func TestHealthService_RegisterRoutes(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	service := NewHealthService("v1.0.0", "test", logger)
 
-	r := chi.NewRouter()
+	r := httpx.NewRouter()
 	service.RegisterRoutes(r)
 
 	// Test that all routes are registered
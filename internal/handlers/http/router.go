@@ -21,7 +21,7 @@ type TaskService interface {
 	GetTask(ctx context.Context, taskID types.UUID) (*domain.Task, error)
 	UpdateTask(ctx context.Context, taskID types.UUID, req services.UpdateTaskRequest) (*domain.Task, error)
 	DeleteTask(ctx context.Context, taskID types.UUID) error
-	ListTasks(ctx context.Context, filters domain.TaskFilter) (*domain.TaskList, error)
+	ListTasks(ctx context.Context, filters domain.TaskFilter) ([]*domain.Task, int, error)
 	CompleteTask(ctx context.Context, taskID types.UUID) (*domain.Task, error)
 	GetTasksByStatus(ctx context.Context, status domain.TaskStatus) ([]*domain.Task, error)
 	GetTasksByAssignee(ctx context.Context, assigneeID types.UUID) ([]*domain.Task, error)
@@ -32,11 +32,17 @@ type HealthServiceInterface interface {
 	RegisterRoutes(r httpx.Router)
 }
 
-// Router creates and configures the HTTP router
+var _ TaskService = (*services.TaskService)(nil)
+
+// Router creates and configures the HTTP router. authenticate guards the
+// /api/v1 routes, putting the caller's user and tenant into the request
+// context; nil leaves them open.
 func NewRouter(
 	taskService TaskService,
+	taskFeed TaskFeed,
 	flagManager *features.FlagManager,
 	healthService HealthServiceInterface,
+	authenticate func(http.Handler) http.Handler,
 	logger *zap.Logger,
 ) httpx.Router {
 	r := httpx.NewRouter()
@@ -63,8 +69,12 @@ func NewRouter(
 
 	// API routes
 	r.Route("/api/v1", func(r httpx.Router) {
+		if authenticate != nil {
+			r.Use(authenticate)
+		}
+
 		// Task routes
-		r.Mount("/tasks", TaskRoutes(taskService, logger))
+		r.Mount("/tasks", TaskRoutes(taskService, taskFeed, logger))
 
 		// Feature flag routes
 		r.Mount("/flags", FeatureFlagRoutes(flagManager, logger))
@@ -74,10 +84,15 @@ func NewRouter(
 }
 
 // TaskRoutes creates task-related routes
-func TaskRoutes(taskService TaskService, logger *zap.Logger) httpx.Router {
+func TaskRoutes(taskService TaskService, taskFeed TaskFeed, logger *zap.Logger) httpx.Router {
 	r := httpx.NewRouter()
 	handlers := NewTaskHandlers(taskService, logger)
 
+	// Registered before /{id} so "stream" is not taken for a task ID
+	if taskFeed != nil {
+		r.Get("/stream", NewTaskStreamHandler(taskFeed, logger).StreamTasks)
+	}
+
 	r.Post("/", handlers.CreateTask)
 	r.Get("/", handlers.ListTasks)
 	r.Get("/{id}", handlers.GetTask)
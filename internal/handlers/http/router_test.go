@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/security"
 	"github.com/vertikon/mcp-ultra/internal/services"
 	"github.com/vertikon/mcp-ultra/pkg/httpx"
 	"github.com/vertikon/mcp-ultra/pkg/types"
@@ -42,8 +43,40 @@ func (m *MockHealthService) RegisterChecker(name string, checker services.Health
 	m.Called(name, checker)
 }
 
+// RegisterRoutes serves /health, /ready and /live from the mocked checks
 func (m *MockHealthService) RegisterRoutes(r httpx.Router) {
 	m.Called(r)
+	r.Get("/health", func(w http.ResponseWriter, req *http.Request) {
+		checks := m.Check(req.Context())
+		status := http.StatusOK
+		for _, check := range checks {
+			if check.Status != "healthy" {
+				status = http.StatusServiceUnavailable
+			}
+		}
+		writeMockJSON(w, status, checks)
+	})
+	r.Get("/ready", func(w http.ResponseWriter, req *http.Request) {
+		ready := m.IsReady(req.Context())
+		writeMockJSON(w, probeStatus(ready), map[string]bool{"ready": ready})
+	})
+	r.Get("/live", func(w http.ResponseWriter, req *http.Request) {
+		live := m.IsLive(req.Context())
+		writeMockJSON(w, probeStatus(live), map[string]bool{"live": live})
+	})
+}
+
+func probeStatus(ok bool) int {
+	if ok {
+		return http.StatusOK
+	}
+	return http.StatusServiceUnavailable
+}
+
+func writeMockJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
 }
 
 // MockTaskService for testing
@@ -74,9 +107,9 @@ func (m *MockTaskService) DeleteTask(ctx context.Context, taskID types.UUID) err
 	return args.Error(0)
 }
 
-func (m *MockTaskService) ListTasks(ctx context.Context, filters domain.TaskFilter) (*domain.TaskList, error) {
+func (m *MockTaskService) ListTasks(ctx context.Context, filters domain.TaskFilter) ([]*domain.Task, int, error) {
 	args := m.Called(ctx, filters)
-	return args.Get(0).(*domain.TaskList), args.Error(1)
+	return args.Get(0).([]*domain.Task), args.Int(1), args.Error(2)
 }
 
 func (m *MockTaskService) CompleteTask(ctx context.Context, taskID types.UUID) (*domain.Task, error) {
@@ -110,12 +143,37 @@ func TestNewRouter(t *testing.T) {
 	// Expect RegisterRoutes to be called during router initialization
 	mockHealthService.On("RegisterRoutes", mock.Anything).Return()
 
-	router := NewRouter(mockTaskService, nil, mockHealthService, logger)
+	router := NewRouter(mockTaskService, nil, nil, mockHealthService, nil, logger)
 
 	assert.NotNil(t, router)
 	mockHealthService.AssertExpectations(t)
 }
 
+func TestNewRouter_AuthenticateGuardsAPI(t *testing.T) {
+	mockHealthService := &MockHealthService{}
+	mockHealthService.On("RegisterRoutes", mock.Anything).Return()
+	mockHealthService.On("IsLive", mock.Anything).Return(true)
+	reject := func(http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		})
+	}
+
+	router := NewRouter(&MockTaskService{}, nil, nil, mockHealthService, reject, zap.NewNop())
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/tasks", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/live", nil))
+	assert.Equal(t, http.StatusOK, w.Code, "probes stay open")
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/tasks/stream", nil))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
 func TestRouter_HealthEndpoints(t *testing.T) {
 	logger := zap.NewNop()
 	mockHealthService := &MockHealthService{}
@@ -189,7 +247,7 @@ func TestRouter_HealthEndpoints(t *testing.T) {
 
 			tt.setupMock()
 
-			router := NewRouter(mockTaskService, nil, mockHealthService, logger)
+			router := NewRouter(mockTaskService, nil, nil, mockHealthService, nil, logger)
 			req := httptest.NewRequest(http.MethodGet, tt.endpoint, nil)
 			w := httptest.NewRecorder()
 
@@ -214,7 +272,7 @@ func TestRouter_TaskEndpoints(t *testing.T) {
 	// Expect RegisterRoutes to be called during router initialization
 	mockHealthService.On("RegisterRoutes", mock.Anything).Return()
 
-	router := NewRouter(mockTaskService, nil, mockHealthService, logger)
+	router := NewRouter(mockTaskService, nil, nil, mockHealthService, nil, logger)
 
 	t.Run("POST /tasks - create task", func(t *testing.T) {
 		creatorID := types.New()
@@ -280,15 +338,14 @@ func TestRouter_TaskEndpoints(t *testing.T) {
 	})
 
 	t.Run("PUT /tasks/:id - update task", func(t *testing.T) {
-		taskUUID := types.MustParse("00000000-0000-0000-0000-000000000123")
-		taskID := taskUUID.String()
+		taskID := types.MustParse("00000000-0000-0000-0000-000000000123")
 		updateRequest := services.UpdateTaskRequest{
 			Title:       ptr("Updated Task"),
 			Description: ptr("Updated Description"),
 		}
 
 		expectedTask := &domain.Task{
-			ID:     taskUUID,
+			ID:     taskID,
 			Title:  "Updated Task",
 			Status: domain.TaskStatusCompleted,
 		}
@@ -296,7 +353,7 @@ func TestRouter_TaskEndpoints(t *testing.T) {
 		mockTaskService.On("UpdateTask", mock.Anything, taskID, updateRequest).Return(expectedTask, nil)
 
 		body, _ := json.Marshal(updateRequest)
-		req := httptest.NewRequest(http.MethodPut, "/api/v1/tasks/"+taskID, bytes.NewBuffer(body))
+		req := httptest.NewRequest(http.MethodPut, "/api/v1/tasks/"+taskID.String(), bytes.NewBuffer(body))
 		req.Header.Set("Content-Type", "application/json")
 		w := httptest.NewRecorder()
 
@@ -313,12 +370,11 @@ func TestRouter_TaskEndpoints(t *testing.T) {
 	})
 
 	t.Run("DELETE /tasks/:id - delete task", func(t *testing.T) {
-		taskUUID := types.MustParse("00000000-0000-0000-0000-000000000123")
-		taskID := taskUUID.String()
+		taskID := types.MustParse("00000000-0000-0000-0000-000000000123")
 
 		mockTaskService.On("DeleteTask", mock.Anything, taskID).Return(nil)
 
-		req := httptest.NewRequest(http.MethodDelete, "/api/v1/tasks/"+taskID, nil)
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/tasks/"+taskID.String(), nil)
 		w := httptest.NewRecorder()
 
 		router.ServeHTTP(w, req)
@@ -349,14 +405,10 @@ func TestRouter_TaskEndpoints(t *testing.T) {
 			},
 		}
 
-		taskList := &domain.TaskList{
-			Items: tasks,
-			Total: len(tasks),
-		}
-
+		// The default page of 20 asks for one more task to detect a next page
 		mockTaskService.On("ListTasks", mock.Anything, mock.MatchedBy(func(f domain.TaskFilter) bool {
-			return f.Limit == 10
-		})).Return(taskList, nil)
+			return f.Limit == 21
+		})).Return(tasks, len(tasks), nil)
 
 		req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks?status=pending&priority=high", nil)
 		w := httptest.NewRecorder()
@@ -365,16 +417,39 @@ func TestRouter_TaskEndpoints(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, w.Code)
 
-		var response domain.TaskList
+		var response TaskListResponse
 		err := json.Unmarshal(w.Body.Bytes(), &response)
 		assert.NoError(t, err)
-		assert.Equal(t, 2, len(response.Items))
+		assert.Equal(t, 2, len(response.Tasks))
 		assert.Equal(t, 2, response.Total)
+		assert.Equal(t, 20, response.Limit)
+		assert.Empty(t, response.NextCursor, "a short page is the last one")
 
 		mockTaskService.AssertExpectations(t)
 	})
 }
 
+func TestTaskHandlers_CreateTaskUsesCaller(t *testing.T) {
+	mockTaskService := &MockTaskService{}
+	handlers := NewTaskHandlers(mockTaskService, zap.NewNop())
+
+	caller := types.New()
+	want := services.CreateTaskRequest{Title: "Test Task", Priority: domain.PriorityHigh, CreatedBy: caller}
+	mockTaskService.On("CreateTask", mock.Anything, want).Return(&domain.Task{ID: types.New(), Title: "Test Task"}, nil)
+
+	forged := want
+	forged.CreatedBy = types.New()
+	body, _ := json.Marshal(forged)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks", bytes.NewBuffer(body))
+	req = req.WithContext(security.WithClaims(req.Context(), &security.Claims{UserID: caller.String()}))
+	w := httptest.NewRecorder()
+
+	handlers.CreateTask(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	mockTaskService.AssertExpectations(t)
+}
+
 func TestRouter_Middleware(t *testing.T) {
 	logger := zap.NewNop()
 	mockHealthService := &MockHealthService{}
@@ -383,7 +458,7 @@ func TestRouter_Middleware(t *testing.T) {
 	// Expect RegisterRoutes to be called during router initialization
 	mockHealthService.On("RegisterRoutes", mock.Anything).Return()
 
-	router := NewRouter(mockTaskService, nil, mockHealthService, logger)
+	router := NewRouter(mockTaskService, nil, nil, mockHealthService, nil, logger)
 
 	t.Run("CORS headers are set", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodOptions, "/api/v1/tasks", nil)
@@ -393,7 +468,8 @@ func TestRouter_Middleware(t *testing.T) {
 
 		router.ServeHTTP(w, req)
 
-		assert.Equal(t, http.StatusNoContent, w.Code)
+		// go-chi/cors answers preflight requests with 200
+		assert.Equal(t, http.StatusOK, w.Code)
 		assert.Contains(t, w.Header().Get("Access-Control-Allow-Origin"), "*")
 		assert.Contains(t, w.Header().Get("Access-Control-Allow-Methods"), "POST")
 	})
@@ -421,7 +497,7 @@ func TestRouter_ErrorHandling(t *testing.T) {
 	// Expect RegisterRoutes to be called during router initialization
 	mockHealthService.On("RegisterRoutes", mock.Anything).Return()
 
-	router := NewRouter(mockTaskService, nil, mockHealthService, logger)
+	router := NewRouter(mockTaskService, nil, nil, mockHealthService, nil, logger)
 
 	t.Run("404 for non-existent endpoint", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/non-existent", nil)
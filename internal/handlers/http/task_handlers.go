@@ -2,13 +2,16 @@ package http
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/security"
 	"github.com/vertikon/mcp-ultra/internal/services"
 	"github.com/vertikon/mcp-ultra/pkg/httpx"
 	"github.com/vertikon/mcp-ultra/pkg/types"
@@ -28,13 +31,22 @@ func NewTaskHandlers(taskService TaskService, logger *zap.Logger) *TaskHandlers
 	}
 }
 
-// CreateTask handles task creation
+// CreateTask handles task creation. The authenticated caller becomes the
+// creator; created_by in the body only counts when auth is disabled.
 func (h *TaskHandlers) CreateTask(w http.ResponseWriter, r *http.Request) {
 	var req services.CreateTaskRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON", err)
 		return
 	}
+	if claims, err := security.GetUserFromContext(r.Context()); err == nil {
+		createdBy, err := types.Parse(claims.UserID)
+		if err != nil {
+			h.writeErrorResponse(w, http.StatusForbidden, "Token user is not a valid user ID", err)
+			return
+		}
+		req.CreatedBy = createdBy
+	}
 
 	task, err := h.taskService.CreateTask(r.Context(), req)
 	if err != nil {
@@ -129,9 +141,16 @@ func (h *TaskHandlers) DeleteTask(w http.ResponseWriter, r *http.Request) {
 
 // ListTasks handles task listing with filters
 func (h *TaskHandlers) ListTasks(w http.ResponseWriter, r *http.Request) {
-	filter := h.parseTaskFilter(r)
+	filter, err := h.parseTaskFilter(r)
+	if err != nil {
+		h.writeErrorResponse(w, http.StatusBadRequest, "Invalid query parameters", err)
+		return
+	}
 
-	taskList, err := h.taskService.ListTasks(r.Context(), filter)
+	// One task more than the page tells whether another page follows
+	limit := filter.Limit
+	filter.Limit++
+	tasks, total, err := h.taskService.ListTasks(r.Context(), filter)
 	if err != nil {
 		h.logger.Error("Failed to list tasks", zap.Error(err))
 		h.writeErrorResponse(w, http.StatusInternalServerError, "Failed to list tasks", err)
@@ -139,10 +158,14 @@ func (h *TaskHandlers) ListTasks(w http.ResponseWriter, r *http.Request) {
 	}
 
 	response := TaskListResponse{
-		Tasks: taskList.Items,
-		Total: taskList.Total,
-		Page:  filter.Offset/filter.Limit + 1,
-		Limit: filter.Limit,
+		Tasks: tasks,
+		Total: total,
+		Page:  filter.Offset/limit + 1,
+		Limit: limit,
+	}
+	if len(tasks) > limit {
+		response.Tasks = tasks[:limit]
+		response.NextCursor = filter.NextCursor(tasks[limit-1])
 	}
 
 	h.writeJSONResponse(w, http.StatusOK, response)
@@ -182,8 +205,10 @@ func (h *TaskHandlers) GetTasksByAssignee(w http.ResponseWriter, r *http.Request
 	h.writeJSONResponse(w, http.StatusOK, tasks)
 }
 
-// parseTaskFilter parses query parameters into TaskFilter
-func (h *TaskHandlers) parseTaskFilter(r *http.Request) domain.TaskFilter {
+// parseTaskFilter parses query parameters into TaskFilter. Malformed
+// filters are skipped; an invalid sort, cursor, tag_match or count is an
+// error.
+func (h *TaskHandlers) parseTaskFilter(r *http.Request) (domain.TaskFilter, error) {
 	filter := domain.TaskFilter{}
 
 	// Status filter
@@ -238,10 +263,43 @@ func (h *TaskHandlers) parseTaskFilter(r *http.Request) domain.TaskFilter {
 		filter.Offset = 0
 	}
 
-	// Tags filter
+	// Tags filter, matching all tags unless tag_match=any
 	filter.Tags = r.URL.Query()["tags"]
+	switch tagMatch := r.URL.Query().Get("tag_match"); tagMatch {
+	case "", "all":
+	case "any":
+		filter.TagMatch = domain.TagMatchAny
+	default:
+		return filter, fmt.Errorf("invalid tag_match %q: want all or any", tagMatch)
+	}
+
+	// Full-text search on title and description
+	filter.Query = r.URL.Query().Get("search")
+
+	// Sort fields, comma separated, descending with a leading "-":
+	// sort=-priority,created_at
+	if sortParam := r.URL.Query().Get("sort"); sortParam != "" {
+		for _, field := range strings.Split(sortParam, ",") {
+			sort := domain.TaskSort{Field: domain.TaskSortField(strings.TrimPrefix(field, "-"))}
+			sort.Descending = strings.HasPrefix(field, "-")
+			filter.Sort = append(filter.Sort, sort)
+		}
+	}
+
+	// Cursor from a previous page's next_cursor
+	filter.After = r.URL.Query().Get("cursor")
+
+	switch count := r.URL.Query().Get("count"); count {
+	case "", "exact":
+	case "estimated":
+		filter.Count = domain.TaskCountEstimated
+	case "none":
+		filter.Count = domain.TaskCountNone
+	default:
+		return filter, fmt.Errorf("invalid count %q: want exact, estimated or none", count)
+	}
 
-	return filter
+	return filter, filter.Validate()
 }
 
 // writeJSONResponse writes a JSON response
@@ -273,9 +331,12 @@ func (h *TaskHandlers) writeErrorResponse(w http.ResponseWriter, statusCode int,
 // Response types
 type TaskListResponse struct {
 	Tasks []*domain.Task `json:"tasks"`
-	Total int            `json:"total"`
-	Page  int            `json:"page"`
-	Limit int            `json:"limit"`
+	// Total is -1 when the request asked for count=none
+	Total int `json:"total"`
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+	// NextCursor resumes the listing after this page; empty on the last one
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 type ErrorResponse struct {
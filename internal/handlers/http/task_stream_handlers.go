@@ -0,0 +1,166 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/events"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// sseKeepAliveInterval keeps idle streams open through proxies
+const sseKeepAliveInterval = 15 * time.Second
+
+// TaskFeed is the live source of task events behind the stream endpoint;
+// subscriptions are scoped to the tenant of ctx
+type TaskFeed interface {
+	Subscribe(ctx context.Context, since uint64) (*events.TaskSubscription, error)
+}
+
+// TaskStreamHandler serves the task change feed as Server-Sent Events
+type TaskStreamHandler struct {
+	feed   TaskFeed
+	logger *zap.Logger
+}
+
+// NewTaskStreamHandler creates a new task stream handler
+func NewTaskStreamHandler(feed TaskFeed, logger *zap.Logger) *TaskStreamHandler {
+	return &TaskStreamHandler{feed: feed, logger: logger}
+}
+
+// StreamTasks streams task events. Each SSE id is the feed version: browsers
+// send it back as Last-Event-ID when reconnecting, other clients can pass
+// ?since=<version>. Filters: type, status, priority, assignee_id, tags, q.
+func (h *TaskStreamHandler) StreamTasks(w http.ResponseWriter, r *http.Request) {
+	since, err := parseSince(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid resume version", err)
+		return
+	}
+
+	filter, err := parseFeedFilter(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid filter", err)
+		return
+	}
+
+	sub, err := h.feed.Subscribe(r.Context(), since)
+	if err != nil {
+		if errors.Is(err, events.ErrVersionExpired) {
+			h.writeError(w, http.StatusGone, "Resume version no longer available; reload tasks and stream from the live edge", err)
+			return
+		}
+		h.writeError(w, http.StatusServiceUnavailable, "Task stream unavailable", err)
+		return
+	}
+	defer sub.Close()
+
+	rc := http.NewResponseController(w)
+	// The server write timeout would cut the stream short
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		h.logger.Debug("Failed to clear write deadline", zap.Error(err))
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		h.logger.Error("Streaming not supported by response writer", zap.Error(err))
+		return
+	}
+
+	keepAlive := time.NewTicker(sseKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	ctx := r.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+
+		case e, ok := <-sub.Events():
+			if !ok {
+				if err := sub.Err(); err != nil {
+					_, _ = fmt.Fprintf(w, "event: error\ndata: %q\n\n", err.Error())
+					_ = rc.Flush()
+				}
+				return
+			}
+			if !filter.Matches(e) {
+				continue
+			}
+
+			data, err := json.Marshal(e)
+			if err != nil {
+				h.logger.Error("Failed to encode task event", zap.Error(err))
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.Version, e.Event.Type, data); err != nil {
+				return
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func (h *TaskStreamHandler) writeError(w http.ResponseWriter, statusCode int, message string, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if encodeErr := json.NewEncoder(w).Encode(ErrorResponse{Error: message, Details: err.Error(), Code: statusCode}); encodeErr != nil {
+		h.logger.Error("Failed to encode error response", zap.Error(encodeErr))
+	}
+}
+
+// parseSince reads the resume version, preferring the Last-Event-ID header
+func parseSince(r *http.Request) (uint64, error) {
+	raw := r.Header.Get("Last-Event-ID")
+	if raw == "" {
+		raw = r.URL.Query().Get("since")
+	}
+	if raw == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(raw, 10, 64)
+}
+
+func parseFeedFilter(r *http.Request) (events.TaskFeedFilter, error) {
+	q := r.URL.Query()
+	filter := events.TaskFeedFilter{
+		EventTypes: q["type"],
+		Tags:       q["tags"],
+		Query:      q.Get("q"),
+	}
+	for _, s := range q["status"] {
+		filter.Status = append(filter.Status, domain.TaskStatus(s))
+	}
+	for _, p := range q["priority"] {
+		filter.Priority = append(filter.Priority, domain.Priority(p))
+	}
+	for _, raw := range q["assignee_id"] {
+		id, err := types.Parse(raw)
+		if err != nil {
+			return filter, fmt.Errorf("invalid assignee_id %q: %w", raw, err)
+		}
+		filter.AssigneeIDs = append(filter.AssigneeIDs, id)
+	}
+	return filter, nil
+}
@@ -635,3 +635,53 @@ func (r *RedisHealthChecker) Check(ctx context.Context) HealthCheck {
 
 	return check
 }
+
+// FuncHealthChecker adapts a plain function to HealthChecker
+type FuncHealthChecker struct {
+	name     string
+	required bool
+	timeout  time.Duration
+	check    func(ctx context.Context) error
+}
+
+// NewFuncHealthChecker creates a checker reporting healthy while check returns nil
+func NewFuncHealthChecker(name string, required bool, timeout time.Duration, check func(ctx context.Context) error) *FuncHealthChecker {
+	return &FuncHealthChecker{
+		name:     name,
+		required: required,
+		timeout:  timeout,
+		check:    check,
+	}
+}
+
+func (f *FuncHealthChecker) Name() string {
+	return f.name
+}
+
+func (f *FuncHealthChecker) IsRequired() bool {
+	return f.required
+}
+
+func (f *FuncHealthChecker) Timeout() time.Duration {
+	return f.timeout
+}
+
+func (f *FuncHealthChecker) Check(ctx context.Context) HealthCheck {
+	check := HealthCheck{
+		Name:      f.name,
+		Status:    HealthStatusHealthy,
+		Message:   "OK",
+		Timestamp: time.Now(),
+	}
+
+	if err := f.check(ctx); err != nil {
+		check.Status = HealthStatusUnhealthy
+		if !f.required {
+			check.Status = HealthStatusDegraded
+		}
+		check.Message = "check failed"
+		check.Error = err.Error()
+	}
+
+	return check
+}
@@ -179,8 +179,6 @@ func NewManager(
 // RegisterComponent registers a component for lifecycle management
 func (lm *Manager) RegisterComponent(component Component) {
 	lm.mu.Lock()
-	defer lm.mu.Unlock()
-
 	lm.components = append(lm.components, component)
 	lm.componentStates[component.Name()] = ComponentState{
 		Name:        component.Name(),
@@ -190,6 +188,8 @@ func (lm *Manager) RegisterComponent(component Component) {
 		StartTime:   time.Time{},
 		Metadata:    make(map[string]string),
 	}
+	// Unlocked before emitEvent, which takes the lock itself
+	lm.mu.Unlock()
 
 	lm.logger.Info("Component registered",
 		"component", component.Name(),
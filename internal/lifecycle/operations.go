@@ -22,6 +22,7 @@ const (
 	OperationCleanup       OperationType = "cleanup"
 	OperationConfiguration OperationType = "configuration"
 	OperationSecurityPatch OperationType = "security_patch"
+	OperationRetention     OperationType = "retention"
 )
 
 // OperationStatus represents the status of an operation
@@ -118,6 +119,20 @@ type OperationsManager struct {
 	workers    int
 	stopCh     chan struct{}
 	running    bool
+
+	// Recurring operations, started with the manager
+	schedules []*operationSchedule
+	sequence  uint64
+}
+
+// operationSchedule creates an operation every interval
+type operationSchedule struct {
+	opType      OperationType
+	name        string
+	description string
+	parameters  map[string]interface{}
+	interval    time.Duration
+	lastID      string
 }
 
 // OperationsConfig configures operations management
@@ -185,6 +200,9 @@ func (om *OperationsManager) Start() error {
 	for i := 0; i < om.workers; i++ {
 		go om.worker()
 	}
+	for _, schedule := range om.schedules {
+		go om.runSchedule(schedule)
+	}
 
 	om.logger.Info("Operations manager started",
 		"workers", om.workers,
@@ -234,7 +252,8 @@ func (om *OperationsManager) CreateOperation(
 	}
 
 	// Generate unique ID
-	id := fmt.Sprintf("%s-%d", opType, time.Now().Unix())
+	om.sequence++
+	id := fmt.Sprintf("%s-%d-%d", opType, time.Now().Unix(), om.sequence)
 
 	ctx, cancel := context.WithTimeout(context.Background(), om.config.DefaultTimeout)
 
@@ -279,6 +298,47 @@ func (om *OperationsManager) CreateOperation(
 	return operation, nil
 }
 
+// ScheduleOperation creates and executes an operation of opType when the
+// manager starts, or at once when it already runs, and then every interval.
+// A run is skipped while the previous one is still pending or running.
+func (om *OperationsManager) ScheduleOperation(
+	opType OperationType,
+	name, description string,
+	parameters map[string]interface{},
+	interval time.Duration,
+) error {
+	if interval <= 0 {
+		return fmt.Errorf("schedule interval must be positive: %v", interval)
+	}
+
+	om.mu.Lock()
+	defer om.mu.Unlock()
+
+	if _, exists := om.executors[opType]; !exists {
+		return fmt.Errorf("no executor registered for operation type: %s", opType)
+	}
+
+	schedule := &operationSchedule{
+		opType:      opType,
+		name:        name,
+		description: description,
+		parameters:  parameters,
+		interval:    interval,
+	}
+	om.schedules = append(om.schedules, schedule)
+	if om.running {
+		go om.runSchedule(schedule)
+	}
+
+	om.logger.Info("Operation scheduled",
+		"type", opType,
+		"name", name,
+		"interval", interval,
+	)
+
+	return nil
+}
+
 // ExecuteOperation executes an operation asynchronously
 func (om *OperationsManager) ExecuteOperation(id string) error {
 	om.mu.RLock()
@@ -442,6 +502,46 @@ func (om *OperationsManager) worker() {
 	}
 }
 
+func (om *OperationsManager) runSchedule(schedule *operationSchedule) {
+	ticker := time.NewTicker(schedule.interval)
+	defer ticker.Stop()
+
+	om.runScheduledOperation(schedule)
+	for {
+		select {
+		case <-om.stopCh:
+			return
+		case <-ticker.C:
+			om.runScheduledOperation(schedule)
+		}
+	}
+}
+
+func (om *OperationsManager) runScheduledOperation(schedule *operationSchedule) {
+	om.mu.RLock()
+	_, previousActive := om.operations[schedule.lastID]
+	om.mu.RUnlock()
+	if previousActive {
+		om.logger.Warn("Skipping scheduled operation, previous run still active",
+			"name", schedule.name,
+			"previous_id", schedule.lastID,
+		)
+		return
+	}
+
+	operation, err := om.CreateOperation(schedule.opType, schedule.name, schedule.description, schedule.parameters, nil)
+	if err != nil {
+		om.logger.Error("Failed to create scheduled operation", "name", schedule.name, "error", err)
+		return
+	}
+	schedule.lastID = operation.ID
+
+	if err := om.ExecuteOperation(operation.ID); err != nil {
+		om.logger.Error("Failed to execute scheduled operation", "id", operation.ID, "error", err)
+		om.failOperation(operation, err)
+	}
+}
+
 func (om *OperationsManager) executeOperationWithRetry(operation *Operation) {
 	executor, exists := om.executors[operation.Type]
 	if !exists {
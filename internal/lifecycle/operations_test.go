@@ -0,0 +1,41 @@
+package lifecycle
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/vertikon/mcp-ultra/pkg/logger"
+)
+
+// countingExecutor counts the operations it executes
+type countingExecutor struct {
+	runs atomic.Int32
+}
+
+func (e *countingExecutor) Execute(context.Context, *Operation) error {
+	e.runs.Add(1)
+	return nil
+}
+
+func (e *countingExecutor) Rollback(context.Context, *Operation) error { return nil }
+
+func (e *countingExecutor) Validate(*Operation) error { return nil }
+
+func TestOperationsManager_ScheduleRunsAtStart(t *testing.T) {
+	manager := NewOperationsManager(DefaultOperationsConfig(), logger.FromZap(zaptest.NewLogger(t)))
+	executor := &countingExecutor{}
+	manager.RegisterExecutor(OperationRetention, executor)
+
+	require.NoError(t, manager.ScheduleOperation(OperationRetention, "retention", "", nil, time.Hour))
+	require.NoError(t, manager.Start())
+	defer func() { _ = manager.Stop() }()
+
+	assert.Eventually(t, func() bool { return executor.runs.Load() == 1 }, 5*time.Second, 10*time.Millisecond,
+		"the first run does not wait for the interval")
+}
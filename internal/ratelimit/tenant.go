@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+
+	"golang.org/x/time/rate"
+
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+)
+
+// TenantLimiter gives every tenant its own in-process token bucket. The
+// limits can be changed at runtime; a rate of 0 lets every request through.
+type TenantLimiter struct {
+	mu       sync.Mutex
+	limit    rate.Limit
+	burst    int
+	limiters map[string]*rate.Limiter
+}
+
+// NewTenantLimiter creates a limiter allowing rps requests per second per
+// tenant, with bursts of up to burst requests
+func NewTenantLimiter(rps float64, burst int) *TenantLimiter {
+	l := &TenantLimiter{}
+	l.SetLimit(rps, burst)
+	return l
+}
+
+// SetLimit changes the rate and burst of every tenant, whose buckets start
+// full again
+func (l *TenantLimiter) SetLimit(rps float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.limit, l.burst = rate.Limit(rps), burst
+	l.limiters = make(map[string]*rate.Limiter)
+}
+
+// Allow reports whether tenantID may make one more request now
+func (l *TenantLimiter) Allow(tenantID string) bool {
+	l.mu.Lock()
+	if l.limit <= 0 {
+		l.mu.Unlock()
+		return true
+	}
+	limiter, ok := l.limiters[tenantID]
+	if !ok {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[tenantID] = limiter
+	}
+	l.mu.Unlock()
+
+	return limiter.Allow()
+}
+
+// Middleware answers 429 once the tenant of the request is over its limit.
+// It must run after authentication so the tenant is known.
+func (l *TenantLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !l.Allow(tenant.ID(r.Context())) {
+			w.Header().Set("Retry-After", strconv.Itoa(1))
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,38 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+)
+
+func TestTenantLimiter(t *testing.T) {
+	limiter := NewTenantLimiter(0, 0)
+	handler := limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	call := func(tenantID string) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req = req.WithContext(tenant.WithID(req.Context(), tenantID))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusNoContent, call("acme"), "a zero rate does not limit")
+	}
+
+	limiter.SetLimit(0.001, 2)
+	assert.Equal(t, http.StatusNoContent, call("acme"))
+	assert.Equal(t, http.StatusNoContent, call("acme"))
+	assert.Equal(t, http.StatusTooManyRequests, call("acme"))
+	assert.Equal(t, http.StatusNoContent, call("globex"), "tenants have their own bucket")
+
+	limiter.SetLimit(0.001, 5)
+	assert.Equal(t, http.StatusNoContent, call("acme"), "new limits refill the buckets")
+}
@@ -0,0 +1,148 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+)
+
+type cacheEntry struct {
+	value     string
+	expiresAt time.Time
+}
+
+func (e cacheEntry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && now.After(e.expiresAt)
+}
+
+// CacheRepository implements domain.CacheRepository in memory.
+// Values are JSON encoded and keys scoped to the tenant of ctx like the
+// Redis implementation so reads behave the same.
+type CacheRepository struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	now     func() time.Time
+}
+
+// NewCacheRepository creates a new in-memory cache repository
+func NewCacheRepository() *CacheRepository {
+	return &CacheRepository{entries: make(map[string]cacheEntry), now: time.Now}
+}
+
+// Set stores a value in cache with TTL in seconds; ttl <= 0 means no expiration
+func (r *CacheRepository) Set(ctx context.Context, key string, value interface{}, ttl int) error {
+	key = tenant.Key(ctx, key)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("marshaling value: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries[key] = r.entry(string(data), ttl)
+	return nil
+}
+
+// Get retrieves a value from cache
+func (r *CacheRepository) Get(ctx context.Context, key string) (string, error) {
+	key = tenant.Key(ctx, key)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.lookup(key)
+	if !ok {
+		return "", fmt.Errorf("key not found")
+	}
+	return entry.value, nil
+}
+
+// Delete removes a key from cache
+func (r *CacheRepository) Delete(ctx context.Context, key string) error {
+	key = tenant.Key(ctx, key)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.entries, key)
+	return nil
+}
+
+// Exists checks if a key exists in cache
+func (r *CacheRepository) Exists(ctx context.Context, key string) (bool, error) {
+	key = tenant.Key(ctx, key)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.lookup(key)
+	return ok, nil
+}
+
+// Increment increments a counter, creating it when missing
+func (r *CacheRepository) Increment(ctx context.Context, key string) (int64, error) {
+	key = tenant.Key(ctx, key)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var current int64
+	entry, ok := r.lookup(key)
+	if ok {
+		v, err := strconv.ParseInt(entry.value, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("incrementing counter: value is not an integer")
+		}
+		current = v
+	}
+	current++
+	entry.value = strconv.FormatInt(current, 10)
+	r.entries[key] = entry
+	return current, nil
+}
+
+// SetNX sets a value only if the key does not exist
+func (r *CacheRepository) SetNX(ctx context.Context, key string, value interface{}, ttl int) (bool, error) {
+	key = tenant.Key(ctx, key)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false, fmt.Errorf("marshaling value: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.lookup(key); ok {
+		return false, nil
+	}
+	r.entries[key] = r.entry(string(data), ttl)
+	return true, nil
+}
+
+func (r *CacheRepository) entry(value string, ttl int) cacheEntry {
+	e := cacheEntry{value: value}
+	if ttl > 0 {
+		e.expiresAt = r.now().Add(time.Duration(ttl) * time.Second)
+	}
+	return e
+}
+
+// lookup returns a live entry, evicting it when expired. Caller holds mu.
+func (r *CacheRepository) lookup(key string) (cacheEntry, bool) {
+	entry, ok := r.entries[key]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	if entry.expired(r.now()) {
+		delete(r.entries, key)
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
@@ -0,0 +1,40 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+)
+
+// EventBus delivers domain events to in-process subscribers.
+// It stands in for the NATS bus when messaging is disabled.
+type EventBus struct {
+	mu       sync.RWMutex
+	handlers []func(ctx context.Context, event *domain.Event)
+}
+
+// NewEventBus creates a new in-process event bus
+func NewEventBus() *EventBus {
+	return &EventBus{}
+}
+
+// Publish hands the event to every subscriber synchronously
+func (b *EventBus) Publish(ctx context.Context, event *domain.Event) error {
+	b.mu.RLock()
+	handlers := make([]func(context.Context, *domain.Event), len(b.handlers))
+	copy(handlers, b.handlers)
+	b.mu.RUnlock()
+
+	for _, h := range handlers {
+		h(ctx, event)
+	}
+	return nil
+}
+
+// Subscribe registers a handler for every published event
+func (b *EventBus) Subscribe(handler func(ctx context.Context, event *domain.Event)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
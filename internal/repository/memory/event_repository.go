@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// EventRepository implements domain.EventRepository as an append-only slice
+type EventRepository struct {
+	mu     sync.RWMutex
+	events []*domain.Event
+}
+
+// NewEventRepository creates a new in-memory event store
+func NewEventRepository() *EventRepository {
+	return &EventRepository{}
+}
+
+// Store appends an event for the tenant of ctx
+func (r *EventRepository) Store(ctx context.Context, event *domain.Event) error {
+	owner, err := tenant.Owner(ctx, event.TenantID)
+	if err != nil {
+		return fmt.Errorf("storing event: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	event.TenantID = owner
+	c := *event
+	r.events = append(r.events, &c)
+	return nil
+}
+
+// GetByAggregateID retrieves events for an aggregate in insertion order
+func (r *EventRepository) GetByAggregateID(ctx context.Context, aggregateID types.UUID) ([]*domain.Event, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	events := make([]*domain.Event, 0)
+	for _, e := range r.events {
+		if e.AggregateID == aggregateID && tenant.Allows(ctx, e.TenantID) {
+			c := *e
+			events = append(events, &c)
+		}
+	}
+	return events, nil
+}
+
+// GetByType retrieves events by type in insertion order
+func (r *EventRepository) GetByType(ctx context.Context, eventType string, limit, offset int) ([]*domain.Event, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	events := make([]*domain.Event, 0)
+	skipped := 0
+	for _, e := range r.events {
+		if e.Type != eventType || !tenant.Allows(ctx, e.TenantID) {
+			continue
+		}
+		if skipped < offset {
+			skipped++
+			continue
+		}
+		if limit > 0 && len(events) >= limit {
+			break
+		}
+		c := *e
+		events = append(events, &c)
+	}
+	return events, nil
+}
+
+// DeleteByAggregateID removes every event of an aggregate. Events are
+// otherwise append-only; this exists for data subject erasure.
+func (r *EventRepository) DeleteByAggregateID(ctx context.Context, aggregateID types.UUID) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	kept := r.events[:0]
+	for _, e := range r.events {
+		if e.AggregateID != aggregateID || !tenant.Allows(ctx, e.TenantID) {
+			kept = append(kept, e)
+		}
+	}
+	deleted := len(r.events) - len(kept)
+	for i := len(kept); i < len(r.events); i++ {
+		r.events[i] = nil
+	}
+	r.events = kept
+	return deleted, nil
+}
+
+// ReplaceData overwrites the payload of one event. Events are otherwise
+// immutable; this exists for retention anonymization.
+func (r *EventRepository) ReplaceData(ctx context.Context, eventID types.UUID, data map[string]interface{}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, e := range r.events {
+		if e.ID == eventID && tenant.Allows(ctx, e.TenantID) {
+			c := *e
+			c.Data = data
+			r.events[i] = &c
+			return nil
+		}
+	}
+	return fmt.Errorf("event not found: %s", eventID)
+}
@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+)
+
+// FeatureFlagRepository implements domain.FeatureFlagRepository in memory.
+// Each tenant has its own flags.
+type FeatureFlagRepository struct {
+	mu    sync.RWMutex
+	flags map[string]map[string]*domain.FeatureFlag
+}
+
+// NewFeatureFlagRepository creates a new in-memory feature flag repository
+func NewFeatureFlagRepository() *FeatureFlagRepository {
+	return &FeatureFlagRepository{flags: make(map[string]map[string]*domain.FeatureFlag)}
+}
+
+// GetByKey retrieves a feature flag by key
+func (r *FeatureFlagRepository) GetByKey(ctx context.Context, key string) (*domain.FeatureFlag, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	flag, ok := r.flags[tenant.ID(ctx)][key]
+	if !ok {
+		return nil, fmt.Errorf("feature flag not found")
+	}
+	c := *flag
+	return &c, nil
+}
+
+// List retrieves every feature flag ordered by key
+func (r *FeatureFlagRepository) List(ctx context.Context) ([]*domain.FeatureFlag, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tenantFlags := r.flags[tenant.ID(ctx)]
+	flags := make([]*domain.FeatureFlag, 0, len(tenantFlags))
+	for _, flag := range tenantFlags {
+		c := *flag
+		flags = append(flags, &c)
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Key < flags[j].Key })
+	return flags, nil
+}
+
+// Create stores a new feature flag
+func (r *FeatureFlagRepository) Create(ctx context.Context, flag *domain.FeatureFlag) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tenantID := tenant.ID(ctx)
+	if _, exists := r.flags[tenantID][flag.Key]; exists {
+		return fmt.Errorf("feature flag already exists: %s", flag.Key)
+	}
+	if r.flags[tenantID] == nil {
+		r.flags[tenantID] = make(map[string]*domain.FeatureFlag)
+	}
+	c := *flag
+	r.flags[tenantID][flag.Key] = &c
+	return nil
+}
+
+// Update replaces an existing feature flag
+func (r *FeatureFlagRepository) Update(ctx context.Context, flag *domain.FeatureFlag) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tenantFlags := r.flags[tenant.ID(ctx)]
+	if _, exists := tenantFlags[flag.Key]; !exists {
+		return fmt.Errorf("feature flag not found")
+	}
+	c := *flag
+	tenantFlags[flag.Key] = &c
+	return nil
+}
+
+// Delete removes a feature flag
+func (r *FeatureFlagRepository) Delete(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tenantFlags := r.flags[tenant.ID(ctx)]
+	if _, exists := tenantFlags[key]; !exists {
+		return fmt.Errorf("feature flag not found")
+	}
+	delete(tenantFlags, key)
+	return nil
+}
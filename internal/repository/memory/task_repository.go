@@ -0,0 +1,275 @@
+// Package memory provides in-process implementations of the domain
+// repositories. They back the service when external stores are disabled
+// and keep tests free of infrastructure.
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// TaskRepository implements domain.TaskRepository in memory
+type TaskRepository struct {
+	mu    sync.RWMutex
+	tasks map[types.UUID]*domain.Task
+}
+
+// NewTaskRepository creates a new in-memory task repository
+func NewTaskRepository() *TaskRepository {
+	return &TaskRepository{tasks: make(map[types.UUID]*domain.Task)}
+}
+
+// Create stores a new task for the tenant of ctx
+func (r *TaskRepository) Create(ctx context.Context, task *domain.Task) error {
+	owner, err := tenant.Owner(ctx, task.TenantID)
+	if err != nil {
+		return fmt.Errorf("creating task: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.tasks[task.ID]; exists {
+		return fmt.Errorf("task already exists: %s", task.ID)
+	}
+	task.TenantID = owner
+	r.tasks[task.ID] = cloneTask(task)
+	return nil
+}
+
+// GetByID retrieves a task by ID
+func (r *TaskRepository) GetByID(ctx context.Context, id types.UUID) (*domain.Task, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	task, ok := r.lookup(ctx, id)
+	if !ok {
+		return nil, fmt.Errorf("task not found")
+	}
+	return cloneTask(task), nil
+}
+
+// Update replaces an existing task. Its tenant never changes.
+func (r *TaskRepository) Update(ctx context.Context, task *domain.Task) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.lookup(ctx, task.ID)
+	if !ok {
+		return fmt.Errorf("task not found")
+	}
+	task.TenantID = existing.TenantID
+	r.tasks[task.ID] = cloneTask(task)
+	return nil
+}
+
+// Delete removes a task
+func (r *TaskRepository) Delete(ctx context.Context, id types.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.lookup(ctx, id); !ok {
+		return fmt.Errorf("task not found")
+	}
+	delete(r.tasks, id)
+	return nil
+}
+
+// List retrieves tasks with filtering and pagination in the filter's sort
+// order. Query matches tasks whose title or description holds every word.
+func (r *TaskRepository) List(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, int, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, 0, err
+	}
+	position, _ := filter.Position()
+	order := filter.SortOrder()
+
+	r.mu.RLock()
+	matched := make([]*domain.Task, 0, len(r.tasks))
+	for _, task := range r.tasks {
+		if tenant.Allows(ctx, task.TenantID) && matchesFilter(task, filter) {
+			matched = append(matched, task)
+		}
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(matched, func(i, j int) bool {
+		return domain.CompareTasks(matched[i], matched[j], order) < 0
+	})
+
+	total := len(matched)
+	if filter.Count == domain.TaskCountNone {
+		total = -1
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+	offset := filter.Offset
+	if position != nil {
+		offset = sort.Search(len(matched), func(i int) bool {
+			return domain.CompareTasks(matched[i], position, order) > 0
+		})
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > len(matched) {
+		offset = len(matched)
+	}
+	end := offset + limit
+	if end > len(matched) {
+		end = len(matched)
+	}
+
+	tasks := make([]*domain.Task, 0, end-offset)
+	for _, task := range matched[offset:end] {
+		tasks = append(tasks, cloneTask(task))
+	}
+
+	return tasks, total, nil
+}
+
+// GetByStatus retrieves tasks by status
+func (r *TaskRepository) GetByStatus(ctx context.Context, status domain.TaskStatus) ([]*domain.Task, error) {
+	tasks, _, err := r.List(ctx, domain.TaskFilter{Status: []domain.TaskStatus{status}, Limit: r.count()})
+	return tasks, err
+}
+
+// GetByAssignee retrieves tasks assigned to a user
+func (r *TaskRepository) GetByAssignee(ctx context.Context, assigneeID types.UUID) ([]*domain.Task, error) {
+	tasks, _, err := r.List(ctx, domain.TaskFilter{AssigneeID: &assigneeID, Limit: r.count()})
+	return tasks, err
+}
+
+// lookup returns the task with id when ctx may see it. Caller holds mu.
+func (r *TaskRepository) lookup(ctx context.Context, id types.UUID) (*domain.Task, bool) {
+	task, ok := r.tasks[id]
+	if !ok || !tenant.Allows(ctx, task.TenantID) {
+		return nil, false
+	}
+	return task, true
+}
+
+func (r *TaskRepository) count() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.tasks) + 1
+}
+
+func matchesFilter(task *domain.Task, filter domain.TaskFilter) bool {
+	if len(filter.Status) > 0 && !containsStatus(filter.Status, task.Status) {
+		return false
+	}
+	if len(filter.Priority) > 0 && !containsPriority(filter.Priority, task.Priority) {
+		return false
+	}
+	if filter.AssigneeID != nil && (task.AssigneeID == nil || *task.AssigneeID != *filter.AssigneeID) {
+		return false
+	}
+	if filter.CreatedBy != nil && task.CreatedBy != *filter.CreatedBy {
+		return false
+	}
+	if filter.FromDate != nil && task.CreatedAt.Before(*filter.FromDate) {
+		return false
+	}
+	if filter.ToDate != nil && task.CreatedAt.After(*filter.ToDate) {
+		return false
+	}
+	if len(filter.Tags) > 0 && !matchesTags(task.Tags, filter.Tags, filter.TagMatch) {
+		return false
+	}
+	if filter.Query != "" && !matchesQuery(task, filter.Query) {
+		return false
+	}
+	return true
+}
+
+func matchesTags(tags, wanted []string, match domain.TagMatch) bool {
+	for _, tag := range wanted {
+		found := containsString(tags, tag)
+		if match == domain.TagMatchAny && found {
+			return true
+		}
+		if match != domain.TagMatchAny && !found {
+			return false
+		}
+	}
+	return match != domain.TagMatchAny
+}
+
+// matchesQuery reports whether every word of query is a word of the task's
+// title or description, ignoring case
+func matchesQuery(task *domain.Task, query string) bool {
+	words := make(map[string]bool)
+	for _, word := range splitWords(task.Title + " " + task.Description) {
+		words[word] = true
+	}
+	for _, word := range splitWords(query) {
+		if !words[word] {
+			return false
+		}
+	}
+	return true
+}
+
+func splitWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func containsStatus(list []domain.TaskStatus, v domain.TaskStatus) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsPriority(list []domain.Priority, v domain.Priority) bool {
+	for _, p := range list {
+		if p == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// cloneTask copies a task so callers never share state with the store
+func cloneTask(task *domain.Task) *domain.Task {
+	c := *task
+	if task.Tags != nil {
+		c.Tags = append([]string(nil), task.Tags...)
+	}
+	if task.Metadata != nil {
+		c.Metadata = make(map[string]interface{}, len(task.Metadata))
+		for k, v := range task.Metadata {
+			c.Metadata[k] = v
+		}
+	}
+	if task.AssigneeID != nil {
+		id := *task.AssigneeID
+		c.AssigneeID = &id
+	}
+	return &c
+}
@@ -0,0 +1,149 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+func TestTaskRepository_ListFiltersAndPaginates(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository()
+	owner := types.New()
+	base := time.Now().Add(-time.Hour)
+
+	for i := 0; i < 4; i++ {
+		task := domain.NewTask("task", "", owner)
+		task.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		if i%2 == 0 {
+			task.Status = domain.TaskStatusInProgress
+			task.Tags = []string{"even"}
+		}
+		require.NoError(t, repo.Create(ctx, task))
+	}
+
+	tasks, total, err := repo.List(ctx, domain.TaskFilter{Status: []domain.TaskStatus{domain.TaskStatusInProgress}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Len(t, tasks, 2)
+	assert.True(t, tasks[0].CreatedAt.After(tasks[1].CreatedAt), "newest first")
+
+	tasks, total, err = repo.List(ctx, domain.TaskFilter{Tags: []string{"even"}, Limit: 1, Offset: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Len(t, tasks, 1)
+
+	tasks, _, err = repo.List(ctx, domain.TaskFilter{Offset: 10})
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+}
+
+func TestTaskRepository_ListSortsAndPagesByCursor(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository()
+	owner := types.New()
+	base := time.Now().Add(-time.Hour)
+
+	priorities := []domain.Priority{domain.PriorityLow, domain.PriorityUrgent, domain.PriorityLow, domain.PriorityHigh, domain.PriorityMedium}
+	for i, priority := range priorities {
+		task := domain.NewTask("task", "", owner)
+		task.Priority = priority
+		task.CreatedAt = base.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, repo.Create(ctx, task))
+	}
+
+	filter := domain.TaskFilter{
+		Sort: []domain.TaskSort{
+			{Field: domain.TaskSortPriority, Descending: true},
+			{Field: domain.TaskSortCreatedAt},
+		},
+		Limit: 2,
+		Count: domain.TaskCountNone,
+	}
+	var listed []*domain.Task
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 3)
+		tasks, total, err := repo.List(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, -1, total)
+		listed = append(listed, tasks...)
+		if len(tasks) < filter.Limit {
+			break
+		}
+		filter.After = filter.NextCursor(tasks[len(tasks)-1])
+	}
+
+	require.Len(t, listed, 5)
+	var got []domain.Priority
+	for _, task := range listed {
+		got = append(got, task.Priority)
+	}
+	assert.Equal(t, []domain.Priority{domain.PriorityUrgent, domain.PriorityHigh, domain.PriorityMedium, domain.PriorityLow, domain.PriorityLow}, got)
+	assert.True(t, listed[3].CreatedAt.Before(listed[4].CreatedAt), "ties by created_at ascending")
+
+	filter.Sort = nil
+	_, _, err := repo.List(ctx, filter)
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor, "a cursor only resumes its own sort order")
+}
+
+func TestTaskRepository_ListMatchesTagsAndQuery(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository()
+	owner := types.New()
+
+	for _, task := range []*domain.Task{
+		{Title: "Deploy gateway", Description: "Roll out the new API gateway", Tags: []string{"ops", "api"}},
+		{Title: "Write docs", Description: "Document the gateway", Tags: []string{"docs"}},
+		{Title: "Fix login", Tags: []string{"api"}},
+	} {
+		task.ID, task.CreatedBy = types.New(), owner
+		require.NoError(t, repo.Create(ctx, task))
+	}
+
+	_, total, err := repo.List(ctx, domain.TaskFilter{Tags: []string{"ops", "api"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total, "all tags by default")
+
+	_, total, err = repo.List(ctx, domain.TaskFilter{Tags: []string{"ops", "docs"}, TagMatch: domain.TagMatchAny})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	_, total, err = repo.List(ctx, domain.TaskFilter{Query: "Gateway"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	tasks, _, err := repo.List(ctx, domain.TaskFilter{Query: "gateway api"})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "Deploy gateway", tasks[0].Title)
+
+	_, total, err = repo.List(ctx, domain.TaskFilter{Query: "gate"})
+	require.NoError(t, err)
+	assert.Zero(t, total, "whole words only")
+}
+
+func TestTaskRepository_ReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+	repo := NewTaskRepository()
+	task := domain.NewTask("original", "", types.New())
+	require.NoError(t, repo.Create(ctx, task))
+
+	task.Title = "mutated"
+	stored, err := repo.GetByID(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "original", stored.Title)
+
+	stored.Title = "mutated again"
+	again, err := repo.GetByID(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "original", again.Title)
+
+	_, err = repo.GetByID(ctx, types.New())
+	assert.Error(t, err)
+}
@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+func TestTaskRepository_IsolatesTenants(t *testing.T) {
+	acme := tenant.WithID(context.Background(), "acme")
+	globex := tenant.WithID(context.Background(), "globex")
+	repo := NewTaskRepository()
+
+	task := domain.NewTask("acme task", "", types.New())
+	require.NoError(t, repo.Create(acme, task))
+	assert.Equal(t, "acme", task.TenantID)
+
+	_, err := repo.GetByID(globex, task.ID)
+	assert.Error(t, err)
+	tasks, total, err := repo.List(globex, domain.TaskFilter{})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Empty(t, tasks)
+
+	hijacked := *task
+	hijacked.Title = "changed by globex"
+	assert.Error(t, repo.Update(globex, &hijacked))
+	assert.Error(t, repo.Delete(globex, task.ID))
+
+	foreign := domain.NewTask("planted", "", types.New())
+	foreign.TenantID = "acme"
+	assert.Error(t, repo.Create(globex, foreign), "a tenant cannot create tasks for another")
+
+	stored, err := repo.GetByID(acme, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "acme task", stored.Title)
+
+	tasks, _, err = repo.List(tenant.WithAll(globex), domain.TaskFilter{})
+	require.NoError(t, err)
+	assert.Len(t, tasks, 1, "system contexts see every tenant")
+}
+
+func TestEventRepository_IsolatesTenants(t *testing.T) {
+	acme := tenant.WithID(context.Background(), "acme")
+	globex := tenant.WithID(context.Background(), "globex")
+	repo := NewEventRepository()
+
+	event := &domain.Event{ID: types.New(), Type: "task.created", AggregateID: types.New(), OccurredAt: time.Now()}
+	require.NoError(t, repo.Store(acme, event))
+
+	events, err := repo.GetByAggregateID(globex, event.AggregateID)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	events, err = repo.GetByType(globex, "task.created", 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	deleted, err := repo.DeleteByAggregateID(globex, event.AggregateID)
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+	assert.Error(t, repo.ReplaceData(globex, event.ID, nil))
+
+	events, err = repo.GetByAggregateID(acme, event.AggregateID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "acme", events[0].TenantID)
+}
+
+func TestCacheRepository_IsolatesTenants(t *testing.T) {
+	acme := tenant.WithID(context.Background(), "acme")
+	globex := tenant.WithID(context.Background(), "globex")
+	repo := NewCacheRepository()
+
+	require.NoError(t, repo.Set(acme, "task:1", "acme", 0))
+
+	_, err := repo.Get(globex, "task:1")
+	assert.Error(t, err)
+	exists, err := repo.Exists(globex, "task:1")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, repo.Delete(globex, "task:1"))
+	value, err := repo.Get(acme, "task:1")
+	require.NoError(t, err)
+	assert.Equal(t, `"acme"`, value)
+}
+
+func TestFeatureFlagRepository_IsolatesTenants(t *testing.T) {
+	acme := tenant.WithID(context.Background(), "acme")
+	globex := tenant.WithID(context.Background(), "globex")
+	repo := NewFeatureFlagRepository()
+
+	require.NoError(t, repo.Create(acme, &domain.FeatureFlag{Key: "beta", Enabled: true}))
+	require.NoError(t, repo.Create(globex, &domain.FeatureFlag{Key: "beta"}), "keys are unique per tenant")
+
+	flag, err := repo.GetByKey(globex, "beta")
+	require.NoError(t, err)
+	assert.False(t, flag.Enabled)
+
+	require.NoError(t, repo.Delete(globex, "beta"))
+	flags, err := repo.List(globex)
+	require.NoError(t, err)
+	assert.Empty(t, flags)
+
+	flag, err = repo.GetByKey(acme, "beta")
+	require.NoError(t, err)
+	assert.True(t, flag.Enabled)
+}
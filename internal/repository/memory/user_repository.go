@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// UserRepository implements domain.UserRepository in memory
+type UserRepository struct {
+	mu    sync.RWMutex
+	users map[types.UUID]*domain.User
+}
+
+// NewUserRepository creates a new in-memory user repository
+func NewUserRepository() *UserRepository {
+	return &UserRepository{users: make(map[types.UUID]*domain.User)}
+}
+
+// Create stores a new user
+func (r *UserRepository) Create(_ context.Context, user *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.users[user.ID]; exists {
+		return fmt.Errorf("user already exists: %s", user.ID)
+	}
+	for _, u := range r.users {
+		if strings.EqualFold(u.Email, user.Email) {
+			return fmt.Errorf("email already in use: %s", user.Email)
+		}
+	}
+	c := *user
+	r.users[user.ID] = &c
+	return nil
+}
+
+// GetByID retrieves a user by ID
+func (r *UserRepository) GetByID(_ context.Context, id types.UUID) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return nil, fmt.Errorf("user not found")
+	}
+	c := *user
+	return &c, nil
+}
+
+// GetByEmail retrieves a user by email
+func (r *UserRepository) GetByEmail(_ context.Context, email string) (*domain.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, user := range r.users {
+		if strings.EqualFold(user.Email, email) {
+			c := *user
+			return &c, nil
+		}
+	}
+	return nil, fmt.Errorf("user not found")
+}
+
+// Update replaces an existing user
+func (r *UserRepository) Update(_ context.Context, user *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[user.ID]; !ok {
+		return fmt.Errorf("user not found")
+	}
+	c := *user
+	r.users[user.ID] = &c
+	return nil
+}
+
+// Delete removes a user
+func (r *UserRepository) Delete(_ context.Context, id types.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.users[id]; !ok {
+		return fmt.Errorf("user not found")
+	}
+	delete(r.users, id)
+	return nil
+}
+
+// List retrieves users ordered by creation time
+func (r *UserRepository) List(_ context.Context, limit, offset int) ([]*domain.User, int, error) {
+	r.mu.RLock()
+	all := make([]*domain.User, 0, len(r.users))
+	for _, user := range r.users {
+		c := *user
+		all = append(all, &c)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(all, func(i, j int) bool { return all[i].CreatedAt.Before(all[j].CreatedAt) })
+
+	total := len(all)
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > total {
+		offset = total
+	}
+	end := total
+	if limit > 0 && offset+limit < total {
+		end = offset + limit
+	}
+
+	return all[offset:end], total, nil
+}
@@ -10,6 +10,23 @@ import (
 
 // Connect creates a PostgreSQL database connection
 func Connect(cfg config.PostgreSQLConfig) (*sql.DB, error) {
+	db, err := Open(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Test connection
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("pinging database: %w", err)
+	}
+
+	return db, nil
+}
+
+// Open creates a PostgreSQL connection pool without connecting; the first
+// query or ping does
+func Open(cfg config.PostgreSQLConfig) (*sql.DB, error) {
 	db, err := sql.Open("postgres", cfg.DSN())
 	if err != nil {
 		return nil, fmt.Errorf("opening database: %w", err)
@@ -20,10 +37,5 @@ func Connect(cfg config.PostgreSQLConfig) (*sql.DB, error) {
 	db.SetMaxIdleConns(cfg.MaxIdleConns)
 	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
 
-	// Test connection
-	if err := db.Ping(); err != nil {
-		return nil, fmt.Errorf("pinging database: %w", err)
-	}
-
 	return db, nil
 }
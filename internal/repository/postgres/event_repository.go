@@ -0,0 +1,286 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/events"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// EventRepository implements domain.EventRepository on the events table.
+// The table is also the transactional outbox: events stored within a
+// TxManager transaction are committed with the aggregate they describe and
+// stay unpublished until an events.OutboxRelay claims them. Queries are
+// restricted to the tenant of their context; the relay's claims span every
+// tenant.
+type EventRepository struct {
+	db *sql.DB
+}
+
+var (
+	_ domain.EventRepository = (*EventRepository)(nil)
+	_ events.OutboxStore     = (*EventRepository)(nil)
+)
+
+// NewEventRepository creates a new PostgreSQL event repository
+func NewEventRepository(db *sql.DB) *EventRepository {
+	return &EventRepository{db: db}
+}
+
+const eventColumns = `id, tenant_id, type, aggregate_id, payload, occurred_at, version`
+
+// Store appends an event to the outbox for the tenant of ctx
+func (r *EventRepository) Store(ctx context.Context, event *domain.Event) error {
+	owner, err := tenant.Owner(ctx, event.TenantID)
+	if err != nil {
+		return fmt.Errorf("storing event: %w", err)
+	}
+
+	query := `
+		INSERT INTO events (id, tenant_id, type, aggregate_id, payload, occurred_at, version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	payload, err := json.Marshal(event.Data)
+	if err != nil {
+		return fmt.Errorf("marshaling event data: %w", err)
+	}
+
+	_, err = scopedExec(ctx, r.db, query,
+		event.ID, owner, event.Type, event.AggregateID, payload, event.OccurredAt, event.Version,
+	)
+	if err != nil {
+		return fmt.Errorf("storing event: %w", err)
+	}
+
+	event.TenantID = owner
+	return nil
+}
+
+// GetByAggregateID retrieves events for an aggregate in insertion order
+func (r *EventRepository) GetByAggregateID(ctx context.Context, aggregateID types.UUID) ([]*domain.Event, error) {
+	query := `SELECT ` + eventColumns + ` FROM events WHERE aggregate_id = $1 AND ` + tenantCondition(2) + ` ORDER BY seq`
+
+	result, err := r.query(ctx, query, append([]interface{}{aggregateID}, tenantArgs(ctx)...)...)
+	if err != nil {
+		return nil, fmt.Errorf("querying events by aggregate: %w", err)
+	}
+	return result, nil
+}
+
+// GetByType retrieves events by type in insertion order
+func (r *EventRepository) GetByType(ctx context.Context, eventType string, limit, offset int) ([]*domain.Event, error) {
+	query := `SELECT ` + eventColumns + ` FROM events WHERE type = $1 AND ` + tenantCondition(4) + ` ORDER BY seq LIMIT $2 OFFSET $3`
+
+	result, err := r.query(ctx, query, append([]interface{}{eventType, limit, offset}, tenantArgs(ctx)...)...)
+	if err != nil {
+		return nil, fmt.Errorf("querying events by type: %w", err)
+	}
+	return result, nil
+}
+
+// query runs a query selecting eventColumns scoped to the tenant of ctx
+func (r *EventRepository) query(ctx context.Context, query string, args ...interface{}) ([]*domain.Event, error) {
+	var result []*domain.Event
+	err := scoped(ctx, r.db, func(ex executor) error {
+		rows, err := ex.QueryContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = rows.Close() }()
+
+		result, err = scanEvents(rows)
+		return err
+	})
+	return result, err
+}
+
+// DeleteByAggregateID removes every event of an aggregate. Events are
+// otherwise append-only; this exists for data subject erasure.
+func (r *EventRepository) DeleteByAggregateID(ctx context.Context, aggregateID types.UUID) (int, error) {
+	deleted, err := scopedExec(ctx, r.db, `DELETE FROM events WHERE aggregate_id = $1 AND `+tenantCondition(2),
+		append([]interface{}{aggregateID}, tenantArgs(ctx)...)...)
+	if err != nil {
+		return 0, fmt.Errorf("deleting events: %w", err)
+	}
+	return int(deleted), nil
+}
+
+// ReplaceData overwrites the payload of one event. Events are otherwise
+// immutable; this exists for retention anonymization.
+func (r *EventRepository) ReplaceData(ctx context.Context, eventID types.UUID, data map[string]interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshaling event data: %w", err)
+	}
+
+	affected, err := scopedExec(ctx, r.db, `UPDATE events SET payload = $2 WHERE id = $1 AND `+tenantCondition(3),
+		append([]interface{}{eventID, payload}, tenantArgs(ctx)...)...)
+	if err != nil {
+		return fmt.Errorf("replacing event data: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("event not found: %s", eventID)
+	}
+
+	return nil
+}
+
+// Claim locks the events due for publication in a transaction that lasts
+// until the batch is committed or rolled back. Only the oldest unpublished
+// event of each aggregate qualifies, and rows locked by another relay are
+// skipped. Claims span every tenant.
+func (r *EventRepository) Claim(ctx context.Context, limit int) (events.OutboxBatch, error) {
+	query := `
+		SELECT e.id, e.tenant_id, e.type, e.aggregate_id, e.payload, e.occurred_at, e.version, e.attempts
+		FROM events e
+		WHERE e.published_at IS NULL AND e.next_attempt_at <= now()
+		  AND NOT EXISTS (
+			SELECT 1 FROM events p
+			WHERE p.aggregate_id = e.aggregate_id AND p.published_at IS NULL AND p.seq < e.seq
+		  )
+		ORDER BY e.seq
+		LIMIT $1
+		FOR UPDATE OF e SKIP LOCKED
+	`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("beginning outbox transaction: %w", err)
+	}
+	if err := setTenant(tenant.WithAll(ctx), tx); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	pending, err := queryPending(ctx, tx, query, limit)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	return &outboxBatch{tx: tx, events: pending}, nil
+}
+
+// ClearPublished resets the retry bookkeeping of the events of every tenant
+// published before the given time. The rows stay: events is also the event
+// store read by GetByAggregateID and data subject exports, and published_at
+// keeps them from being claimed again.
+func (r *EventRepository) ClearPublished(ctx context.Context, before time.Time) (int64, error) {
+	cleared, err := scopedExec(tenant.WithAll(ctx), r.db, `
+		UPDATE events SET attempts = 0, last_error = NULL
+		WHERE published_at IS NOT NULL AND published_at < $1
+		  AND (attempts <> 0 OR last_error IS NOT NULL)
+	`, before)
+	if err != nil {
+		return 0, fmt.Errorf("clearing published events: %w", err)
+	}
+	return cleared, nil
+}
+
+func queryPending(ctx context.Context, tx *sql.Tx, query string, limit int) ([]events.OutboxEvent, error) {
+	rows, err := tx.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("claiming outbox events: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	pending := make([]events.OutboxEvent, 0)
+	for rows.Next() {
+		var attempts int
+		event, err := scanEvent(rows, &attempts)
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, events.OutboxEvent{Event: event, Attempts: attempts})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating outbox events: %w", err)
+	}
+	return pending, nil
+}
+
+// outboxBatch is the transaction holding the rows a relay claimed
+type outboxBatch struct {
+	tx     *sql.Tx
+	events []events.OutboxEvent
+}
+
+func (b *outboxBatch) Events() []events.OutboxEvent {
+	return b.events
+}
+
+func (b *outboxBatch) MarkPublished(ctx context.Context, id types.UUID) error {
+	_, err := b.tx.ExecContext(ctx,
+		`UPDATE events SET published_at = now(), last_error = NULL WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("marking event published: %w", err)
+	}
+	return nil
+}
+
+func (b *outboxBatch) MarkFailed(ctx context.Context, id types.UUID, retryAt time.Time, cause error) error {
+	_, err := b.tx.ExecContext(ctx,
+		`UPDATE events SET attempts = attempts + 1, next_attempt_at = $2, last_error = $3 WHERE id = $1`,
+		id, retryAt, cause.Error())
+	if err != nil {
+		return fmt.Errorf("marking event failed: %w", err)
+	}
+	return nil
+}
+
+func (b *outboxBatch) Commit() error {
+	return b.tx.Commit()
+}
+
+func (b *outboxBatch) Rollback() error {
+	return b.tx.Rollback()
+}
+
+func scanEvents(rows *sql.Rows) ([]*domain.Event, error) {
+	result := make([]*domain.Event, 0)
+	for rows.Next() {
+		event, err := scanEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating events: %w", err)
+	}
+	return result, nil
+}
+
+// scanEvent scans the eventColumns, followed by extra columns, into an Event
+func scanEvent(rows *sql.Rows, extra ...interface{}) (*domain.Event, error) {
+	var event domain.Event
+	var aggregateID sql.NullString
+	var payload []byte
+
+	dest := append([]interface{}{
+		&event.ID, &event.TenantID, &event.Type, &aggregateID, &payload, &event.OccurredAt, &event.Version,
+	}, extra...)
+	if err := rows.Scan(dest...); err != nil {
+		return nil, fmt.Errorf("scanning event: %w", err)
+	}
+
+	if aggregateID.Valid {
+		id, err := types.Parse(aggregateID.String)
+		if err != nil {
+			return nil, fmt.Errorf("parsing aggregate id: %w", err)
+		}
+		event.AggregateID = id
+	}
+	if len(payload) > 0 {
+		if err := json.Unmarshal(payload, &event.Data); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event data: %w", err)
+		}
+	}
+	return &event, nil
+}
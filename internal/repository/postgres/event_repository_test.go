@@ -0,0 +1,183 @@
+//go:build integration
+// +build integration
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// baselineSchema is the lineage formerly kept in migrations/, which the
+// embedded migrations adopt
+var baselineSchema = []string{
+	"testdata/baseline/0001_baseline.sql",
+	"testdata/baseline/0002_compliance_audit_log.sql",
+	"testdata/baseline/0003_compliance_consent_retention.sql",
+	"testdata/baseline/0004_event_outbox.sql",
+	"testdata/baseline/0005_tenant_isolation.sql",
+}
+
+// newTestDB starts a database running the scripts in initScripts, then
+// migrates it with the embedded migrations
+func newTestDB(t *testing.T, initScripts []string) *sql.DB {
+	t.Helper()
+	ctx := context.Background()
+
+	container, err := postgres.Run(ctx, "postgres:16-alpine",
+		postgres.WithDatabase("test_mcp_ultra"),
+		postgres.WithOrderedInitScripts(initScripts...),
+		postgres.BasicWaitStrategies(),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = container.Terminate(ctx) })
+
+	dsn, err := container.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+	db, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	migrator, err := NewMigrator(db)
+	require.NoError(t, err)
+	_, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	return db
+}
+
+func testEvent(aggregateID types.UUID) *domain.Event {
+	return &domain.Event{
+		ID:          types.New(),
+		Type:        "task.updated",
+		AggregateID: aggregateID,
+		Data:        map[string]interface{}{"task_id": aggregateID.String()},
+		OccurredAt:  time.Now().UTC().Truncate(time.Microsecond),
+		Version:     1,
+	}
+}
+
+func TestTxManager(t *testing.T) {
+	db := newTestDB(t, nil)
+	tasks, events, tx := NewTaskRepository(db), NewEventRepository(db), NewTxManager(db)
+	ctx := context.Background()
+	task := domain.NewTask("Outbox", "", createTestUser(t, NewUserRepository(db)).ID)
+
+	err := tx.WithinTx(ctx, func(ctx context.Context) error {
+		require.NoError(t, tasks.Create(ctx, task))
+		require.NoError(t, events.Store(ctx, testEvent(task.ID)))
+		return errors.New("publishing failed")
+	})
+	assert.EqualError(t, err, "publishing failed")
+	_, err = tasks.GetByID(ctx, task.ID)
+	assert.Error(t, err, "the task must be rolled back")
+	stored, err := events.GetByAggregateID(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Empty(t, stored, "the event must be rolled back with the task")
+
+	event := testEvent(task.ID)
+	require.NoError(t, tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := tasks.Create(ctx, task); err != nil {
+			return err
+		}
+		return events.Store(ctx, event)
+	}))
+	_, err = tasks.GetByID(ctx, task.ID)
+	require.NoError(t, err)
+	stored, err = events.GetByAggregateID(ctx, task.ID)
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	assert.Equal(t, event.ID, stored[0].ID)
+	assert.Equal(t, event.Data, stored[0].Data)
+	assert.True(t, event.OccurredAt.Equal(stored[0].OccurredAt))
+}
+
+func TestEventRepository_Outbox(t *testing.T) {
+	for name, schema := range map[string][]string{"package": nil, "baseline": baselineSchema} {
+		t.Run(name, func(t *testing.T) { testOutbox(t, newTestDB(t, schema)) })
+	}
+}
+
+func testOutbox(t *testing.T, db *sql.DB) {
+	repo := NewEventRepository(db)
+	ctx := context.Background()
+	first, second := types.New(), types.New()
+	a1, a2, b1 := testEvent(first), testEvent(first), testEvent(second)
+	for _, event := range []*domain.Event{a1, a2, b1} {
+		require.NoError(t, repo.Store(ctx, event))
+	}
+
+	batch, err := repo.Claim(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, batch.Events(), 2, "only the oldest event of each aggregate is claimed")
+	assert.Equal(t, a1.ID, batch.Events()[0].Event.ID)
+	assert.Equal(t, b1.ID, batch.Events()[1].Event.ID)
+
+	concurrent, err := repo.Claim(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, concurrent.Events(), "claimed events and those queued behind them are skipped")
+	require.NoError(t, concurrent.Rollback())
+
+	require.NoError(t, batch.MarkPublished(ctx, a1.ID))
+	require.NoError(t, batch.MarkFailed(ctx, b1.ID, time.Now().Add(time.Hour), errors.New("nats: timeout")))
+	require.NoError(t, batch.Commit())
+
+	batch, err = repo.Claim(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, batch.Events(), 1, "a failed event waits for its retry time")
+	assert.Equal(t, a2.ID, batch.Events()[0].Event.ID)
+	require.NoError(t, batch.MarkPublished(ctx, a2.ID))
+	require.NoError(t, batch.Commit())
+
+	var attempts int
+	var lastError string
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT attempts, last_error FROM events WHERE id = $1`, b1.ID).Scan(&attempts, &lastError))
+	assert.Equal(t, 1, attempts)
+	assert.Equal(t, "nats: timeout", lastError)
+
+	_, err = db.ExecContext(ctx, `UPDATE events SET attempts = 3, last_error = 'nats: timeout' WHERE id = $1`, a1.ID)
+	require.NoError(t, err)
+	cleared, err := repo.ClearPublished(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), cleared, "only published events with bookkeeping are cleared")
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT attempts FROM events WHERE id = $1`, a1.ID).Scan(&attempts))
+	assert.Zero(t, attempts)
+	events, err := repo.GetByType(ctx, "task.updated", 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, events, 3, "published events stay in the event store")
+
+	batch, err = repo.Claim(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, batch.Events(), "cleared events are not claimed again")
+	require.NoError(t, batch.Rollback())
+}
+
+func TestEventRepository_Erasure(t *testing.T) {
+	repo := NewEventRepository(newTestDB(t, nil))
+	ctx := context.Background()
+	first, second := types.New(), types.New()
+	kept := testEvent(second)
+	for _, event := range []*domain.Event{testEvent(first), testEvent(first), kept} {
+		require.NoError(t, repo.Store(ctx, event))
+	}
+
+	require.NoError(t, repo.ReplaceData(ctx, kept.ID, map[string]interface{}{"task_id": "[anonymized]"}))
+	assert.Error(t, repo.ReplaceData(ctx, types.New(), nil))
+	deleted, err := repo.DeleteByAggregateID(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+
+	events, err := repo.GetByType(ctx, "task.updated", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "[anonymized]", events[0].Data["task_id"])
+}
@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+)
+
+// FeatureFlagRepository implements domain.FeatureFlagRepository using
+// PostgreSQL. Each tenant has its own flags, keyed by tenant and key.
+type FeatureFlagRepository struct {
+	db *sql.DB
+}
+
+var _ domain.FeatureFlagRepository = (*FeatureFlagRepository)(nil)
+
+// NewFeatureFlagRepository creates a new PostgreSQL feature flag repository
+func NewFeatureFlagRepository(db *sql.DB) *FeatureFlagRepository {
+	return &FeatureFlagRepository{db: db}
+}
+
+const flagColumns = `key, name, description, enabled, strategy, parameters, created_at, updated_at`
+
+// GetByKey retrieves a feature flag by key
+func (r *FeatureFlagRepository) GetByKey(ctx context.Context, key string) (*domain.FeatureFlag, error) {
+	query := `SELECT ` + flagColumns + ` FROM feature_flags WHERE tenant_id = $1 AND key = $2`
+
+	var flag *domain.FeatureFlag
+	err := scoped(ctx, r.db, func(ex executor) error {
+		var err error
+		flag, err = scanFlag(ex.QueryRowContext(ctx, query, tenant.ID(ctx), key))
+		return err
+	})
+	return flag, err
+}
+
+// List retrieves every feature flag ordered by key
+func (r *FeatureFlagRepository) List(ctx context.Context) ([]*domain.FeatureFlag, error) {
+	query := `SELECT ` + flagColumns + ` FROM feature_flags WHERE tenant_id = $1 ORDER BY key`
+
+	var flags []*domain.FeatureFlag
+	err := scoped(ctx, r.db, func(ex executor) error {
+		rows, err := ex.QueryContext(ctx, query, tenant.ID(ctx))
+		if err != nil {
+			return fmt.Errorf("listing feature flags: %w", err)
+		}
+		defer func() { _ = rows.Close() }()
+
+		flags = make([]*domain.FeatureFlag, 0)
+		for rows.Next() {
+			flag, err := scanFlag(rows)
+			if err != nil {
+				return fmt.Errorf("scanning feature flag: %w", err)
+			}
+			flags = append(flags, flag)
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("iterating feature flags: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return flags, nil
+}
+
+// Create inserts a new feature flag
+func (r *FeatureFlagRepository) Create(ctx context.Context, flag *domain.FeatureFlag) error {
+	query := `
+		INSERT INTO feature_flags (tenant_id, key, name, description, enabled, strategy, parameters, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	parameters, err := json.Marshal(flag.Parameters)
+	if err != nil {
+		return fmt.Errorf("marshaling parameters: %w", err)
+	}
+
+	err = scoped(ctx, r.db, func(ex executor) error {
+		_, err := ex.ExecContext(ctx, query,
+			tenant.ID(ctx), flag.Key, flag.Name, flag.Description, flag.Enabled, flag.Strategy, parameters,
+			flag.CreatedAt, flag.UpdatedAt,
+		)
+		return err
+	})
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return fmt.Errorf("feature flag already exists: %s", flag.Key)
+	}
+	if err != nil {
+		return fmt.Errorf("creating feature flag: %w", err)
+	}
+
+	return nil
+}
+
+// Update updates an existing feature flag
+func (r *FeatureFlagRepository) Update(ctx context.Context, flag *domain.FeatureFlag) error {
+	query := `
+		UPDATE feature_flags SET
+			name = $2, description = $3, enabled = $4, strategy = $5, parameters = $6, updated_at = $7
+		WHERE key = $1 AND tenant_id = $8
+	`
+
+	parameters, err := json.Marshal(flag.Parameters)
+	if err != nil {
+		return fmt.Errorf("marshaling parameters: %w", err)
+	}
+
+	affected, err := scopedExec(ctx, r.db, query,
+		flag.Key, flag.Name, flag.Description, flag.Enabled, flag.Strategy, parameters, flag.UpdatedAt,
+		tenant.ID(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("updating feature flag: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("feature flag not found")
+	}
+
+	return nil
+}
+
+// Delete removes a feature flag
+func (r *FeatureFlagRepository) Delete(ctx context.Context, key string) error {
+	affected, err := scopedExec(ctx, r.db, `DELETE FROM feature_flags WHERE tenant_id = $1 AND key = $2`, tenant.ID(ctx), key)
+	if err != nil {
+		return fmt.Errorf("deleting feature flag: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("feature flag not found")
+	}
+
+	return nil
+}
+
+// scanFlag scans the flagColumns of a row into a FeatureFlag
+func scanFlag(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*domain.FeatureFlag, error) {
+	var flag domain.FeatureFlag
+	var description sql.NullString
+	var parameters []byte
+
+	err := scanner.Scan(
+		&flag.Key, &flag.Name, &description, &flag.Enabled, &flag.Strategy, &parameters,
+		&flag.CreatedAt, &flag.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("feature flag not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	flag.Description = description.String
+	if len(parameters) > 0 {
+		if err := json.Unmarshal(parameters, &flag.Parameters); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal parameters: %w", err)
+		}
+	}
+	if flag.Parameters == nil {
+		flag.Parameters = make(map[string]interface{})
+	}
+
+	return &flag, nil
+}
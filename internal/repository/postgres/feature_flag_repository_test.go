@@ -0,0 +1,54 @@
+//go:build integration
+// +build integration
+
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+)
+
+func TestFeatureFlagRepository(t *testing.T) {
+	repo := NewFeatureFlagRepository(newTestDB(t, nil))
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	flag := &domain.FeatureFlag{
+		Key: "new_dashboard", Name: "New dashboard", Enabled: true, Strategy: "percentage",
+		Parameters: map[string]interface{}{"percentage": float64(25)},
+		CreatedAt:  now, UpdatedAt: now,
+	}
+	require.NoError(t, repo.Create(ctx, flag))
+	require.NoError(t, repo.Create(ctx, &domain.FeatureFlag{Key: "beta", Name: "Beta", Strategy: "simple", CreatedAt: now, UpdatedAt: now}))
+	assert.EqualError(t, repo.Create(ctx, flag), "feature flag already exists: new_dashboard")
+
+	found, err := repo.GetByKey(ctx, "new_dashboard")
+	require.NoError(t, err)
+	assert.Equal(t, flag.Parameters, found.Parameters)
+	assert.Equal(t, "", found.Description)
+
+	flags, err := repo.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, flags, 2)
+	assert.Equal(t, "beta", flags[0].Key)
+	assert.NotNil(t, flags[0].Parameters, "flags without parameters get an empty map")
+
+	flag.Enabled, flag.Description = false, "Rolled back"
+	require.NoError(t, repo.Update(ctx, flag))
+	found, err = repo.GetByKey(ctx, "new_dashboard")
+	require.NoError(t, err)
+	assert.False(t, found.Enabled)
+	assert.Equal(t, "Rolled back", found.Description)
+
+	require.NoError(t, repo.Delete(ctx, "new_dashboard"))
+	assert.EqualError(t, repo.Delete(ctx, "new_dashboard"), "feature flag not found")
+	assert.EqualError(t, repo.Update(ctx, flag), "feature flag not found")
+	_, err = repo.GetByKey(ctx, "new_dashboard")
+	assert.EqualError(t, err, "feature flag not found")
+}
@@ -36,6 +36,24 @@ CREATE TABLE IF NOT EXISTS events (
     version INTEGER NOT NULL DEFAULT 1
 );
 
+-- Databases created from the former migrations/0001_baseline.sql already
+-- hold tasks and events; add the columns that baseline lacks. Its tasks
+-- need a creator before created_by can be required.
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS priority VARCHAR(50) NOT NULL DEFAULT 'medium',
+    ADD COLUMN IF NOT EXISTS assignee_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS created_by UUID REFERENCES users(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS due_date TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS tags JSONB DEFAULT '[]'::jsonb;
+ALTER TABLE tasks ALTER COLUMN created_by SET NOT NULL;
+ALTER TABLE tasks ALTER COLUMN metadata SET DEFAULT '{}'::jsonb;
+
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS aggregate_id UUID,
+    ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
 -- Create feature_flags table
 CREATE TABLE IF NOT EXISTS feature_flags (
     key VARCHAR(255) PRIMARY KEY,
@@ -74,16 +92,19 @@ END;
 $$ LANGUAGE plpgsql;
 
 -- Create triggers for updated_at
+DROP TRIGGER IF EXISTS update_users_updated_at ON users;
 CREATE TRIGGER update_users_updated_at
     BEFORE UPDATE ON users
     FOR EACH ROW
     EXECUTE FUNCTION update_updated_at_column();
 
+DROP TRIGGER IF EXISTS update_tasks_updated_at ON tasks;
 CREATE TRIGGER update_tasks_updated_at
     BEFORE UPDATE ON tasks
     FOR EACH ROW
     EXECUTE FUNCTION update_updated_at_column();
 
+DROP TRIGGER IF EXISTS update_feature_flags_updated_at ON feature_flags;
 CREATE TRIGGER update_feature_flags_updated_at
     BEFORE UPDATE ON feature_flags
     FOR EACH ROW
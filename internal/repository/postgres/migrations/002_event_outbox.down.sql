@@ -0,0 +1,19 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_events_outbox_published;
+DROP INDEX IF EXISTS idx_events_outbox_pending;
+DROP INDEX IF EXISTS idx_events_aggregate_seq;
+
+-- Drop outbox columns
+ALTER TABLE events
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS attempts,
+    DROP COLUMN IF EXISTS published_at,
+    DROP COLUMN IF EXISTS seq;
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'events' AND column_name = 'payload') THEN
+        ALTER TABLE events RENAME COLUMN payload TO data;
+    END IF;
+END $$;
@@ -0,0 +1,32 @@
+-- Turn events into the transactional outbox read by EventRepository.
+-- Statements are idempotent so databases created from the former
+-- migrations/ baseline, which may hold these columns already, are adopted.
+DO $$
+BEGIN
+    -- Rename data to payload, the column name of migrations/0001_baseline.sql
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'events' AND column_name = 'data') THEN
+        ALTER TABLE events RENAME COLUMN data TO payload;
+    END IF;
+
+    -- The baseline's MCP id does not apply to domain events
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'events' AND column_name = 'mcp_id') THEN
+        ALTER TABLE events ALTER COLUMN mcp_id SET DEFAULT 'mcp-ultra';
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'events' AND column_name = 'published_at') THEN
+        ALTER TABLE events
+            ADD COLUMN seq BIGINT GENERATED ALWAYS AS IDENTITY,
+            ADD COLUMN published_at TIMESTAMP WITH TIME ZONE,
+            ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0,
+            ADD COLUMN next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+            ADD COLUMN last_error TEXT;
+
+        -- Events stored before the outbox are not published again
+        UPDATE events SET published_at = occurred_at;
+    END IF;
+END $$;
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_events_aggregate_seq ON events(aggregate_id, seq);
+CREATE INDEX IF NOT EXISTS idx_events_outbox_pending ON events(seq) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_events_outbox_published ON events(published_at) WHERE published_at IS NOT NULL;
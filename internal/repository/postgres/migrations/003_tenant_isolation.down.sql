@@ -0,0 +1,29 @@
+-- Drop row level security
+DROP POLICY IF EXISTS tenant_isolation ON feature_flags;
+DROP POLICY IF EXISTS tenant_isolation ON events;
+DROP POLICY IF EXISTS tenant_isolation ON tasks;
+ALTER TABLE feature_flags NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE feature_flags DISABLE ROW LEVEL SECURITY;
+ALTER TABLE events NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE events DISABLE ROW LEVEL SECURITY;
+ALTER TABLE tasks NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE tasks DISABLE ROW LEVEL SECURITY;
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_events_tenant_aggregate;
+DROP INDEX IF EXISTS idx_tasks_tenant_created_at;
+
+-- Flag keys are global again; the flags of other tenants are dropped
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'feature_flags' AND column_name = 'tenant_id') THEN
+        DELETE FROM feature_flags WHERE tenant_id <> 'default';
+        ALTER TABLE feature_flags DROP CONSTRAINT feature_flags_pkey;
+        ALTER TABLE feature_flags ADD CONSTRAINT feature_flags_pkey PRIMARY KEY (key);
+    END IF;
+END $$;
+
+-- Drop tenant columns
+ALTER TABLE feature_flags DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE events DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE tasks DROP COLUMN IF EXISTS tenant_id;
@@ -0,0 +1,51 @@
+-- Scope tasks, events and feature flags to a tenant. Statements are
+-- idempotent so databases created from the former migrations/ baseline,
+-- which has tenant columns without a default, are adopted.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE events ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE feature_flags ADD COLUMN IF NOT EXISTS tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE tasks ALTER COLUMN tenant_id SET DEFAULT 'default';
+ALTER TABLE events ALTER COLUMN tenant_id SET DEFAULT 'default';
+
+-- Flag keys are unique per tenant
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM pg_constraint
+        WHERE conrelid = 'feature_flags'::regclass AND contype = 'p' AND array_length(conkey, 1) = 1
+    ) THEN
+        ALTER TABLE feature_flags DROP CONSTRAINT feature_flags_pkey;
+        ALTER TABLE feature_flags ADD CONSTRAINT feature_flags_pkey PRIMARY KEY (tenant_id, key);
+    END IF;
+END $$;
+
+-- Create indexes, replacing the baseline's copy of the tasks one
+DROP INDEX IF EXISTS idx_tasks_tenant_created;
+CREATE INDEX IF NOT EXISTS idx_tasks_tenant_created_at ON tasks(tenant_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_events_tenant_aggregate ON events(tenant_id, aggregate_id);
+
+-- Row level security backstops the tenant conditions of the repositories.
+-- They scope each transaction with set_config('app.tenant_id') and, for
+-- system processes such as the outbox relay, set_config('app.all_tenants').
+-- Without either no row is visible. Superusers bypass these policies; FORCE
+-- applies them to the table owner.
+ALTER TABLE tasks ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tasks FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON tasks;
+CREATE POLICY tenant_isolation ON tasks
+    USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on')
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on');
+
+ALTER TABLE events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE events FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON events;
+CREATE POLICY tenant_isolation ON events
+    USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on')
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on');
+
+ALTER TABLE feature_flags ENABLE ROW LEVEL SECURITY;
+ALTER TABLE feature_flags FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON feature_flags;
+CREATE POLICY tenant_isolation ON feature_flags
+    USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on')
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on');
@@ -0,0 +1,9 @@
+-- Drop triggers
+DROP TRIGGER IF EXISTS compliance_audit_log_no_truncate ON compliance_audit_log;
+DROP TRIGGER IF EXISTS compliance_audit_log_no_update ON compliance_audit_log;
+
+-- Drop function
+DROP FUNCTION IF EXISTS compliance_audit_log_append_only();
+
+-- Drop table
+DROP TABLE IF EXISTS compliance_audit_log;
@@ -0,0 +1,39 @@
+-- Hash-chained compliance audit log (LGPD/GDPR) read by
+-- compliance.PostgresAuditStore. Each record stores the hash of the
+-- previous one; "event" is the exact JSON that was hashed, so it is TEXT
+-- rather than JSONB (JSONB reorders keys).
+CREATE TABLE IF NOT EXISTS compliance_audit_log (
+    sequence BIGINT PRIMARY KEY,
+    event_id TEXT NOT NULL UNIQUE,
+    event_type TEXT NOT NULL,
+    subject_id TEXT NOT NULL DEFAULT '',
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    event TEXT NOT NULL,
+    previous_hash CHAR(64) NOT NULL,
+    hash CHAR(64) NOT NULL UNIQUE
+);
+
+-- Create indexes
+CREATE INDEX IF NOT EXISTS idx_compliance_audit_subject ON compliance_audit_log(subject_id, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_compliance_audit_type ON compliance_audit_log(event_type, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_compliance_audit_occurred ON compliance_audit_log(occurred_at);
+
+-- Append only: UPDATE, DELETE and TRUNCATE are rejected
+CREATE OR REPLACE FUNCTION compliance_audit_log_append_only()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'compliance_audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS compliance_audit_log_no_update ON compliance_audit_log;
+CREATE TRIGGER compliance_audit_log_no_update
+    BEFORE UPDATE OR DELETE ON compliance_audit_log
+    FOR EACH ROW
+    EXECUTE FUNCTION compliance_audit_log_append_only();
+
+DROP TRIGGER IF EXISTS compliance_audit_log_no_truncate ON compliance_audit_log;
+CREATE TRIGGER compliance_audit_log_no_truncate
+    BEFORE TRUNCATE ON compliance_audit_log
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION compliance_audit_log_append_only();
@@ -0,0 +1,10 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_compliance_retention_expiry;
+DROP INDEX IF EXISTS idx_compliance_retention_subject;
+DROP INDEX IF EXISTS idx_compliance_consent_history_subject;
+DROP INDEX IF EXISTS idx_compliance_consents_expires;
+
+-- Drop tables
+DROP TABLE IF EXISTS compliance_retention_records;
+DROP TABLE IF EXISTS compliance_consent_history;
+DROP TABLE IF EXISTS compliance_consents;
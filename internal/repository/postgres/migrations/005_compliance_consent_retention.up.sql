@@ -0,0 +1,72 @@
+-- Consents and data retention records (LGPD/GDPR) read by
+-- compliance.PostgresConsentRepository and PostgresRetentionRepository.
+
+-- Current version of each consent: one row per subject and purpose
+CREATE TABLE IF NOT EXISTS compliance_consents (
+    id TEXT PRIMARY KEY,
+    subject_id TEXT NOT NULL,
+    purpose TEXT NOT NULL,
+    granted BOOLEAN NOT NULL,
+    legal_basis TEXT NOT NULL,
+    consent_source TEXT NOT NULL DEFAULT '',
+    consented_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    withdrawn_at TIMESTAMP WITH TIME ZONE,
+    ip_address TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    consent_string TEXT NOT NULL DEFAULT '',
+    metadata JSONB,
+    version INTEGER NOT NULL CHECK (version > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    UNIQUE (subject_id, purpose)
+);
+
+-- History: a copy of every version written, the current one included
+CREATE TABLE IF NOT EXISTS compliance_consent_history (
+    consent_id TEXT NOT NULL,
+    version INTEGER NOT NULL,
+    subject_id TEXT NOT NULL,
+    purpose TEXT NOT NULL,
+    granted BOOLEAN NOT NULL,
+    legal_basis TEXT NOT NULL,
+    consent_source TEXT NOT NULL DEFAULT '',
+    consented_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    withdrawn_at TIMESTAMP WITH TIME ZONE,
+    ip_address TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    consent_string TEXT NOT NULL DEFAULT '',
+    metadata JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (consent_id, version)
+);
+
+-- Retention records; extensions and metadata are JSON
+CREATE TABLE IF NOT EXISTS compliance_retention_records (
+    id TEXT PRIMARY KEY,
+    subject_id TEXT NOT NULL,
+    data_type TEXT NOT NULL,
+    policy_id TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    retention_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    retention_end TIMESTAMP WITH TIME ZONE NOT NULL,
+    grace_end TIMESTAMP WITH TIME ZONE,
+    status TEXT NOT NULL,
+    action TEXT NOT NULL,
+    action_taken BOOLEAN NOT NULL DEFAULT FALSE,
+    action_taken_at TIMESTAMP WITH TIME ZONE,
+    legal_hold BOOLEAN NOT NULL DEFAULT FALSE,
+    legal_hold_reason TEXT NOT NULL DEFAULT '',
+    extensions JSONB,
+    metadata JSONB,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- Create indexes; expired consents are granted, not withdrawn and past expires_at
+CREATE INDEX IF NOT EXISTS idx_compliance_consents_expires ON compliance_consents(expires_at)
+    WHERE granted AND withdrawn_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_compliance_consent_history_subject ON compliance_consent_history(subject_id, purpose, version);
+CREATE INDEX IF NOT EXISTS idx_compliance_retention_subject ON compliance_retention_records(subject_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_compliance_retention_expiry ON compliance_retention_records(status, retention_end);
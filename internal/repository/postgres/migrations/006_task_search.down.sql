@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_tasks_search;
+DROP INDEX IF EXISTS idx_tasks_tenant_created_id;
+CREATE INDEX IF NOT EXISTS idx_tasks_tenant_created_at ON tasks(tenant_id, created_at DESC);
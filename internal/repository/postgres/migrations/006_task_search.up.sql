@@ -0,0 +1,11 @@
+-- Task listing: keyset pagination and full-text search
+
+-- The default order, newest first with ties by id, resumes from a cursor
+-- without sorting
+DROP INDEX IF EXISTS idx_tasks_tenant_created_at;
+CREATE INDEX IF NOT EXISTS idx_tasks_tenant_created_id ON tasks(tenant_id, created_at DESC, id DESC);
+
+-- Full-text search on title and description; the expression must match the
+-- one the task repository queries
+CREATE INDEX IF NOT EXISTS idx_tasks_search ON tasks
+    USING GIN(to_tsvector('simple', title || ' ' || coalesce(description, '')));
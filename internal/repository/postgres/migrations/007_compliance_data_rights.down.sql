@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_compliance_data_rights_status;
+DROP INDEX IF EXISTS idx_compliance_data_rights_subject;
+
+-- Drop tables
+DROP TABLE IF EXISTS compliance_data_right_verifications;
+DROP TABLE IF EXISTS compliance_data_right_requests;
@@ -0,0 +1,30 @@
+-- Data subject rights requests (LGPD/GDPR) read by
+-- compliance.PostgresDataRightRepository.
+
+-- One row per request; updates, source results and the export are JSON
+CREATE TABLE IF NOT EXISTS compliance_data_right_requests (
+    id TEXT PRIMARY KEY,
+    subject_id TEXT NOT NULL,
+    type TEXT NOT NULL,
+    status TEXT NOT NULL,
+    requested_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    data JSONB,
+    updates JSONB,
+    sources JSONB,
+    export JSONB
+);
+
+-- The verification code issued to each subject, stored as a SHA-256 hash
+CREATE TABLE IF NOT EXISTS compliance_data_right_verifications (
+    subject_id TEXT PRIMARY KEY,
+    code_hash TEXT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0
+);
+
+-- Create indexes; requests are listed newest first
+CREATE INDEX IF NOT EXISTS idx_compliance_data_rights_subject ON compliance_data_right_requests(subject_id, requested_at DESC);
+CREATE INDEX IF NOT EXISTS idx_compliance_data_rights_status ON compliance_data_right_requests(status, requested_at DESC);
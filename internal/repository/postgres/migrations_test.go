@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrator, err := NewMigrator(nil)
+	require.NoError(t, err)
+
+	migrations := migrator.Migrations()
+	require.NotEmpty(t, migrations)
+	for i, migration := range migrations {
+		assert.Equal(t, int64(i+1), migration.Version, "versions are consecutive from 1")
+		assert.Len(t, migration.Checksum, 64)
+	}
+	assert.Equal(t, "initial_schema", migrations[0].Name)
+}
+
+func TestLoadMigrations(t *testing.T) {
+	files := fstest.MapFS{
+		"m/002_second.up.sql":   {Data: []byte("SELECT 2")},
+		"m/002_second.down.sql": {Data: []byte("SELECT -2")},
+		"m/001_first.up.sql":    {Data: []byte("SELECT 1")},
+		"m/001_first.down.sql":  {Data: []byte("SELECT -1")},
+	}
+	migrations, err := loadMigrations(files, "m")
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+	assert.Equal(t, "first", migrations[0].Name)
+	assert.Equal(t, "SELECT 1", migrations[0].up)
+	assert.Equal(t, "SELECT -1", migrations[0].down)
+	assert.NotEqual(t, migrations[0].Checksum, migrations[1].Checksum)
+
+	for name, files := range map[string]fstest.MapFS{
+		"missing down": {"m/001_first.up.sql": {Data: []byte("SELECT 1")}},
+		"bad name":     {"m/first.up.sql": {Data: []byte("SELECT 1")}},
+		"renamed": {
+			"m/001_first.up.sql":   {Data: []byte("SELECT 1")},
+			"m/001_other.down.sql": {Data: []byte("SELECT -1")},
+		},
+	} {
+		_, err := loadMigrations(files, "m")
+		assert.Error(t, err, name)
+	}
+}
@@ -0,0 +1,110 @@
+//go:build integration
+// +build integration
+
+package postgres
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+)
+
+func TestMigrator(t *testing.T) {
+	db := newTestDB(t, nil)
+	ctx := context.Background()
+	migrator, err := NewMigrator(db)
+	require.NoError(t, err)
+	total := len(migrator.Migrations())
+
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, applied, "an up to date schema has nothing to apply")
+	require.NoError(t, migrator.Check(ctx))
+
+	reverted, err := migrator.Down(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, reverted, 2)
+	assert.Equal(t, int64(total), reverted[0].Version, "the newest migration is reverted first")
+	assert.ErrorIs(t, migrator.Check(ctx), ErrSchemaBehind)
+
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, total)
+	assert.True(t, statuses[0].Applied)
+	assert.False(t, statuses[total-1].Applied)
+
+	// Every down file reverts its up file
+	_, err = migrator.Down(ctx, total)
+	require.NoError(t, err)
+	var tables int
+	require.NoError(t, db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = 'public' AND table_name <> 'schema_migrations'`).Scan(&tables))
+	assert.Zero(t, tables)
+
+	// Pods starting together apply each migration once
+	var wg sync.WaitGroup
+	counts := make([]int, 3)
+	for i := range counts {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			applied, err := migrator.Up(ctx)
+			assert.NoError(t, err)
+			counts[i] = len(applied)
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, total, counts[0]+counts[1]+counts[2])
+	require.NoError(t, migrator.Check(ctx))
+}
+
+func TestMigrator_Drift(t *testing.T) {
+	db := newTestDB(t, nil)
+	ctx := context.Background()
+	migrator, err := NewMigrator(db)
+	require.NoError(t, err)
+
+	// Migrations of a newer release are accepted
+	_, err = db.ExecContext(ctx, `INSERT INTO schema_migrations (version, name, checksum) VALUES (999, 'future', 'x')`)
+	require.NoError(t, err)
+	require.NoError(t, migrator.Check(ctx))
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.True(t, statuses[len(statuses)-1].Unknown)
+
+	_, err = migrator.Down(ctx, 1)
+	assert.Error(t, err, "a migration this build does not have cannot be reverted")
+
+	_, err = db.ExecContext(ctx, `UPDATE schema_migrations SET checksum = 'edited' WHERE version = 1`)
+	require.NoError(t, err)
+	assert.ErrorIs(t, migrator.Check(ctx), ErrSchemaDrift)
+}
+
+// TestMigrator_AdoptsBaseline migrates a database created from the former
+// migrations/ lineage and runs the repositories on it
+func TestMigrator_AdoptsBaseline(t *testing.T) {
+	db := newTestDB(t, baselineSchema)
+	ctx := context.Background()
+
+	user := createTestUser(t, NewUserRepository(db))
+	tasks := NewTaskRepository(db)
+	task := domain.NewTask("Adopted", "", user.ID)
+	require.NoError(t, tasks.Create(ctx, task))
+	found, err := tasks.GetByID(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PriorityMedium, found.Priority)
+
+	flags := NewFeatureFlagRepository(db)
+	require.NoError(t, flags.Create(ctx, &domain.FeatureFlag{Key: "beta", Name: "Beta", Strategy: "simple"}))
+	_, err = flags.GetByKey(ctx, "beta")
+	require.NoError(t, err)
+
+	var audit int
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT COUNT(*) FROM compliance_audit_log`).Scan(&audit))
+	assert.Zero(t, audit)
+}
@@ -4,15 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/lib/pq"
+
 	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
 	"github.com/vertikon/mcp-ultra/pkg/types"
 )
 
-// TaskRepository implements domain.TaskRepository using PostgreSQL
+// TaskRepository implements domain.TaskRepository using PostgreSQL.
+// Every query is restricted to the tenant of its context.
 type TaskRepository struct {
 	db *sql.DB
 }
@@ -22,82 +27,99 @@ func NewTaskRepository(db *sql.DB) *TaskRepository {
 	return &TaskRepository{db: db}
 }
 
-// Create inserts a new task
+const taskColumns = `id, tenant_id, title, description, status, priority, assignee_id, created_by,
+	created_at, updated_at, completed_at, due_date, tags, metadata`
+
+// Create inserts a new task for the tenant of ctx
 func (r *TaskRepository) Create(ctx context.Context, task *domain.Task) error {
+	owner, err := tenant.Owner(ctx, task.TenantID)
+	if err != nil {
+		return fmt.Errorf("creating task: %w", err)
+	}
+
 	query := `
-		INSERT INTO tasks (id, title, description, status, priority, assignee_id, created_by, created_at, updated_at, due_date, tags, metadata)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		INSERT INTO tasks (id, tenant_id, title, description, status, priority, assignee_id, created_by, created_at, updated_at, due_date, tags, metadata)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 	`
 
 	tagsJSON, _ := json.Marshal(task.Tags)
 	metadataJSON, _ := json.Marshal(task.Metadata)
 
-	_, err := r.db.ExecContext(ctx, query,
-		task.ID, task.Title, task.Description, task.Status, task.Priority,
-		task.AssigneeID, task.CreatedBy, task.CreatedAt, task.UpdatedAt,
-		task.DueDate, tagsJSON, metadataJSON,
-	)
+	err = scoped(ctx, r.db, func(ex executor) error {
+		_, err := ex.ExecContext(ctx, query,
+			task.ID, owner, task.Title, task.Description, task.Status, task.Priority,
+			task.AssigneeID, task.CreatedBy, task.CreatedAt, task.UpdatedAt,
+			task.DueDate, tagsJSON, metadataJSON,
+		)
+		return err
+	})
 
 	if err != nil {
 		return fmt.Errorf("creating task: %w", err)
 	}
 
+	task.TenantID = owner
 	return nil
 }
 
 // GetByID retrieves a task by ID
 func (r *TaskRepository) GetByID(ctx context.Context, id types.UUID) (*domain.Task, error) {
-	query := `
-		SELECT id, title, description, status, priority, assignee_id, created_by,
-		       created_at, updated_at, completed_at, due_date, tags, metadata
-		FROM tasks WHERE id = $1
-	`
-
-	row := r.db.QueryRowContext(ctx, query, id)
-	return r.scanTask(row)
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE id = $1 AND ` + tenantCondition(2)
+
+	var task *domain.Task
+	err := scoped(ctx, r.db, func(ex executor) error {
+		var err error
+		task, err = r.scanTask(ex.QueryRowContext(ctx, query, append([]interface{}{id}, tenantArgs(ctx)...)...))
+		return err
+	})
+	return task, err
 }
 
-// Update updates an existing task
+// Update updates an existing task. Its tenant never changes.
 func (r *TaskRepository) Update(ctx context.Context, task *domain.Task) error {
 	query := `
 		UPDATE tasks SET
 			title = $2, description = $3, status = $4, priority = $5,
 			assignee_id = $6, updated_at = $7, completed_at = $8, due_date = $9,
 			tags = $10, metadata = $11
-		WHERE id = $1
+		WHERE id = $1 AND ` + tenantCondition(12) + `
+		RETURNING tenant_id
 	`
 
 	tagsJSON, _ := json.Marshal(task.Tags)
 	metadataJSON, _ := json.Marshal(task.Metadata)
 
-	result, err := r.db.ExecContext(ctx, query,
+	args := append([]interface{}{
 		task.ID, task.Title, task.Description, task.Status, task.Priority,
 		task.AssigneeID, task.UpdatedAt, task.CompletedAt, task.DueDate,
 		tagsJSON, metadataJSON,
-	)
+	}, tenantArgs(ctx)...)
 
-	if err != nil {
-		return fmt.Errorf("updating task: %w", err)
-	}
+	var owner string
+	err := scoped(ctx, r.db, func(ex executor) error {
+		return ex.QueryRowContext(ctx, query, args...).Scan(&owner)
+	})
 
-	affected, _ := result.RowsAffected()
-	if affected == 0 {
+	if errors.Is(err, sql.ErrNoRows) {
 		return fmt.Errorf("task not found")
 	}
+	if err != nil {
+		return fmt.Errorf("updating task: %w", err)
+	}
 
+	task.TenantID = owner
 	return nil
 }
 
 // Delete removes a task
 func (r *TaskRepository) Delete(ctx context.Context, id types.UUID) error {
-	query := `DELETE FROM tasks WHERE id = $1`
+	query := `DELETE FROM tasks WHERE id = $1 AND ` + tenantCondition(2)
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	affected, err := scopedExec(ctx, r.db, query, append([]interface{}{id}, tenantArgs(ctx)...)...)
 	if err != nil {
 		return fmt.Errorf("deleting task: %w", err)
 	}
 
-	affected, _ := result.RowsAffected()
 	if affected == 0 {
 		return fmt.Errorf("task not found")
 	}
@@ -105,12 +127,20 @@ func (r *TaskRepository) Delete(ctx context.Context, id types.UUID) error {
 	return nil
 }
 
-// List retrieves tasks with filtering and pagination
+// List retrieves tasks with filtering and pagination. Pages after the
+// first resume from the filter's cursor, so deep pages cost as much as the
+// first one; Offset is kept for callers without a cursor.
 func (r *TaskRepository) List(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, int, error) {
-	// Build WHERE clause
-	conditions := make([]string, 0)
-	args := make([]interface{}, 0)
-	argIndex := 1
+	if err := filter.Validate(); err != nil {
+		return nil, 0, err
+	}
+	position, _ := filter.Position()
+	order := filter.SortOrder()
+
+	// Build WHERE clause, always restricted to the tenant
+	conditions := []string{tenantCondition(1)}
+	args := tenantArgs(ctx)
+	argIndex := 3
 
 	if len(filter.Status) > 0 {
 		placeholders := make([]string, len(filter.Status))
@@ -144,6 +174,28 @@ func (r *TaskRepository) List(ctx context.Context, filter domain.TaskFilter) ([]
 		argIndex++
 	}
 
+	if len(filter.Tags) > 0 {
+		// Both operators are served by the GIN index on tags
+		if filter.TagMatch == domain.TagMatchAny {
+			conditions = append(conditions, fmt.Sprintf("tags ?| $%d", argIndex))
+			args = append(args, pq.Array(filter.Tags))
+		} else {
+			tags, err := json.Marshal(filter.Tags)
+			if err != nil {
+				return nil, 0, fmt.Errorf("marshaling tags: %w", err)
+			}
+			conditions = append(conditions, fmt.Sprintf("tags @> $%d", argIndex))
+			args = append(args, string(tags))
+		}
+		argIndex++
+	}
+
+	if filter.Query != "" {
+		conditions = append(conditions, fmt.Sprintf("%s @@ plainto_tsquery('simple', $%d)", taskSearchVector, argIndex))
+		args = append(args, filter.Query)
+		argIndex++
+	}
+
 	if filter.FromDate != nil {
 		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", argIndex))
 		args = append(args, *filter.FromDate)
@@ -156,82 +208,209 @@ func (r *TaskRepository) List(ctx context.Context, filter domain.TaskFilter) ([]
 		argIndex++
 	}
 
-	whereClause := ""
-	if len(conditions) > 0 {
-		whereClause = "WHERE " + strings.Join(conditions, " AND ")
-	}
-
-	// Count query
-	countQuery := "SELECT COUNT(*) FROM tasks " + whereClause
-	var total int
-	err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
-	if err != nil {
-		return nil, 0, fmt.Errorf("counting tasks: %w", err)
-	}
-
-	// Data query
-	query := `
-		SELECT id, title, description, status, priority, assignee_id, created_by,
-		       created_at, updated_at, completed_at, due_date, tags, metadata
-		FROM tasks ` + whereClause + `
-		ORDER BY created_at DESC
-		LIMIT $` + strconv.Itoa(argIndex) + ` OFFSET $` + strconv.Itoa(argIndex+1)
+	// The total counts every match, not only those after the cursor
+	whereClause := "WHERE " + strings.Join(conditions, " AND ")
+	countArgs := args
 
 	limit := filter.Limit
 	if limit <= 0 {
 		limit = 50
 	}
 	offset := filter.Offset
-	if offset < 0 {
+	if offset < 0 || position != nil {
 		offset = 0
 	}
 
-	args = append(args, limit, offset)
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	pageClause := whereClause
+	if position != nil {
+		var condition string
+		condition, args = keysetCondition(order, position, args)
+		pageClause += " AND " + condition
+		argIndex = len(args) + 1
+	}
+
+	// Data query
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks ` + pageClause + `
+		ORDER BY ` + orderByClause(order) + `
+		LIMIT $` + strconv.Itoa(argIndex) + ` OFFSET $` + strconv.Itoa(argIndex+1)
+
+	var tasks []*domain.Task
+	total := -1
+	err := scoped(ctx, r.db, func(ex executor) error {
+		if filter.Count != domain.TaskCountNone {
+			var err error
+			total, err = countTasks(ctx, ex, whereClause, countArgs, filter.Count == domain.TaskCountEstimated)
+			if err != nil {
+				return err
+			}
+		}
+
+		var err error
+		tasks, err = r.queryTasks(ctx, ex, query, append(args, limit, offset)...)
+		return err
+	})
 	if err != nil {
-		return nil, 0, fmt.Errorf("querying tasks: %w", err)
+		return nil, 0, err
 	}
-	defer func() {
-		_ = rows.Close() // Explicitly ignore error in defer
-	}()
 
-	tasks := make([]*domain.Task, 0)
-	for rows.Next() {
-		task, err := r.scanTask(rows)
+	return tasks, total, nil
+}
+
+// taskSearchVector is the document full-text search matches, indexed by
+// idx_tasks_search
+const taskSearchVector = `to_tsvector('simple', title || ' ' || coalesce(description, ''))`
+
+// exactCountBelow is the estimate under which List counts exactly: the
+// planner's estimates are least reliable for small results, which are
+// cheap to count
+const exactCountBelow = 1000
+
+// countTasks counts the tasks matching whereClause, or asks the planner for
+// an estimate
+func countTasks(ctx context.Context, ex executor, whereClause string, args []interface{}, estimate bool) (int, error) {
+	if estimate {
+		var plan []byte
+		err := ex.QueryRowContext(ctx, "EXPLAIN (FORMAT JSON) SELECT 1 FROM tasks "+whereClause, args...).Scan(&plan)
 		if err != nil {
-			return nil, 0, fmt.Errorf("scanning task: %w", err)
+			return 0, fmt.Errorf("estimating tasks: %w", err)
+		}
+		var explained []struct {
+			Plan struct {
+				Rows float64 `json:"Plan Rows"`
+			} `json:"Plan"`
+		}
+		if err := json.Unmarshal(plan, &explained); err != nil || len(explained) == 0 {
+			return 0, fmt.Errorf("estimating tasks: unexpected plan %s", plan)
+		}
+		if rows := int(explained[0].Plan.Rows); rows >= exactCountBelow {
+			return rows, nil
 		}
-		tasks = append(tasks, task)
 	}
 
-	return tasks, total, nil
+	var total int
+	if err := ex.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks "+whereClause, args...).Scan(&total); err != nil {
+		return 0, fmt.Errorf("counting tasks: %w", err)
+	}
+	return total, nil
+}
+
+// taskPriorityRank orders priorities like domain.Priority.Rank
+const taskPriorityRank = `CASE priority WHEN 'low' THEN 1 WHEN 'medium' THEN 2 WHEN 'high' THEN 3 WHEN 'urgent' THEN 4 ELSE 0 END`
+
+// sortExpression is the SQL ordering tasks by field, ranking priorities and
+// placing tasks without a due date after every other
+func sortExpression(field domain.TaskSortField) string {
+	switch field {
+	case domain.TaskSortDueDate:
+		return `COALESCE(due_date, 'infinity'::timestamptz)`
+	case domain.TaskSortPriority:
+		return taskPriorityRank
+	}
+	return string(field)
+}
+
+// sortArgument is the value of sortExpression for the task at position
+func sortArgument(position *domain.Task, field domain.TaskSortField) interface{} {
+	switch field {
+	case domain.TaskSortCreatedAt:
+		return position.CreatedAt
+	case domain.TaskSortUpdatedAt:
+		return position.UpdatedAt
+	case domain.TaskSortDueDate:
+		if position.DueDate == nil {
+			return "infinity"
+		}
+		return *position.DueDate
+	case domain.TaskSortPriority:
+		return position.Priority.Rank()
+	case domain.TaskSortStatus:
+		return string(position.Status)
+	}
+	return position.Title
+}
+
+// orderByClause orders by the sort fields, then by id in the direction of
+// the last one
+func orderByClause(order []domain.TaskSort) string {
+	terms := make([]string, 0, len(order)+1)
+	for _, sort := range order {
+		terms = append(terms, sortExpression(sort.Field)+direction(sort.Descending))
+	}
+	terms = append(terms, "id"+direction(order[len(order)-1].Descending))
+	return strings.Join(terms, ", ")
+}
+
+func direction(descending bool) string {
+	if descending {
+		return " DESC"
+	}
+	return " ASC"
+}
+
+// keysetCondition selects the tasks after position in order, appending its
+// parameters to args. Keys sorted in one direction compare as a row, which
+// the (tenant_id, created_at, id) index serves; mixed directions expand to
+// (a > $1) OR (a = $1 AND b < $2) OR ...
+func keysetCondition(order []domain.TaskSort, position *domain.Task, args []interface{}) (string, []interface{}) {
+	var exprs, params []string
+	var descending []bool
+	for _, sort := range order {
+		args = append(args, sortArgument(position, sort.Field))
+		exprs = append(exprs, sortExpression(sort.Field))
+		params = append(params, fmt.Sprintf("$%d", len(args)))
+		descending = append(descending, sort.Descending)
+	}
+	args = append(args, position.ID)
+	exprs = append(exprs, "id")
+	params = append(params, fmt.Sprintf("$%d", len(args)))
+	descending = append(descending, order[len(order)-1].Descending)
+
+	uniform := true
+	for _, d := range descending {
+		uniform = uniform && d == descending[0]
+	}
+	if uniform {
+		return fmt.Sprintf("(%s) %s (%s)", strings.Join(exprs, ", "), after(descending[0]), strings.Join(params, ", ")), args
+	}
+
+	branches := make([]string, len(exprs))
+	for i := range exprs {
+		terms := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			terms = append(terms, fmt.Sprintf("%s = %s", exprs[j], params[j]))
+		}
+		terms = append(terms, fmt.Sprintf("%s %s %s", exprs[i], after(descending[i]), params[i]))
+		branches[i] = "(" + strings.Join(terms, " AND ") + ")"
+	}
+	return "(" + strings.Join(branches, " OR ") + ")", args
+}
+
+func after(descending bool) string {
+	if descending {
+		return "<"
+	}
+	return ">"
 }
 
 // GetByStatus retrieves tasks by status
 func (r *TaskRepository) GetByStatus(ctx context.Context, status domain.TaskStatus) ([]*domain.Task, error) {
 	query := `
-		SELECT id, title, description, status, priority, assignee_id, created_by,
-		       created_at, updated_at, completed_at, due_date, tags, metadata
-		FROM tasks WHERE status = $1
+		SELECT ` + taskColumns + `
+		FROM tasks WHERE status = $1 AND ` + tenantCondition(2) + `
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, status)
+	var tasks []*domain.Task
+	err := scoped(ctx, r.db, func(ex executor) error {
+		var err error
+		tasks, err = r.queryTasks(ctx, ex, query, append([]interface{}{status}, tenantArgs(ctx)...)...)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("querying tasks by status: %w", err)
 	}
-	defer func() {
-		_ = rows.Close() // Explicitly ignore error in defer
-	}()
-
-	tasks := make([]*domain.Task, 0)
-	for rows.Next() {
-		task, err := r.scanTask(rows)
-		if err != nil {
-			return nil, fmt.Errorf("scanning task: %w", err)
-		}
-		tasks = append(tasks, task)
-	}
 
 	return tasks, nil
 }
@@ -239,16 +418,30 @@ func (r *TaskRepository) GetByStatus(ctx context.Context, status domain.TaskStat
 // GetByAssignee retrieves tasks assigned to a specific user
 func (r *TaskRepository) GetByAssignee(ctx context.Context, assigneeID types.UUID) ([]*domain.Task, error) {
 	query := `
-		SELECT id, title, description, status, priority, assignee_id, created_by,
-		       created_at, updated_at, completed_at, due_date, tags, metadata
-		FROM tasks WHERE assignee_id = $1
+		SELECT ` + taskColumns + `
+		FROM tasks WHERE assignee_id = $1 AND ` + tenantCondition(2) + `
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, assigneeID)
+	var tasks []*domain.Task
+	err := scoped(ctx, r.db, func(ex executor) error {
+		var err error
+		tasks, err = r.queryTasks(ctx, ex, query, append([]interface{}{assigneeID}, tenantArgs(ctx)...)...)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("querying tasks by assignee: %w", err)
 	}
+
+	return tasks, nil
+}
+
+// queryTasks runs a query selecting taskColumns and scans every row
+func (r *TaskRepository) queryTasks(ctx context.Context, ex executor, query string, args ...interface{}) ([]*domain.Task, error) {
+	rows, err := ex.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying tasks: %w", err)
+	}
 	defer func() {
 		_ = rows.Close() // Explicitly ignore error in defer
 	}()
@@ -261,6 +454,9 @@ func (r *TaskRepository) GetByAssignee(ctx context.Context, assigneeID types.UUI
 		}
 		tasks = append(tasks, task)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating tasks: %w", err)
+	}
 
 	return tasks, nil
 }
@@ -273,7 +469,7 @@ func (r *TaskRepository) scanTask(scanner interface {
 	var tagsJSON, metadataJSON []byte
 
 	err := scanner.Scan(
-		&task.ID, &task.Title, &task.Description, &task.Status, &task.Priority,
+		&task.ID, &task.TenantID, &task.Title, &task.Description, &task.Status, &task.Priority,
 		&task.AssigneeID, &task.CreatedBy, &task.CreatedAt, &task.UpdatedAt,
 		&task.CompletedAt, &task.DueDate, &tagsJSON, &metadataJSON,
 	)
@@ -0,0 +1,115 @@
+//go:build integration
+// +build integration
+
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+)
+
+func TestTaskRepository_ListPagesByCursor(t *testing.T) {
+	db := newTestDB(t, nil)
+	repo := NewTaskRepository(db)
+	ctx := context.Background()
+	owner := createTestUser(t, NewUserRepository(db)).ID
+	base := time.Now().UTC().Truncate(time.Microsecond).Add(-time.Hour)
+
+	priorities := []domain.Priority{domain.PriorityLow, domain.PriorityUrgent, domain.PriorityLow, domain.PriorityHigh, domain.PriorityMedium}
+	for i, priority := range priorities {
+		task := domain.NewTask("Task", "", owner)
+		task.Priority = priority
+		// Two tasks share a timestamp, so the id breaks the tie
+		task.CreatedAt = base.Add(time.Duration(i/2) * time.Minute)
+		if i%2 == 1 {
+			due := base.Add(time.Duration(i) * time.Hour)
+			task.DueDate = &due
+		}
+		require.NoError(t, repo.Create(ctx, task))
+	}
+
+	for name, sort := range map[string][]domain.TaskSort{
+		"default": nil,
+		"mixed":   {{Field: domain.TaskSortPriority, Descending: true}, {Field: domain.TaskSortCreatedAt}},
+		"due":     {{Field: domain.TaskSortDueDate}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			all, total, err := repo.List(ctx, domain.TaskFilter{Sort: sort})
+			require.NoError(t, err)
+			require.Equal(t, 5, total)
+
+			filter := domain.TaskFilter{Sort: sort, Limit: 2, Count: domain.TaskCountNone}
+			var paged []*domain.Task
+			for pages := 0; ; pages++ {
+				require.Less(t, pages, 3)
+				tasks, total, err := repo.List(ctx, filter)
+				require.NoError(t, err)
+				assert.Equal(t, -1, total)
+				paged = append(paged, tasks...)
+				if len(tasks) < filter.Limit {
+					break
+				}
+				filter.After = filter.NextCursor(tasks[len(tasks)-1])
+			}
+
+			require.Len(t, paged, len(all))
+			for i := range all {
+				assert.Equal(t, all[i].ID, paged[i].ID, "cursor pages match the full listing")
+				if i > 0 {
+					assert.Negative(t, domain.CompareTasks(all[i-1], all[i], filter.SortOrder()), "same order as the memory repository")
+				}
+			}
+		})
+	}
+
+	_, _, err := repo.List(ctx, domain.TaskFilter{After: "garbage"})
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor)
+}
+
+func TestTaskRepository_ListMatchesTagsAndQuery(t *testing.T) {
+	db := newTestDB(t, nil)
+	repo := NewTaskRepository(db)
+	ctx := context.Background()
+	owner := createTestUser(t, NewUserRepository(db)).ID
+
+	for _, spec := range []struct {
+		title, description string
+		tags               []string
+	}{
+		{"Deploy gateway", "Roll out the new API gateway", []string{"ops", "api"}},
+		{"Write docs", "Document the gateway", []string{"docs"}},
+		{"Fix login", "", []string{"api"}},
+	} {
+		task := domain.NewTask(spec.title, spec.description, owner)
+		task.Tags = spec.tags
+		require.NoError(t, repo.Create(ctx, task))
+	}
+
+	_, total, err := repo.List(ctx, domain.TaskFilter{Tags: []string{"ops", "api"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total, "all tags by default")
+
+	_, total, err = repo.List(ctx, domain.TaskFilter{Tags: []string{"ops", "docs"}, TagMatch: domain.TagMatchAny})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	_, total, err = repo.List(ctx, domain.TaskFilter{Query: "Gateway"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	tasks, _, err := repo.List(ctx, domain.TaskFilter{Query: "gateway api"})
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "Deploy gateway", tasks[0].Title)
+
+	// Small results are counted exactly even when an estimate is asked for
+	_, total, err = repo.List(ctx, domain.TaskFilter{Tags: []string{"api"}, Count: domain.TaskCountEstimated})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+}
@@ -0,0 +1,148 @@
+//go:build integration
+// +build integration
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+func TestTaskRepository_IsolatesTenants(t *testing.T) {
+	db := newTestDB(t, nil)
+	repo := NewTaskRepository(db)
+	acme := tenant.WithID(context.Background(), "acme")
+	globex := tenant.WithID(context.Background(), "globex")
+
+	task := domain.NewTask("Acme task", "", createTestUser(t, NewUserRepository(db)).ID)
+	require.NoError(t, repo.Create(acme, task))
+	assert.Equal(t, "acme", task.TenantID)
+
+	_, err := repo.GetByID(globex, task.ID)
+	assert.Error(t, err)
+	tasks, total, err := repo.List(globex, domain.TaskFilter{})
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	assert.Empty(t, tasks)
+	tasks, err = repo.GetByStatus(globex, task.Status)
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+
+	hijacked := *task
+	hijacked.Title = "Changed by globex"
+	assert.Error(t, repo.Update(globex, &hijacked))
+	assert.Error(t, repo.Delete(globex, task.ID))
+
+	stored, err := repo.GetByID(acme, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Acme task", stored.Title)
+	assert.Equal(t, "acme", stored.TenantID)
+
+	tasks, total, err = repo.List(tenant.WithAll(globex), domain.TaskFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total, "system contexts see every tenant")
+	assert.Len(t, tasks, 1)
+}
+
+func TestEventRepository_IsolatesTenants(t *testing.T) {
+	repo := NewEventRepository(newTestDB(t, nil))
+	acme := tenant.WithID(context.Background(), "acme")
+	globex := tenant.WithID(context.Background(), "globex")
+
+	event := testEvent(types.New())
+	require.NoError(t, repo.Store(acme, event))
+
+	events, err := repo.GetByAggregateID(globex, event.AggregateID)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+	deleted, err := repo.DeleteByAggregateID(globex, event.AggregateID)
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+	assert.Error(t, repo.ReplaceData(globex, event.ID, nil))
+
+	batch, err := repo.Claim(globex, 10)
+	require.NoError(t, err)
+	require.Len(t, batch.Events(), 1, "the relay claims the events of every tenant")
+	assert.Equal(t, "acme", batch.Events()[0].Event.TenantID)
+	require.NoError(t, batch.Rollback())
+}
+
+func TestFeatureFlagRepository_IsolatesTenants(t *testing.T) {
+	repo := NewFeatureFlagRepository(newTestDB(t, nil))
+	acme := tenant.WithID(context.Background(), "acme")
+	globex := tenant.WithID(context.Background(), "globex")
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	require.NoError(t, repo.Create(acme, &domain.FeatureFlag{Key: "beta", Name: "Beta", Enabled: true, Strategy: "simple", CreatedAt: now, UpdatedAt: now}))
+	_, err := repo.GetByKey(globex, "beta")
+	assert.Error(t, err)
+	assert.Error(t, repo.Delete(globex, "beta"))
+
+	require.NoError(t, repo.Create(globex, &domain.FeatureFlag{Key: "beta", Name: "Beta", Strategy: "simple", CreatedAt: now, UpdatedAt: now}),
+		"keys are unique per tenant")
+	flag, err := repo.GetByKey(acme, "beta")
+	require.NoError(t, err)
+	assert.True(t, flag.Enabled)
+	flags, err := repo.List(globex)
+	require.NoError(t, err)
+	require.Len(t, flags, 1)
+	assert.False(t, flags[0].Enabled)
+}
+
+// TestRowLevelSecurity runs queries without tenant conditions as a role
+// the policies apply to, unlike the superuser of the other tests
+func TestRowLevelSecurity(t *testing.T) {
+	for name, schema := range map[string][]string{"package": nil, "baseline": baselineSchema} {
+		t.Run(name, func(t *testing.T) { testRowLevelSecurity(t, newTestDB(t, schema)) })
+	}
+}
+
+func testRowLevelSecurity(t *testing.T, db *sql.DB) {
+	ctx := context.Background()
+	repo := NewEventRepository(db)
+	for _, tenantID := range []string{"acme", "acme", "globex"} {
+		require.NoError(t, repo.Store(tenant.WithID(ctx, tenantID), testEvent(types.New())))
+	}
+	_, err := db.ExecContext(ctx, `CREATE ROLE app_user NOSUPERUSER; GRANT SELECT, INSERT ON events TO app_user`)
+	require.NoError(t, err)
+
+	asApp := func(settings string, fn func(tx *sql.Tx)) {
+		tx, err := db.BeginTx(ctx, nil)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback() }()
+		_, err = tx.ExecContext(ctx, `SET LOCAL ROLE app_user; `+settings)
+		require.NoError(t, err)
+		fn(tx)
+	}
+	count := func(tx *sql.Tx) int {
+		var n int
+		require.NoError(t, tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM events`).Scan(&n))
+		return n
+	}
+
+	asApp(`SELECT 1`, func(tx *sql.Tx) {
+		assert.Zero(t, count(tx), "without a tenant no row is visible")
+	})
+	asApp(`SELECT set_config('app.tenant_id', 'acme', true)`, func(tx *sql.Tx) {
+		assert.Equal(t, 2, count(tx))
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO events (id, tenant_id, type, aggregate_id, payload) VALUES ($1, 'globex', 'task.updated', $2, '{}')`,
+			types.New(), types.New())
+		assert.Error(t, err, "rows of another tenant cannot be written")
+	})
+	asApp(`SELECT set_config('app.tenant_id', 'globex', true)`, func(tx *sql.Tx) {
+		assert.Equal(t, 1, count(tx))
+	})
+	asApp(`SELECT set_config('app.all_tenants', 'on', true)`, func(tx *sql.Tx) {
+		assert.Equal(t, 3, count(tx))
+	})
+}
@@ -0,0 +1,37 @@
+-- 0002_compliance_audit_log.sql
+-- Log de auditoria de compliance (LGPD/GDPR) encadeado por hash
+
+BEGIN;
+
+-- Cada registro guarda o hash do anterior; "event" é o JSON exato que foi
+-- hasheado, por isso é TEXT e não JSONB (JSONB reordena as chaves)
+CREATE TABLE IF NOT EXISTS compliance_audit_log (
+    sequence BIGINT PRIMARY KEY,
+    event_id TEXT NOT NULL UNIQUE,
+    event_type TEXT NOT NULL,
+    subject_id TEXT NOT NULL DEFAULT '',
+    occurred_at TIMESTAMPTZ NOT NULL,
+    event TEXT NOT NULL,
+    previous_hash CHAR(64) NOT NULL,
+    hash CHAR(64) NOT NULL UNIQUE
+);
+
+CREATE INDEX IF NOT EXISTS idx_compliance_audit_subject ON compliance_audit_log (subject_id, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_compliance_audit_type ON compliance_audit_log (event_type, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_compliance_audit_occurred ON compliance_audit_log (occurred_at);
+
+-- Somente inserção: UPDATE, DELETE e TRUNCATE são rejeitados
+CREATE OR REPLACE FUNCTION compliance_audit_log_append_only()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'compliance_audit_log is append-only';
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER compliance_audit_log_no_update BEFORE UPDATE OR DELETE ON compliance_audit_log
+    FOR EACH ROW EXECUTE FUNCTION compliance_audit_log_append_only();
+
+CREATE TRIGGER compliance_audit_log_no_truncate BEFORE TRUNCATE ON compliance_audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION compliance_audit_log_append_only();
+
+COMMIT;
@@ -0,0 +1,78 @@
+-- 0003_compliance_consent_retention.sql
+-- Consentimentos e registros de retenção de dados (LGPD/GDPR)
+
+BEGIN;
+
+-- Versão atual de cada consentimento: uma linha por titular e finalidade
+CREATE TABLE IF NOT EXISTS compliance_consents (
+    id TEXT PRIMARY KEY,
+    subject_id TEXT NOT NULL,
+    purpose TEXT NOT NULL,
+    granted BOOLEAN NOT NULL,
+    legal_basis TEXT NOT NULL,
+    consent_source TEXT NOT NULL DEFAULT '',
+    consented_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ,
+    withdrawn_at TIMESTAMPTZ,
+    ip_address TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    consent_string TEXT NOT NULL DEFAULT '',
+    metadata JSONB,
+    version INTEGER NOT NULL CHECK (version > 0),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (subject_id, purpose)
+);
+
+-- Consentimentos vencidos: concedidos, não revogados e com expires_at no passado
+CREATE INDEX IF NOT EXISTS idx_compliance_consents_expires ON compliance_consents (expires_at)
+    WHERE granted AND withdrawn_at IS NULL;
+
+-- Histórico: uma cópia de cada versão gravada, inclusive a atual
+CREATE TABLE IF NOT EXISTS compliance_consent_history (
+    consent_id TEXT NOT NULL,
+    version INTEGER NOT NULL,
+    subject_id TEXT NOT NULL,
+    purpose TEXT NOT NULL,
+    granted BOOLEAN NOT NULL,
+    legal_basis TEXT NOT NULL,
+    consent_source TEXT NOT NULL DEFAULT '',
+    consented_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ,
+    withdrawn_at TIMESTAMPTZ,
+    ip_address TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    consent_string TEXT NOT NULL DEFAULT '',
+    metadata JSONB,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (consent_id, version)
+);
+
+CREATE INDEX IF NOT EXISTS idx_compliance_consent_history_subject ON compliance_consent_history (subject_id, purpose, version);
+
+-- Registros de retenção; extensions e metadata são JSON
+CREATE TABLE IF NOT EXISTS compliance_retention_records (
+    id TEXT PRIMARY KEY,
+    subject_id TEXT NOT NULL,
+    data_type TEXT NOT NULL,
+    policy_id TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    retention_start TIMESTAMPTZ NOT NULL,
+    retention_end TIMESTAMPTZ NOT NULL,
+    grace_end TIMESTAMPTZ,
+    status TEXT NOT NULL,
+    action TEXT NOT NULL,
+    action_taken BOOLEAN NOT NULL DEFAULT FALSE,
+    action_taken_at TIMESTAMPTZ,
+    legal_hold BOOLEAN NOT NULL DEFAULT FALSE,
+    legal_hold_reason TEXT NOT NULL DEFAULT '',
+    extensions JSONB,
+    metadata JSONB,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_compliance_retention_subject ON compliance_retention_records (subject_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_compliance_retention_expiry ON compliance_retention_records (status, retention_end);
+
+COMMIT;
@@ -0,0 +1,31 @@
+-- 0004_event_outbox.sql
+-- Outbox transacional: eventos gravados na mesma transação da task e
+-- publicados no NATS por um relay (events.OutboxRelay)
+
+BEGIN;
+
+ALTER TABLE events
+    ADD COLUMN IF NOT EXISTS aggregate_id UUID,
+    ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS occurred_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS seq BIGINT GENERATED ALWAYS AS IDENTITY,
+    ADD COLUMN IF NOT EXISTS published_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS last_error TEXT;
+
+-- Eventos anteriores ao outbox não são republicados
+UPDATE events SET published_at = created_at WHERE published_at IS NULL;
+
+-- Eventos de domínio não carregam tenant nem MCP ainda
+ALTER TABLE events
+    ALTER COLUMN tenant_id SET DEFAULT 'default',
+    ALTER COLUMN mcp_id SET DEFAULT 'mcp-ultra';
+
+-- "seq" ordena os eventos de cada agregado; o relay publica apenas o mais
+-- antigo ainda pendente de cada um
+CREATE INDEX IF NOT EXISTS idx_events_aggregate_seq ON events (aggregate_id, seq);
+CREATE INDEX IF NOT EXISTS idx_events_outbox_pending ON events (seq) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_events_outbox_published ON events (published_at) WHERE published_at IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,31 @@
+-- 0005_tenant_isolation.sql
+-- Isolamento por tenant: Row Level Security como barreira adicional às
+-- condições de tenant aplicadas pelos repositórios
+
+BEGIN;
+
+-- Tasks criadas sem tenant pertencem ao tenant padrão, como os eventos
+ALTER TABLE tasks ALTER COLUMN tenant_id SET DEFAULT 'default';
+
+CREATE INDEX IF NOT EXISTS idx_tasks_tenant_created ON tasks (tenant_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_events_tenant_aggregate ON events (tenant_id, aggregate_id);
+
+-- Cada transação dos repositórios define app.tenant_id; processos de
+-- sistema (relay do outbox, retenção) definem app.all_tenants = 'on'.
+-- Sem nenhum dos dois nenhuma linha é visível. Superusuários ignoram as
+-- políticas; FORCE as aplica também ao dono das tabelas.
+ALTER TABLE tasks ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tasks FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON tasks;
+CREATE POLICY tenant_isolation ON tasks
+    USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on')
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on');
+
+ALTER TABLE events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE events FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS tenant_isolation ON events;
+CREATE POLICY tenant_isolation ON events
+    USING (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on')
+    WITH CHECK (tenant_id = current_setting('app.tenant_id', true) OR current_setting('app.all_tenants', true) = 'on');
+
+COMMIT;
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
+)
+
+// executor is the part of *sql.DB and *sql.Tx the repositories use
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+type txKey struct{}
+
+// conn returns the transaction TxManager put in ctx, or db outside one
+func conn(ctx context.Context, db *sql.DB) executor {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return db
+}
+
+// scoped runs fn on the transaction in ctx, or on a new transaction scoped
+// to the tenant of ctx when there is none, so the row level security
+// policies apply to every statement fn runs
+func scoped(ctx context.Context, db *sql.DB, fn func(ex executor) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(tx)
+	}
+	return NewTxManager(db).WithinTx(ctx, func(ctx context.Context) error {
+		return fn(conn(ctx, db))
+	})
+}
+
+// scopedExec runs a statement like scoped and returns the number of rows it
+// affected
+func scopedExec(ctx context.Context, db *sql.DB, query string, args ...interface{}) (int64, error) {
+	var affected int64
+	err := scoped(ctx, db, func(ex executor) error {
+		result, err := ex.ExecContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		affected, err = result.RowsAffected()
+		return err
+	})
+	return affected, err
+}
+
+// setTenant scopes the row level security policies of tx to the tenant of
+// ctx for the rest of the transaction
+func setTenant(ctx context.Context, tx *sql.Tx) error {
+	allTenants := "off"
+	if tenant.IsAll(ctx) {
+		allTenants = "on"
+	}
+	_, err := tx.ExecContext(ctx,
+		`SELECT set_config('app.tenant_id', $1, true), set_config('app.all_tenants', $2, true)`,
+		tenant.ID(ctx), allTenants)
+	if err != nil {
+		return fmt.Errorf("setting transaction tenant: %w", err)
+	}
+	return nil
+}
+
+// tenantCondition restricts the rows of a query to the tenant of ctx,
+// unless ctx acts for every tenant. Its parameters are numbered from
+// argIndex and come from tenantArgs.
+func tenantCondition(argIndex int) string {
+	return fmt.Sprintf("(tenant_id = $%d OR $%d)", argIndex, argIndex+1)
+}
+
+// tenantArgs returns the parameters of tenantCondition
+func tenantArgs(ctx context.Context) []interface{} {
+	return []interface{}{tenant.ID(ctx), tenant.IsAll(ctx)}
+}
+
+// TxManager implements domain.Transactor over a PostgreSQL connection pool
+type TxManager struct {
+	db *sql.DB
+}
+
+var _ domain.Transactor = (*TxManager)(nil)
+
+// NewTxManager creates a transaction manager for the repositories sharing db
+func NewTxManager(db *sql.DB) *TxManager {
+	return &TxManager{db: db}
+}
+
+// WithinTx runs fn in a transaction scoped to the tenant of ctx. Nested
+// calls join the transaction already in ctx.
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	if err := setTenant(ctx, tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil && !errors.Is(rbErr, sql.ErrTxDone) {
+			return errors.Join(err, fmt.Errorf("rolling back transaction: %w", rbErr))
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
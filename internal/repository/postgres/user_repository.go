@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+// uniqueViolation is the PostgreSQL error code of a unique constraint
+// violation
+const uniqueViolation = "23505"
+
+// UserRepository implements domain.UserRepository using PostgreSQL
+type UserRepository struct {
+	db *sql.DB
+}
+
+var _ domain.UserRepository = (*UserRepository)(nil)
+
+// NewUserRepository creates a new PostgreSQL user repository
+func NewUserRepository(db *sql.DB) *UserRepository {
+	return &UserRepository{db: db}
+}
+
+const userColumns = `id, email, name, role, created_at, updated_at, active`
+
+// Create inserts a new user
+func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
+	query := `
+		INSERT INTO users (id, email, name, role, created_at, updated_at, active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query,
+		user.ID, user.Email, user.Name, user.Role, user.CreatedAt, user.UpdatedAt, user.Active,
+	)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		if pqErr.Constraint == "users_email_key" {
+			return fmt.Errorf("email already in use: %s", user.Email)
+		}
+		return fmt.Errorf("user already exists: %s", user.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("creating user: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a user by ID
+func (r *UserRepository) GetByID(ctx context.Context, id types.UUID) (*domain.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
+
+	return scanUser(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+}
+
+// GetByEmail retrieves a user by email, ignoring case
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE lower(email) = lower($1)`
+
+	return scanUser(conn(ctx, r.db).QueryRowContext(ctx, query, email))
+}
+
+// Update updates an existing user
+func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
+	query := `
+		UPDATE users SET email = $2, name = $3, role = $4, updated_at = $5, active = $6
+		WHERE id = $1
+	`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query,
+		user.ID, user.Email, user.Name, user.Role, user.UpdatedAt, user.Active,
+	)
+	if err != nil {
+		return fmt.Errorf("updating user: %w", err)
+	}
+
+	affected, _ := result.RowsAffected()
+	if affected == 0 {
+		return fmt.Errorf("user not found")
+	}
+
+	return nil
+}
+
+// Delete removes a user
+func (r *UserRepository) Delete(ctx context.Context, id types.UUID) error {
+	result, err := conn(ctx, r.db).ExecContext(ctx, `DELETE FROM users WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("deleting user: %w", err)
+	}
+
+	affected, _ := result.RowsAffected()
+	if affected == 0 {
+		return fmt.Errorf("user not found")
+	}
+
+	return nil
+}
+
+// List retrieves users ordered by creation time; a limit of zero or less
+// returns every user from offset on
+func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]*domain.User, int, error) {
+	var total int
+	if err := conn(ctx, r.db).QueryRowContext(ctx, `SELECT COUNT(*) FROM users`).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("counting users: %w", err)
+	}
+
+	pageSize := sql.NullInt64{Int64: int64(limit), Valid: limit > 0}
+	if offset < 0 {
+		offset = 0
+	}
+	query := `SELECT ` + userColumns + ` FROM users ORDER BY created_at, id LIMIT $1 OFFSET $2`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, pageSize, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("listing users: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	users := make([]*domain.User, 0)
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("scanning user: %w", err)
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("iterating users: %w", err)
+	}
+
+	return users, total, nil
+}
+
+// scanUser scans the userColumns of a row into a User
+func scanUser(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*domain.User, error) {
+	var user domain.User
+
+	err := scanner.Scan(
+		&user.ID, &user.Email, &user.Name, &user.Role, &user.CreatedAt, &user.UpdatedAt, &user.Active,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
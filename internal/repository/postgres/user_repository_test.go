@@ -0,0 +1,82 @@
+//go:build integration
+// +build integration
+
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/pkg/types"
+)
+
+func createTestUser(t *testing.T, repo *UserRepository) *domain.User {
+	t.Helper()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	id := types.New()
+	user := &domain.User{
+		ID: id, Email: id.String() + "@example.com", Name: "Ana", Role: domain.RoleUser,
+		CreatedAt: now, UpdatedAt: now, Active: true,
+	}
+	require.NoError(t, repo.Create(context.Background(), user))
+	return user
+}
+
+func TestUserRepository(t *testing.T) {
+	repo := NewUserRepository(newTestDB(t, nil))
+	ctx := context.Background()
+	user := createTestUser(t, repo)
+
+	found, err := repo.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, user.Email, found.Email)
+	assert.True(t, user.CreatedAt.Equal(found.CreatedAt))
+
+	found, err = repo.GetByEmail(ctx, user.ID.String()+"@EXAMPLE.com")
+	require.NoError(t, err, "emails are matched ignoring case")
+	assert.Equal(t, user.ID, found.ID)
+
+	duplicate := *user
+	assert.EqualError(t, repo.Create(ctx, &duplicate), "user already exists: "+user.ID.String())
+	duplicate.ID = types.New()
+	assert.EqualError(t, repo.Create(ctx, &duplicate), "email already in use: "+user.Email)
+
+	user.Name, user.Role, user.Active = "Ana Souza", domain.RoleAdmin, false
+	require.NoError(t, repo.Update(ctx, user))
+	found, err = repo.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Ana Souza", found.Name)
+	assert.Equal(t, domain.RoleAdmin, found.Role)
+	assert.False(t, found.Active)
+
+	require.NoError(t, repo.Delete(ctx, user.ID))
+	assert.EqualError(t, repo.Delete(ctx, user.ID), "user not found")
+	assert.EqualError(t, repo.Update(ctx, user), "user not found")
+	_, err = repo.GetByID(ctx, user.ID)
+	assert.EqualError(t, err, "user not found")
+}
+
+func TestUserRepository_List(t *testing.T) {
+	repo := NewUserRepository(newTestDB(t, nil))
+	ctx := context.Background()
+	var created []types.UUID
+	for i := 0; i < 3; i++ {
+		created = append(created, createTestUser(t, repo).ID)
+	}
+
+	page, total, err := repo.List(ctx, 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, total)
+	require.Len(t, page, 2)
+	assert.Equal(t, created[1], page[0].ID)
+	assert.Equal(t, created[2], page[1].ID)
+
+	all, _, err := repo.List(ctx, 0, 0)
+	require.NoError(t, err)
+	assert.Len(t, all, 3, "a limit of zero lists every user")
+}
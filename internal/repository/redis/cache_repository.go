@@ -7,9 +7,12 @@ import (
 	"time"
 
 	"github.com/redis/go-redis/v9"
+
+	"github.com/vertikon/mcp-ultra/internal/tenant"
 )
 
-// CacheRepository implements domain.CacheRepository using Redis
+// CacheRepository implements domain.CacheRepository using Redis. Keys are
+// scoped to the tenant of their context, "tenant:<id>:<key>".
 type CacheRepository struct {
 	client *redis.Client
 }
@@ -31,7 +34,7 @@ func (r *CacheRepository) Set(ctx context.Context, key string, value interface{}
 		expiration = 0 // No expiration
 	}
 
-	err = r.client.Set(ctx, key, data, expiration).Err()
+	err = r.client.Set(ctx, tenant.Key(ctx, key), data, expiration).Err()
 	if err != nil {
 		return fmt.Errorf("setting cache value: %w", err)
 	}
@@ -41,7 +44,7 @@ func (r *CacheRepository) Set(ctx context.Context, key string, value interface{}
 
 // Get retrieves a value from cache
 func (r *CacheRepository) Get(ctx context.Context, key string) (string, error) {
-	result, err := r.client.Get(ctx, key).Result()
+	result, err := r.client.Get(ctx, tenant.Key(ctx, key)).Result()
 	if err == redis.Nil {
 		return "", fmt.Errorf("key not found")
 	}
@@ -54,7 +57,7 @@ func (r *CacheRepository) Get(ctx context.Context, key string) (string, error) {
 
 // Delete removes a key from cache
 func (r *CacheRepository) Delete(ctx context.Context, key string) error {
-	err := r.client.Del(ctx, key).Err()
+	err := r.client.Del(ctx, tenant.Key(ctx, key)).Err()
 	if err != nil {
 		return fmt.Errorf("deleting cache key: %w", err)
 	}
@@ -64,7 +67,7 @@ func (r *CacheRepository) Delete(ctx context.Context, key string) error {
 
 // Exists checks if a key exists in cache
 func (r *CacheRepository) Exists(ctx context.Context, key string) (bool, error) {
-	result, err := r.client.Exists(ctx, key).Result()
+	result, err := r.client.Exists(ctx, tenant.Key(ctx, key)).Result()
 	if err != nil {
 		return false, fmt.Errorf("checking cache key existence: %w", err)
 	}
@@ -74,7 +77,7 @@ func (r *CacheRepository) Exists(ctx context.Context, key string) (bool, error)
 
 // Increment increments a counter
 func (r *CacheRepository) Increment(ctx context.Context, key string) (int64, error) {
-	result, err := r.client.Incr(ctx, key).Result()
+	result, err := r.client.Incr(ctx, tenant.Key(ctx, key)).Result()
 	if err != nil {
 		return 0, fmt.Errorf("incrementing counter: %w", err)
 	}
@@ -94,7 +97,7 @@ func (r *CacheRepository) SetNX(ctx context.Context, key string, value interface
 		expiration = 0 // No expiration
 	}
 
-	result, err := r.client.SetNX(ctx, key, data, expiration).Result()
+	result, err := r.client.SetNX(ctx, tenant.Key(ctx, key), data, expiration).Result()
 	if err != nil {
 		return false, fmt.Errorf("setting cache value with NX: %w", err)
 	}
@@ -124,7 +127,7 @@ func (r *CacheRepository) SetWithExpiry(ctx context.Context, key string, value i
 		return fmt.Errorf("marshaling value: %w", err)
 	}
 
-	err = r.client.SetEx(ctx, key, data, time.Until(expiry)).Err()
+	err = r.client.SetEx(ctx, tenant.Key(ctx, key), data, time.Until(expiry)).Err()
 	if err != nil {
 		return fmt.Errorf("setting cache value with expiry: %w", err)
 	}
@@ -134,7 +137,7 @@ func (r *CacheRepository) SetWithExpiry(ctx context.Context, key string, value i
 
 // GetTTL returns the remaining time-to-live of a key
 func (r *CacheRepository) GetTTL(ctx context.Context, key string) (time.Duration, error) {
-	result, err := r.client.TTL(ctx, key).Result()
+	result, err := r.client.TTL(ctx, tenant.Key(ctx, key)).Result()
 	if err != nil {
 		return 0, fmt.Errorf("getting TTL: %w", err)
 	}
@@ -142,7 +145,7 @@ func (r *CacheRepository) GetTTL(ctx context.Context, key string) (time.Duration
 	return result, nil
 }
 
-// FlushAll removes all keys (use with caution)
+// FlushAll removes all keys of every tenant (use with caution)
 func (r *CacheRepository) FlushAll(ctx context.Context) error {
 	err := r.client.FlushAll(ctx).Err()
 	if err != nil {
@@ -5,23 +5,36 @@ import (
 	"crypto/rsa"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/tenant"
 )
 
 // Context keys for auth data
 type contextKey string
 
 const (
-	userKey     contextKey = "user"
-	userIDKey   contextKey = "user_id"
-	tenantIDKey contextKey = "tenant_id"
+	userKey   contextKey = "user"
+	userIDKey contextKey = "user_id"
+)
+
+var (
+	// ErrInvalidToken means a request carries no token, or one that does
+	// not verify
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrInvalidTenant means a verified token names an unusable tenant
+	ErrInvalidTenant = errors.New("invalid tenant")
+	// ErrForbidden means the authorizer denied a verified token the request
+	ErrForbidden = errors.New("insufficient permissions")
 )
 
 // OPAAuthorizer is the interface for OPA authorization
@@ -41,10 +54,12 @@ type Claims struct {
 
 // AuthConfig holds authentication configuration
 type AuthConfig struct {
-	Mode          string        `yaml:"mode"` // jwt, oauth2, api-key
-	JWKSUrl       string        `yaml:"jwks_url"`
-	Issuer        string        `yaml:"issuer"`
-	Audience      string        `yaml:"audience"`
+	// Mode is jwt (the default) or none; none leaves the APIs open and is
+	// refused in production
+	Mode          string        `yaml:"mode" envconfig:"AUTH_MODE"`
+	JWKSUrl       string        `yaml:"jwks_url" envconfig:"JWT_JWKS_URL"`
+	Issuer        string        `yaml:"issuer" envconfig:"JWT_ISSUER"`
+	Audience      string        `yaml:"audience" envconfig:"JWT_AUDIENCE"`
 	TokenExpiry   time.Duration `yaml:"token_expiry"`
 	RefreshExpiry time.Duration `yaml:"refresh_expiry"`
 }
@@ -52,12 +67,14 @@ type AuthConfig struct {
 // AuthService handles JWT authentication and authorization
 type AuthService struct {
 	config     AuthConfig
+	keysMu     sync.RWMutex
 	publicKeys map[string]*rsa.PublicKey
 	logger     *zap.Logger
 	opa        OPAAuthorizer
 }
 
-// NewAuthService creates a new authentication service
+// NewAuthService creates a new authentication service. A nil opa skips
+// authorization, so every verified token is allowed.
 func NewAuthService(config AuthConfig, logger *zap.Logger, opa OPAAuthorizer) *AuthService {
 	as := &AuthService{
 		config:     config,
@@ -99,33 +116,73 @@ func (as *AuthService) JWTMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		// Parse and validate token
-		claims, err := as.validateToken(tokenString)
-		if err != nil {
-			as.logger.Warn("Token validation failed", zap.Error(err))
-			as.writeUnauthorized(w, "invalid token")
+		ctx, err := as.Authenticate(r.Context(), tokenString, r.Method, r.URL.Path)
+		switch {
+		case errors.Is(err, ErrForbidden):
+			as.writeForbidden(w, ErrForbidden.Error())
 			return
-		}
-
-		// Check OPA authorization
-		if !as.opa.IsAuthorized(r.Context(), claims, r.Method, r.URL.Path) {
-			as.writeForbidden(w, "insufficient permissions")
+		case errors.Is(err, ErrInvalidTenant):
+			as.writeUnauthorized(w, ErrInvalidTenant.Error())
+			return
+		case err != nil:
+			as.writeUnauthorized(w, ErrInvalidToken.Error())
 			return
 		}
 
-		// Add user context
-		ctx := context.WithValue(r.Context(), userKey, claims)
-		ctx = context.WithValue(ctx, userIDKey, claims.UserID)
-		ctx = context.WithValue(ctx, tenantIDKey, claims.TenantID)
-
 		// Set security headers
+		claims, _ := GetUserFromContext(ctx)
 		w.Header().Set("X-User-ID", claims.UserID)
-		w.Header().Set("X-Tenant-ID", claims.TenantID)
+		w.Header().Set("X-Tenant-ID", tenant.ID(ctx))
 
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// Authenticate verifies token and authorizes method on path for it. The
+// returned context carries the token's claims, user and tenant; tokens
+// without a tenant act for the default tenant. Errors wrap ErrInvalidToken,
+// ErrInvalidTenant or ErrForbidden.
+func (as *AuthService) Authenticate(ctx context.Context, token, method, path string) (context.Context, error) {
+	claims, err := as.validateToken(token)
+	if err != nil {
+		as.logger.Warn("Token validation failed", zap.Error(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	// Check OPA authorization
+	if as.opa != nil && !as.opa.IsAuthorized(ctx, claims, method, path) {
+		return nil, ErrForbidden
+	}
+
+	if claims.TenantID == "" {
+		claims.TenantID = tenant.Default
+	}
+	if err := tenant.Validate(claims.TenantID); err != nil {
+		as.logger.Warn("Token tenant rejected", zap.Error(err))
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTenant, err)
+	}
+
+	return WithClaims(ctx, claims), nil
+}
+
+// WithClaims returns a copy of ctx acting as the user and tenant of claims
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	ctx = context.WithValue(ctx, userKey, claims)
+	ctx = context.WithValue(ctx, userIDKey, claims.UserID)
+	if claims.TenantID != "" {
+		ctx = tenant.WithID(ctx, claims.TenantID)
+	}
+	return ctx
+}
+
+// AddPublicKey trusts key for tokens signed with key ID kid, alongside the
+// keys loaded from the JWKS URL
+func (as *AuthService) AddPublicKey(kid string, key *rsa.PublicKey) {
+	as.keysMu.Lock()
+	defer as.keysMu.Unlock()
+	as.publicKeys[kid] = key
+}
+
 // validateToken parses and validates JWT token
 func (as *AuthService) validateToken(tokenString string) (*Claims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
@@ -141,7 +198,9 @@ func (as *AuthService) validateToken(tokenString string) (*Claims, error) {
 		}
 
 		// Get public key for this key ID
+		as.keysMu.RLock()
 		publicKey, ok := as.publicKeys[kid]
+		as.keysMu.RUnlock()
 		if !ok {
 			return nil, fmt.Errorf("unknown key ID: %s", kid)
 		}
@@ -205,10 +264,10 @@ func (as *AuthService) loadJWKS() error {
 			as.logger.Warn("Failed to convert JWK to RSA", zap.String("kid", key.Kid), zap.Error(err))
 			continue
 		}
-		as.publicKeys[key.Kid] = publicKey
+		as.AddPublicKey(key.Kid, publicKey)
 	}
 
-	as.logger.Info("Loaded JWKS", zap.Int("keys_count", len(as.publicKeys)))
+	as.logger.Info("Loaded JWKS", zap.Int("keys_count", len(jwks.Keys)))
 	return nil
 }
 
@@ -258,7 +317,7 @@ func (as *AuthService) jwkToRSA(n, e string) (*rsa.PublicKey, error) {
 
 // GetUserFromContext extracts user claims from request context
 func GetUserFromContext(ctx context.Context) (*Claims, error) {
-	user, ok := ctx.Value("user").(*Claims)
+	user, ok := ctx.Value(userKey).(*Claims)
 	if !ok {
 		return nil, fmt.Errorf("user not found in context")
 	}
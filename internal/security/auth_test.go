@@ -14,6 +14,8 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+
+	"github.com/vertikon/mcp-ultra/internal/tenant"
 )
 
 // Mock OPA Service
@@ -102,6 +104,7 @@ func TestJWTMiddleware_Success(t *testing.T) {
 		user, err := GetUserFromContext(r.Context())
 		assert.NoError(t, err)
 		assert.Equal(t, "user123", user.UserID)
+		assert.Equal(t, "tenant123", tenant.ID(r.Context()))
 
 		w.WriteHeader(http.StatusOK)
 		if _, writeErr := w.Write([]byte("success")); writeErr != nil {
@@ -18,7 +18,7 @@ const (
 
 // OPAConfig holds OPA configuration
 type OPAConfig struct {
-	URL     string        `yaml:"url"`
+	URL     string        `yaml:"url" envconfig:"OPA_URL"`
 	Timeout time.Duration `yaml:"timeout"`
 }
 
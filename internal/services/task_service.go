@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/tenant"
 	"github.com/vertikon/mcp-ultra/pkg/types"
 )
 
@@ -20,6 +21,32 @@ type TaskService struct {
 	cacheRepo domain.CacheRepository
 	logger    *zap.Logger
 	eventBus  EventBus
+	preSave   []TaskHook
+	tx        domain.Transactor
+}
+
+// TaskHook runs on a task before it is saved; an error aborts the save
+type TaskHook func(ctx context.Context, task *domain.Task) error
+
+// TaskServiceOption configures optional TaskService behaviour
+type TaskServiceOption func(*TaskService)
+
+// WithPreSaveHook runs hook on every task CreateTask and UpdateTask are
+// about to save, e.g. to mask PII. Hooks run in the order given.
+func WithPreSaveHook(hook TaskHook) TaskServiceOption {
+	return func(s *TaskService) {
+		s.preSave = append(s.preSave, hook)
+	}
+}
+
+// WithTransactor stores each task change and its event in one transaction
+// through tx, making eventRepo a transactional outbox. Events are then not
+// published by the service; an events.OutboxRelay publishes them once
+// committed.
+func WithTransactor(tx domain.Transactor) TaskServiceOption {
+	return func(s *TaskService) {
+		s.tx = tx
+	}
 }
 
 // EventBus defines interface for publishing events
@@ -35,8 +62,9 @@ func NewTaskService(
 	cacheRepo domain.CacheRepository,
 	logger *zap.Logger,
 	eventBus EventBus,
+	opts ...TaskServiceOption,
 ) *TaskService {
-	return &TaskService{
+	s := &TaskService{
 		taskRepo:  taskRepo,
 		userRepo:  userRepo,
 		eventRepo: eventRepo,
@@ -44,6 +72,10 @@ func NewTaskService(
 		logger:    logger,
 		eventBus:  eventBus,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // CreateTask creates a new task
@@ -68,17 +100,16 @@ func (s *TaskService) CreateTask(ctx context.Context, req CreateTaskRequest) (*d
 
 	// Create task
 	task := domain.NewTask(req.Title, req.Description, creator.ID)
+	task.TenantID = tenant.ID(ctx)
 	task.Priority = req.Priority
 	task.AssigneeID = req.AssigneeID
 	task.DueDate = req.DueDate
 	task.Tags = req.Tags
 
-	// Save to repository
-	if err := s.taskRepo.Create(ctx, task); err != nil {
-		return nil, fmt.Errorf("creating task: %w", err)
+	if err := s.runPreSave(ctx, task); err != nil {
+		return nil, err
 	}
 
-	// Publish event
 	event := &domain.Event{
 		ID:          types.New(),
 		Type:        "task.created",
@@ -89,13 +120,21 @@ func (s *TaskService) CreateTask(ctx context.Context, req CreateTaskRequest) (*d
 			"created_by":  task.CreatedBy,
 			"assignee_id": task.AssigneeID,
 			"priority":    task.Priority,
+			"task":        task,
 		},
 		OccurredAt: time.Now(),
 		Version:    1,
 	}
 
-	if err := s.publishEvent(ctx, event); err != nil {
-		s.logger.Error("Failed to publish task created event", zap.Error(err))
+	// Save to repository
+	err = s.save(ctx, event, func(ctx context.Context) error {
+		if err := s.taskRepo.Create(ctx, task); err != nil {
+			return fmt.Errorf("creating task: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Clear cache
@@ -143,12 +182,17 @@ func (s *TaskService) UpdateTask(ctx context.Context, id types.UUID, req UpdateT
 
 	task.UpdatedAt = time.Now()
 
-	// Save changes
-	if err := s.taskRepo.Update(ctx, task); err != nil {
-		return nil, fmt.Errorf("updating task: %w", err)
+	if err := s.runPreSave(ctx, task); err != nil {
+		return nil, err
+	}
+	// The event reports the changes as saved, after the hooks ran
+	if req.Title != nil {
+		req.Title = &task.Title
+	}
+	if req.Description != nil {
+		req.Description = &task.Description
 	}
 
-	// Publish event
 	event := &domain.Event{
 		ID:          types.New(),
 		Type:        "task.updated",
@@ -156,13 +200,21 @@ func (s *TaskService) UpdateTask(ctx context.Context, id types.UUID, req UpdateT
 		Data: map[string]interface{}{
 			"task_id": task.ID,
 			"changes": req,
+			"task":    task,
 		},
 		OccurredAt: time.Now(),
 		Version:    1,
 	}
 
-	if err := s.publishEvent(ctx, event); err != nil {
-		s.logger.Error("Failed to publish task updated event", zap.Error(err))
+	// Save changes
+	err = s.save(ctx, event, func(ctx context.Context) error {
+		if err := s.taskRepo.Update(ctx, task); err != nil {
+			return fmt.Errorf("updating task: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Clear cache
@@ -173,6 +225,48 @@ func (s *TaskService) UpdateTask(ctx context.Context, id types.UUID, req UpdateT
 	return task, nil
 }
 
+// UnassignTask removes the assignee of a task. UpdateTask cannot do it, as
+// a nil AssigneeID there leaves the assignee unchanged.
+func (s *TaskService) UnassignTask(ctx context.Context, id types.UUID) (*domain.Task, error) {
+	task, err := s.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("task not found: %w", err)
+	}
+
+	task.AssigneeID = nil
+	task.UpdatedAt = time.Now()
+
+	event := &domain.Event{
+		ID:          types.New(),
+		Type:        "task.updated",
+		AggregateID: task.ID,
+		Data: map[string]interface{}{
+			"task_id": task.ID,
+			"changes": map[string]interface{}{"assignee_id": nil},
+			"task":    task,
+		},
+		OccurredAt: time.Now(),
+		Version:    1,
+	}
+
+	err = s.save(ctx, event, func(ctx context.Context) error {
+		if err := s.taskRepo.Update(ctx, task); err != nil {
+			return fmt.Errorf("unassigning task: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Clear cache
+	s.invalidateTaskCache(ctx)
+
+	s.logger.Info("Task unassigned", zap.String("task_id", task.ID.String()))
+
+	return task, nil
+}
+
 // CompleteTask marks a task as completed
 func (s *TaskService) CompleteTask(ctx context.Context, id types.UUID) (*domain.Task, error) {
 	task, err := s.taskRepo.GetByID(ctx, id)
@@ -186,11 +280,6 @@ func (s *TaskService) CompleteTask(ctx context.Context, id types.UUID) (*domain.
 
 	task.Complete()
 
-	if err := s.taskRepo.Update(ctx, task); err != nil {
-		return nil, fmt.Errorf("completing task: %w", err)
-	}
-
-	// Publish event
 	event := &domain.Event{
 		ID:          types.New(),
 		Type:        "task.completed",
@@ -198,13 +287,20 @@ func (s *TaskService) CompleteTask(ctx context.Context, id types.UUID) (*domain.
 		Data: map[string]interface{}{
 			"task_id":      task.ID,
 			"completed_at": task.CompletedAt,
+			"task":         task,
 		},
 		OccurredAt: time.Now(),
 		Version:    1,
 	}
 
-	if err := s.publishEvent(ctx, event); err != nil {
-		s.logger.Error("Failed to publish task completed event", zap.Error(err))
+	err = s.save(ctx, event, func(ctx context.Context) error {
+		if err := s.taskRepo.Update(ctx, task); err != nil {
+			return fmt.Errorf("completing task: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Clear cache
@@ -253,11 +349,6 @@ func (s *TaskService) DeleteTask(ctx context.Context, id types.UUID) error {
 		return fmt.Errorf("task not found: %w", err)
 	}
 
-	if err := s.taskRepo.Delete(ctx, id); err != nil {
-		return fmt.Errorf("deleting task: %w", err)
-	}
-
-	// Publish event
 	event := &domain.Event{
 		ID:          types.New(),
 		Type:        "task.deleted",
@@ -269,8 +360,14 @@ func (s *TaskService) DeleteTask(ctx context.Context, id types.UUID) error {
 		Version:    1,
 	}
 
-	if err := s.publishEvent(ctx, event); err != nil {
-		s.logger.Error("Failed to publish task deleted event", zap.Error(err))
+	err := s.save(ctx, event, func(ctx context.Context) error {
+		if err := s.taskRepo.Delete(ctx, id); err != nil {
+			return fmt.Errorf("deleting task: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	// Clear cache
@@ -291,6 +388,34 @@ func (s *TaskService) GetTasksByAssignee(ctx context.Context, assigneeID types.U
 	return s.taskRepo.GetByAssignee(ctx, assigneeID)
 }
 
+// save runs mutate and records event for the tenant ctx acts for. With a transactor both commit or
+// neither does, and the outbox relay publishes the event; otherwise the
+// event is published after mutate succeeds, and a failure to publish it is
+// only logged.
+func (s *TaskService) save(ctx context.Context, event *domain.Event, mutate func(ctx context.Context) error) error {
+	event.TenantID = tenant.ID(ctx)
+
+	if s.tx != nil {
+		return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+			if err := mutate(ctx); err != nil {
+				return err
+			}
+			if err := s.eventRepo.Store(ctx, event); err != nil {
+				return fmt.Errorf("storing event: %w", err)
+			}
+			return nil
+		})
+	}
+
+	if err := mutate(ctx); err != nil {
+		return err
+	}
+	if err := s.publishEvent(ctx, event); err != nil {
+		s.logger.Error("Failed to publish task event", zap.String("event_type", event.Type), zap.Error(err))
+	}
+	return nil
+}
+
 // publishEvent publishes an event to the event store and event bus
 func (s *TaskService) publishEvent(ctx context.Context, event *domain.Event) error {
 	// Store in event store
@@ -306,6 +431,16 @@ func (s *TaskService) publishEvent(ctx context.Context, event *domain.Event) err
 	return nil
 }
 
+// runPreSave runs the pre-save hooks on task
+func (s *TaskService) runPreSave(ctx context.Context, task *domain.Task) error {
+	for _, hook := range s.preSave {
+		if err := hook(ctx, task); err != nil {
+			return fmt.Errorf("pre-save hook: %w", err)
+		}
+	}
+	return nil
+}
+
 // invalidateTaskCache clears task-related cache entries
 func (s *TaskService) invalidateTaskCache(_ context.Context) {
 	// Implementation would depend on cache invalidation strategy
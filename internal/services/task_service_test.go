@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -403,6 +404,31 @@ func TestTaskService_UpdateTask_TaskNotFound(t *testing.T) {
 	taskRepo.AssertExpectations(t)
 }
 
+func TestTaskService_UnassignTask(t *testing.T) {
+	service, taskRepo, _, eventRepo, _, eventBus := createTestTaskService()
+
+	existingTask := createTestTask()
+	assignee := createTestUser()
+	existingTask.AssigneeID = &assignee.ID
+
+	ctx := context.Background()
+
+	taskRepo.On("GetByID", ctx, existingTask.ID).Return(existingTask, nil)
+	taskRepo.On("Update", ctx, mock.AnythingOfType("*domain.Task")).Return(nil)
+	eventRepo.On("Store", ctx, mock.AnythingOfType("*domain.Event")).Return(nil)
+	eventBus.On("Publish", ctx, mock.MatchedBy(func(event *domain.Event) bool {
+		return event.Type == "task.updated" && event.AggregateID == existingTask.ID
+	})).Return(nil)
+
+	result, err := service.UnassignTask(ctx, existingTask.ID)
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.AssigneeID)
+
+	taskRepo.AssertExpectations(t)
+	eventBus.AssertExpectations(t)
+}
+
 func TestCreateTaskRequest_Validate_Success(t *testing.T) {
 	req := CreateTaskRequest{
 		Title:     "Valid Task",
@@ -434,3 +460,128 @@ func TestCreateTaskRequest_Validate_EmptyCreatedBy(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "created_by is required")
 }
+
+func TestTaskService_PreSaveHook(t *testing.T) {
+	taskRepo := &mockTaskRepository{}
+	userRepo := &mockUserRepository{}
+	eventRepo := &mockEventRepository{}
+	eventBus := &mockEventBus{}
+	mask := WithPreSaveHook(func(_ context.Context, task *domain.Task) error {
+		task.Description = strings.ReplaceAll(task.Description, "ana@example.com", "[email]")
+		return nil
+	})
+	service := NewTaskService(taskRepo, userRepo, eventRepo, &mockCacheRepository{}, zap.NewNop(), eventBus, mask)
+
+	creator := createTestUser()
+	ctx := context.Background()
+	userRepo.On("GetByID", ctx, creator.ID).Return(creator, nil)
+	taskRepo.On("Create", ctx, mock.MatchedBy(func(task *domain.Task) bool {
+		return task.Description == "Responder [email]"
+	})).Return(nil)
+	eventRepo.On("Store", ctx, mock.AnythingOfType("*domain.Event")).Return(nil)
+	eventBus.On("Publish", ctx, mock.AnythingOfType("*domain.Event")).Return(nil).Once()
+
+	created, err := service.CreateTask(ctx, CreateTaskRequest{
+		Title:       "Contato",
+		Description: "Responder ana@example.com",
+		Priority:    domain.PriorityLow,
+		CreatedBy:   creator.ID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Responder [email]", created.Description)
+
+	// Update events report the masked changes
+	description := "Novo contato ana@example.com"
+	taskRepo.On("GetByID", ctx, created.ID).Return(created, nil)
+	taskRepo.On("Update", ctx, mock.AnythingOfType("*domain.Task")).Return(nil)
+	eventBus.On("Publish", ctx, mock.MatchedBy(func(event *domain.Event) bool {
+		if event.Type != "task.updated" {
+			return false
+		}
+		changes := event.Data["changes"].(UpdateTaskRequest)
+		return *changes.Description == "Novo contato [email]"
+	})).Return(nil).Once()
+
+	updated, err := service.UpdateTask(ctx, created.ID, UpdateTaskRequest{Description: &description})
+	assert.NoError(t, err)
+	assert.Equal(t, "Novo contato [email]", updated.Description)
+
+	taskRepo.AssertExpectations(t)
+	eventBus.AssertExpectations(t)
+}
+
+func TestTaskService_PreSaveHookError(t *testing.T) {
+	taskRepo := &mockTaskRepository{}
+	userRepo := &mockUserRepository{}
+	reject := WithPreSaveHook(func(context.Context, *domain.Task) error {
+		return errors.New("masking failed")
+	})
+	service := NewTaskService(taskRepo, userRepo, &mockEventRepository{}, &mockCacheRepository{}, zap.NewNop(), &mockEventBus{}, reject)
+
+	creator := createTestUser()
+	ctx := context.Background()
+	userRepo.On("GetByID", ctx, creator.ID).Return(creator, nil)
+
+	result, err := service.CreateTask(ctx, CreateTaskRequest{
+		Title:     "Contato",
+		Priority:  domain.PriorityLow,
+		CreatedBy: creator.ID,
+	})
+	assert.Nil(t, result)
+	assert.ErrorContains(t, err, "masking failed")
+	taskRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+type txKey struct{}
+
+// fakeTransactor marks the context it hands to fn and counts the outcomes
+type fakeTransactor struct {
+	committed, rolledBack int
+}
+
+func (f *fakeTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(context.WithValue(ctx, txKey{}, true)); err != nil {
+		f.rolledBack++
+		return err
+	}
+	f.committed++
+	return nil
+}
+
+var inTx = mock.MatchedBy(func(ctx context.Context) bool { return ctx.Value(txKey{}) != nil })
+
+func TestTaskService_Outbox(t *testing.T) {
+	taskRepo := &mockTaskRepository{}
+	userRepo := &mockUserRepository{}
+	eventRepo := &mockEventRepository{}
+	eventBus := &mockEventBus{}
+	tx := &fakeTransactor{}
+	service := NewTaskService(taskRepo, userRepo, eventRepo, &mockCacheRepository{}, zap.NewNop(), eventBus, WithTransactor(tx))
+
+	creator := createTestUser()
+	ctx := context.Background()
+	userRepo.On("GetByID", ctx, creator.ID).Return(creator, nil)
+	taskRepo.On("Create", inTx, mock.AnythingOfType("*domain.Task")).Return(nil)
+	eventRepo.On("Store", inTx, mock.MatchedBy(func(event *domain.Event) bool {
+		return event.Type == "task.created"
+	})).Return(nil).Once()
+
+	created, err := service.CreateTask(ctx, CreateTaskRequest{Title: "Outbox", Priority: domain.PriorityLow, CreatedBy: creator.ID})
+	assert.NoError(t, err)
+	assert.NotNil(t, created)
+	assert.Equal(t, 1, tx.committed)
+	eventBus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+
+	// The task change is rolled back with an event that cannot be stored
+	taskRepo.On("GetByID", ctx, created.ID).Return(created, nil)
+	taskRepo.On("Delete", inTx, created.ID).Return(nil)
+	eventRepo.On("Store", inTx, mock.AnythingOfType("*domain.Event")).Return(errors.New("connection reset"))
+
+	err = service.DeleteTask(ctx, created.ID)
+	assert.ErrorContains(t, err, "storing event: connection reset")
+	assert.Equal(t, 1, tx.rolledBack)
+	eventBus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+
+	taskRepo.AssertExpectations(t)
+	eventRepo.AssertExpectations(t)
+}
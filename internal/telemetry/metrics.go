@@ -3,35 +3,11 @@ package telemetry
 
 import (
 	"net/http"
-	"time"
-
-	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promauto"
-)
-
-var (
-	RequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
-		Name:    "http_request_duration_seconds",
-		Help:    "Duration of HTTP requests",
-		Buckets: prometheus.DefBuckets,
-	}, []string{"path", "method", "status"})
 )
 
-type statusWriter struct {
-	http.ResponseWriter
-	status int
-}
-
-func (w *statusWriter) WriteHeader(code int) {
-	w.status = code
-	w.ResponseWriter.WriteHeader(code)
-}
-
+// Middleware records HTTP request metrics. It is HTTPMetrics under its
+// older name; both observe http_request_duration_seconds, which may only
+// be registered once.
 func Middleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		start := time.Now()
-		sw := &statusWriter{ResponseWriter: w, status: 200}
-		next.ServeHTTP(sw, r)
-		RequestDuration.WithLabelValues(r.URL.Path, r.Method, http.StatusText(sw.status)).Observe(time.Since(start).Seconds())
-	})
+	return HTTPMetrics(next)
 }
@@ -0,0 +1,95 @@
+// Package tenant carries the tenant a request acts for through its context.
+//
+// The auth middleware stores the tenant from the JWT claims; repositories,
+// the cache and the event bus read it back to scope every query, key and
+// subject to that tenant.
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+)
+
+// Default is the tenant of requests and rows without one
+const Default = "default"
+
+type contextKey int
+
+const (
+	idKey contextKey = iota
+	allKey
+)
+
+var validID = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// Validate checks that id can be used in cache keys and NATS subjects
+func Validate(id string) error {
+	if !validID.MatchString(id) {
+		return fmt.Errorf("invalid tenant id %q", id)
+	}
+	return nil
+}
+
+// WithID returns a copy of ctx acting for tenant id
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, idKey, id)
+}
+
+// ID returns the tenant ctx acts for, Default when there is none
+func ID(ctx context.Context) string {
+	if id, ok := ctx.Value(idKey).(string); ok && id != "" {
+		return id
+	}
+	return Default
+}
+
+// WithAll returns a copy of ctx acting for every tenant. It is meant for
+// system processes such as the outbox relay and data retention, never for
+// request contexts.
+func WithAll(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allKey, true)
+}
+
+// IsAll reports whether ctx acts for every tenant
+func IsAll(ctx context.Context) bool {
+	all, _ := ctx.Value(allKey).(bool)
+	return all
+}
+
+// Allows reports whether ctx may access data owned by tenantID
+func Allows(ctx context.Context, tenantID string) bool {
+	if IsAll(ctx) {
+		return true
+	}
+	if tenantID == "" {
+		tenantID = Default
+	}
+	return tenantID == ID(ctx)
+}
+
+// Owner returns the tenant a new row of ctx is stored for: tenantID when
+// set, the tenant of ctx otherwise. It fails when ctx may not write for
+// tenantID.
+func Owner(ctx context.Context, tenantID string) (string, error) {
+	if tenantID == "" {
+		return ID(ctx), nil
+	}
+	if !Allows(ctx, tenantID) {
+		return "", fmt.Errorf("tenant %s may not write for tenant %s", ID(ctx), tenantID)
+	}
+	return tenantID, nil
+}
+
+// Key scopes key to the tenant ctx acts for
+func Key(ctx context.Context, key string) string {
+	return ScopedKey(ID(ctx), key)
+}
+
+// ScopedKey scopes key to tenantID
+func ScopedKey(tenantID, key string) string {
+	if tenantID == "" {
+		tenantID = Default
+	}
+	return "tenant:" + tenantID + ":" + key
+}
@@ -0,0 +1,57 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestID(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, Default, ID(ctx))
+	assert.Equal(t, Default, ID(WithID(ctx, "")))
+	assert.Equal(t, "acme", ID(WithID(ctx, "acme")))
+}
+
+func TestAllows(t *testing.T) {
+	acme := WithID(context.Background(), "acme")
+
+	assert.True(t, Allows(acme, "acme"))
+	assert.False(t, Allows(acme, "globex"))
+	assert.False(t, Allows(acme, ""))
+	assert.True(t, Allows(context.Background(), ""))
+	assert.True(t, Allows(WithAll(acme), "globex"))
+}
+
+func TestOwner(t *testing.T) {
+	acme := WithID(context.Background(), "acme")
+
+	owner, err := Owner(acme, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "acme", owner)
+
+	_, err = Owner(acme, "globex")
+	assert.Error(t, err)
+
+	owner, err = Owner(WithAll(acme), "globex")
+	assert.NoError(t, err)
+	assert.Equal(t, "globex", owner)
+}
+
+func TestKey(t *testing.T) {
+	acme := WithID(context.Background(), "acme")
+
+	assert.Equal(t, "tenant:acme:task:1", Key(acme, "task:1"))
+	assert.Equal(t, "tenant:default:task:1", Key(context.Background(), "task:1"))
+	assert.Equal(t, "tenant:default:task:1", ScopedKey("", "task:1"))
+}
+
+func TestValidate(t *testing.T) {
+	for _, id := range []string{"acme", "tenant_1", "ACME-2"} {
+		assert.NoError(t, Validate(id), id)
+	}
+	for _, id := range []string{"", "a.b", "a*", "a>", "tenant 1", "a:b"} {
+		assert.Error(t, Validate(id), id)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -11,13 +12,33 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/yaml.v3"
 
+	compliancev1 "github.com/vertikon/mcp-ultra/api/grpc/gen/compliance/v1"
+	systemv1 "github.com/vertikon/mcp-ultra/api/grpc/gen/system/v1"
+	taskv1 "github.com/vertikon/mcp-ultra/api/grpc/gen/task/v1"
+	"github.com/vertikon/mcp-ultra/internal/ai/inference"
+	"github.com/vertikon/mcp-ultra/internal/ai/wiring"
+	"github.com/vertikon/mcp-ultra/internal/cache"
+	"github.com/vertikon/mcp-ultra/internal/compliance"
+	"github.com/vertikon/mcp-ultra/internal/compliance/datasources"
 	"github.com/vertikon/mcp-ultra/internal/config"
-	"github.com/vertikon/mcp-ultra/internal/handlers"
-	"github.com/vertikon/mcp-ultra/pkg/httpx"
-	"github.com/vertikon/mcp-ultra/pkg/metrics"
+	"github.com/vertikon/mcp-ultra/internal/domain"
+	"github.com/vertikon/mcp-ultra/internal/events"
+	grpcserver "github.com/vertikon/mcp-ultra/internal/grpc/server"
+	httphandlers "github.com/vertikon/mcp-ultra/internal/handlers/http"
+	"github.com/vertikon/mcp-ultra/internal/lifecycle"
+	"github.com/vertikon/mcp-ultra/internal/ratelimit"
+	"github.com/vertikon/mcp-ultra/internal/repository/memory"
+	"github.com/vertikon/mcp-ultra/internal/repository/postgres"
+	"github.com/vertikon/mcp-ultra/internal/services"
+	applog "github.com/vertikon/mcp-ultra/pkg/logger"
 )
 
+// logCaptureSize is how many recent log entries the admin API can return
+const logCaptureSize = 1000
+
 var (
 	Version   = "dev"
 	BuildDate = "unknown"
@@ -25,11 +46,27 @@ var (
 )
 
 func main() {
-	// Initialize logger
-	logger, err := zap.NewProduction()
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		err := runMigrate(ctx, os.Args[2:], os.Stdout)
+		stop()
+		if err != nil {
+			log.Fatalf("migrate: %v", err)
+		}
+		return
+	}
+
+	// Initialize logger, keeping recent entries in memory for the admin API.
+	// Its level follows telemetry.log_level, which can change at runtime.
+	logLevel := zap.NewAtomicLevel()
+	zapConfig := zap.NewProductionConfig()
+	zapConfig.Level = logLevel
+	baseLogger, err := zapConfig.Build()
 	if err != nil {
 		log.Fatalf("Failed to initialize logger: %v", err)
 	}
+	logCapture := applog.NewBroadcaster(logCaptureSize, zapcore.InfoLevel)
+	logger := logCapture.Tee(baseLogger)
 	defer func() {
 		if syncErr := logger.Sync(); syncErr != nil {
 			// Ignore sync errors on shutdown (common on Windows)
@@ -49,27 +86,95 @@ func main() {
 		logger.Fatal("Failed to load configuration", zap.Error(err))
 	}
 
-	// Initialize HTTP router
-	router := httpx.NewRouter()
+	configStore, err := config.NewStore(cfg, nil)
+	if err != nil {
+		logger.Fatal("Failed to initialize configuration store", zap.Error(err))
+	}
 
-	// Add middleware
-	router.Use(httpx.RequestID)
-	router.Use(httpx.RealIP)
-	router.Use(httpx.Logger)
-	router.Use(httpx.Recoverer)
-	router.Use(httpx.Timeout(60)) // Timeout in seconds
+	complianceFramework, closeComplianceDB, err := newComplianceFramework(cfg, logger)
+	if err != nil {
+		// The compliance API answers Unavailable until this is fixed
+		logger.Error("Failed to initialize compliance framework", zap.Error(err))
+	} else {
+		defer closeComplianceDB()
+		defer func() {
+			if err := complianceFramework.Close(); err != nil {
+				logger.Error("Failed to close compliance framework", zap.Error(err))
+			}
+		}()
+	}
 
-	// CORS configuration
-	router.Use(httpx.DefaultCORS())
+	// Build the repositories, messaging and flags from the config. The
+	// lifecycle manager starts them in priority order and stops them in
+	// reverse. Disabled backends, and NATS when unreachable, are replaced by
+	// in-process ones.
+	manager := lifecycle.NewManager(lifecycle.DefaultConfig(), applog.FromZap(logger), nil)
+	healthService := httphandlers.NewHealthService(Version, cfg.Environment, logger)
 
-	// Initialize health handler
-	healthHandler := handlers.NewHealthHandler()
+	// Circuit breakers fail calls to Redis and NATS fast while they are
+	// down; the system API lists and operates them
+	circuitBreakers := cache.NewCircuitBreakerRegistry()
+	redisBreaker := registerBreaker(circuitBreakers, "redis", "cache")
 
-	// Register routes
-	router.Get("/livez", healthHandler.Livez)
-	router.Get("/readyz", healthHandler.Readyz)
-	router.Get("/health", healthHandler.Health)
-	router.Method("GET", "/metrics", metrics.Handler())
+	repos, err := newRepositories(cfg, manager, healthService, redisBreaker, logger)
+	if err != nil {
+		logger.Fatal("Failed to initialize repositories", zap.Error(err))
+	}
+
+	// Initialize task service and its change feed
+	taskFeed := events.NewTaskFeed(events.DefaultTaskFeedCapacity, logger)
+	taskService, busChecker, err := newTaskService(cfg, repos, taskFeed, manager, healthService, circuitBreakers, logger, taskServiceOptions(cfg, complianceFramework)...)
+	if err != nil {
+		logger.Fatal("Failed to initialize task service", zap.Error(err))
+	}
+	flagManager := newFlagManager(repos, manager, logger)
+
+	if err := manager.Start(context.Background()); err != nil {
+		logger.Fatal("Failed to start components", zap.Error(err))
+	}
+
+	if complianceFramework != nil {
+		registerDataSources(complianceFramework, repos, taskService, logger)
+		stopRetention := startRetentionEnforcement(cfg, complianceFramework, repos, taskService, logger)
+		defer stopRetention()
+	}
+
+	// Initialize health monitoring; results are served over gRPC and HTTP
+	healthConfig := lifecycle.DefaultHealthConfig()
+	healthConfig.EnableHTTPEndpoint = false
+	healthConfig.EnablePersistence = false
+	healthMonitor := lifecycle.NewHealthMonitor(healthConfig, Version, applog.FromZap(logger))
+	healthMonitor.RegisterChecker(busChecker)
+	if err := healthMonitor.Start(); err != nil {
+		logger.Fatal("Failed to start health monitor", zap.Error(err))
+	}
+	defer func() { _ = healthMonitor.Stop() }()
+
+	// Bearer tokens carry the user and tenant of HTTP and gRPC requests
+	authService, err := newAuthService(cfg, logger)
+	if err != nil {
+		logger.Fatal("Failed to initialize authentication", zap.Error(err))
+	}
+
+	// AI inference answers 503 until feature_flags.json enables it; the
+	// flags and router rules are reloaded on change or SIGHUP
+	aiConfig, closeAI := newAIConfig(cfg, complianceFramework, circuitBreakers, logger)
+	defer closeAI()
+	aiService, err := wiring.Init(context.Background(), aiConfig)
+	if err != nil {
+		logger.Fatal("Failed to initialize AI", zap.Error(err))
+	}
+	defer aiService.Close()
+
+	// Initialize HTTP router with the health, metrics, task, feature flag
+	// and AI APIs
+	// Log level, flag refresh and rate limits follow config updates made
+	// over the admin API; other fields need a restart
+	limiter := ratelimit.NewTenantLimiter(0, 0)
+	watchConfig(configStore, logLevel, flagManager, limiter, logger)
+
+	router := newRouter(taskService, taskFeed, flagManager, healthService,
+		inference.NewHandler(aiService.Inference, logger).Routes(), authService, limiter, logger)
 
 	// Create HTTP server
 	server := &http.Server{
@@ -92,6 +197,31 @@ func main() {
 		}
 	}()
 
+	// Create gRPC server
+	grpcServer := newGRPCServer(cfg.GRPC, authService, logger)
+	taskv1.RegisterTaskServiceServer(grpcServer, grpcserver.NewTaskServer(taskService, taskFeed, logger))
+	systemv1.RegisterSystemServiceServer(grpcServer, grpcserver.NewSystemServer(
+		healthMonitor,
+		configStore,
+		circuitBreakers,
+		logCapture,
+		grpcserver.BuildInfo{
+			ServiceName: cfg.Telemetry.ServiceName,
+			Version:     Version,
+			BuildDate:   BuildDate,
+			GitCommit:   GitCommit,
+		},
+		logger,
+	))
+	compliancev1.RegisterComplianceServiceServer(grpcServer, grpcserver.NewComplianceServer(complianceFramework, logger))
+
+	// Start gRPC server in goroutine
+	go func() {
+		if err := grpcServer.ListenAndServe(); err != nil {
+			logger.Fatal("Failed to start gRPC server", zap.Error(err))
+		}
+	}()
+
 	// Wait for interrupt signal to gracefully shutdown the server
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -103,10 +233,219 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
+	// End open task streams so graceful shutdown does not wait on them
+	taskFeed.Close()
+
 	// Shutdown HTTP server
 	if err := server.Shutdown(ctx); err != nil {
 		logger.Error("Server forced to shutdown", zap.Error(err))
 	}
 
+	// Shutdown gRPC server
+	if err := grpcServer.Shutdown(ctx); err != nil {
+		logger.Error("gRPC server forced to shutdown", zap.Error(err))
+	}
+
+	// Stop the components once no request can reach them
+	if err := manager.Stop(ctx); err != nil {
+		logger.Error("Failed to stop components", zap.Error(err))
+	}
+
 	logger.Info("Server exited")
 }
+
+// newTaskService builds the task service over repos. Events go to NATS when
+// it is enabled and reachable and stay in-process otherwise. With an outbox
+// in repos, the service stores them in the task's transaction and a relay
+// publishes them once committed.
+// Either way the task feed receives every task event for streaming. The
+// returned checker reports on the event bus in use.
+func newTaskService(cfg *config.Config, repos repositories, feed *events.TaskFeed, manager *lifecycle.Manager, health *httphandlers.HealthService, breakers *cache.CircuitBreakerRegistry, logger *zap.Logger, opts ...services.TaskServiceOption) (*services.TaskService, lifecycle.HealthChecker, error) {
+	memBus := memory.NewEventBus()
+	memBus.Subscribe(func(ctx context.Context, event *domain.Event) {
+		if err := feed.Handle(ctx, event); err != nil {
+			logger.Debug("Task feed rejected event", zap.Error(err))
+		}
+	})
+	var bus services.EventBus = memBus
+	checker := lifecycle.NewFuncHealthChecker("event_bus", false, time.Second, func(context.Context) error {
+		return nil
+	})
+
+	var natsBus *events.NATSEventBus
+	if cfg.NATS.Enabled {
+		natsBus = connectNATS(cfg, feed, breakers, logger)
+	} else {
+		logger.Info("NATS disabled, task events stay in-process")
+	}
+	if natsBus != nil {
+		bus = natsBus
+		checkNATS := func(context.Context) error {
+			if !natsBus.IsConnected() {
+				return errors.New("NATS disconnected")
+			}
+			return nil
+		}
+		checker = lifecycle.NewFuncHealthChecker("event_bus", false, time.Second, checkNATS)
+		manager.RegisterComponent(&component{
+			name:     "nats",
+			priority: priorityMessaging,
+			stop:     closer(natsBus.Close),
+			check:    checkNATS,
+		})
+		health.RegisterChecker("nats", httphandlers.NewNATSHealthChecker(natsBus.IsConnected))
+	}
+
+	if repos.tx != nil {
+		if err := newOutboxRelay(cfg, repos, natsBus, memBus, manager, logger); err != nil {
+			return nil, nil, fmt.Errorf("creating outbox relay: %w", err)
+		}
+		opts = append(opts, services.WithTransactor(repos.tx))
+	}
+
+	taskService := services.NewTaskService(
+		repos.tasks,
+		repos.users,
+		repos.events,
+		repos.cache,
+		logger,
+		bus,
+		opts...,
+	)
+	return taskService, checker, nil
+}
+
+// connectNATS connects to NATS, guarding publishes with a "nats" breaker,
+// and subscribes feed to task events. It returns nil when either fails,
+// leaving events in-process.
+func connectNATS(cfg *config.Config, feed *events.TaskFeed, breakers *cache.CircuitBreakerRegistry, logger *zap.Logger) *events.NATSEventBus {
+	natsBus, err := events.NewNATSEventBus(cfg.NATS.URL, logger, events.WithBreaker(registerBreaker(breakers, "nats", "events")))
+	if err != nil {
+		logger.Warn("NATS unavailable, task events stay in-process", zap.Error(err))
+		return nil
+	}
+	if _, err := natsBus.Subscribe("task.*", feed); err != nil {
+		logger.Warn("Task feed subscription failed, task events stay in-process", zap.Error(err))
+		closeNATS(natsBus, logger)
+		return nil
+	}
+	return natsBus
+}
+
+// taskServiceOptions masks PII in tasks before they are saved when the
+// compliance config asks for it
+func taskServiceOptions(cfg *config.Config, framework *compliance.Framework) []services.TaskServiceOption {
+	if framework == nil || !cfg.Compliance.Enabled || !cfg.Compliance.PIIDetection.MaskOnSave {
+		return nil
+	}
+	return []services.TaskServiceOption{
+		services.WithPreSaveHook(func(ctx context.Context, task *domain.Task) error {
+			_, err := framework.MaskPII(ctx, task)
+			return err
+		}),
+	}
+}
+
+// newComplianceFramework builds the compliance framework from the service
+// config. config.ComplianceConfig mirrors compliance.Config field for field,
+// so the conversion goes through their shared YAML layout. The returned
+// function closes the database connection opened for postgres stores.
+func newComplianceFramework(cfg *config.Config, logger *zap.Logger) (*compliance.Framework, func(), error) {
+	raw, err := yaml.Marshal(cfg.Compliance)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encoding compliance config: %w", err)
+	}
+	var frameworkConfig compliance.Config
+	if err := yaml.Unmarshal(raw, &frameworkConfig); err != nil {
+		return nil, nil, fmt.Errorf("decoding compliance config: %w", err)
+	}
+
+	// Connect to PostgreSQL only when a compliance store lives there
+	var opts []compliance.Option
+	closeDB := func() {}
+	if compliancePostgresStores(frameworkConfig) {
+		db, err := postgres.Connect(cfg.Database.PostgreSQL)
+		if err != nil {
+			return nil, nil, fmt.Errorf("connecting to the compliance database: %w", err)
+		}
+		opts = append(opts, compliance.WithDatabase(db))
+		closeDB = func() {
+			if err := db.Close(); err != nil {
+				logger.Error("Failed to close compliance database", zap.Error(err))
+			}
+		}
+	}
+
+	framework, err := compliance.NewFramework(frameworkConfig, logger, opts...)
+	if err != nil {
+		closeDB()
+		return nil, nil, err
+	}
+	return framework, closeDB, nil
+}
+
+// compliancePostgresStores reports whether any compliance store is
+// configured as "postgres"
+func compliancePostgresStores(cfg compliance.Config) bool {
+	return cfg.Enabled &&
+		((cfg.AuditLogging.Enabled && cfg.AuditLogging.Store == compliance.AuditStorePostgres) ||
+			cfg.Consent.Store == compliance.RepositoryPostgres ||
+			cfg.DataRetention.Store == compliance.RepositoryPostgres ||
+			cfg.DataRights.Store == compliance.RepositoryPostgres)
+}
+
+// registerDataSources lets data subject rights requests reach every
+// repository holding personal data. Tasks are erased through taskService
+// so their events are published.
+func registerDataSources(framework *compliance.Framework, repos repositories, taskService *services.TaskService, logger *zap.Logger) {
+	for _, source := range datasources.Sources(repos.tasks, taskService, repos.users, repos.events, repos.cache) {
+		if err := framework.RegisterDataSource(source); err != nil {
+			logger.Error("Failed to register data source", zap.String("source", source.Name()), zap.Error(err))
+		}
+	}
+}
+
+// startRetentionEnforcement registers the repositories as retention
+// targets and, when auto delete is on, runs enforcement as a scheduled
+// lifecycle operation. The returned function stops the schedule.
+func startRetentionEnforcement(cfg *config.Config, framework *compliance.Framework, repos repositories, taskService *services.TaskService, logger *zap.Logger) func() {
+	for _, target := range datasources.RetentionTargets(repos.tasks, taskService, repos.events, repos.cache) {
+		if err := framework.RegisterRetentionTarget(target); err != nil {
+			logger.Error("Failed to register retention target", zap.String("target", target.Name()), zap.Error(err))
+		}
+	}
+
+	retention := cfg.Compliance.DataRetention
+	if !cfg.Compliance.Enabled || !retention.Enabled || !retention.AutoDelete {
+		return func() {}
+	}
+
+	interval := retention.EnforcementInterval
+	if interval <= 0 {
+		interval = 24 * time.Hour
+	}
+
+	operations := lifecycle.NewOperationsManager(lifecycle.DefaultOperationsConfig(), applog.FromZap(logger))
+	operations.RegisterExecutor(lifecycle.OperationRetention, compliance.NewRetentionOperation(framework))
+	if err := operations.ScheduleOperation(
+		lifecycle.OperationRetention,
+		"retention-enforcement",
+		"Delete, anonymize or archive data whose retention period ended",
+		nil,
+		interval,
+	); err != nil {
+		logger.Error("Failed to schedule retention enforcement", zap.Error(err))
+		return func() {}
+	}
+	if err := operations.Start(); err != nil {
+		logger.Error("Failed to start retention enforcement", zap.Error(err))
+		return func() {}
+	}
+	return func() { _ = operations.Stop() }
+}
+
+func closeNATS(bus *events.NATSEventBus, logger *zap.Logger) {
+	if err := bus.Close(); err != nil {
+		logger.Error("Failed to close NATS connection", zap.Error(err))
+	}
+}
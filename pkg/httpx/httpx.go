@@ -211,3 +211,9 @@ func (w *wrapResponseWriter) Status() int {
 func (w *wrapResponseWriter) BytesWritten() int {
 	return w.bytesWritten
 }
+
+// Unwrap returns the underlying ResponseWriter so http.ResponseController
+// can reach Flush and deadline support, e.g. for streaming responses.
+func (w *wrapResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,182 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// broadcastSubscriberBuffer bounds how many entries a subscriber may lag
+// behind; further entries are dropped for that subscriber only
+const broadcastSubscriberBuffer = 256
+
+// Entry is a structured log record captured by a Broadcaster
+type Entry struct {
+	Time    time.Time
+	Level   zapcore.Level
+	Logger  string
+	Message string
+	Caller  string
+	Fields  map[string]string
+}
+
+// Broadcaster keeps the most recent log entries in memory and fans new ones
+// out to subscribers, e.g. to serve logs over an admin API. Attach it to a
+// zap logger with Tee.
+type Broadcaster struct {
+	mu          sync.RWMutex
+	level       zapcore.LevelEnabler
+	entries     []Entry
+	next        int
+	full        bool
+	subscribers map[chan Entry]struct{}
+}
+
+// NewBroadcaster creates a broadcaster retaining up to capacity entries at
+// or above level
+func NewBroadcaster(capacity int, level zapcore.LevelEnabler) *Broadcaster {
+	if capacity <= 0 {
+		capacity = 1000
+	}
+	return &Broadcaster{
+		level:       level,
+		entries:     make([]Entry, capacity),
+		subscribers: make(map[chan Entry]struct{}),
+	}
+}
+
+// Tee returns a copy of l that also writes to the broadcaster
+func (b *Broadcaster) Tee(l *zap.Logger) *zap.Logger {
+	return l.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return zapcore.NewTee(core, b.Core())
+	}))
+}
+
+// Core returns a zapcore.Core writing into the broadcaster
+func (b *Broadcaster) Core() zapcore.Core {
+	return &broadcastCore{broadcaster: b}
+}
+
+// Recent returns up to limit retained entries, oldest first. limit <= 0
+// returns everything retained.
+func (b *Broadcaster) Recent(limit int) []Entry {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.recent(limit)
+}
+
+// Subscribe returns up to backlog retained entries, a channel receiving every
+// entry logged after them and a function that ends the subscription.
+func (b *Broadcaster) Subscribe(backlog int) ([]Entry, <-chan Entry, func()) {
+	ch := make(chan Entry, broadcastSubscriberBuffer)
+
+	b.mu.Lock()
+	var recent []Entry
+	if backlog > 0 {
+		recent = b.recent(backlog)
+	}
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return recent, ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, ch)
+			b.mu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// recent implements Recent. Caller holds mu.
+func (b *Broadcaster) recent(limit int) []Entry {
+	var ordered []Entry
+	if b.full {
+		ordered = append(ordered, b.entries[b.next:]...)
+	}
+	ordered = append(ordered, b.entries[:b.next]...)
+
+	if limit > 0 && len(ordered) > limit {
+		ordered = ordered[len(ordered)-limit:]
+	}
+	return ordered
+}
+
+func (b *Broadcaster) publish(e Entry) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.entries[b.next] = e
+	b.next = (b.next + 1) % len(b.entries)
+	if b.next == 0 {
+		b.full = true
+	}
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+// broadcastCore adapts a Broadcaster to zapcore.Core
+type broadcastCore struct {
+	broadcaster *Broadcaster
+	fields      []zapcore.Field
+}
+
+func (c *broadcastCore) Enabled(level zapcore.Level) bool {
+	return c.broadcaster.level.Enabled(level)
+}
+
+func (c *broadcastCore) With(fields []zapcore.Field) zapcore.Core {
+	merged := make([]zapcore.Field, 0, len(c.fields)+len(fields))
+	merged = append(merged, c.fields...)
+	merged = append(merged, fields...)
+	return &broadcastCore{broadcaster: c.broadcaster, fields: merged}
+}
+
+func (c *broadcastCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *broadcastCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	enc := zapcore.NewMapObjectEncoder()
+	for _, f := range c.fields {
+		f.AddTo(enc)
+	}
+	for _, f := range fields {
+		f.AddTo(enc)
+	}
+
+	flat := make(map[string]string, len(enc.Fields))
+	for k, v := range enc.Fields {
+		flat[k] = fmt.Sprint(v)
+	}
+
+	e := Entry{
+		Time:    entry.Time,
+		Level:   entry.Level,
+		Logger:  entry.LoggerName,
+		Message: entry.Message,
+		Fields:  flat,
+	}
+	if entry.Caller.Defined {
+		e.Caller = entry.Caller.TrimmedPath()
+	}
+
+	c.broadcaster.publish(e)
+	return nil
+}
+
+func (c *broadcastCore) Sync() error {
+	return nil
+}
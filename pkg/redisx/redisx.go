@@ -17,6 +17,9 @@ var ErrKeyNotFound = errors.New("redis: key not found")
 // Options is an alias for redis.Options for convenience.
 type Options = redis.Options
 
+// Hook is an alias for redis.Hook, which intercepts the client's commands.
+type Hook = redis.Hook
+
 // Client wraps redis.Client and provides a cleaner API.
 type Client struct {
 	client *redis.Client
@@ -44,6 +47,12 @@ func (c *Client) Ping(ctx context.Context) error {
 	return c.client.Ping(ctx).Err()
 }
 
+// AddHook intercepts the client's commands with hook, e.g. a circuit
+// breaker. Add hooks before the client is used.
+func (c *Client) AddHook(hook Hook) {
+	c.client.AddHook(hook)
+}
+
 // Close closes the Redis connection.
 func (c *Client) Close() error {
 	return c.client.Close()
@@ -215,3 +224,23 @@ func (c *Client) FlushDB(ctx context.Context) error {
 func (c *Client) FlushAll(ctx context.Context) error {
 	return c.client.FlushAll(ctx).Err()
 }
+
+// Script is a Lua script executed atomically on the server.
+type Script struct {
+	script *redis.Script
+}
+
+// NewScript creates a script from its Lua source.
+func NewScript(src string) *Script {
+	return &Script{script: redis.NewScript(src)}
+}
+
+// Run executes the script with EVALSHA, loading it first if the server
+// does not have it cached. A nil reply is returned as ErrKeyNotFound.
+func (s *Script) Run(ctx context.Context, c *Client, keys []string, args ...interface{}) (interface{}, error) {
+	val, err := s.script.Run(ctx, c.client, keys, args...).Result()
+	if err == redis.Nil {
+		return nil, ErrKeyNotFound
+	}
+	return val, err
+}